@@ -17,6 +17,7 @@ import (
 	debugconsole "github.com/Versifine/locus/internal/debug"
 	"github.com/Versifine/locus/internal/llm"
 	"github.com/Versifine/locus/internal/logger"
+	"github.com/Versifine/locus/internal/protocol"
 	"github.com/Versifine/locus/internal/skill"
 	"github.com/Versifine/locus/internal/skill/behaviors"
 	"github.com/Versifine/locus/internal/world"
@@ -25,6 +26,13 @@ import (
 const physicsTickInterval = 50 * time.Millisecond
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "ping" {
+		os.Exit(runPing(os.Args[2:], os.Stdout, os.Stderr))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		os.Exit(runReplay(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	cfg, err := config.Load("configs/config.yaml")
 	if err != nil {
 		slog.Error("Failed to load config", "error", err)
@@ -54,10 +62,41 @@ func main() {
 }
 
 func startBot(ctx context.Context, cfg *config.Config) error {
-	b := bot.NewBot(
-		fmt.Sprintf("%s:%d", cfg.Backend.Host, cfg.Backend.Port),
-		cfg.Bot.Username,
-	)
+	serverAddr := fmt.Sprintf("%s:%d", cfg.Backend.Host, cfg.Backend.Port)
+	version, err := selectServerVersion(ctx, serverAddr, cfg.Bot)
+	if err != nil {
+		return err
+	}
+	b := bot.NewBot(serverAddr, cfg.Bot.Username)
+	if err := b.SetVersion(version); err != nil {
+		return err
+	}
+	if cfg.Bot.CaptureFile != "" {
+		capture, err := b.StartCapture(cfg.Bot.CaptureFile)
+		if err != nil {
+			return fmt.Errorf("start packet capture: %w", err)
+		}
+		defer capture.Close()
+	}
+	if cfg.Bot.ChunkCacheDir != "" {
+		chunkCache, err := b.OpenChunkCache(cfg.Bot.ChunkCacheDir)
+		if err != nil {
+			return fmt.Errorf("open chunk cache: %w", err)
+		}
+		defer func() {
+			if err := chunkCache.Close(); err != nil {
+				slog.Warn("Failed to save chunk cache", "error", err)
+			}
+		}()
+	}
+	b.SetManualRespawn(cfg.Bot.ManualRespawn)
+	if cfg.Bot.Auth.AccessToken != "" {
+		b.SetAuthenticator(&bot.MojangSessionAuthenticator{
+			AccessToken: cfg.Bot.Auth.AccessToken,
+			ProfileID:   cfg.Bot.Auth.ProfileID,
+			Endpoint:    cfg.Bot.Auth.SessionEndpoint,
+		})
+	}
 	llmClient := llm.NewLLMClient(&cfg.LLM)
 
 	runCtx, cancelRun := context.WithCancel(ctx)
@@ -65,7 +104,12 @@ func startBot(ctx context.Context, cfg *config.Config) error {
 
 	botErrCh := make(chan error, 1)
 	go func() {
-		err := b.Start(runCtx)
+		var err error
+		if cfg.Bot.Reconnect.Disabled {
+			err = b.Start(runCtx)
+		} else {
+			err = b.RunWithReconnect(runCtx, reconnectPolicy(cfg.Bot.Reconnect))
+		}
 		botErrCh <- err
 		cancelRun()
 	}()
@@ -101,6 +145,7 @@ func startBot(ctx context.Context, cfg *config.Config) error {
 		}
 
 		runner := skill.NewBehaviorRunner(b.SendMsgToServer, b.GetState, b)
+		runner.SetCrafter(b)
 		idle := behaviors.IdleSpec(0)
 		if ok := runner.Start(idle.Name, idle.Fn, idle.Channels, idle.Priority); !ok {
 			slog.Warn("Failed to start idle behavior")
@@ -116,6 +161,8 @@ func startBot(ctx context.Context, cfg *config.Config) error {
 			b,
 			agent.DefaultCamera(),
 		)
+		loopAgent.SetInventory(b)
+		loopAgent.SetContainers(b)
 
 		slog.Info("Agent loop enabled")
 		if err := loopAgent.Start(runCtx); err != nil && runCtx.Err() == nil {
@@ -123,13 +170,56 @@ func startBot(ctx context.Context, cfg *config.Config) error {
 		}
 	}()
 
-	err := <-botErrCh
+	err = <-botErrCh
 	if err != nil && ctx.Err() == nil && runCtx.Err() == nil {
 		return err
 	}
 	return nil
 }
 
+// selectServerVersion picks the protocol version to log in with: the one named
+// in the config, or the one the server reports in its status ping. When the
+// ping fails or the server runs an unknown version, it falls back to the
+// built-in version and leaves the error for login to report.
+func selectServerVersion(ctx context.Context, addr string, cfg config.BotConfig) (*protocol.Version, error) {
+	root := cfg.DataDir
+	if root == "" {
+		root = protocol.DefaultDataRoot()
+	}
+	reg, err := protocol.DiscoverVersions(root)
+	if err != nil {
+		return nil, err
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, defaultPingTimeout)
+	defer cancel()
+	v, err := bot.SelectVersion(pingCtx, addr, reg, cfg.Version)
+	if err != nil {
+		if cfg.Version != "" && cfg.Version != "auto" {
+			return nil, err
+		}
+		fallback := protocol.DefaultVersion()
+		slog.Warn("Server version detection failed, using built-in version",
+			"address", addr, "version", fallback.String(), "error", err)
+		return fallback, nil
+	}
+	slog.Info("Selected protocol version", "version", v.String(), "data_dir", v.DataDir)
+	return v, nil
+}
+
+func reconnectPolicy(cfg config.ReconnectConfig) bot.ReconnectPolicy {
+	policy := bot.DefaultReconnectPolicy()
+	if cfg.InitialDelayMs > 0 {
+		policy.InitialDelay = time.Duration(cfg.InitialDelayMs) * time.Millisecond
+	}
+	if cfg.MaxDelayMs > 0 {
+		policy.MaxDelay = time.Duration(cfg.MaxDelayMs) * time.Millisecond
+	}
+	if cfg.MaxAttempts > 0 {
+		policy.MaxAttempts = cfg.MaxAttempts
+	}
+	return policy
+}
+
 func runIdleBodyLoop(ctx context.Context, b *body.Body, stateProvider interface{ GetState() world.Snapshot }) {
 	ticker := time.NewTicker(physicsTickInterval)
 	defer ticker.Stop()
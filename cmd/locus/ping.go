@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Versifine/locus/internal/bot"
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+const defaultPingTimeout = 5 * time.Second
+
+// runPing implements `locus ping host[:port]`.
+func runPing(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("ping", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	timeout := fs.Duration("timeout", defaultPingTimeout, "connect and read timeout")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: locus ping [-timeout 5s] host[:port]")
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	status, err := bot.PingServer(ctx, fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "ping %s: %v\n", fs.Arg(0), err)
+		return 1
+	}
+	reg, err := protocol.DiscoverVersions(protocol.DefaultDataRoot())
+	if err != nil {
+		reg = protocol.NewVersionRegistry(protocol.DefaultVersion())
+	}
+	printStatus(stdout, status, reg)
+	return 0
+}
+
+func printStatus(w io.Writer, status *bot.ServerStatus, reg *protocol.VersionRegistry) {
+	resp := status.Response
+	compat := "compatible"
+	if !status.Compatible(reg) {
+		known := make([]string, 0)
+		for _, v := range reg.Versions() {
+			known = append(known, fmt.Sprint(v.Protocol))
+		}
+		compat = fmt.Sprintf("incompatible, locus speaks %s", strings.Join(known, ", "))
+	}
+	fmt.Fprintf(w, "Server:  %s\n", status.Address)
+	fmt.Fprintf(w, "Version: %s (protocol %d, %s)\n", resp.Version.Name, resp.Version.Protocol, compat)
+
+	players := fmt.Sprintf("%d/%d", resp.Players.Online, resp.Players.Max)
+	if len(resp.Players.Sample) > 0 {
+		names := make([]string, 0, len(resp.Players.Sample))
+		for _, p := range resp.Players.Sample {
+			names = append(names, p.Name)
+		}
+		players += " " + strings.Join(names, ", ")
+	}
+	fmt.Fprintf(w, "Players: %s\n", players)
+	fmt.Fprintf(w, "MOTD:    %s\n", strings.ReplaceAll(resp.Description, "\n", " / "))
+	fmt.Fprintf(w, "Latency: %s\n", status.Latency.Round(time.Millisecond))
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Versifine/locus/internal/bot"
+)
+
+// runReplay implements `locus replay capture.lcap`: it rebuilds the world
+// from a packet capture and prints what the bot ended up knowing.
+func runReplay(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	username := fs.String("username", "Locus", "bot username used while replaying")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: locus replay [-username name] capture.lcap")
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "replay: %v\n", err)
+		return 1
+	}
+	defer f.Close()
+
+	b := bot.NewBot("replay", *username)
+	stats, err := b.Replay(context.Background(), f)
+	if stats != nil {
+		fmt.Fprintf(stdout, "Packets:  %d in, %d out\n", stats.Inbound, stats.Outbound)
+		fmt.Fprintf(stdout, "Sessions: %d (%d disconnects)\n", stats.Sessions, len(stats.Disconnects))
+		for _, d := range stats.Disconnects {
+			fmt.Fprintf(stdout, "  %v\n", d)
+		}
+		fmt.Fprintf(stdout, "Chunks:   %d loaded\n", stats.LoadedChunks)
+		fmt.Fprintf(stdout, "State:    %s\n", b.GetState())
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "replay: %v\n", err)
+		return 1
+	}
+	return 0
+}
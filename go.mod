@@ -2,9 +2,9 @@ module github.com/Versifine/locus
 
 go 1.25.4
 
-require gopkg.in/yaml.v3 v3.0.1
-
 require (
-	golang.org/x/sys v0.30.0 // indirect
-	golang.org/x/term v0.29.0 // indirect
+	golang.org/x/term v0.29.0
+	gopkg.in/yaml.v3 v3.0.1
 )
+
+require golang.org/x/sys v0.30.0 // indirect
@@ -35,6 +35,16 @@ type MessageSender interface {
 	SendMsgToServer(message string) error
 }
 
+// WhisperSender is implemented by senders that can reply privately with /msg.
+type WhisperSender interface {
+	SendWhisper(target, message string) error
+}
+
+// Respawner is implemented by senders that can respawn the bot after death.
+type Respawner interface {
+	Respawn() error
+}
+
 type StateProvider interface {
 	GetState() world.Snapshot
 }
@@ -189,13 +199,23 @@ func (a *Agent) handleSourcePlayer(evt *event.ChatEvent) {
 			continue
 		}
 		for _, l := range SplitByRunes(line, 250) {
-			if err := a.sender.SendMsgToServer(l); err != nil {
+			if err := a.reply(evt, l); err != nil {
 				slog.Error("Failed to send message to server", "error", err)
 			}
 		}
 	}
 }
 
+// reply answers whispers privately and everything else in public chat.
+func (a *Agent) reply(evt *event.ChatEvent, line string) error {
+	if evt.Source == event.SourceWhisper {
+		if whisper, ok := a.sender.(WhisperSender); ok {
+			return whisper.SendWhisper(evt.Username, line)
+		}
+	}
+	return a.sender.SendMsgToServer(line)
+}
+
 func SplitByRunes(s string, limit int) []string {
 	if limit <= 0 {
 		return nil
@@ -228,7 +248,7 @@ func (a *Agent) ChatEventHandler(raw any) {
 
 	slog.Info("Chat event", "username", chatEvent.Username, "uuid", chatEvent.UUID.String(), "message", chatEvent.Message, "source", chatEvent.Source.String())
 	switch chatEvent.Source {
-	case event.SourcePlayer:
+	case event.SourcePlayer, event.SourceWhisper:
 		go a.handleSourcePlayer(chatEvent)
 	case event.SourceSystem:
 		// reserved for system messages
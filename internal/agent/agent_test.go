@@ -287,3 +287,46 @@ func TestHandleSourcePlayer_StripsMemoryTagAndStoresSummary(t *testing.T) {
 		t.Fatalf("last message role = %q, want user", requestPayload.Messages[len(requestPayload.Messages)-1].Role)
 	}
 }
+
+type mockWhisperSender struct {
+	mockSender
+	whispers []string
+}
+
+func (m *mockWhisperSender) SendWhisper(target, message string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.whispers = append(m.whispers, target+": "+message)
+	return nil
+}
+
+func TestAgentReplyWhispersPrivately(t *testing.T) {
+	sender := &mockWhisperSender{}
+	a := &Agent{sender: sender}
+
+	whisper := event.NewChatEvent(context.Background(), "Steve", protocol.UUID{}, "你在哪", event.SourceWhisper)
+	if err := a.reply(whisper, "在家"); err != nil {
+		t.Fatalf("reply error: %v", err)
+	}
+	public := event.NewChatEvent(context.Background(), "Alex", protocol.UUID{}, "hi", event.SourcePlayer)
+	if err := a.reply(public, "你好"); err != nil {
+		t.Fatalf("reply error: %v", err)
+	}
+
+	if len(sender.whispers) != 1 || sender.whispers[0] != "Steve: 在家" {
+		t.Fatalf("whispers = %v", sender.whispers)
+	}
+	if msgs := sender.messages(); len(msgs) != 1 || msgs[0] != "你好" {
+		t.Fatalf("public messages = %v", msgs)
+	}
+
+	// Senders without /msg support fall back to public chat.
+	plain := &mockSender{}
+	a.sender = plain
+	if err := a.reply(whisper, "在家"); err != nil {
+		t.Fatalf("reply error: %v", err)
+	}
+	if msgs := plain.messages(); len(msgs) != 1 || msgs[0] != "在家" {
+		t.Fatalf("fallback messages = %v", msgs)
+	}
+}
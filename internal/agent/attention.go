@@ -1,13 +1,24 @@
 package agent
 
 import (
+	"math"
+	"strconv"
+	"time"
+
 	"github.com/Versifine/locus/internal/event"
 	"github.com/Versifine/locus/internal/world"
 )
 
+// damageSourceMaxAge is how long a recorded hit may precede the health drop
+// it explains.
+const damageSourceMaxAge = 2 * time.Second
+
 type Attention struct {
 	prevSnap      world.Snapshot
 	hasPrevSnap   bool
+	weatherKnown  bool
+	damageSeq     uint64
+	soundSeq      uint64
 	bus           *event.Bus
 	SpatialMemory *SpatialMemory
 }
@@ -31,10 +42,15 @@ func (a *Attention) Tick(snap world.Snapshot, tick uint64) {
 	}
 
 	if a.bus != nil && snap.Health < a.prevSnap.Health {
-		a.bus.Publish(event.EventDamage, event.DamageEvent{
-			Amount: a.prevSnap.Health - snap.Health,
-			NewHP:  snap.Health,
-		})
+		a.bus.Publish(event.EventDamage, a.damageEvent(snap))
+	}
+
+	if a.bus != nil {
+		for _, change := range statusChanges(a.prevSnap, snap) {
+			a.bus.Publish(event.EventStatusChange, change)
+		}
+		a.publishEnvironmentChanges(a.prevSnap, snap)
+		a.publishUrgentSounds(snap)
 	}
 
 	prevMap := make(map[int32]world.Entity, len(a.prevSnap.Entities))
@@ -81,6 +97,85 @@ func (a *Attention) Tick(snap world.Snapshot, tick uint64) {
 	a.prevSnap = snap
 }
 
+// damageEvent describes a health drop, attributed to the last recorded hit
+// when that hit is new and recent.
+func (a *Attention) damageEvent(snap world.Snapshot) event.DamageEvent {
+	evt := event.DamageEvent{
+		Amount: a.prevSnap.Health - snap.Health,
+		NewHP:  snap.Health,
+	}
+	src := snap.LastDamage
+	if src == nil || src.Seq <= a.damageSeq || time.Since(src.Time) > damageSourceMaxAge {
+		return evt
+	}
+	a.damageSeq = src.Seq
+
+	evt.Type = src.Type
+	evt.SourceEntityID = src.SourceEntityID
+	evt.DirectEntityID = src.DirectEntityID
+	for _, e := range snap.Entities {
+		if e.EntityID == src.SourceEntityID && src.SourceEntityID != 0 {
+			evt.SourceName = entityDisplayName(e)
+			break
+		}
+	}
+	switch {
+	case src.HasSourcePos:
+		evt.HasSourcePos = true
+		evt.SourceX, evt.SourceY, evt.SourceZ = src.SourceX, src.SourceY, src.SourceZ
+		evt.Direction = directionTo(snap.Position, src.SourceX, src.SourceZ)
+	case src.HasHurtYaw:
+		// The hurt animation yaw is 90 for a hit from straight ahead.
+		evt.Direction = relativeDirection(normalizeAngle(src.HurtYaw - 90))
+	}
+	return evt
+}
+
+// publishEnvironmentChanges reports day phase and weather transitions. Both
+// are only compared once both snapshots have heard the server time. The
+// server sends the weather of a world it is raining in right after the time,
+// so the first of those comparisons only records the weather at login.
+func (a *Attention) publishEnvironmentChanges(prev, curr world.Snapshot) {
+	if prev.GameTime.Age <= 0 || curr.GameTime.Age <= 0 {
+		return
+	}
+	if from, to := prev.GameTime.DayPhase(), curr.GameTime.DayPhase(); from != to {
+		a.bus.Publish(event.EventTimePhase, event.TimePhaseEvent{From: from, To: to, MoonPhase: curr.GameTime.MoonPhase()})
+	}
+	if !a.weatherKnown {
+		a.weatherKnown = true
+		return
+	}
+	if from, to := prev.Weather.Name(), curr.Weather.Name(); from != to {
+		a.bus.Publish(event.EventWeather, event.WeatherEvent{From: from, To: to})
+	}
+}
+
+// directionTo names where x, z lies relative to the way pos faces, or ""
+// when it is right on top of pos.
+func directionTo(pos world.Position, x, z float64) string {
+	dx, dz := x-pos.X, z-pos.Z
+	if dx*dx+dz*dz <= 1e-6 {
+		return ""
+	}
+	yawTo := float32(math.Atan2(-dx, dz) * 180 / math.Pi)
+	return relativeDirection(signedAngleDelta(pos.Yaw, yawTo))
+}
+
+// relativeDirection names a signed yaw offset; positive yaw turns right.
+func relativeDirection(delta float32) string {
+	switch {
+	case delta >= -45 && delta <= 45:
+		return "front"
+	case delta > 45 && delta < 135:
+		return "right"
+	case delta < -45 && delta > -135:
+		return "left"
+	default:
+		return "behind"
+	}
+}
+
 func entityDisplayName(entity world.Entity) string {
 	if entity.Type == 71 && entity.ItemName != "" {
 		return "Item(" + entity.ItemName + ")"
@@ -90,3 +185,50 @@ func entityDisplayName(entity world.Entity) string {
 	}
 	return "Unknown"
 }
+
+// statusChanges diffs the bot's game mode, level, flight and effects.
+func statusChanges(prev, curr world.Snapshot) []event.StatusChangeEvent {
+	var changes []event.StatusChangeEvent
+	if prev.GameMode != curr.GameMode {
+		changes = append(changes, event.StatusChangeEvent{Kind: "gamemode", From: prev.GameMode, To: curr.GameMode})
+	}
+	if prev.Experience.Level != curr.Experience.Level {
+		changes = append(changes, event.StatusChangeEvent{Kind: "level", From: strconv.Itoa(int(prev.Experience.Level)), To: strconv.Itoa(int(curr.Experience.Level))})
+	}
+	if prev.Abilities.Flying != curr.Abilities.Flying {
+		changes = append(changes, event.StatusChangeEvent{Kind: "flying", From: strconv.FormatBool(prev.Abilities.Flying), To: strconv.FormatBool(curr.Abilities.Flying)})
+	}
+
+	prevEffects := make(map[int32]world.ActiveEffect, len(prev.Effects))
+	for _, e := range prev.Effects {
+		prevEffects[e.ID] = e
+	}
+	for _, e := range curr.Effects {
+		old, had := prevEffects[e.ID]
+		delete(prevEffects, e.ID)
+		if had && old.Amplifier == e.Amplifier {
+			continue
+		}
+		from := ""
+		if had {
+			from = effectLevelName(old.Level())
+		}
+		changes = append(changes, event.StatusChangeEvent{Kind: "effect", Name: e.Name, From: from, To: effectLevelName(e.Level())})
+	}
+	for _, prevEffect := range prev.Effects {
+		if _, removed := prevEffects[prevEffect.ID]; removed {
+			changes = append(changes, event.StatusChangeEvent{Kind: "effect", Name: prevEffect.Name, From: effectLevelName(prevEffect.Level())})
+		}
+	}
+	return changes
+}
+
+var romanLevels = [...]string{"I", "II", "III", "IV", "V", "VI", "VII", "VIII", "IX", "X"}
+
+// effectLevelName writes levels the way the game does, e.g. "II".
+func effectLevelName(level int32) string {
+	if level >= 1 && int(level) <= len(romanLevels) {
+		return romanLevels[level-1]
+	}
+	return strconv.Itoa(int(level))
+}
@@ -36,6 +36,55 @@ func TestAttentionPublishesDamage(t *testing.T) {
 	}
 }
 
+func TestAttentionAttributesDamageToSource(t *testing.T) {
+	bus := event.NewBus()
+	attention := NewAttention(bus)
+
+	ch := make(chan event.DamageEvent, 4)
+	bus.Subscribe(event.EventDamage, func(raw any) {
+		if evt, ok := raw.(event.DamageEvent); ok {
+			ch <- evt
+		}
+	})
+	next := func() event.DamageEvent {
+		t.Helper()
+		select {
+		case evt := <-ch:
+			return evt
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting damage event")
+			return event.DamageEvent{}
+		}
+	}
+
+	// Facing south (+Z) with a zombie hitting from the north.
+	zombie := world.Entity{EntityID: 40, Type: 150, X: 0.5, Y: 64, Z: -1.5}
+	pos := world.Position{X: 0.5, Y: 64, Z: 0.5}
+	hit := &world.DamageSource{Seq: 1, Type: "mob_attack", SourceEntityID: 40, DirectEntityID: 40,
+		HasSourcePos: true, SourceX: 0.5, SourceY: 64, SourceZ: -1.5, Time: time.Now()}
+	attention.Tick(world.Snapshot{Health: 20, Position: pos, Entities: []world.Entity{zombie}}, 1)
+	attention.Tick(world.Snapshot{Health: 16, Position: pos, Entities: []world.Entity{zombie}, LastDamage: hit}, 2)
+
+	evt := next()
+	if evt.Type != "mob_attack" || evt.SourceEntityID != 40 || evt.SourceName != "Zombie" || evt.Direction != "behind" {
+		t.Fatalf("damage event = %+v, want zombie mob_attack from behind", evt)
+	}
+
+	// Starving later is not blamed on the zombie's old hit.
+	attention.Tick(world.Snapshot{Health: 15, Position: pos, LastDamage: hit}, 3)
+	if evt := next(); evt.SourceEntityID != 0 || evt.Type != "" {
+		t.Fatalf("damage event = %+v, want no source", evt)
+	}
+
+	// Without a position the hurt animation still gives a direction.
+	arrow := &world.DamageSource{Seq: 2, Type: "arrow", SourceEntityID: 77, DirectEntityID: 78,
+		HasHurtYaw: true, HurtYaw: 180, Time: time.Now()}
+	attention.Tick(world.Snapshot{Health: 12, Position: pos, LastDamage: arrow}, 4)
+	if evt := next(); evt.Direction != "right" || evt.DirectEntityID != 78 || evt.HasSourcePos {
+		t.Fatalf("damage event = %+v, want arrow from the right", evt)
+	}
+}
+
 func TestAttentionPublishesEntityAppearLeave(t *testing.T) {
 	bus := event.NewBus()
 	attention := NewAttention(bus)
@@ -128,3 +177,103 @@ func TestAttentionRefreshesVisibleEntityAndPreventsGCExpiry(t *testing.T) {
 		t.Fatalf("entity tick=%d want 2", entities[0].TickID)
 	}
 }
+
+func TestAttentionPublishesStatusChanges(t *testing.T) {
+	bus := event.NewBus()
+	attention := NewAttention(bus)
+
+	ch := make(chan event.StatusChangeEvent, 8)
+	bus.Subscribe(event.EventStatusChange, func(raw any) {
+		if evt, ok := raw.(event.StatusChangeEvent); ok {
+			ch <- evt
+		}
+	})
+
+	attention.Tick(world.Snapshot{
+		GameMode:   "survival",
+		Experience: world.Experience{Level: 2, Total: 10},
+		Effects:    []world.ActiveEffect{{ID: 1, Name: "Slowness"}, {ID: 7, Name: "Jump Boost"}},
+	}, 1)
+	attention.Tick(world.Snapshot{
+		GameMode:   "creative",
+		Experience: world.Experience{Level: 3, Total: 20},
+		Abilities:  world.Abilities{Flying: true},
+		Effects:    []world.ActiveEffect{{ID: 0, Name: "Speed", Amplifier: 1}, {ID: 7, Name: "Jump Boost"}},
+	}, 2)
+
+	want := map[event.StatusChangeEvent]bool{
+		{Kind: "gamemode", From: "survival", To: "creative"}: true,
+		{Kind: "level", From: "2", To: "3"}:                  true,
+		{Kind: "flying", From: "false", To: "true"}:          true,
+		{Kind: "effect", Name: "Speed", To: "II"}:            true,
+		{Kind: "effect", Name: "Slowness", From: "I"}:        true,
+	}
+	for n := len(want); n > 0; n-- {
+		select {
+		case evt := <-ch:
+			if !want[evt] {
+				t.Fatalf("unexpected status change %+v", evt)
+			}
+			delete(want, evt)
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting status changes, missing %+v", want)
+		}
+	}
+	select {
+	case evt := <-ch:
+		t.Fatalf("unexpected extra status change %+v", evt)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestAttentionPublishesTimePhaseAndWeatherChanges(t *testing.T) {
+	bus := event.NewBus()
+	attention := NewAttention(bus)
+
+	phases := make(chan event.TimePhaseEvent, 4)
+	bus.Subscribe(event.EventTimePhase, func(raw any) {
+		if evt, ok := raw.(event.TimePhaseEvent); ok {
+			phases <- evt
+		}
+	})
+	weathers := make(chan event.WeatherEvent, 4)
+	bus.Subscribe(event.EventWeather, func(raw any) {
+		if evt, ok := raw.(event.WeatherEvent); ok {
+			weathers <- evt
+		}
+	})
+
+	// The first snapshot with a time must not report a change from the zero
+	// value, nor the rain the server sends right after it at login.
+	attention.Tick(world.Snapshot{GameTime: world.GameTime{WorldTime: 12500, Age: 100}}, 1)
+	attention.Tick(world.Snapshot{
+		GameTime: world.GameTime{WorldTime: 12501, Age: 101},
+		Weather:  world.Weather{Raining: true, RainLevel: 1},
+	}, 2)
+	attention.Tick(world.Snapshot{GameTime: world.GameTime{WorldTime: 24000 + 13000, Age: 200}}, 3)
+
+	select {
+	case evt := <-phases:
+		want := event.TimePhaseEvent{From: "dusk", To: "night", MoonPhase: "waning_gibbous"}
+		if evt != want {
+			t.Fatalf("phase event=%+v want %+v", evt, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting time phase event")
+	}
+	select {
+	case evt := <-weathers:
+		if evt.From != "rain" || evt.To != "clear" {
+			t.Fatalf("weather event=%+v want rain -> clear", evt)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting weather event")
+	}
+	select {
+	case evt := <-phases:
+		t.Fatalf("unexpected extra phase event %+v", evt)
+	case evt := <-weathers:
+		t.Fatalf("unexpected extra weather event %+v", evt)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,85 @@
+package agent
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/Versifine/locus/internal/world"
+)
+
+const (
+	defaultFindBiomeDist = 256
+	maxFindBiomeDist     = 1024
+)
+
+// BiomeSource is optionally implemented by a BlockAccess that keeps chunk
+// biomes. Only loaded chunks are searched.
+type BiomeSource interface {
+	GetBiome(x, y, z int) (world.Biome, bool)
+	FindNearestBiome(from world.BlockPos, maxDist int, match func(world.Biome) bool) (world.BlockPos, world.Biome, bool)
+}
+
+// biomeMatches accepts a biome name ("dark_forest") or a category ("forest").
+func biomeMatches(biome world.Biome, query string) bool {
+	query = strings.TrimPrefix(normalizeBlockName(query), "minecraft:")
+	return query != "" && (biome.Name == query || biome.Category == query)
+}
+
+// thinkerBiomeStatus names the biome at the player's feet for [Basic Status].
+func thinkerBiomeStatus(snap world.Snapshot, blocks BlockAccess) string {
+	biomes, ok := blocks.(BiomeSource)
+	if !ok {
+		return "unknown"
+	}
+	biome, ok := biomes.GetBiome(snapshotBlockPos(snap))
+	if !ok {
+		return "unknown"
+	}
+	return biome.Name
+}
+
+func snapshotBlockPos(snap world.Snapshot) (int, int, int) {
+	return int(math.Floor(snap.Position.X)), int(math.Floor(snap.Position.Y)), int(math.Floor(snap.Position.Z))
+}
+
+func (e ToolExecutor) executeFindBiome(input map[string]any) (string, error) {
+	query := strings.TrimSpace(asString(input["biome"]))
+	if query == "" {
+		return "", fmt.Errorf("find_biome missing biome")
+	}
+	snap, err := e.snapshot()
+	if err != nil {
+		return "", err
+	}
+	biomes, ok := e.World.(BiomeSource)
+	if e.World == nil || !ok {
+		return toJSONString(map[string]any{"status": "unavailable", "reason": "biome_data_unavailable"}), nil
+	}
+
+	maxDist := defaultFindBiomeDist
+	if rawDist, ok := asInt(input["max_dist"]); ok && rawDist > 0 {
+		maxDist = min(rawDist, maxFindBiomeDist)
+	}
+
+	x, y, z := snapshotBlockPos(snap)
+	result := map[string]any{"query": query}
+	if current, ok := biomes.GetBiome(x, y, z); ok {
+		result["current"] = current.Name
+	}
+	pos, biome, found := biomes.FindNearestBiome(world.BlockPos{X: x, Y: y, Z: z}, maxDist, func(b world.Biome) bool {
+		return biomeMatches(b, query)
+	})
+	if !found {
+		result["status"] = "not_found"
+		result["reason"] = "not_in_loaded_chunks"
+		return toJSONString(result), nil
+	}
+	dx, dy, dz := float64(pos.X-x), float64(pos.Y-y), float64(pos.Z-z)
+	result["status"] = "ok"
+	result["biome"] = biome.Name
+	result["category"] = biome.Category
+	result["position"] = [3]int{pos.X, pos.Y, pos.Z}
+	result["distance"] = math.Round(math.Sqrt(dx*dx+dy*dy+dz*dz)*10) / 10
+	return toJSONString(result), nil
+}
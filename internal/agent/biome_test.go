@@ -0,0 +1,81 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Versifine/locus/internal/world"
+)
+
+// biomeTestBlocks puts a desert at x >= 32 and plains everywhere else.
+type biomeTestBlocks struct {
+	*cameraTestBlocks
+}
+
+var (
+	testPlains = world.Biome{ID: 0, Name: "plains", Category: "plains"}
+	testDesert = world.Biome{ID: 1, Name: "desert", Category: "desert"}
+)
+
+func (b biomeTestBlocks) GetBiome(x, y, z int) (world.Biome, bool) {
+	if x >= 32 {
+		return testDesert, true
+	}
+	return testPlains, true
+}
+
+func (b biomeTestBlocks) FindNearestBiome(from world.BlockPos, maxDist int, match func(world.Biome) bool) (world.BlockPos, world.Biome, bool) {
+	if match(testPlains) {
+		return from, testPlains, true
+	}
+	if match(testDesert) && 34-from.X <= maxDist {
+		return world.BlockPos{X: 34, Y: from.Y, Z: from.Z}, testDesert, true
+	}
+	return world.BlockPos{}, world.Biome{}, false
+}
+
+func TestToolExecutorFindBiome(t *testing.T) {
+	executor := ToolExecutor{
+		SnapshotFn: func() world.Snapshot {
+			return world.Snapshot{Position: world.Position{X: 4.5, Y: 64, Z: 0.5}}
+		},
+		World: biomeTestBlocks{newCameraTestBlocks()},
+	}
+
+	text, err := executor.ExecuteTool(context.Background(), "find_biome", map[string]any{"biome": "minecraft:desert"})
+	if err != nil {
+		t.Fatalf("find_biome error: %v", err)
+	}
+	var out struct {
+		Status   string  `json:"status"`
+		Current  string  `json:"current"`
+		Biome    string  `json:"biome"`
+		Position [3]int  `json:"position"`
+		Distance float64 `json:"distance"`
+	}
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		t.Fatalf("parse result: %v", err)
+	}
+	if out.Status != "ok" || out.Current != "plains" || out.Biome != "desert" || out.Position != [3]int{34, 64, 0} || out.Distance != 30 {
+		t.Fatalf("find_biome result=%s", text)
+	}
+
+	text, _ = executor.ExecuteTool(context.Background(), "find_biome", map[string]any{"biome": "desert", "max_dist": 8})
+	if err := json.Unmarshal([]byte(text), &out); err != nil || out.Status != "not_found" {
+		t.Fatalf("find_biome beyond max_dist=%s want not_found", text)
+	}
+	if _, err := executor.ExecuteTool(context.Background(), "find_biome", nil); err == nil {
+		t.Fatal("find_biome without biome should fail")
+	}
+}
+
+func TestThinkerBiomeStatus(t *testing.T) {
+	snap := world.Snapshot{Position: world.Position{X: 40, Y: 64, Z: 0}}
+	if got := thinkerBiomeStatus(snap, biomeTestBlocks{newCameraTestBlocks()}); got != "desert" {
+		t.Fatalf("biome status=%q want desert", got)
+	}
+	if got := thinkerBiomeStatus(snap, newCameraTestBlocks()); got != "unknown" {
+		t.Fatalf("biome status without biomes=%q want unknown", got)
+	}
+}
@@ -3,9 +3,17 @@ package agent
 import (
 	"math"
 	"sort"
+	"time"
+
+	"github.com/Versifine/locus/internal/world"
 )
 
-const maxRaycastTransparentPassThrough = 8
+const (
+	maxRaycastTransparentPassThrough = 8
+	// darkVisionDist is how far the camera still makes out blocks that get
+	// no light at all, like an unlit cave wall next to the player.
+	darkVisionDist = 4.0
+)
 
 type Vec3 struct {
 	X float64
@@ -19,6 +27,30 @@ type BlockAccess interface {
 	IsSolid(x, y, z int) bool
 }
 
+// BlockStateDescriber is optionally implemented by a BlockAccess that can
+// decode state properties (facing, open, age, ...).
+type BlockStateDescriber interface {
+	DescribeBlockState(stateID int32) (world.BlockState, bool)
+}
+
+// BlockTagSource is optionally implemented by a BlockAccess that knows the
+// block tags the server synchronized.
+type BlockTagSource interface {
+	BlockTags(stateID int32) []string
+}
+
+// LastKnownBlockSource is optionally implemented by a BlockAccess that
+// remembers blocks in chunks the server has unloaded.
+type LastKnownBlockSource interface {
+	LastKnownBlockState(x, y, z int) (int32, time.Time, bool)
+}
+
+// LightSource is optionally implemented by a BlockAccess that tracks chunk
+// light. With it the camera cannot see unlit blocks beyond darkVisionDist.
+type LightSource interface {
+	GetLight(x, y, z int) (world.LightLevel, bool)
+}
+
 type Camera struct {
 	FOV     float64
 	MaxDist float64
@@ -118,8 +150,10 @@ func ddaFirstHit(origin Vec3, dir Vec3, maxDist float64, blocks BlockAccess) (Bl
 	stepY, tMaxY, tDeltaY := ddaAxis(origin.Y, dir.Y, y)
 	stepZ, tMaxZ, tDeltaZ := ddaAxis(origin.Z, dir.Z, z)
 
+	lights, _ := blocks.(LightSource)
 	passedThrough := make([]BlockInfo, 0, 4)
 	distance := 0.0
+	prevX, prevY, prevZ := x, y, z
 	for distance <= maxDist {
 		stateID, ok := blocks.GetBlockState(x, y, z)
 		if ok {
@@ -130,14 +164,19 @@ func ddaFirstHit(origin Vec3, dir Vec3, maxDist float64, blocks BlockAccess) (Bl
 				}
 				info := BlockInfo{Type: name, Pos: [3]int{x, y, z}}
 				if isTransparent(blocks, stateID) {
-					if len(passedThrough) < maxRaycastTransparentPassThrough {
+					if len(passedThrough) < maxRaycastTransparentPassThrough && isLit(lights, x, y, z, distance) {
 						passedThrough = append(passedThrough, info)
 					}
 				} else {
+					// A solid block is lit through the face the ray entered.
+					if !isLit(lights, prevX, prevY, prevZ, distance) {
+						return BlockInfo{}, passedThrough, false
+					}
 					return info, passedThrough, true
 				}
 			}
 		}
+		prevX, prevY, prevZ = x, y, z
 
 		switch {
 		case tMaxX <= tMaxY && tMaxX <= tMaxZ:
@@ -158,6 +197,14 @@ func ddaFirstHit(origin Vec3, dir Vec3, maxDist float64, blocks BlockAccess) (Bl
 	return BlockInfo{}, passedThrough, false
 }
 
+func isLit(lights LightSource, x, y, z int, distance float64) bool {
+	if lights == nil || distance <= darkVisionDist {
+		return true
+	}
+	light, ok := lights.GetLight(x, y, z)
+	return !ok || light.Max() > 0
+}
+
 func ddaAxis(origin, dir float64, cell int) (step int, tMax float64, tDelta float64) {
 	if nearlyZero(dir) {
 		return 0, math.Inf(1), math.Inf(1)
@@ -1,6 +1,10 @@
 package agent
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/Versifine/locus/internal/world"
+)
 
 type cameraTestBlocks struct {
 	states map[[3]int]int32
@@ -116,3 +120,37 @@ func TestDDAFirstHitTransparentPassThroughCapped(t *testing.T) {
 		t.Fatalf("last passed pos=%v want [0 1 8]", passed[len(passed)-1].Pos)
 	}
 }
+
+// litCameraTestBlocks adds light; cells default to dark.
+type litCameraTestBlocks struct {
+	*cameraTestBlocks
+	light map[[3]int]world.LightLevel
+}
+
+func (b *litCameraTestBlocks) GetLight(x, y, z int) (world.LightLevel, bool) {
+	return b.light[[3]int{x, y, z}], true
+}
+
+func TestCameraCannotSeeUnlitBlocksFarAway(t *testing.T) {
+	blocks := &litCameraTestBlocks{cameraTestBlocks: newCameraTestBlocks(), light: make(map[[3]int]world.LightLevel)}
+	blocks.set(0, 1, 10, 1)
+	camera := Camera{FOV: 70, MaxDist: 32, Width: 1, Height: 1}
+	eye := Vec3{X: 0.5, Y: 1.62, Z: 0.5}
+
+	if visible := camera.VisibleSurfaceBlocks(eye, 0, 0, blocks); len(visible) != 0 {
+		t.Fatalf("unlit wall visible: %v", visible)
+	}
+
+	// A torch-lit face in front of the wall makes it visible again.
+	blocks.light[[3]int{0, 1, 9}] = world.LightLevel{Block: 7}
+	if visible := camera.VisibleSurfaceBlocks(eye, 0, 0, blocks); len(visible) != 1 {
+		t.Fatalf("lit wall visible len=%d want 1", len(visible))
+	}
+
+	// Dark blocks right next to the player are still made out.
+	blocks.set(0, 1, 3, 2)
+	visible := camera.VisibleSurfaceBlocks(eye, 0, 0, blocks)
+	if len(visible) != 1 || visible[0].Pos != [3]int{0, 1, 3} {
+		t.Fatalf("near dark block visible=%v want [0 1 3]", visible)
+	}
+}
@@ -0,0 +1,121 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+)
+
+// ContainerView is the content of an open container window. Slot indexes are
+// window slots, so they can be used directly for clicks.
+type ContainerView struct {
+	WindowID int32           `json:"window_id"`
+	Type     string          `json:"type"`
+	Title    string          `json:"title"`
+	Size     int             `json:"size"`
+	Slots    []InventoryItem `json:"slots"`
+}
+
+type ContainerTransfer struct {
+	Direction string // "deposit" (inventory → container) or "withdraw"
+	Item      string // item name filter, empty means any item
+	Count     int    // <= 0 means every matching item
+}
+
+type ContainerTransferResult struct {
+	Moved     int
+	Reason    string
+	Container ContainerView
+}
+
+type ContainerController interface {
+	OpenContainer(ctx context.Context, x, y, z int) (ContainerView, error)
+	TransferItems(ctx context.Context, req ContainerTransfer) (ContainerTransferResult, error)
+	CloseContainer() error
+}
+
+func (e ToolExecutor) executeOpenContainer(ctx context.Context, input map[string]any) (string, error) {
+	if e.Containers == nil {
+		slog.Warn("open_container unavailable", "reason", "containers_not_ready")
+		return toJSONString(map[string]any{
+			"status": "unavailable",
+			"reason": "containers_not_ready",
+		}), nil
+	}
+
+	x, okX := asInt(input["x"])
+	y, okY := asInt(input["y"])
+	z, okZ := asInt(input["z"])
+	if !okX || !okY || !okZ {
+		return "", fmt.Errorf("open_container requires x, y, z")
+	}
+
+	view, err := e.Containers.OpenContainer(ctx, x, y, z)
+	if err != nil {
+		return "", err
+	}
+	return toJSONString(map[string]any{
+		"status":    "ok",
+		"container": view,
+		"summary":   summarizeContainer(view),
+	}), nil
+}
+
+func (e ToolExecutor) executeTransferItems(ctx context.Context, input map[string]any) (string, error) {
+	if e.Containers == nil {
+		slog.Warn("transfer_items unavailable", "reason", "containers_not_ready")
+		return toJSONString(map[string]any{
+			"status": "unavailable",
+			"reason": "containers_not_ready",
+		}), nil
+	}
+
+	direction := strings.TrimSpace(asString(input["direction"]))
+	if direction != "deposit" && direction != "withdraw" {
+		return "", fmt.Errorf("transfer_items direction must be deposit or withdraw")
+	}
+	count, _ := asInt(input["count"])
+	req := ContainerTransfer{
+		Direction: direction,
+		Item:      strings.TrimSpace(asString(input["item"])),
+		Count:     count,
+	}
+
+	result, err := e.Containers.TransferItems(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	keepOpen, _ := asBool(input["keep_open"])
+	if !keepOpen {
+		if err := e.Containers.CloseContainer(); err != nil {
+			slog.Warn("Failed to close container after transfer", "error", err)
+		}
+	}
+
+	return toJSONString(map[string]any{
+		"status":    "ok",
+		"moved":     result.Moved,
+		"reason":    result.Reason,
+		"container": result.Container,
+		"summary":   summarizeContainer(result.Container),
+	}), nil
+}
+
+func summarizeContainer(view ContainerView) string {
+	if len(view.Slots) == 0 {
+		return fmt.Sprintf("%s (%d slots): empty", view.Type, view.Size)
+	}
+	totals := make(map[string]int)
+	for _, item := range view.Slots {
+		totals[item.Name] += item.Count
+	}
+	parts := make([]string, 0, len(totals))
+	for name, count := range totals {
+		parts = append(parts, fmt.Sprintf("%s x%d", name, count))
+	}
+	sort.Strings(parts)
+	return fmt.Sprintf("%s (%d slots): %s", view.Type, view.Size, strings.Join(parts, ", "))
+}
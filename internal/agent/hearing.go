@@ -0,0 +1,123 @@
+package agent
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Versifine/locus/internal/event"
+	"github.com/Versifine/locus/internal/world"
+)
+
+const (
+	// urgentSoundMaxAge is how old an urgent sound may be when attention
+	// first sees it and still be worth interrupting for.
+	urgentSoundMaxAge = 3 * time.Second
+	maxListenSounds   = 20
+)
+
+// urgentSounds need a reaction within seconds.
+var urgentSounds = map[string]bool{
+	"entity.creeper.primed":  true,
+	"entity.tnt.primed":      true,
+	"entity.generic.explode": true,
+}
+
+// publishUrgentSounds publishes the urgent sounds heard since the last tick.
+func (a *Attention) publishUrgentSounds(snap world.Snapshot) {
+	seen := a.soundSeq
+	for _, sound := range snap.Sounds {
+		if sound.Seq <= seen {
+			continue
+		}
+		a.soundSeq = max(a.soundSeq, sound.Seq)
+		if !urgentSounds[sound.Name] || time.Since(sound.LastHeard) > urgentSoundMaxAge {
+			continue
+		}
+		a.bus.Publish(event.EventSound, event.SoundEvent{
+			Name:      sound.Name,
+			Category:  sound.Category,
+			EntityID:  sound.EntityID,
+			X:         sound.X,
+			Y:         sound.Y,
+			Z:         sound.Z,
+			Distance:  soundDistance(snap.Position, sound),
+			Direction: directionTo(snap.Position, sound.X, sound.Z),
+		})
+	}
+}
+
+func soundDistance(pos world.Position, sound world.Sound) float64 {
+	dx, dy, dz := sound.X-pos.X, sound.Y-pos.Y, sound.Z-pos.Z
+	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+func (e ToolExecutor) executeListen(input map[string]any) (string, error) {
+	snap, err := e.snapshot()
+	if err != nil {
+		return "", err
+	}
+	maxAge := world.SoundMemoryTTL
+	if rawAge, ok := asInt(input["max_age_sec"]); ok && rawAge > 0 {
+		maxAge = min(time.Duration(rawAge)*time.Second, world.SoundMemoryTTL)
+	}
+	category := strings.TrimSpace(asString(input["category"]))
+
+	entityNames := make(map[int32]string)
+	for _, entity := range snap.Entities {
+		entityNames[entity.EntityID] = entityDisplayName(entity)
+	}
+
+	now := time.Now()
+	sounds := make([]world.Sound, 0, len(snap.Sounds))
+	for _, sound := range snap.Sounds {
+		if now.Sub(sound.LastHeard) > maxAge || (category != "" && sound.Category != category) {
+			continue
+		}
+		sounds = append(sounds, sound)
+	}
+	sort.SliceStable(sounds, func(i, j int) bool {
+		return soundDistance(snap.Position, sounds[i]) < soundDistance(snap.Position, sounds[j])
+	})
+
+	byDirection := make(map[string]int)
+	items := make([]map[string]any, 0, min(len(sounds), maxListenSounds))
+	for _, sound := range sounds {
+		direction := directionTo(snap.Position, sound.X, sound.Z)
+		if direction == "" {
+			direction = "here"
+		}
+		byDirection[direction] += sound.Count
+		if len(items) == maxListenSounds {
+			continue
+		}
+		item := map[string]any{
+			"name":      sound.Name,
+			"category":  sound.Category,
+			"direction": direction,
+			"distance":  math.Round(soundDistance(snap.Position, sound)*10) / 10,
+			"dy":        int(math.Round(sound.Y - snap.Position.Y)),
+			"position":  [3]int{int(math.Floor(sound.X)), int(math.Floor(sound.Y)), int(math.Floor(sound.Z))},
+			"count":     sound.Count,
+			"age_sec":   math.Round(now.Sub(sound.LastHeard).Seconds()*10) / 10,
+		}
+		if urgentSounds[sound.Name] {
+			item["urgent"] = true
+		}
+		if sound.EntityID != 0 {
+			item["entity_id"] = sound.EntityID
+			if name, ok := entityNames[sound.EntityID]; ok {
+				item["entity"] = name
+			}
+		}
+		items = append(items, item)
+	}
+
+	return toJSONString(map[string]any{
+		"status":       "ok",
+		"count":        len(sounds),
+		"by_direction": byDirection,
+		"sounds":       items,
+	}), nil
+}
@@ -0,0 +1,106 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Versifine/locus/internal/event"
+	"github.com/Versifine/locus/internal/world"
+)
+
+func TestAttentionPublishesUrgentSounds(t *testing.T) {
+	bus := event.NewBus()
+	attention := NewAttention(bus)
+
+	ch := make(chan event.SoundEvent, 4)
+	bus.Subscribe(event.EventSound, func(raw any) {
+		if evt, ok := raw.(event.SoundEvent); ok {
+			ch <- evt
+		}
+	})
+
+	now := time.Now()
+	primed := world.Sound{Seq: 2, Name: "entity.creeper.primed", Category: "hostile", EntityID: 9, Z: -3, LastHeard: now}
+	attention.Tick(world.Snapshot{}, 1)
+	attention.Tick(world.Snapshot{Sounds: []world.Sound{
+		{Seq: 1, Name: "entity.zombie.ambient", Category: "hostile", X: 5, LastHeard: now},
+		primed,
+	}}, 2)
+	// The same sounds again must not be republished.
+	attention.Tick(world.Snapshot{Sounds: []world.Sound{primed}}, 3)
+
+	select {
+	case evt := <-ch:
+		want := event.SoundEvent{Name: "entity.creeper.primed", Category: "hostile", EntityID: 9, Z: -3, Distance: 3, Direction: "behind"}
+		if evt != want {
+			t.Fatalf("sound event=%+v want %+v", evt, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting sound event")
+	}
+	select {
+	case evt := <-ch:
+		t.Fatalf("unexpected extra sound event %+v", evt)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestToolExecutorListen(t *testing.T) {
+	now := time.Now()
+	executor := ToolExecutor{
+		SnapshotFn: func() world.Snapshot {
+			return world.Snapshot{
+				Position: world.Position{X: 0.5, Y: 64, Z: 0.5},
+				Entities: []world.Entity{{EntityID: 9, Type: 150}},
+				Sounds: []world.Sound{
+					{Name: "entity.zombie.ambient", Category: "hostile", X: 0.5, Y: 58, Z: 12.5, EntityID: 9, Count: 3, LastHeard: now},
+					{Name: "block.wooden_door.open", Category: "block", X: 4.5, Y: 64, Z: 0.5, Count: 1, LastHeard: now},
+					{Name: "entity.cow.ambient", Category: "neutral", X: -20, Y: 64, Z: 0.5, Count: 1, LastHeard: now.Add(-20 * time.Second)},
+				},
+			}
+		},
+	}
+
+	text, err := executor.ExecuteTool(context.Background(), "listen", nil)
+	if err != nil {
+		t.Fatalf("listen error: %v", err)
+	}
+	var out struct {
+		Status      string         `json:"status"`
+		Count       int            `json:"count"`
+		ByDirection map[string]int `json:"by_direction"`
+		Sounds      []struct {
+			Name      string  `json:"name"`
+			Direction string  `json:"direction"`
+			Distance  float64 `json:"distance"`
+			DY        int     `json:"dy"`
+			Entity    string  `json:"entity"`
+		} `json:"sounds"`
+	}
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		t.Fatalf("parse result: %v", err)
+	}
+	if out.Status != "ok" || out.Count != 3 || len(out.Sounds) != 3 {
+		t.Fatalf("listen result=%s", text)
+	}
+	door, zombie, cow := out.Sounds[0], out.Sounds[1], out.Sounds[2]
+	if door.Name != "block.wooden_door.open" || door.Direction != "left" || door.Distance != 4 {
+		t.Fatalf("nearest sound=%+v want the door on the left", door)
+	}
+	if zombie.Direction != "front" || zombie.DY != -6 || zombie.Entity != "Zombie" {
+		t.Fatalf("zombie sound=%+v want in front and below", zombie)
+	}
+	if cow.Direction != "right" {
+		t.Fatalf("cow sound=%+v want right", cow)
+	}
+	if out.ByDirection["front"] != 3 || out.ByDirection["left"] != 1 {
+		t.Fatalf("by_direction=%v", out.ByDirection)
+	}
+
+	text, _ = executor.ExecuteTool(context.Background(), "listen", map[string]any{"max_age_sec": 10, "category": "hostile"})
+	if err := json.Unmarshal([]byte(text), &out); err != nil || out.Count != 1 || out.Sounds[0].Name != "entity.zombie.ambient" {
+		t.Fatalf("filtered listen result=%s", text)
+	}
+}
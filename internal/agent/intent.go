@@ -113,6 +113,19 @@ func ParseIntent(input map[string]any) (Intent, error) {
 		if slot < 0 || slot > 8 {
 			return Intent{}, fmt.Errorf("slot out of range")
 		}
+	case "craft":
+		item := strings.TrimSpace(asString(input["item"]))
+		if item == "" {
+			return Intent{}, fmt.Errorf("missing item")
+		}
+		params["item"] = item
+		if v, ok := input["count"]; ok {
+			count, ok := asInt(v)
+			if !ok || count <= 0 {
+				return Intent{}, fmt.Errorf("invalid count")
+			}
+			params["count"] = count
+		}
 	default:
 		return Intent{}, fmt.Errorf("unknown intent action: %s", action)
 	}
@@ -93,3 +93,20 @@ func TestParseIntentInvalidDurationMs(t *testing.T) {
 		t.Fatal("expected duration_ms validation error")
 	}
 }
+
+func TestParseIntentCraft(t *testing.T) {
+	intent, err := ParseIntent(map[string]any{"action": "craft", "item": " wooden_pickaxe ", "count": float64(2)})
+	if err != nil {
+		t.Fatalf("ParseIntent error: %v", err)
+	}
+	if intent.Params["item"] != "wooden_pickaxe" || intent.Params["count"] != 2 {
+		t.Fatalf("params=%v", intent.Params)
+	}
+
+	if _, err := ParseIntent(map[string]any{"action": "craft"}); err == nil {
+		t.Fatal("expected error for missing item")
+	}
+	if _, err := ParseIntent(map[string]any{"action": "craft", "item": "stick", "count": 0}); err == nil {
+		t.Fatal("expected error for non-positive count")
+	}
+}
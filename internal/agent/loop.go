@@ -95,6 +95,8 @@ type LoopAgent struct {
 	headSpeed         float32
 
 	tickCounter atomic.Uint64
+	// offline is set between a disconnect and the next successful login.
+	offline atomic.Bool
 }
 
 func NewLoopAgent(
@@ -152,6 +154,12 @@ func NewLoopAgent(
 			return a.waitForIdle(ctx, timeout)
 		},
 	}
+	if whisper, ok := sender.(WhisperSender); ok {
+		a.toolExecutor.Whisper = whisper.SendWhisper
+	}
+	if respawner, ok := sender.(Respawner); ok {
+		a.toolExecutor.Respawn = respawner.Respawn
+	}
 	a.attention.SpatialMemory = a.spatialMemory
 
 	a.subscribeEvents()
@@ -218,6 +226,13 @@ func (a *LoopAgent) SetInventory(inv InventoryProvider) {
 	a.toolExecutor.Inventory = inv
 }
 
+func (a *LoopAgent) SetContainers(containers ContainerController) {
+	if a == nil {
+		return
+	}
+	a.toolExecutor.Containers = containers
+}
+
 func (a *LoopAgent) Start(ctx context.Context) error {
 	if a == nil {
 		return nil
@@ -301,7 +316,7 @@ func (a *LoopAgent) drainEvents() {
 }
 
 func (a *LoopAgent) shouldThink() bool {
-	if a == nil {
+	if a == nil || a.offline.Load() {
 		return false
 	}
 
@@ -477,6 +492,40 @@ func (a *LoopAgent) subscribeEvents() {
 	a.bus.Subscribe(event.EventEntityLeave, func(raw any) {
 		a.enqueueEvent(event.EventEntityLeave, raw, PriorityLow)
 	})
+	a.bus.Subscribe(event.EventDeath, func(raw any) {
+		a.rememberDeath(raw)
+		a.enqueueEvent(event.EventDeath, raw, PriorityUrgent)
+	})
+	a.bus.Subscribe(event.EventStatusChange, func(raw any) {
+		a.enqueueEvent(event.EventStatusChange, raw, PriorityNormal)
+	})
+	a.bus.Subscribe(event.EventSound, func(raw any) {
+		a.enqueueEvent(event.EventSound, raw, PriorityUrgent)
+	})
+	a.bus.Subscribe(event.EventTimePhase, func(raw any) {
+		a.enqueueEvent(event.EventTimePhase, raw, PriorityNormal)
+	})
+	a.bus.Subscribe(event.EventWeather, func(raw any) {
+		a.enqueueEvent(event.EventWeather, raw, PriorityNormal)
+	})
+	a.bus.Subscribe(event.EventDisconnect, func(raw any) {
+		a.handleDisconnect()
+		a.enqueueEvent(event.EventDisconnect, raw, PriorityNormal)
+	})
+	a.bus.Subscribe(event.EventReconnect, func(raw any) {
+		a.offline.Store(false)
+		a.enqueueEvent(event.EventReconnect, raw, PriorityNormal)
+	})
+}
+
+// handleDisconnect stops acting on a world that is gone. Memory, episodes and
+// spatial memory are kept for the next session.
+func (a *LoopAgent) handleDisconnect() {
+	a.offline.Store(true)
+	a.cancelThinker()
+	if a.runner != nil {
+		a.runner.CancelAll()
+	}
 }
 
 func (a *LoopAgent) enqueueEvent(name string, payload any, priority Priority) {
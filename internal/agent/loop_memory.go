@@ -366,7 +366,7 @@ func (a *LoopAgent) captureActivePlayer(payload any) {
 	if !ok || chat == nil {
 		return
 	}
-	if chat.Source != event.SourcePlayer {
+	if chat.Source != event.SourcePlayer && chat.Source != event.SourceWhisper {
 		return
 	}
 	name := strings.TrimSpace(chat.Username)
@@ -545,6 +545,34 @@ func (a *LoopAgent) applyAutoMemoryRules(closed ClosedEpisode) {
 	}
 }
 
+// rememberDeath writes the death location to long-term memory so the agent
+// can find its way back to the dropped items.
+func (a *LoopAgent) rememberDeath(raw any) {
+	if a == nil || a.memoryStore == nil {
+		return
+	}
+	death, ok := raw.(event.DeathEvent)
+	if !ok {
+		return
+	}
+	snap := world.Snapshot{}
+	if a.stateProvider != nil {
+		snap = a.stateProvider.GetState()
+	}
+	ctx := a.memoryContextFromSnapshot(snap, a.tickCounter.Load())
+	ctx.Dimension = death.Dimension
+	ctx.Position = [3]int{
+		int(math.Floor(death.X)),
+		int(math.Floor(death.Y)),
+		int(math.Floor(death.Z)),
+	}
+	content := fmt.Sprintf("在 [%d,%d,%d] (%s) 死亡，掉落的物品可能还在那里", ctx.Position[0], ctx.Position[1], ctx.Position[2], emptyAsUnknown(ctx.Dimension))
+	if death.Message != "" {
+		content += "；死因: " + death.Message
+	}
+	a.memoryStore.Remember(content, map[string]string{"type": "death"}, ctx, "auto")
+}
+
 func (a *LoopAgent) allowAutoRule(key string, tickID uint64, cooldown uint64) bool {
 	if a == nil {
 		return false
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Versifine/locus/internal/config"
+	"github.com/Versifine/locus/internal/event"
 	"github.com/Versifine/locus/internal/skill"
 	"github.com/Versifine/locus/internal/world"
 )
@@ -61,6 +62,41 @@ func TestShouldThinkUrgentAndCooldown(t *testing.T) {
 	}
 }
 
+func TestShouldThinkPausedWhileOffline(t *testing.T) {
+	bus := event.NewBus()
+	a := &LoopAgent{
+		bus:         bus,
+		eventBuffer: NewEventBuffer(10),
+		eventInCh:   make(chan incomingEvent, 4),
+	}
+	a.subscribeEvents()
+
+	bus.Publish(event.EventDisconnect, event.DisconnectEvent{Reason: "timeout", Kind: "network", Retry: true})
+	waitUntil(t, func() bool { return a.offline.Load() })
+	a.eventBuffer.Push("chat", nil, PriorityUrgent)
+	if a.shouldThink() {
+		t.Fatal("should not think while disconnected")
+	}
+
+	bus.Publish(event.EventReconnect, event.ReconnectEvent{Attempt: 1})
+	waitUntil(t, func() bool { return !a.offline.Load() })
+	if !a.shouldThink() {
+		t.Fatal("urgent event should trigger think after reconnect")
+	}
+}
+
+func waitUntil(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("condition not met in time")
+}
+
 func TestDrainThinkerActions(t *testing.T) {
 	sender := &loopTestSender{}
 	runner := skill.NewBehaviorRunner(nil, nil, nil)
@@ -359,3 +359,29 @@ func TestLoopAgentRememberRecallHandlers(t *testing.T) {
 		t.Fatalf("player tag=%q want Steve", tags["player"])
 	}
 }
+
+func TestLoopAgentRemembersDeathLocation(t *testing.T) {
+	a := &LoopAgent{
+		stateProvider:    loopTestState{},
+		memoryStore:      NewMemoryStore(10),
+		autoRuleLastTick: map[string]uint64{},
+	}
+	a.rememberDeath(event.DeathEvent{
+		Message:   "death.attack.mob(Bot, entity.minecraft.zombie)",
+		X:         -12.3,
+		Y:         40,
+		Z:         8.9,
+		Dimension: "minecraft:overworld",
+	})
+
+	results := a.memoryStore.Recall("死亡 物品", map[string]string{"type": "death"}, MemoryContext{}, 1)
+	if len(results) != 1 {
+		t.Fatalf("recall death results=%+v want 1", results)
+	}
+	if !strings.Contains(results[0].Content, "[-13,40,8]") || !strings.Contains(results[0].Content, "zombie") {
+		t.Fatalf("death memory=%q missing position or cause", results[0].Content)
+	}
+	if results[0].Tags["dim"] != "minecraft:overworld" {
+		t.Fatalf("dim tag=%q want minecraft:overworld", results[0].Tags["dim"])
+	}
+}
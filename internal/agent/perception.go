@@ -75,12 +75,53 @@ func FormatEntities(entities []world.Entity, players []world.Player) string {
 		if entity.Type == 71 && entity.ItemName != "" {
 			label = fmt.Sprintf("Item(%s)", entity.ItemName)
 		}
-		lines = append(lines, fmt.Sprintf("%s(id=%d): [%d,%d,%d]", label, entity.EntityID, int(math.Round(entity.X)), int(math.Round(entity.Y)), int(math.Round(entity.Z))))
+		if entity.Baby {
+			label = "baby " + label
+		}
+		if entity.CustomName != "" {
+			label = fmt.Sprintf("%s %q", label, entity.CustomName)
+		}
+		line := fmt.Sprintf("%s(id=%d): [%d,%d,%d]", label, entity.EntityID, int(math.Round(entity.X)), int(math.Round(entity.Y)), int(math.Round(entity.Z)))
+		if details := entityDetails(entity); len(details) > 0 {
+			line += " " + strings.Join(details, ", ")
+		}
+		lines = append(lines, line)
 	}
 	sort.Strings(lines)
 	return strings.Join(lines, "\n")
 }
 
+// entityDetails describes what metadata and equipment say about an entity,
+// e.g. "holding Iron Sword", "60% hp".
+func entityDetails(entity world.Entity) []string {
+	var details []string
+	if entity.MainHand != "" {
+		details = append(details, "holding "+entity.MainHand)
+	}
+	var armor []string
+	for i := len(entity.Armor) - 1; i >= 0; i-- {
+		if entity.Armor[i] != "" {
+			armor = append(armor, entity.Armor[i])
+		}
+	}
+	if len(armor) > 0 {
+		details = append(details, "wearing "+strings.Join(armor, " and "))
+	}
+	switch {
+	case entity.MaxHealth > 0 && entity.Health > 0:
+		details = append(details, fmt.Sprintf("%d%% hp", int(math.Round(float64(entity.Health/entity.MaxHealth*100)))))
+	case entity.Health > 0:
+		details = append(details, fmt.Sprintf("%.0f hp", entity.Health))
+	}
+	if entity.OnFire {
+		details = append(details, "on fire")
+	}
+	if entity.Pose != "" && entity.Pose != "standing" {
+		details = append(details, entity.Pose)
+	}
+	return details
+}
+
 func summarizeAsBox(positions [][3]int) (string, bool) {
 	if len(positions) < 4 {
 		return "", false
@@ -41,3 +41,31 @@ func TestFormatEntitiesUsesPlayerName(t *testing.T) {
 		t.Fatalf("expected player label in %q", formatted)
 	}
 }
+
+func TestFormatEntitiesDescribesMetadataAndEquipment(t *testing.T) {
+	entities := []world.Entity{{
+		EntityID:  3,
+		Type:      150,
+		X:         1,
+		Y:         64,
+		Z:         2,
+		Baby:      true,
+		MainHand:  "Iron Sword",
+		Armor:     [4]string{"", "", "", "Iron Helmet"},
+		Health:    12,
+		MaxHealth: 20,
+		OnFire:    true,
+		Pose:      "standing",
+	}}
+	formatted := FormatEntities(entities, nil)
+	want := "baby Zombie(id=3): [1,64,2] holding Iron Sword, wearing Iron Helmet, 60% hp, on fire"
+	if formatted != want {
+		t.Fatalf("FormatEntities() = %q, want %q", formatted, want)
+	}
+
+	entities = []world.Entity{{EntityID: 4, Type: 150, CustomName: "Bob", Health: 7, Pose: "sleeping"}}
+	formatted = FormatEntities(entities, nil)
+	if want := `Zombie "Bob"(id=4): [0,0,0] 7 hp, sleeping`; formatted != want {
+		t.Fatalf("FormatEntities() = %q, want %q", formatted, want)
+	}
+}
@@ -0,0 +1,154 @@
+package agent
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Versifine/locus/internal/world"
+)
+
+const (
+	defaultSpawnRiskRadius = 16
+	maxSpawnRiskRadius     = 32
+	// spawnRiskHeight limits the vertical scan; mobs far above or below
+	// rarely matter to the player's base.
+	spawnRiskHeight   = 8
+	maxSpawnRiskSpots = 40
+	// maxSkyLightForDaySpawns is the highest sky light hostile mobs spawn in
+	// during the day.
+	maxSkyLightForDaySpawns = 7
+)
+
+// SpawnSpot is a position where a hostile mob can spawn.
+type SpawnSpot struct {
+	Pos [3]int
+	// NightOnly spots are open to the sky and only dangerous after dark.
+	NightOnly bool
+}
+
+// SpawnRiskSpots lists positions within radius of center where hostile mobs
+// can spawn: two non-solid, non-fluid blocks above an opaque solid block,
+// with block light 0 as required since 1.18. Nearest spots come first.
+func SpawnRiskSpots(center [3]int, radius int, blocks BlockAccess, lights LightSource) []SpawnSpot {
+	if blocks == nil || lights == nil {
+		return nil
+	}
+	var spots []SpawnSpot
+	for y := center[1] - spawnRiskHeight; y <= center[1]+spawnRiskHeight; y++ {
+		for x := center[0] - radius; x <= center[0]+radius; x++ {
+			for z := center[2] - radius; z <= center[2]+radius; z++ {
+				if !canSpawnAt(x, y, z, blocks) {
+					continue
+				}
+				light, ok := lights.GetLight(x, y, z)
+				if !ok || light.Block > 0 {
+					continue
+				}
+				spots = append(spots, SpawnSpot{Pos: [3]int{x, y, z}, NightOnly: light.Sky > maxSkyLightForDaySpawns})
+			}
+		}
+	}
+	sort.Slice(spots, func(i, j int) bool {
+		di, dj := spotDistSq(center, spots[i].Pos), spotDistSq(center, spots[j].Pos)
+		if di != dj {
+			return di < dj
+		}
+		if spots[i].Pos[1] != spots[j].Pos[1] {
+			return spots[i].Pos[1] < spots[j].Pos[1]
+		}
+		if spots[i].Pos[0] != spots[j].Pos[0] {
+			return spots[i].Pos[0] < spots[j].Pos[0]
+		}
+		return spots[i].Pos[2] < spots[j].Pos[2]
+	})
+	return spots
+}
+
+func canSpawnAt(x, y, z int, blocks BlockAccess) bool {
+	if !isSpawnSpace(x, y, z, blocks) || !isSpawnSpace(x, y+1, z, blocks) {
+		return false
+	}
+	below, ok := blocks.GetBlockState(x, y-1, z)
+	if !ok || !blocks.IsSolid(x, y-1, z) || isTransparent(blocks, below) {
+		return false
+	}
+	return true
+}
+
+func isSpawnSpace(x, y, z int, blocks BlockAccess) bool {
+	stateID, ok := blocks.GetBlockState(x, y, z)
+	if !ok || blocks.IsSolid(x, y, z) {
+		return false
+	}
+	if isAirState(blocks, stateID) {
+		return true
+	}
+	name, _ := blocks.GetBlockNameByStateID(stateID)
+	switch normalizeBlockName(name) {
+	case "water", "lava", "bubble_column":
+		return false
+	}
+	return true
+}
+
+func spotDistSq(a, b [3]int) int {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+func (e ToolExecutor) executeQuerySpawnRisk(input map[string]any) (string, error) {
+	snap, err := e.snapshot()
+	if err != nil {
+		return "", err
+	}
+	lights, ok := e.World.(LightSource)
+	if e.World == nil || !ok {
+		return toJSONString(map[string]any{"status": "unavailable", "reason": "light_data_unavailable"}), nil
+	}
+
+	radius := defaultSpawnRiskRadius
+	if rawRadius, ok := asInt(input["radius"]); ok && rawRadius > 0 {
+		radius = min(rawRadius, maxSpawnRiskRadius)
+	}
+
+	center := [3]int{
+		int(math.Floor(snap.Position.X)),
+		int(math.Floor(snap.Position.Y)),
+		int(math.Floor(snap.Position.Z)),
+	}
+	spots := SpawnRiskSpots(center, radius, e.World, lights)
+	nightOnly := 0
+	items := make([]map[string]any, 0, min(len(spots), maxSpawnRiskSpots))
+	for i, spot := range spots {
+		if spot.NightOnly {
+			nightOnly++
+		}
+		if i < maxSpawnRiskSpots {
+			items = append(items, map[string]any{
+				"position":   spot.Pos,
+				"night_only": spot.NightOnly,
+			})
+		}
+	}
+
+	result := map[string]any{
+		"status":           "ok",
+		"center":           center,
+		"radius":           radius,
+		"count":            len(spots),
+		"night_only_count": nightOnly,
+		"spots":            items,
+	}
+	if light, ok := lights.GetLight(center[0], center[1], center[2]); ok {
+		result["standing_light"] = lightInfo(light)
+	}
+	return toJSONString(result), nil
+}
+
+func lightInfo(light world.LightLevel) map[string]any {
+	return map[string]any{
+		"sky":   light.Sky,
+		"block": light.Block,
+		"dark":  light.Max() == 0,
+	}
+}
@@ -0,0 +1,113 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Versifine/locus/internal/world"
+)
+
+func newSpawnRiskTestBlocks() *litCameraTestBlocks {
+	blocks := &litCameraTestBlocks{cameraTestBlocks: newCameraTestBlocks(), light: make(map[[3]int]world.LightLevel)}
+	for x := -1; x <= 1; x++ {
+		for z := -1; z <= 1; z++ {
+			blocks.set(x, 0, z, 1)
+		}
+	}
+	return blocks
+}
+
+func TestSpawnRiskSpotsSkipsLitAndTransparentFloors(t *testing.T) {
+	blocks := newSpawnRiskTestBlocks()
+	blocks.light[[3]int{0, 1, 0}] = world.LightLevel{Block: 10}
+	blocks.set(1, 0, 1, 3)
+	blocks.light[[3]int{-1, 1, -1}] = world.LightLevel{Sky: 15}
+	// A low ceiling leaves no room for a mob below it, but its top is open.
+	blocks.set(0, 2, -1, 1)
+
+	spots := SpawnRiskSpots([3]int{0, 1, 0}, 2, blocks, blocks)
+	if len(spots) != 7 {
+		t.Fatalf("spots=%v want 7", spots)
+	}
+	if spots[0].Pos != [3]int{-1, 1, 0} || spots[6].Pos != [3]int{0, 3, -1} {
+		t.Fatalf("spots=%v want [-1 1 0] first and [0 3 -1] last", spots)
+	}
+	for _, spot := range spots {
+		switch spot.Pos {
+		case [3]int{0, 1, 0}, [3]int{1, 1, 1}, [3]int{0, 1, -1}:
+			t.Fatalf("unexpected spawn spot %v", spot.Pos)
+		}
+		wantNightOnly := spot.Pos == [3]int{-1, 1, -1}
+		if spot.NightOnly != wantNightOnly {
+			t.Fatalf("spot %v night_only=%v want %v", spot.Pos, spot.NightOnly, wantNightOnly)
+		}
+	}
+}
+
+func TestToolExecutorQuerySpawnRisk(t *testing.T) {
+	blocks := newSpawnRiskTestBlocks()
+	blocks.light[[3]int{0, 1, 0}] = world.LightLevel{Block: 14}
+	executor := ToolExecutor{
+		SnapshotFn: func() world.Snapshot {
+			return world.Snapshot{Position: world.Position{X: 0.5, Y: 1, Z: 0.5}}
+		},
+		World: blocks,
+	}
+
+	text, err := executor.ExecuteTool(context.Background(), "query_spawn_risk", map[string]any{"radius": 3})
+	if err != nil {
+		t.Fatalf("query_spawn_risk error: %v", err)
+	}
+	var out struct {
+		Status        string `json:"status"`
+		Count         int    `json:"count"`
+		StandingLight struct {
+			Block int  `json:"block"`
+			Dark  bool `json:"dark"`
+		} `json:"standing_light"`
+	}
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		t.Fatalf("parse result: %v", err)
+	}
+	if out.Status != "ok" || out.Count != 8 {
+		t.Fatalf("result=%s want ok with 8 spots", text)
+	}
+	if out.StandingLight.Block != 14 || out.StandingLight.Dark {
+		t.Fatalf("standing_light=%+v want block 14", out.StandingLight)
+	}
+
+	executor.World = newCameraTestBlocks()
+	text, err = executor.ExecuteTool(context.Background(), "query_spawn_risk", nil)
+	if err != nil {
+		t.Fatalf("query_spawn_risk without light error: %v", err)
+	}
+	if err := json.Unmarshal([]byte(text), &out); err != nil || out.Status != "unavailable" {
+		t.Fatalf("result=%s want unavailable", text)
+	}
+}
+
+func TestToolExecutorLookReportsLight(t *testing.T) {
+	blocks := newSpawnRiskTestBlocks()
+	executor := ToolExecutor{
+		SnapshotFn: func() world.Snapshot {
+			return world.Snapshot{Position: world.Position{X: 0.5, Y: 1, Z: 0.5}}
+		},
+		World:  blocks,
+		Camera: Camera{FOV: 70, MaxDist: 16, Width: 1, Height: 1},
+	}
+
+	text, err := executor.ExecuteTool(context.Background(), "look", map[string]any{"direction": "forward"})
+	if err != nil {
+		t.Fatalf("look error: %v", err)
+	}
+	var out struct {
+		Light map[string]any `json:"light"`
+	}
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		t.Fatalf("parse look result: %v", err)
+	}
+	if dark, _ := out.Light["dark"].(bool); !dark {
+		t.Fatalf("light=%v want dark", out.Light)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/Versifine/locus/internal/config"
 	"github.com/Versifine/locus/internal/event"
@@ -49,7 +51,7 @@ func (t *thinker) think(ctx context.Context, snap world.Snapshot, events []Buffe
 
 	messages := []llm.ToolMessage{
 		{Role: "system", Content: thinkerSystemPrompt(t.llmClient.Config().SystemPrompt)},
-		{Role: "user", Content: thinkerInitialInput(snap, events, t.runner, shortTerm, thinkerSpatialContext(snap, t.executor.SpatialMemory))},
+		{Role: "user", Content: thinkerInitialInput(snap, events, t.runner, shortTerm, thinkerSpatialContext(snap, t.executor.SpatialMemory), thinkerInventoryStatus(t.executor.Inventory), thinkerBiomeStatus(snap, t.executor.World))},
 	}
 
 	for {
@@ -159,7 +161,7 @@ func thinkerSystemPrompt(base string) string {
 	return base + "\n\n重要：你必须通过工具获取信息和执行动作。[Basic Status] 提供你的基础状态，[Events] 是最近发生的事件。根据这些信息决定下一步行动。如果不确定周围环境，先调用 look() 观察。"
 }
 
-func thinkerInitialInput(snap world.Snapshot, events []BufferedEvent, runner *skill.BehaviorRunner, shortTerm string, spatialContext string) string {
+func thinkerInitialInput(snap world.Snapshot, events []BufferedEvent, runner *skill.BehaviorRunner, shortTerm string, spatialContext string, inventory string, biome string) string {
 	active := "none"
 	if runner != nil {
 		names := runner.Active()
@@ -178,6 +180,14 @@ func thinkerInitialInput(snap world.Snapshot, events []BufferedEvent, runner *sk
 		spatialContext = "- none"
 	}
 
+	inventory = strings.TrimSpace(inventory)
+	if inventory == "" {
+		inventory = "unknown"
+	}
+	if biome == "" {
+		biome = "unknown"
+	}
+
 	eventLines := make([]string, 0, len(events))
 	for _, evt := range events {
 		eventLines = append(eventLines, "- "+formatBufferedEvent(evt))
@@ -187,7 +197,7 @@ func thinkerInitialInput(snap world.Snapshot, events []BufferedEvent, runner *sk
 	}
 
 	return fmt.Sprintf(
-		"[Basic Status]\nposition=(%.2f, %.2f, %.2f) yaw=%.2f pitch=%.2f hp=%.1f food=%d active=%s\n\n[Short-term Memory]\n%s\n\n[Spatial Context]\n%s\n\n[Events]\n%s",
+		"[Basic Status]\nposition=(%.2f, %.2f, %.2f) yaw=%.2f pitch=%.2f hp=%.1f food=%d biome=%s active=%s\n%s\n%s\ninventory: %s\n\n[Short-term Memory]\n%s\n\n[Spatial Context]\n%s\n\n[Events]\n%s",
 		snap.Position.X,
 		snap.Position.Y,
 		snap.Position.Z,
@@ -195,13 +205,127 @@ func thinkerInitialInput(snap world.Snapshot, events []BufferedEvent, runner *sk
 		snap.Position.Pitch,
 		snap.Health,
 		snap.Food,
+		biome,
 		active,
+		thinkerPlayerStatus(snap, time.Now()),
+		thinkerEnvironment(snap),
+		inventory,
 		shortTerm,
 		spatialContext,
 		strings.Join(eventLines, "\n"),
 	)
 }
 
+// thinkerPlayerStatus renders game mode, experience, flight and effects.
+func thinkerPlayerStatus(snap world.Snapshot, now time.Time) string {
+	mode := snap.GameMode
+	if mode == "" {
+		mode = "unknown"
+	}
+	effects := make([]string, 0, len(snap.Effects))
+	for _, e := range snap.Effects {
+		text := e.Name + " " + effectLevelName(e.Level())
+		if left, ok := e.Remaining(now); ok {
+			text += fmt.Sprintf(" (%ds)", int(left.Seconds()))
+		}
+		effects = append(effects, text)
+	}
+	effectText := "none"
+	if len(effects) > 0 {
+		effectText = strings.Join(effects, ", ")
+	}
+	status := fmt.Sprintf(
+		"mode=%s level=%d (%d%%) flying=%t effects=%s",
+		mode,
+		snap.Experience.Level,
+		int(snap.Experience.Progress*100),
+		snap.Abilities.Flying,
+		effectText,
+	)
+	if d := snap.LastDeath; d != nil {
+		status += fmt.Sprintf(" last_death=[%d,%d,%d] %s", int(math.Floor(d.X)), int(math.Floor(d.Y)), int(math.Floor(d.Z)), emptyAsUnknown(d.Dimension))
+	}
+	return status
+}
+
+// borderWarnDistance is how close the world border must be to be shown.
+const borderWarnDistance = 128
+
+// thinkerEnvironment renders the time of day, weather and a nearby world
+// border for [Basic Status].
+func thinkerEnvironment(snap world.Snapshot) string {
+	// Time of day 0 is 06:00.
+	tod := snap.GameTime.TimeOfDay()
+	hours := (tod/1000 + 6) % 24
+	minutes := (tod % 1000) * 60 / 1000
+	text := fmt.Sprintf(
+		"time=%02d:%02d phase=%s moon=%s weather=%s",
+		hours, minutes,
+		snap.GameTime.DayPhase(),
+		snap.GameTime.MoonPhase(),
+		snap.Weather.Name(),
+	)
+	if b := snap.WorldBorder; b.Known {
+		if dist := b.Distance(snap.Position.X, snap.Position.Z); dist < borderWarnDistance {
+			text += fmt.Sprintf(" border_dist=%d", int(math.Floor(dist)))
+			if b.TargetDiameter < b.Diameter {
+				text += " border_shrinking=true"
+			}
+		}
+	}
+	return text
+}
+
+// thinkerInventoryStatus renders a compact one-line inventory for [Basic Status].
+// Stacks of the same item are merged so the line stays short.
+func thinkerInventoryStatus(provider InventoryProvider) string {
+	if provider == nil {
+		return "unknown"
+	}
+	inv, ok := provider.GetInventorySnapshot()
+	if !ok {
+		return "unknown"
+	}
+
+	held := "empty"
+	if item, ok := inv.HeldItem(); ok {
+		held = fmt.Sprintf("%s x%d", item.Name, item.Count)
+	}
+	parts := []string{fmt.Sprintf("held[%d]=%s", inv.HeldSlot, held)}
+
+	if len(inv.Armor) > 0 {
+		armor := make([]string, 0, len(inv.Armor))
+		for _, item := range inv.Armor {
+			armor = append(armor, item.Name)
+		}
+		parts = append(parts, "armor="+strings.Join(armor, ","))
+	}
+	if inv.Offhand != nil {
+		parts = append(parts, "offhand="+inv.Offhand.Name)
+	}
+
+	totals := make(map[string]int)
+	order := make([]string, 0)
+	for _, group := range [][]InventoryItem{inv.Hotbar, inv.Main} {
+		for _, item := range group {
+			if _, seen := totals[item.Name]; !seen {
+				order = append(order, item.Name)
+			}
+			totals[item.Name] += item.Count
+		}
+	}
+	if len(order) == 0 {
+		parts = append(parts, "items=none")
+	} else {
+		items := make([]string, 0, len(order))
+		for _, name := range order {
+			items = append(items, fmt.Sprintf("%s x%d", name, totals[name]))
+		}
+		parts = append(parts, "items="+strings.Join(items, ", "))
+	}
+	return strings.Join(parts, " ")
+}
+
 func thinkerSpatialContext(snap world.Snapshot, spatialMemory *SpatialMemory) string {
 	if spatialMemory == nil {
 		return "- none"
@@ -218,16 +342,60 @@ func formatBufferedEvent(evt BufferedEvent) string {
 	switch evt.Name {
 	case event.EventChat:
 		if chat, ok := asChatEvent(evt.Payload); ok {
+			if chat.Notice != nil {
+				return fmt.Sprintf("%s source=%s notice=%s player=%s msg=%q", base, chat.Source.String(), chat.Notice.Kind, chat.Notice.Player, chat.Message)
+			}
+			if chat.EntityID != 0 {
+				return fmt.Sprintf("%s user=%s entity_id=%d source=%s msg=%q", base, chat.Username, chat.EntityID, chat.Source.String(), chat.Message)
+			}
 			return fmt.Sprintf("%s user=%s source=%s msg=%q", base, chat.Username, chat.Source.String(), chat.Message)
 		}
 	case event.EventDamage:
 		if dmg, ok := asDamageEvent(evt.Payload); ok {
-			return fmt.Sprintf("%s amount=%.1f hp=%.1f", base, dmg.Amount, dmg.NewHP)
+			return formatDamageEvent(base, dmg)
 		}
 	case event.EventBehaviorEnd:
 		if done, ok := asBehaviorEndEvent(evt.Payload); ok {
 			return fmt.Sprintf("%s name=%s run_id=%d reason=%s", base, done.Name, done.RunID, done.Reason)
 		}
+	case event.EventDisconnect:
+		if d, ok := evt.Payload.(event.DisconnectEvent); ok {
+			return fmt.Sprintf("%s kind=%s reason=%q", base, d.Kind, d.Reason)
+		}
+	case event.EventReconnect:
+		if r, ok := evt.Payload.(event.ReconnectEvent); ok {
+			return fmt.Sprintf("%s attempt=%d downtime=%s", base, r.Attempt, r.Downtime.Round(time.Second))
+		}
+	case event.EventStatusChange:
+		if c, ok := evt.Payload.(event.StatusChangeEvent); ok {
+			if c.Name != "" {
+				return fmt.Sprintf("%s kind=%s name=%s from=%q to=%q", base, c.Kind, c.Name, c.From, c.To)
+			}
+			return fmt.Sprintf("%s kind=%s from=%q to=%q", base, c.Kind, c.From, c.To)
+		}
+	case event.EventSound:
+		if snd, ok := evt.Payload.(event.SoundEvent); ok {
+			text := fmt.Sprintf("%s sound=%s category=%s pos=[%.1f,%.1f,%.1f] dist=%.1f", base, snd.Name, snd.Category, snd.X, snd.Y, snd.Z, snd.Distance)
+			if snd.EntityID != 0 {
+				text += fmt.Sprintf(" entity_id=%d", snd.EntityID)
+			}
+			if snd.Direction != "" {
+				text += " from=" + snd.Direction
+			}
+			return text
+		}
+	case event.EventTimePhase:
+		if p, ok := evt.Payload.(event.TimePhaseEvent); ok {
+			return fmt.Sprintf("%s from=%s to=%s moon=%s", base, p.From, p.To, p.MoonPhase)
+		}
+	case event.EventWeather:
+		if w, ok := evt.Payload.(event.WeatherEvent); ok {
+			return fmt.Sprintf("%s from=%s to=%s", base, w.From, w.To)
+		}
+	case event.EventDeath:
+		if d, ok := evt.Payload.(event.DeathEvent); ok {
+			return fmt.Sprintf("%s msg=%q pos=[%.1f,%.1f,%.1f] dim=%s", base, d.Message, d.X, d.Y, d.Z, emptyAsUnknown(d.Dimension))
+		}
 	case event.EventEntityAppear, event.EventEntityLeave:
 		if e, ok := asEntityEvent(evt.Payload); ok {
 			return fmt.Sprintf("%s entity_id=%d name=%s type=%d", base, e.EntityID, e.Name, e.Type)
@@ -259,6 +427,29 @@ func asChatEvent(raw any) (*event.ChatEvent, bool) {
 	}
 }
 
+func formatDamageEvent(base string, dmg event.DamageEvent) string {
+	text := fmt.Sprintf("%s amount=%.1f hp=%.1f", base, dmg.Amount, dmg.NewHP)
+	if dmg.Type != "" {
+		text += " type=" + dmg.Type
+	}
+	if dmg.SourceEntityID != 0 {
+		text += fmt.Sprintf(" attacker_id=%d", dmg.SourceEntityID)
+		if dmg.SourceName != "" {
+			text += " attacker=" + dmg.SourceName
+		}
+	}
+	if dmg.DirectEntityID != 0 && dmg.DirectEntityID != dmg.SourceEntityID {
+		text += fmt.Sprintf(" direct_id=%d", dmg.DirectEntityID)
+	}
+	if dmg.HasSourcePos {
+		text += fmt.Sprintf(" from_pos=[%.1f,%.1f,%.1f]", dmg.SourceX, dmg.SourceY, dmg.SourceZ)
+	}
+	if dmg.Direction != "" {
+		text += " from=" + dmg.Direction
+	}
+	return text
+}
+
 func asDamageEvent(raw any) (event.DamageEvent, bool) {
 	switch v := raw.(type) {
 	case event.DamageEvent:
@@ -205,6 +205,62 @@ func TestFormatBufferedEventDetails(t *testing.T) {
 		t.Fatalf("chat formatted=%q", chat)
 	}
 
+	followMe := formatBufferedEvent(BufferedEvent{
+		Name:    event.EventChat,
+		Payload: &event.ChatEvent{Username: "Steve", Message: "follow me", Source: event.SourcePlayer, EntityID: 12},
+	})
+	if !containsAll(followMe, []string{"user=Steve", "entity_id=12", "follow me"}) {
+		t.Fatalf("chat with sender entity formatted=%q", followMe)
+	}
+
+	death := formatBufferedEvent(BufferedEvent{
+		Name: event.EventChat,
+		Payload: &event.ChatEvent{
+			Username: "SYSTEM",
+			Message:  "Steve was slain by Zombie",
+			Source:   event.SourceSystem,
+			Notice:   &event.ChatNotice{Kind: event.NoticeDeath, Key: "death.attack.mob", Player: "Steve"},
+		},
+	})
+	if !containsAll(death, []string{"notice=death", "player=Steve", "slain by Zombie"}) {
+		t.Fatalf("death formatted=%q", death)
+	}
+
+	effect := formatBufferedEvent(BufferedEvent{
+		Name:    event.EventStatusChange,
+		Payload: event.StatusChangeEvent{Kind: "effect", Name: "Speed", To: "II"},
+	})
+	if !containsAll(effect, []string{"status.change", "kind=effect", "name=Speed", `to="II"`}) {
+		t.Fatalf("status change formatted=%q", effect)
+	}
+
+	hit := formatBufferedEvent(BufferedEvent{
+		Name: event.EventDamage,
+		Payload: event.DamageEvent{
+			Amount: 3, NewHP: 17, Type: "arrow", SourceEntityID: 40, SourceName: "skeleton", DirectEntityID: 41,
+			HasSourcePos: true, SourceX: 4, SourceY: 64, SourceZ: -2, Direction: "left",
+		},
+	})
+	if !containsAll(hit, []string{"amount=3.0", "type=arrow", "attacker_id=40", "attacker=skeleton", "direct_id=41", "from_pos=[4.0,64.0,-2.0]", "from=left"}) {
+		t.Fatalf("damage formatted=%q", hit)
+	}
+
+	died := formatBufferedEvent(BufferedEvent{
+		Name:    event.EventDeath,
+		Payload: event.DeathEvent{Message: "Bot drowned", X: 1, Y: 62, Z: -4.5, Dimension: "minecraft:overworld"},
+	})
+	if !containsAll(died, []string{"death", `msg="Bot drowned"`, "pos=[1.0,62.0,-4.5]", "dim=minecraft:overworld"}) {
+		t.Fatalf("self death formatted=%q", died)
+	}
+
+	hiss := formatBufferedEvent(BufferedEvent{
+		Name:    event.EventSound,
+		Payload: event.SoundEvent{Name: "entity.creeper.primed", Category: "hostile", EntityID: 9, Z: -3, Distance: 3, Direction: "behind"},
+	})
+	if !containsAll(hiss, []string{"sound=entity.creeper.primed", "category=hostile", "pos=[0.0,0.0,-3.0]", "dist=3.0", "entity_id=9", "from=behind"}) {
+		t.Fatalf("sound formatted=%q", hiss)
+	}
+
 	behaviorEnd := formatBufferedEvent(BufferedEvent{
 		Name:    event.EventBehaviorEnd,
 		TickID:  11,
@@ -224,8 +280,10 @@ func TestThinkerInitialInputIncludesFormattedEvents(t *testing.T) {
 		nil,
 		"- [closed] id=ep-1 tick=10 trigger=chat decision=go_to outcome=behavior_end",
 		"Nearby entities (last 30s): none\nRecent blocks: none",
+		"held[0]=stone x3",
+		"plains",
 	)
-	if !containsAll(text, []string{"[Basic Status]", "[Short-term Memory]", "[Spatial Context]", "[Events]", "damage@tick=21", "amount=2.0", "hp=18.0"}) {
+	if !containsAll(text, []string{"[Basic Status]", "biome=plains", "mode=unknown level=0 (0%) flying=false effects=none", "inventory: held[0]=stone x3", "[Short-term Memory]", "[Spatial Context]", "[Events]", "damage@tick=21", "amount=2.0", "hp=18.0"}) {
 		t.Fatalf("initial input=%q", text)
 	}
 }
@@ -248,3 +306,50 @@ func containsAll(text string, parts []string) bool {
 	}
 	return true
 }
+
+func TestThinkerPlayerStatus(t *testing.T) {
+	now := time.Now()
+	snap := world.Snapshot{
+		GameMode:   "survival",
+		Experience: world.Experience{Level: 7, Progress: 0.5},
+		Effects: []world.ActiveEffect{
+			{ID: 0, Name: "Speed", Amplifier: 1, Expires: now.Add(30 * time.Second)},
+			{ID: 16, Name: "Night Vision"},
+		},
+	}
+	got := thinkerPlayerStatus(snap, now)
+	want := "mode=survival level=7 (50%) flying=false effects=Speed II (30s), Night Vision I"
+	if got != want {
+		t.Fatalf("thinkerPlayerStatus() = %q, want %q", got, want)
+	}
+
+	snap.LastDeath = &world.DeathLocation{X: -0.5, Y: 64, Z: 10.2, Dimension: "minecraft:the_nether"}
+	got = thinkerPlayerStatus(snap, now)
+	if !strings.HasSuffix(got, " last_death=[-1,64,10] minecraft:the_nether") {
+		t.Fatalf("thinkerPlayerStatus() = %q, want last death suffix", got)
+	}
+}
+
+func TestThinkerEnvironment(t *testing.T) {
+	snap := world.Snapshot{
+		Position: world.Position{X: 90, Z: 10},
+		GameTime: world.GameTime{WorldTime: 4*24000 + 18000, Age: 1},
+		Weather:  world.Weather{Raining: true, RainLevel: 1, ThunderLevel: 1},
+	}
+	got := thinkerEnvironment(snap)
+	want := "time=00:00 phase=night moon=new_moon weather=thunder"
+	if got != want {
+		t.Fatalf("thinkerEnvironment() = %q, want %q", got, want)
+	}
+
+	snap.WorldBorder = world.WorldBorder{Known: true, Diameter: 400, TargetDiameter: 300}
+	got = thinkerEnvironment(snap)
+	if !strings.HasSuffix(got, " border_dist=60 border_shrinking=true") {
+		t.Fatalf("thinkerEnvironment() = %q, want nearby border suffix", got)
+	}
+
+	snap.WorldBorder = world.WorldBorder{Known: true, Diameter: 60000000, TargetDiameter: 60000000}
+	if got = thinkerEnvironment(snap); got != want {
+		t.Fatalf("thinkerEnvironment() = %q, want distant border hidden", got)
+	}
+}
@@ -13,15 +13,32 @@ import (
 	"github.com/Versifine/locus/internal/world"
 )
 
+// InventoryItem slots use the player inventory index:
+// 0-8 hotbar, 9-35 main, 36-39 armor (feet, legs, chest, head), 40 offhand.
 type InventoryItem struct {
-	Slot  int    `json:"slot"`
-	Name  string `json:"name"`
-	Count int    `json:"count"`
+	Slot       int            `json:"slot"`
+	ItemID     int32          `json:"item_id"`
+	Name       string         `json:"name"`
+	Count      int            `json:"count"`
+	Components map[string]any `json:"components,omitempty"`
 }
 
 type InventorySnapshot struct {
-	Hotbar []InventoryItem `json:"hotbar"`
-	Main   []InventoryItem `json:"main"`
+	Hotbar   []InventoryItem `json:"hotbar"`
+	Main     []InventoryItem `json:"main"`
+	Armor    []InventoryItem `json:"armor"`
+	Offhand  *InventoryItem  `json:"offhand,omitempty"`
+	HeldSlot int             `json:"held_slot"`
+}
+
+// HeldItem returns the item in the selected hotbar slot, if any.
+func (inv InventorySnapshot) HeldItem() (InventoryItem, bool) {
+	for _, item := range inv.Hotbar {
+		if item.Slot == inv.HeldSlot {
+			return item, true
+		}
+	}
+	return InventoryItem{}, false
 }
 
 type InventoryProvider interface {
@@ -36,7 +53,11 @@ type ToolExecutor struct {
 
 	SpatialMemory *SpatialMemory
 
-	SpeakChan  chan<- string
+	SpeakChan chan<- string
+	// Whisper sends a private message; speak with "to" uses it.
+	Whisper func(target, message string) error
+	// Respawn respawns the bot after death when automatic respawn is off.
+	Respawn    func() error
 	IntentChan chan<- Intent
 	CancelAll  func()
 	SetHead    func(yaw, pitch float32)
@@ -45,7 +66,8 @@ type ToolExecutor struct {
 	Recall      func(ctx context.Context, query string, filter map[string]any, topK int) (map[string]any, error)
 	Remember    func(ctx context.Context, content string, tags map[string]any) (map[string]any, error)
 
-	Inventory InventoryProvider
+	Inventory  InventoryProvider
+	Containers ContainerController
 }
 
 func ExecuteTool(name string, input map[string]any, snapshotFn func() world.Snapshot, worldAccess BlockAccess) (string, error) {
@@ -72,12 +94,20 @@ func (e ToolExecutor) ExecuteTool(ctx context.Context, name string, input map[st
 		return e.executeQueryBlock(input)
 	case "query_nearby":
 		return e.executeQueryNearby(input)
+	case "query_spawn_risk":
+		return e.executeQuerySpawnRisk(input)
+	case "find_biome":
+		return e.executeFindBiome(input)
+	case "listen":
+		return e.executeListen(input)
 	case "check_inventory":
 		return e.executeCheckInventory()
 	case "speak":
 		return e.executeSpeak(ctx, input)
 	case "stop":
 		return e.executeStop()
+	case "respawn":
+		return e.executeRespawn()
 	case "go_to":
 		return e.executeActionIntent(ctx, "go_to", input)
 	case "follow":
@@ -92,8 +122,14 @@ func (e ToolExecutor) ExecuteTool(ctx context.Context, name string, input map[st
 		return e.executeActionIntent(ctx, "use_item", input)
 	case "switch_slot":
 		return e.executeActionIntent(ctx, "switch_slot", input)
+	case "craft":
+		return e.executeActionIntent(ctx, "craft", input)
 	case "set_intent":
 		return e.executeSetIntent(ctx, input)
+	case "open_container":
+		return e.executeOpenContainer(ctx, input)
+	case "transfer_items":
+		return e.executeTransferItems(ctx, input)
 	case "wait_for_idle":
 		return e.executeWaitForIdle(ctx, input)
 	case "recall":
@@ -150,6 +186,12 @@ func (e ToolExecutor) executeLook(input map[string]any) (string, error) {
 		"blocks":    FormatBlocks(blocks),
 		"entities":  FormatEntities(entities, snap.PlayerList),
 	}
+	if lights, ok := e.World.(LightSource); ok {
+		feet := [3]int{int(math.Floor(snap.Position.X)), int(math.Floor(snap.Position.Y)), int(math.Floor(snap.Position.Z))}
+		if light, ok := lights.GetLight(feet[0], feet[1], feet[2]); ok {
+			result["light"] = lightInfo(light)
+		}
+	}
 	if e.SpatialMemory != nil {
 		e.SpatialMemory.UpdateBlocks(blocks, e.currentTickID())
 		e.SpatialMemory.UpdateEntities(entities, e.currentTickID())
@@ -225,10 +267,22 @@ func (e ToolExecutor) executeQueryBlock(input map[string]any) (string, error) {
 
 	stateID, found := e.World.GetBlockState(x, y, z)
 	if !found {
-		return toJSONString(map[string]any{
+		result := map[string]any{
 			"position": fmt.Sprintf("[%d,%d,%d]", x, y, z),
 			"status":   "unloaded",
-		}), nil
+		}
+		if memory, ok := e.World.(LastKnownBlockSource); ok {
+			if lastState, seenAt, ok := memory.LastKnownBlockState(x, y, z); ok {
+				lastName, ok := e.World.GetBlockNameByStateID(lastState)
+				if !ok {
+					lastName = fmt.Sprintf("state_%d", lastState)
+				}
+				result["last_known"] = lastName
+				result["last_known_state_id"] = lastState
+				result["last_seen_sec_ago"] = int(time.Since(seenAt).Seconds())
+			}
+		}
+		return toJSONString(result), nil
 	}
 
 	name, ok := e.World.GetBlockNameByStateID(stateID)
@@ -242,6 +296,20 @@ func (e ToolExecutor) executeQueryBlock(input map[string]any) (string, error) {
 		"name":     name,
 		"solid":    e.World.IsSolid(x, y, z),
 	}
+	if describer, ok := e.World.(BlockStateDescriber); ok {
+		if state, ok := describer.DescribeBlockState(stateID); ok {
+			result["block"] = state.Block
+			if len(state.Properties) > 0 {
+				result["state"] = state.String()
+				result["properties"] = state.Properties
+			}
+		}
+	}
+	if tagger, ok := e.World.(BlockTagSource); ok {
+		if tags := tagger.BlockTags(stateID); len(tags) > 0 {
+			result["tags"] = tags
+		}
+	}
 	return toJSONString(result), nil
 }
 
@@ -352,21 +420,33 @@ func (e ToolExecutor) executeCheckInventory() (string, error) {
 	}
 
 	return toJSONString(map[string]any{
-		"status":  "ok",
-		"hotbar":  inventory.Hotbar,
-		"main":    inventory.Main,
-		"summary": summarizeInventory(inventory),
+		"status":    "ok",
+		"held_slot": inventory.HeldSlot,
+		"hotbar":    inventory.Hotbar,
+		"main":      inventory.Main,
+		"armor":     inventory.Armor,
+		"offhand":   inventory.Offhand,
+		"summary":   summarizeInventory(inventory),
 	}), nil
 }
 
 func (e ToolExecutor) executeSpeak(ctx context.Context, input map[string]any) (string, error) {
-	if e.SpeakChan == nil {
-		return "", fmt.Errorf("speak channel unavailable")
-	}
 	message := strings.TrimSpace(asString(input["message"]))
 	if message == "" {
 		return "", fmt.Errorf("speak message is empty")
 	}
+	if to := strings.TrimSpace(asString(input["to"])); to != "" {
+		if e.Whisper == nil {
+			return toJSONString(map[string]any{"status": "unavailable", "reason": "whisper_not_supported"}), nil
+		}
+		if err := e.Whisper(to, message); err != nil {
+			return "", err
+		}
+		return toJSONString(map[string]any{"status": "ok", "to": to}), nil
+	}
+	if e.SpeakChan == nil {
+		return "", fmt.Errorf("speak channel unavailable")
+	}
 
 	select {
 	case <-ctx.Done():
@@ -384,6 +464,26 @@ func (e ToolExecutor) executeStop() (string, error) {
 	return toJSONString(map[string]any{"status": "ok"}), nil
 }
 
+func (e ToolExecutor) executeRespawn() (string, error) {
+	if e.Respawn == nil {
+		slog.Warn("respawn unavailable", "reason", "respawn_not_supported")
+		return toJSONString(map[string]any{
+			"status": "unavailable",
+			"reason": "respawn_not_supported",
+		}), nil
+	}
+	if e.SnapshotFn != nil && e.SnapshotFn().Health > 0 {
+		return toJSONString(map[string]any{
+			"status": "ignored",
+			"reason": "not_dead",
+		}), nil
+	}
+	if err := e.Respawn(); err != nil {
+		return "", err
+	}
+	return toJSONString(map[string]any{"status": "ok"}), nil
+}
+
 func (e ToolExecutor) executeActionIntent(ctx context.Context, action string, input map[string]any) (string, error) {
 	if e.IntentChan == nil {
 		return "", fmt.Errorf("intent channel unavailable")
@@ -391,6 +491,9 @@ func (e ToolExecutor) executeActionIntent(ctx context.Context, action string, in
 	if input == nil {
 		input = map[string]any{}
 	}
+	if err := e.resolvePlayerTarget(action, input); err != nil {
+		return "", err
+	}
 	input["action"] = action
 	intent, err := ParseIntent(input)
 	if err != nil {
@@ -406,6 +509,30 @@ func (e ToolExecutor) executeActionIntent(ctx context.Context, action string, in
 	return toJSONString(map[string]any{"status": "ok", "action": intent.Action}), nil
 }
 
+// resolvePlayerTarget fills entity_id for follow and attack from a player
+// name, so the model does not have to look the ID up first.
+func (e ToolExecutor) resolvePlayerTarget(action string, input map[string]any) error {
+	if action != "follow" && action != "attack" {
+		return nil
+	}
+	name := strings.TrimSpace(asString(input["player"]))
+	if _, ok := input["entity_id"]; ok || name == "" {
+		return nil
+	}
+	if e.SnapshotFn == nil {
+		return fmt.Errorf("snapshot unavailable")
+	}
+	player, ok := e.SnapshotFn().PlayerByName(name)
+	if !ok {
+		return fmt.Errorf("player %q is not online", name)
+	}
+	if player.EntityID == 0 {
+		return fmt.Errorf("player %q is out of view", player.Name)
+	}
+	input["entity_id"] = int(player.EntityID)
+	return nil
+}
+
 func (e ToolExecutor) executeSetIntent(ctx context.Context, input map[string]any) (string, error) {
 	if e.IntentChan == nil {
 		return "", fmt.Errorf("intent channel unavailable")
@@ -517,13 +644,19 @@ func (e ToolExecutor) currentTickID() uint64 {
 }
 
 func summarizeInventory(inv InventorySnapshot) string {
-	parts := make([]string, 0, len(inv.Hotbar)+len(inv.Main))
+	parts := make([]string, 0, len(inv.Hotbar)+len(inv.Main)+len(inv.Armor)+1)
 	for _, item := range inv.Hotbar {
 		parts = append(parts, fmt.Sprintf("hotbar[%d]=%s x%d", item.Slot, item.Name, item.Count))
 	}
 	for _, item := range inv.Main {
 		parts = append(parts, fmt.Sprintf("main[%d]=%s x%d", item.Slot, item.Name, item.Count))
 	}
+	for _, item := range inv.Armor {
+		parts = append(parts, fmt.Sprintf("armor[%d]=%s x%d", item.Slot, item.Name, item.Count))
+	}
+	if inv.Offhand != nil {
+		parts = append(parts, fmt.Sprintf("offhand=%s x%d", inv.Offhand.Name, inv.Offhand.Count))
+	}
 	if len(parts) == 0 {
 		return "empty"
 	}
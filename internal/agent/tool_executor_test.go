@@ -71,6 +71,43 @@ func TestToolExecutorSpeakAndSetIntent(t *testing.T) {
 	}
 }
 
+func TestToolExecutorSpeakWhisper(t *testing.T) {
+	speakCh := make(chan string, 1)
+	var gotTarget, gotMessage string
+	executor := ToolExecutor{
+		SpeakChan: speakCh,
+		Whisper: func(target, message string) error {
+			gotTarget, gotMessage = target, message
+			return nil
+		},
+	}
+
+	text, err := executor.ExecuteTool(context.Background(), "speak", map[string]any{"message": "on my way", "to": "Steve"})
+	if err != nil {
+		t.Fatalf("speak error: %v", err)
+	}
+	if gotTarget != "Steve" || gotMessage != "on my way" {
+		t.Fatalf("whisper target=%q message=%q", gotTarget, gotMessage)
+	}
+	if !strings.Contains(text, `"to":"Steve"`) {
+		t.Fatalf("unexpected result: %s", text)
+	}
+	select {
+	case got := <-speakCh:
+		t.Fatalf("whisper should not go to public chat, got %q", got)
+	default:
+	}
+
+	executor.Whisper = nil
+	text, err = executor.ExecuteTool(context.Background(), "speak", map[string]any{"message": "hi", "to": "Steve"})
+	if err != nil {
+		t.Fatalf("speak error: %v", err)
+	}
+	if !strings.Contains(text, "unavailable") {
+		t.Fatalf("expected unavailable without whisper support, got %s", text)
+	}
+}
+
 func TestToolExecutorCheckInventoryUnavailable(t *testing.T) {
 	executor := ToolExecutor{}
 	text, err := executor.ExecuteTool(context.Background(), "check_inventory", nil)
@@ -90,6 +127,144 @@ func TestToolExecutorCheckInventoryUnavailable(t *testing.T) {
 	}
 }
 
+type staticInventory struct {
+	inv InventorySnapshot
+}
+
+func (s staticInventory) GetInventorySnapshot() (InventorySnapshot, bool) {
+	return s.inv, true
+}
+
+func TestToolExecutorCheckInventory(t *testing.T) {
+	offhand := InventoryItem{Slot: 40, ItemID: 1154, Name: "shield", Count: 1}
+	executor := ToolExecutor{Inventory: staticInventory{inv: InventorySnapshot{
+		Hotbar:   []InventoryItem{{Slot: 0, ItemID: 840, Name: "diamond_sword", Count: 1}},
+		Main:     []InventoryItem{{Slot: 9, ItemID: 1, Name: "stone", Count: 64}},
+		Armor:    []InventoryItem{{Slot: 39, ItemID: 900, Name: "iron_helmet", Count: 1}},
+		Offhand:  &offhand,
+		HeldSlot: 0,
+	}}}
+
+	text, err := executor.ExecuteTool(context.Background(), "check_inventory", nil)
+	if err != nil {
+		t.Fatalf("check_inventory error: %v", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		t.Fatalf("parse result json: %v", err)
+	}
+	if out["status"] != "ok" {
+		t.Fatalf("status=%v want ok", out["status"])
+	}
+	summary, _ := out["summary"].(string)
+	for _, part := range []string{"hotbar[0]=diamond_sword x1", "main[9]=stone x64", "armor[39]=iron_helmet x1", "offhand=shield x1"} {
+		if !strings.Contains(summary, part) {
+			t.Fatalf("summary=%q missing %q", summary, part)
+		}
+	}
+}
+
+func TestThinkerInventoryStatus(t *testing.T) {
+	if got := thinkerInventoryStatus(nil); got != "unknown" {
+		t.Fatalf("nil provider status=%q want unknown", got)
+	}
+
+	status := thinkerInventoryStatus(staticInventory{inv: InventorySnapshot{
+		Hotbar: []InventoryItem{
+			{Slot: 0, Name: "oak_log", Count: 10},
+			{Slot: 2, Name: "torch", Count: 5},
+		},
+		Main:     []InventoryItem{{Slot: 12, Name: "oak_log", Count: 6}},
+		Armor:    []InventoryItem{{Slot: 36, Name: "leather_boots", Count: 1}},
+		HeldSlot: 2,
+	}})
+	for _, part := range []string{"held[2]=torch x5", "armor=leather_boots", "oak_log x16"} {
+		if !strings.Contains(status, part) {
+			t.Fatalf("status=%q missing %q", status, part)
+		}
+	}
+}
+
+type fakeContainers struct {
+	view     ContainerView
+	opened   [3]int
+	requests []ContainerTransfer
+	closed   int
+}
+
+func (f *fakeContainers) OpenContainer(_ context.Context, x, y, z int) (ContainerView, error) {
+	f.opened = [3]int{x, y, z}
+	return f.view, nil
+}
+
+func (f *fakeContainers) TransferItems(_ context.Context, req ContainerTransfer) (ContainerTransferResult, error) {
+	f.requests = append(f.requests, req)
+	return ContainerTransferResult{Moved: 12, Reason: "completed", Container: f.view}, nil
+}
+
+func (f *fakeContainers) CloseContainer() error {
+	f.closed++
+	return nil
+}
+
+func TestToolExecutorContainerTools(t *testing.T) {
+	containers := &fakeContainers{view: ContainerView{
+		WindowID: 3,
+		Type:     "generic_9x3",
+		Size:     27,
+		Slots: []InventoryItem{
+			{Slot: 0, Name: "Oak Log", Count: 20},
+			{Slot: 5, Name: "Oak Log", Count: 12},
+		},
+	}}
+	executor := ToolExecutor{Containers: containers}
+
+	text, err := executor.ExecuteTool(context.Background(), "open_container", map[string]any{"x": 1.0, "y": 64.0, "z": -3.0})
+	if err != nil {
+		t.Fatalf("open_container error: %v", err)
+	}
+	if containers.opened != [3]int{1, 64, -3} {
+		t.Fatalf("opened=%v want [1 64 -3]", containers.opened)
+	}
+	if !strings.Contains(text, "generic_9x3 (27 slots): Oak Log x32") {
+		t.Fatalf("open_container result=%s missing summary", text)
+	}
+
+	text, err = executor.ExecuteTool(context.Background(), "transfer_items", map[string]any{
+		"direction": "withdraw",
+		"item":      "oak_log",
+		"count":     12.0,
+		"keep_open": true,
+	})
+	if err != nil {
+		t.Fatalf("transfer_items error: %v", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		t.Fatalf("parse result json: %v", err)
+	}
+	if out["moved"] != 12.0 || out["reason"] != "completed" {
+		t.Fatalf("unexpected transfer result: %v", out)
+	}
+	if got := containers.requests[0]; got.Direction != "withdraw" || got.Item != "oak_log" || got.Count != 12 {
+		t.Fatalf("unexpected transfer request: %+v", got)
+	}
+	if containers.closed != 0 {
+		t.Fatalf("keep_open=true should leave the container open")
+	}
+
+	if _, err := executor.ExecuteTool(context.Background(), "transfer_items", map[string]any{"direction": "deposit"}); err != nil {
+		t.Fatalf("transfer_items deposit error: %v", err)
+	}
+	if containers.closed != 1 {
+		t.Fatalf("closed=%d want 1 after transfer without keep_open", containers.closed)
+	}
+
+	if _, err := executor.ExecuteTool(context.Background(), "transfer_items", map[string]any{"direction": "sideways"}); err == nil {
+		t.Fatalf("expected error for invalid direction")
+	}
+}
+
 func TestToolExecutorWaitForIdle(t *testing.T) {
 	executor := ToolExecutor{
 		WaitForIdle: func(ctx context.Context, timeout time.Duration) (map[string]any, error) {
@@ -214,3 +389,192 @@ func TestToolExecutorQueryNearbySummaryMatchesFilterAndAge(t *testing.T) {
 		t.Fatalf("summary=%q should match filtered empty block list", summary)
 	}
 }
+
+type stateTestBlocks struct {
+	*cameraTestBlocks
+}
+
+func (b stateTestBlocks) DescribeBlockState(stateID int32) (world.BlockState, bool) {
+	if stateID != 4 {
+		return world.BlockState{}, false
+	}
+	return world.BlockState{
+		ID:         4,
+		Block:      "oak_door",
+		Properties: map[string]string{"open": "false", "half": "lower"},
+	}, true
+}
+
+func TestToolExecutorQueryBlockIncludesStateProperties(t *testing.T) {
+	blocks := newCameraTestBlocks()
+	blocks.names[4] = "Oak Door"
+	blocks.set(1, 2, 3, 4)
+	executor := ToolExecutor{World: stateTestBlocks{blocks}}
+
+	text, err := executor.ExecuteTool(context.Background(), "query_block", map[string]any{"x": 1, "y": 2, "z": 3})
+	if err != nil {
+		t.Fatalf("ExecuteTool query_block error: %v", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		t.Fatalf("parse query_block result json: %v", err)
+	}
+	if out["name"] != "Oak Door" || out["block"] != "oak_door" || out["state"] != "oak_door[half=lower,open=false]" {
+		t.Fatalf("unexpected query_block result: %v", out)
+	}
+	props, _ := out["properties"].(map[string]any)
+	if props["open"] != "false" {
+		t.Fatalf("properties = %v, want open=false", props)
+	}
+
+	executor.World = blocks
+	text, err = executor.ExecuteTool(context.Background(), "query_block", map[string]any{"x": 1, "y": 2, "z": 3})
+	if err != nil {
+		t.Fatalf("ExecuteTool query_block error: %v", err)
+	}
+	if strings.Contains(text, "properties") {
+		t.Fatalf("plain block access should not report properties: %s", text)
+	}
+}
+
+type tagTestBlocks struct {
+	*cameraTestBlocks
+}
+
+func (b tagTestBlocks) BlockTags(stateID int32) []string {
+	if stateID != 5 {
+		return nil
+	}
+	return []string{"logs", "mineable/axe"}
+}
+
+func TestToolExecutorQueryBlockIncludesTags(t *testing.T) {
+	blocks := newCameraTestBlocks()
+	blocks.names[5] = "Oak Log"
+	blocks.set(1, 2, 3, 5)
+	blocks.set(1, 3, 3, 4)
+	executor := ToolExecutor{World: tagTestBlocks{blocks}}
+
+	text, err := executor.ExecuteTool(context.Background(), "query_block", map[string]any{"x": 1, "y": 2, "z": 3})
+	if err != nil {
+		t.Fatalf("ExecuteTool query_block error: %v", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		t.Fatalf("parse query_block result json: %v", err)
+	}
+	tags, _ := out["tags"].([]any)
+	if len(tags) != 2 || tags[0] != "logs" || tags[1] != "mineable/axe" {
+		t.Fatalf("tags = %v, want [logs mineable/axe]", out["tags"])
+	}
+
+	text, err = executor.ExecuteTool(context.Background(), "query_block", map[string]any{"x": 1, "y": 3, "z": 3})
+	if err != nil {
+		t.Fatalf("ExecuteTool query_block error: %v", err)
+	}
+	if strings.Contains(text, "tags") {
+		t.Fatalf("untagged block should not report tags: %s", text)
+	}
+}
+
+type rememberedTestBlocks struct {
+	*cameraTestBlocks
+	seenAt time.Time
+}
+
+// GetBlockState reports every chunk as unloaded.
+func (b rememberedTestBlocks) GetBlockState(x, y, z int) (int32, bool) {
+	return 0, false
+}
+
+func (b rememberedTestBlocks) LastKnownBlockState(x, y, z int) (int32, time.Time, bool) {
+	if x != 100 || y != 64 || z != 100 {
+		return 0, time.Time{}, false
+	}
+	return 4, b.seenAt, true
+}
+
+func TestToolExecutorQueryBlockReportsLastKnownBlock(t *testing.T) {
+	blocks := newCameraTestBlocks()
+	blocks.names[4] = "Chest"
+	executor := ToolExecutor{World: rememberedTestBlocks{blocks, time.Now().Add(-90 * time.Second)}}
+
+	text, err := executor.ExecuteTool(context.Background(), "query_block", map[string]any{"x": 100, "y": 64, "z": 100})
+	if err != nil {
+		t.Fatalf("ExecuteTool query_block error: %v", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		t.Fatalf("parse query_block result json: %v", err)
+	}
+	if out["status"] != "unloaded" || out["last_known"] != "Chest" {
+		t.Fatalf("unexpected query_block result: %v", out)
+	}
+	if age, _ := out["last_seen_sec_ago"].(float64); age < 89 || age > 95 {
+		t.Fatalf("last_seen_sec_ago = %v, want about 90", out["last_seen_sec_ago"])
+	}
+
+	text, err = executor.ExecuteTool(context.Background(), "query_block", map[string]any{"x": 0, "y": 64, "z": 0})
+	if err != nil {
+		t.Fatalf("ExecuteTool query_block error: %v", err)
+	}
+	if strings.Contains(text, "last_known") {
+		t.Fatalf("never-seen block should have no last known state: %s", text)
+	}
+}
+
+func TestToolExecutorFollowResolvesPlayerName(t *testing.T) {
+	intentCh := make(chan Intent, 1)
+	snap := world.Snapshot{PlayerList: []world.Player{
+		{Name: "Steve", UUID: "u1", EntityID: 12},
+		{Name: "Alex", UUID: "u2"},
+	}}
+	executor := ToolExecutor{
+		SnapshotFn: func() world.Snapshot { return snap },
+		IntentChan: intentCh,
+	}
+
+	if _, err := executor.ExecuteTool(context.Background(), "follow", map[string]any{"player": "steve"}); err != nil {
+		t.Fatalf("follow error: %v", err)
+	}
+	got := <-intentCh
+	if got.Action != "follow" || got.Params["entity_id"] != 12 {
+		t.Fatalf("intent=%+v want follow entity_id=12", got)
+	}
+
+	if _, err := executor.ExecuteTool(context.Background(), "attack", map[string]any{"player": "Alex"}); err == nil || !strings.Contains(err.Error(), "out of view") {
+		t.Fatalf("attack on out-of-view player err=%v", err)
+	}
+	if _, err := executor.ExecuteTool(context.Background(), "follow", map[string]any{"player": "Herobrine"}); err == nil {
+		t.Fatal("follow should fail for an unknown player")
+	}
+}
+
+func TestToolExecutorRespawn(t *testing.T) {
+	respawns := 0
+	health := float32(20)
+	executor := ToolExecutor{
+		SnapshotFn: func() world.Snapshot { return world.Snapshot{Health: health} },
+		Respawn: func() error {
+			respawns++
+			return nil
+		},
+	}
+
+	text, err := executor.ExecuteTool(context.Background(), "respawn", nil)
+	if err != nil {
+		t.Fatalf("respawn error: %v", err)
+	}
+	if respawns != 0 || !strings.Contains(text, "not_dead") {
+		t.Fatalf("respawn while alive result=%s respawns=%d", text, respawns)
+	}
+
+	health = 0
+	text, err = executor.ExecuteTool(context.Background(), "respawn", nil)
+	if err != nil {
+		t.Fatalf("respawn error: %v", err)
+	}
+	if respawns != 1 || !strings.Contains(text, `"ok"`) {
+		t.Fatalf("respawn result=%s respawns=%d", text, respawns)
+	}
+}
@@ -20,7 +20,7 @@ type ToolDef struct {
 var PerceptionTools = []ToolDef{
 	{
 		Name:        "look",
-		Description: "获取某方向视锥内的方块和实体",
+		Description: "获取某方向视锥内的方块和实体，以及脚下的光照（dark 表示身处黑暗，远处未被照亮的方块看不见）",
 		Parameters: map[string]ParamDef{
 			"direction": {
 				Type:        "string",
@@ -47,7 +47,7 @@ var PerceptionTools = []ToolDef{
 	},
 	{
 		Name:        "query_block",
-		Description: "查询特定坐标方块详情",
+		Description: "查询特定坐标方块详情，包括方块状态属性（朝向、上下半、开关、含水、作物生长阶段等）以及服务器同步的方块标签；未加载区域会返回最后已知的方块",
 		Parameters: map[string]ParamDef{
 			"x": {Type: "integer", Required: true},
 			"y": {Type: "integer", Required: true},
@@ -63,6 +63,33 @@ var PerceptionTools = []ToolDef{
 			"max_age_sec": {Type: "integer", Default: 30},
 		},
 	},
+	{
+		Name:        "query_spawn_risk",
+		Description: "列出附近敌对生物可能刷新的位置（方块光照为 0 的地面），night_only 表示露天、只在夜间刷怪；用于决定在哪里插火把照亮基地",
+		Parameters: map[string]ParamDef{
+			"radius": {Type: "integer", Default: 16},
+		},
+	},
+	{
+		Name:        "find_biome",
+		Description: "在已加载的区块中查找最近的指定生物群系，biome 可以是群系名（如 desert、dark_forest）或类别（如 jungle、ocean）",
+		Parameters: map[string]ParamDef{
+			"biome":    {Type: "string", Required: true},
+			"max_dist": {Type: "integer", Default: 256},
+		},
+	},
+	{
+		Name:        "listen",
+		Description: "列出最近听到的声音（最多 30 秒内），按距离排序并给出方向（front/right/left/behind）和高度差；可以听到墙后或视线外的生物、开门、爆炸等，urgent 表示需要立刻反应",
+		Parameters: map[string]ParamDef{
+			"max_age_sec": {Type: "integer", Default: 30},
+			"category": {
+				Type:        "string",
+				Description: "只看某类声音",
+				Enum:        []string{"hostile", "neutral", "player", "block", "ambient", "weather"},
+			},
+		},
+	},
 	{
 		Name:        "recall",
 		Description: "混合检索长期记忆",
@@ -85,9 +112,10 @@ var PerceptionTools = []ToolDef{
 var ActionTools = []ToolDef{
 	{
 		Name:        "speak",
-		Description: "发送聊天消息",
+		Description: "发送聊天消息；指定 to 时改为私聊该玩家",
 		Parameters: map[string]ParamDef{
 			"message":     {Type: "string", Required: true},
+			"to":          {Type: "string", Description: "私聊对象玩家名（可选，回复 Whisper 消息时使用）"},
 			"duration_ms": {Type: "integer", Description: "行为持续时长毫秒（可选）"},
 		},
 	},
@@ -98,6 +126,11 @@ var ActionTools = []ToolDef{
 			"duration_ms": {Type: "integer", Description: "行为持续时长毫秒（可选）"},
 		},
 	},
+	{
+		Name:        "respawn",
+		Description: "死亡后重生（仅在关闭自动重生时需要）；重生前可先 recall 死亡位置",
+		Parameters:  map[string]ParamDef{},
+	},
 	{
 		Name:        "go_to",
 		Description: "走到目标坐标（自动寻路）",
@@ -113,7 +146,8 @@ var ActionTools = []ToolDef{
 		Name:        "follow",
 		Description: "跟随指定实体移动",
 		Parameters: map[string]ParamDef{
-			"entity_id":   {Type: "integer", Required: true, Description: "实体 ID"},
+			"entity_id":   {Type: "integer", Description: "实体 ID（与 player 二选一）"},
+			"player":      {Type: "string", Description: "玩家名，自动解析为其实体 ID"},
 			"distance":    {Type: "number", Description: "保持距离（默认 3）"},
 			"sprint":      {Type: "boolean", Description: "是否疾跑跟随"},
 			"duration_ms": {Type: "integer", Description: "行为持续时长毫秒（可选）"},
@@ -123,7 +157,8 @@ var ActionTools = []ToolDef{
 		Name:        "attack",
 		Description: "攻击指定实体",
 		Parameters: map[string]ParamDef{
-			"entity_id":   {Type: "integer", Required: true, Description: "实体 ID"},
+			"entity_id":   {Type: "integer", Description: "实体 ID（与 player 二选一）"},
+			"player":      {Type: "string", Description: "玩家名，自动解析为其实体 ID"},
 			"duration_ms": {Type: "integer", Description: "行为持续时长毫秒（可选）"},
 		},
 	},
@@ -166,6 +201,34 @@ var ActionTools = []ToolDef{
 			"duration_ms": {Type: "integer", Description: "行为持续时长毫秒（可选）"},
 		},
 	},
+	{
+		Name:        "craft",
+		Description: "合成物品：按配方自动合成所需的中间材料（如原木→木板→木棍），需要 3x3 配方时会走到附近的工作台",
+		Parameters: map[string]ParamDef{
+			"item":        {Type: "string", Required: true, Description: "目标物品名（如 wooden_pickaxe）"},
+			"count":       {Type: "integer", Description: "合成数量（默认 1）"},
+			"duration_ms": {Type: "integer", Description: "行为持续时长毫秒（可选）"},
+		},
+	},
+	{
+		Name:        "open_container",
+		Description: "打开指定坐标的容器（箱子、熔炉等），返回容器内容；需在眼睛到方块中心 5 格内",
+		Parameters: map[string]ParamDef{
+			"x": {Type: "integer", Required: true},
+			"y": {Type: "integer", Required: true},
+			"z": {Type: "integer", Required: true},
+		},
+	},
+	{
+		Name:        "transfer_items",
+		Description: "在已打开的容器和背包之间转移物品，需先调用 open_container",
+		Parameters: map[string]ParamDef{
+			"direction": {Type: "string", Required: true, Enum: []string{"deposit", "withdraw"}, Description: "deposit=背包→容器 withdraw=容器→背包"},
+			"item":      {Type: "string", Description: "物品名（如 oak_log），留空表示全部物品"},
+			"count":     {Type: "integer", Description: "转移数量，留空或 0 表示全部"},
+			"keep_open": {Type: "boolean", Description: "转移后保持容器打开（默认关闭）"},
+		},
+	},
 	{
 		Name:        "wait_for_idle",
 		Description: "阻塞等待当前行为结束（不消耗 LLM token）",
@@ -80,9 +80,13 @@ func (b *Body) Tick(input InputState) error {
 	}
 
 	effectiveInput := normalizeMovementInput(input)
-	entityColliders := b.currentEntityColliders()
+	snapshot, hasSnapshot := b.currentSnapshot()
+	entityColliders := entityColliders(snapshot)
 
 	b.mu.Lock()
+	if hasSnapshot {
+		b.physics.Status = movementStatus(snapshot)
+	}
 	physics.PhysicsTickWithEntities(&b.physics, physics.InputState(effectiveInput), b.blockStore, entityColliders)
 	pos := b.physics.Position
 	onGround := b.physics.OnGround
@@ -171,16 +175,18 @@ func (b *Body) SetEntityProvider(source EntitySnapshotProvider) {
 	b.mu.Unlock()
 }
 
-func (b *Body) currentEntityColliders() []physics.EntityCollider {
+func (b *Body) currentSnapshot() (world.Snapshot, bool) {
 	b.mu.Lock()
 	source := b.entitySource
 	b.mu.Unlock()
 
 	if source == nil {
-		return nil
+		return world.Snapshot{}, false
 	}
+	return source.GetState(), true
+}
 
-	snapshot := source.GetState()
+func entityColliders(snapshot world.Snapshot) []physics.EntityCollider {
 	if len(snapshot.Entities) == 0 {
 		return nil
 	}
@@ -202,6 +208,18 @@ func (b *Body) currentEntityColliders() []physics.EntityCollider {
 	return colliders
 }
 
+// movementStatus picks the effects and abilities that change how the
+// player moves out of the world snapshot.
+func movementStatus(snapshot world.Snapshot) physics.MovementStatus {
+	return physics.MovementStatus{
+		Speed:     int(snapshot.EffectLevel("speed")),
+		Slowness:  int(snapshot.EffectLevel("slowness")),
+		JumpBoost: int(snapshot.EffectLevel("jump_boost")),
+		Flying:    snapshot.Abilities.Flying,
+		FlySpeed:  float64(snapshot.Abilities.FlySpeed),
+	}
+}
+
 func (b *Body) syncServerSprintAction(
 	currentSprint bool,
 	desiredSprint bool,
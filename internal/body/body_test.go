@@ -257,6 +257,36 @@ func TestBodyTickAirborneFallsAndClearsOnGround(t *testing.T) {
 	}
 }
 
+type staticSnapshotProvider struct {
+	snapshot world.Snapshot
+}
+
+func (p staticSnapshotProvider) GetState() world.Snapshot {
+	return p.snapshot
+}
+
+func TestBodyTickAppliesFlyingAndEffectsFromSnapshot(t *testing.T) {
+	store := newMockBlockStore()
+	sender := &mockPacketSender{}
+
+	b := New(world.Position{X: 0, Y: 10, Z: 0}, false, sender, store, nil)
+	b.SetEntityProvider(staticSnapshotProvider{snapshot: world.Snapshot{
+		Abilities: world.Abilities{Flying: true, AllowFlying: true, FlySpeed: 0.05},
+		Effects:   []world.ActiveEffect{{Name: "Speed", Key: "speed", Amplifier: 1}},
+	}})
+	if err := b.Tick(InputState{}); err != nil {
+		t.Fatalf("Tick failed: %v", err)
+	}
+
+	state := b.PhysicsState()
+	if state.Position.Y != 10 {
+		t.Fatalf("physics y = %.6f, want 10 while flying", state.Position.Y)
+	}
+	if !state.Status.Flying || state.Status.Speed != 2 {
+		t.Fatalf("status = %+v, want flying with speed 2", state.Status)
+	}
+}
+
 func TestBodyTickWallCollisionKeepsPosition(t *testing.T) {
 	store := newMockBlockStore()
 	addFloor(store, -2, 2, -2, 2, -1)
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
-	"strconv"
 	"sync"
 	"time"
 
@@ -34,6 +33,11 @@ type Bot struct {
 	digSyncState
 	selfEntityState
 	positionSyncState
+	inventoryState
+	containerState
+	sessionState
+	deathState
+	registryState
 }
 
 type connectionState struct {
@@ -43,6 +47,12 @@ type connectionState struct {
 	conn       net.Conn
 	connState  *protocol.ConnState
 	mu         sync.RWMutex
+	// authenticator joins the session server for online-mode logins.
+	authenticator SessionAuthenticator
+	// version is the server's protocol version; nil means the canonical one.
+	version *protocol.Version
+	// capture records packets for offline replay when set.
+	capture *protocol.CaptureWriter
 }
 
 type runtimeState struct {
@@ -146,6 +156,10 @@ func NewBot(serverAddr, username string) *Bot {
 	if err != nil {
 		slog.Error("Failed to initialize block store", "error", err)
 	}
+	registries := world.NewRegistries()
+	if blockStore != nil {
+		blockStore.SetRegistries(registries)
+	}
 	return &Bot{
 		connectionState: connectionState{
 			serverAddr: serverAddr,
@@ -165,6 +179,9 @@ func NewBot(serverAddr, username string) *Bot {
 		positionSyncState: positionSyncState{
 			initialPosCh: make(chan struct{}),
 		},
+		registryState: registryState{
+			registries: registries,
+		},
 	}
 }
 
@@ -175,48 +192,71 @@ func (b *Bot) Start(ctx context.Context) error {
 		return err
 	}
 	slog.Info("Connected to server", "address", b.serverAddr)
+	b.resetSession()
+	b.mu.Lock()
 	b.conn = conn
-	defer conn.Close()
 	b.connState = protocol.NewConnState()
+	b.mu.Unlock()
+	defer func() {
+		conn.Close()
+		b.mu.Lock()
+		b.conn = nil
+		b.mu.Unlock()
+	}()
+
+	sessionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Unblock pending reads when the caller gives up on the session.
+	go func() {
+		<-sessionCtx.Done()
+		conn.Close()
+	}()
+
 	//handshake and login
-	if err := b.login(); err != nil {
+	if err := b.login(sessionCtx); err != nil {
 		return fmt.Errorf("login failed: %w", err)
 	}
 	//configuration
 	if err := b.handleConfiguration(); err != nil {
 		return fmt.Errorf("configuration failed: %w", err)
 	}
+	b.beginPlay()
 	//start play state handler
-	go b.handleInjection(ctx)
-	go b.logBlockUnderFeetLoop(ctx)
+	go b.handleInjection(sessionCtx)
+	go b.logBlockUnderFeetLoop(sessionCtx)
 
-	return b.handlePlayState(ctx)
+	return b.handlePlayState(sessionCtx)
 }
 
-func (b *Bot) login() error {
-	host, portStr, err := net.SplitHostPort(b.serverAddr)
+func (b *Bot) login(ctx context.Context) error {
+	host, port, err := splitServerAddr(b.serverAddr)
 	if err != nil {
-		return fmt.Errorf("invalid server address: %w", err)
+		return err
 	}
-	port, _ := strconv.ParseUint(portStr, 10, 16)
 	// 发送握手包和登录开始包
 	slog.Info("Starting Handshake", "state", "Handshake")
-	handshakePacket := protocol.CreateHandshakePacket(protocol.CurrentProtocolVersion, host, uint16(port), protocol.NextStateLogin)
-	if err := protocol.WritePacket(b.conn, handshakePacket, b.connState.GetThreshold()); err != nil {
+	handshakePacket := protocol.CreateHandshakePacket(b.Version().ProtocolNumber(), host, port, protocol.NextStateLogin)
+	if err := b.writePacket(b.conn, handshakePacket, b.connState.GetThreshold()); err != nil {
 		return err
 	}
 	b.connState.Set(protocol.Login)
 	slog.Info("Starting Login", "state", "Login")
 	loginStartPacket := protocol.CreateLoginStartPacket(b.username, b.uuid)
-	if err := protocol.WritePacket(b.conn, loginStartPacket, b.connState.GetThreshold()); err != nil {
+	if err := b.writePacket(b.conn, loginStartPacket, b.connState.GetThreshold()); err != nil {
 		return err
 	}
 	for {
-		packet, err := protocol.ReadPacket(b.conn, b.connState.GetThreshold())
+		packet, err := b.readPacket()
 		if err != nil {
 			return err
 		}
 		switch packet.ID {
+		case protocol.S2CLoginDisconnect:
+			return b.handleLoginDisconnect(packet.Payload)
+		case protocol.S2CEncryptionRequest:
+			if err := b.handleEncryptionRequest(ctx, packet.Payload); err != nil {
+				return err
+			}
 		case protocol.S2CSetCompression:
 			// 设置压缩
 			slog.Info("Setting compression", "threshold", packet.Payload)
@@ -240,7 +280,7 @@ func (b *Bot) login() error {
 			b.username = loginSuccess.Username
 
 			loginAckPacket := protocol.CreateLoginAcknowledgedPacket()
-			if err := protocol.WritePacket(b.conn, loginAckPacket, b.connState.GetThreshold()); err != nil {
+			if err := b.writePacket(b.conn, loginAckPacket, b.connState.GetThreshold()); err != nil {
 				return err
 			}
 			b.connState.Set(protocol.Configuration)
@@ -257,11 +297,13 @@ func (b *Bot) handleConfiguration() error {
 	}
 	slog.Info("Starting Configuration", "state", "Configuration")
 	for {
-		packet, err := protocol.ReadPacket(b.conn, b.connState.GetThreshold())
+		packet, err := b.readPacket()
 		if err != nil {
 			return err
 		}
 		switch packet.ID {
+		case protocol.S2CConfigDisconnect:
+			return b.handleDisconnect(protocol.Configuration, packet.Payload)
 		case protocol.S2CConfigKeepAlive:
 			// 响应保持连接包
 			packetRdr := bytes.NewReader(packet.Payload)
@@ -280,13 +322,15 @@ func (b *Bot) handleConfiguration() error {
 			knownPacks := []protocol.KnownPack{
 				{NameSpace: "minecraft",
 					Id:      "locus",
-					Version: "1.21.11"},
+					Version: b.Version().Name},
 			}
 
 			selectKnownPacket := protocol.CreateSelectKnownPacket(knownPacks, protocol.C2SSelectKnown) // 示例选择第一个已知选项
 			if err := b.writePacket(b.conn, selectKnownPacket, b.connState.GetThreshold()); err != nil {
 				return err
 			}
+		case protocol.S2CRegistryData, protocol.S2CConfigUpdateTags:
+			b.handleConfigurationData(packet)
 		case protocol.S2CFinishConfiguration:
 			// 完成配置，进入游戏状态
 			ack := protocol.CreateFinishConfigurationPacket(protocol.C2SFinishConfiguration)
@@ -303,224 +347,246 @@ func (b *Bot) handleConfiguration() error {
 func (b *Bot) handlePlayState(ctx context.Context) error {
 	slog.Info("Starting Play", "state", "Play")
 	for {
-		packet, err := protocol.ReadPacket(b.conn, b.connState.GetThreshold())
+		packet, err := b.readPacket()
 		if err != nil {
 			return err
 		}
-		switch packet.ID {
-		case protocol.S2CPlayKeepAlive:
-			// 响应保持连接包
-			packetRdr := bytes.NewReader(packet.Payload)
-			keepAlivePacket, err := protocol.ParseKeepAlive(packetRdr)
-			if err != nil {
-				return err
-			}
-			keepAliveResponsePacket := protocol.CreateKeepAlivePacket(keepAlivePacket.KeepAliveID, protocol.C2SPlayKeepAlive)
-			if err := b.writePacket(b.conn, keepAliveResponsePacket, b.connState.GetThreshold()); err != nil {
-				return err
-			}
-		case protocol.S2CPlayerChatMessage:
-			// 处理聊天消息
-			packetRdr := bytes.NewReader(packet.Payload)
-			playerChat, err := protocol.ParsePlayerChat(packetRdr)
-			if err != nil {
-				slog.Warn("Failed to parse player chat", "error", err)
-				continue
-			}
-			if playerChat.SenderUUID == b.uuid {
-				// 忽略自己的消息
-				continue
-			}
-			b.eventBus.Publish(event.EventChat, event.NewChatEvent(ctx, protocol.FormatTextComponent(playerChat.NetworkName), playerChat.SenderUUID, playerChat.PlainMessage, event.SourcePlayer))
-
-		case protocol.S2CSystemChatMessage:
-			// 处理系统消息
-		case protocol.S2CLogin:
-			b.handlePlayLogin(packet.Payload)
-		case protocol.S2CRespawn:
-			b.handleRespawn(packet.Payload)
-		case protocol.S2CUpdateViewPosition:
-			b.handleUpdateViewPosition(packet.Payload)
-		case protocol.S2CChunkBatchStart:
-			b.handleChunkBatchStart(packet.Payload)
-		case protocol.S2CChunkBatchFinished:
-			b.handleChunkBatchFinished(packet.Payload)
-		case protocol.S2CPlayerPosition:
-			// 处理玩家位置更新
-			packetRdr := bytes.NewReader(packet.Payload)
-			playerPos, err := protocol.ParsePlayerPosition(packetRdr)
-			if err != nil {
-				return err
-			}
-			teleCfmPacket := protocol.CreateTeleportConfirmPacket(playerPos.TeleportID)
-			newPos := world.Position{
-				X:     playerPos.X,
-				Y:     playerPos.Y,
-				Z:     playerPos.Z,
-				Yaw:   playerPos.Yaw,
-				Pitch: playerPos.Pitch,
-			}
-			b.worldState.UpdatePosition(newPos)
-			b.markInitialPositionReady()
-			b.syncLocalPosition(newPos)
-			b.logBlockUnderFeetState()
-			if err := b.writePacket(b.conn, teleCfmPacket, b.connState.GetThreshold()); err != nil {
-				return err
-			}
-			// Vanilla clients send a movement packet after teleport confirm.
-			// Some servers rely on this to continue chunk streaming.
-			posAck := protocol.CreatePlayerPositionAndRotationPacket(
-				playerPos.X,
-				playerPos.Y,
-				playerPos.Z,
-				playerPos.Yaw,
-				playerPos.Pitch,
-				false,
-			)
-			if err := b.writePacket(b.conn, posAck, b.connState.GetThreshold()); err != nil {
-				return err
-			}
-			if err := b.maybeSendPlayerLoaded(); err != nil {
-				return err
-			}
-		case protocol.S2CLevelChunkWithLight:
-			b.handleLevelChunkWithLight(packet.Payload)
-		case protocol.S2CUnloadChunk:
-			b.handleUnloadChunk(packet.Payload)
-		case protocol.S2CBlockChange:
-			b.handleBlockChange(packet.Payload)
-		case protocol.S2CMultiBlockChange:
-			b.handleMultiBlockChange(packet.Payload)
-		case protocol.S2CTileEntityData:
-			b.handleTileEntityData(packet.Payload)
-		case protocol.S2CBlockAction:
-			b.handleBlockAction(packet.Payload)
-		case protocol.S2CAcknowledgePlayerDigging:
-			b.handleAcknowledgePlayerDigging(packet.Payload)
-		case protocol.S2CUpdateHealth:
-			packetRdr := bytes.NewReader(packet.Payload)
-			updateHealth, err := protocol.ParseUpdateHealth(packetRdr)
-			if err != nil {
-				return err
-			}
-			b.worldState.UpdateHealth(updateHealth.Health, updateHealth.Food)
-			if updateHealth.Health <= 0 {
-				slog.Info("Bot died, sending respawn")
-				respawnPacket := protocol.CreateClientCommandPacket(0)
-				if err := b.writePacket(b.conn, respawnPacket, b.connState.GetThreshold()); err != nil {
-					return err
-				}
-			}
-		case protocol.S2CUpdateTime:
-			// 处理时间更新
-			packetRdr := bytes.NewReader(packet.Payload)
-			updateTime, err := protocol.ParseUpdateTime(packetRdr)
-			if err != nil {
-				return err
-			}
-			b.worldState.UpdateGameTime(world.GameTime{
-				WorldTime: updateTime.WorldTime,
-				Age:       updateTime.Age,
-			})
-		case protocol.S2CPlayerInfo:
-			// 处理玩家信息更新
-			packetRdr := bytes.NewReader(packet.Payload)
-			playerInfoUpdate, err := protocol.ParsePlayerInfo(packetRdr)
-			if err != nil {
-				slog.Error("Failed to parse player info", "error", err)
-				continue
-			}
-			addPlayerList := make([]world.Player, 0)
-			if playerInfoUpdate.Actions&0x01 != 0 {
-				for _, p := range playerInfoUpdate.Players {
-					addPlayerList = append(addPlayerList, world.Player{
-						Name: p.Name,
-						UUID: p.UUID.String(),
-					})
-				}
-			}
-			if len(addPlayerList) > 0 {
-				b.worldState.AddPlayer(addPlayerList)
-			}
-		case protocol.S2CPlayerRemove:
-			// 处理玩家移除
-			packetRdr := bytes.NewReader(packet.Payload)
-			playerRemove, err := protocol.ParsePlayerRemove(packetRdr)
-			if err != nil {
-				return err
-			}
-			for _, uuid := range playerRemove.Players {
-				b.worldState.RemovePlayer(uuid.String())
-			}
-		case protocol.S2CSpawnEntity:
-			packetRdr := bytes.NewReader(packet.Payload)
-			spawn, err := protocol.ParseSpawnEntity(packetRdr)
-			if err != nil {
-				slog.Warn("Failed to parse spawn entity", "error", err)
-				continue
-			}
-			b.worldState.AddEntity(world.Entity{
-				EntityID: spawn.EntityID,
-				UUID:     spawn.ObjectUUID.String(),
-				Type:     spawn.Type,
-				X:        spawn.X,
-				Y:        spawn.Y,
-				Z:        spawn.Z,
-			})
-		case protocol.S2CEntityMetadata:
-			packetRdr := bytes.NewReader(packet.Payload)
-			entityID, itemID, found, err := protocol.ParseEntityMetadataItemSlot(packetRdr)
-			if err != nil {
-				slog.Warn("Failed to parse entity metadata", "error", err)
-				continue
-			}
-			if found {
-				itemName := world.ItemName(itemID)
-				b.worldState.UpdateEntityItemName(entityID, itemName)
-			}
-		case protocol.S2CEntityDestroy:
-			packetRdr := bytes.NewReader(packet.Payload)
-			destroy, err := protocol.ParseEntityDestroy(packetRdr)
-			if err != nil {
-				slog.Warn("Failed to parse entity destroy", "error", err)
-				continue
-			}
-			b.worldState.RemoveEntities(destroy.EntityIDs)
-		case protocol.S2CRelEntityMove:
-			packetRdr := bytes.NewReader(packet.Payload)
-			move, err := protocol.ParseRelEntityMove(packetRdr)
-			if err != nil {
-				slog.Warn("Failed to parse rel entity move", "error", err)
-				continue
-			}
-			b.worldState.UpdateEntityPositionRelative(move.EntityID, move.DeltaX(), move.DeltaY(), move.DeltaZ())
-		case protocol.S2CEntityMoveLook:
-			packetRdr := bytes.NewReader(packet.Payload)
-			move, err := protocol.ParseEntityMoveLook(packetRdr)
-			if err != nil {
-				slog.Warn("Failed to parse entity move look", "error", err)
-				continue
-			}
-			b.worldState.UpdateEntityPositionRelative(move.EntityID, move.DeltaX(), move.DeltaY(), move.DeltaZ())
-		case protocol.S2CEntityTeleport:
-			packetRdr := bytes.NewReader(packet.Payload)
-			tp, err := protocol.ParseEntityTeleport(packetRdr)
-			if err != nil {
-				slog.Warn("Failed to parse entity teleport", "error", err)
-				continue
-			}
-			b.worldState.UpdateEntityPosition(tp.EntityID, tp.X, tp.Y, tp.Z)
-		case protocol.S2CSyncEntityPosition:
-			packetRdr := bytes.NewReader(packet.Payload)
-			syncPos, err := protocol.ParseSyncEntityPosition(packetRdr)
-			if err != nil {
-				slog.Warn("Failed to parse sync entity position", "error", err)
-				continue
-			}
-			b.worldState.UpdateEntityPosition(syncPos.EntityID, syncPos.X, syncPos.Y, syncPos.Z)
-		default:
-			b.logUnhandledPlayPacket(packet.ID)
+		if err := b.handlePlayPacket(ctx, packet); err != nil {
+			return err
 		}
+	}
+}
 
+// handlePlayPacket dispatches one Play packet. An error ends the session.
+func (b *Bot) handlePlayPacket(ctx context.Context, packet *protocol.Packet) error {
+	switch packet.ID {
+	case protocol.S2CKickDisconnect:
+		return b.handleDisconnect(protocol.Play, packet.Payload)
+	case protocol.S2CPlayKeepAlive:
+		// 响应保持连接包
+		packetRdr := bytes.NewReader(packet.Payload)
+		keepAlivePacket, err := protocol.ParseKeepAlive(packetRdr)
+		if err != nil {
+			return err
+		}
+		keepAliveResponsePacket := protocol.CreateKeepAlivePacket(keepAlivePacket.KeepAliveID, protocol.C2SPlayKeepAlive)
+		if err := b.writePacket(b.conn, keepAliveResponsePacket, b.connState.GetThreshold()); err != nil {
+			return err
+		}
+	case protocol.S2CPlayerChatMessage:
+		b.handlePlayerChat(ctx, packet.Payload)
+	case protocol.S2CDisguisedChat:
+		b.handleDisguisedChat(ctx, packet.Payload)
+	case protocol.S2CSystemChatMessage:
+		b.handleSystemChat(ctx, packet.Payload)
+	case protocol.S2CLogin:
+		b.handlePlayLogin(packet.Payload)
+	case protocol.S2CRespawn:
+		b.handleRespawn(packet.Payload)
+	case protocol.S2CUpdateViewPosition:
+		b.handleUpdateViewPosition(packet.Payload)
+	case protocol.S2CChunkBatchStart:
+		b.handleChunkBatchStart(packet.Payload)
+	case protocol.S2CChunkBatchFinished:
+		b.handleChunkBatchFinished(packet.Payload)
+	case protocol.S2CPlayerPosition:
+		// 处理玩家位置更新
+		packetRdr := bytes.NewReader(packet.Payload)
+		playerPos, err := protocol.ParsePlayerPosition(packetRdr)
+		if err != nil {
+			return err
+		}
+		teleCfmPacket := protocol.CreateTeleportConfirmPacket(playerPos.TeleportID)
+		newPos := world.Position{
+			X:     playerPos.X,
+			Y:     playerPos.Y,
+			Z:     playerPos.Z,
+			Yaw:   playerPos.Yaw,
+			Pitch: playerPos.Pitch,
+		}
+		b.worldState.UpdatePosition(newPos)
+		b.markInitialPositionReady()
+		b.syncLocalPosition(newPos)
+		b.logBlockUnderFeetState()
+		if err := b.writePacket(b.conn, teleCfmPacket, b.connState.GetThreshold()); err != nil {
+			return err
+		}
+		// Vanilla clients send a movement packet after teleport confirm.
+		// Some servers rely on this to continue chunk streaming.
+		posAck := protocol.CreatePlayerPositionAndRotationPacket(
+			playerPos.X,
+			playerPos.Y,
+			playerPos.Z,
+			playerPos.Yaw,
+			playerPos.Pitch,
+			false,
+		)
+		if err := b.writePacket(b.conn, posAck, b.connState.GetThreshold()); err != nil {
+			return err
+		}
+		if err := b.maybeSendPlayerLoaded(); err != nil {
+			return err
+		}
+	case protocol.S2CLevelChunkWithLight:
+		b.handleLevelChunkWithLight(packet.Payload)
+	case protocol.S2CUnloadChunk:
+		b.handleUnloadChunk(packet.Payload)
+	case protocol.S2CUpdateLight:
+		b.handleUpdateLight(packet.Payload)
+	case protocol.S2CBlockChange:
+		b.handleBlockChange(packet.Payload)
+	case protocol.S2CMultiBlockChange:
+		b.handleMultiBlockChange(packet.Payload)
+	case protocol.S2CTileEntityData:
+		b.handleTileEntityData(packet.Payload)
+	case protocol.S2CBlockAction:
+		b.handleBlockAction(packet.Payload)
+	case protocol.S2CAcknowledgePlayerDigging:
+		b.handleAcknowledgePlayerDigging(packet.Payload)
+	case protocol.S2CUpdateHealth:
+		if err := b.handleUpdateHealth(packet.Payload); err != nil {
+			return err
+		}
+	case protocol.S2CDeathCombatEvent:
+		b.handleDeathCombatEvent(packet.Payload)
+	case protocol.S2CDamageEvent:
+		b.handleDamageEvent(packet.Payload)
+	case protocol.S2CHurtAnimation:
+		b.handleHurtAnimation(packet.Payload)
+	case protocol.S2CInitializeWorldBorder:
+		b.handleInitializeWorldBorder(packet.Payload)
+	case protocol.S2CWorldBorderCenter:
+		b.handleWorldBorderCenter(packet.Payload)
+	case protocol.S2CWorldBorderLerpSize:
+		b.handleWorldBorderLerpSize(packet.Payload)
+	case protocol.S2CWorldBorderSize:
+		b.handleWorldBorderSize(packet.Payload)
+	case protocol.S2CUpdateTags:
+		b.handleUpdateTags(packet.Payload)
+	case protocol.S2CSoundEffect:
+		b.handleSoundEffect(packet.Payload)
+	case protocol.S2CEntitySoundEffect:
+		b.handleEntitySoundEffect(packet.Payload)
+	case protocol.S2CExplosion:
+		b.handleExplosion(packet.Payload)
+	case protocol.S2CUpdateTime:
+		// 处理时间更新
+		packetRdr := bytes.NewReader(packet.Payload)
+		updateTime, err := protocol.ParseUpdateTime(packetRdr)
+		if err != nil {
+			return err
+		}
+		b.worldState.UpdateGameTime(world.GameTime{
+			WorldTime: updateTime.WorldTime,
+			Age:       updateTime.Age,
+		})
+	case protocol.S2CPlayerInfo:
+		b.handlePlayerInfo(packet.Payload)
+	case protocol.S2CPlayerRemove:
+		// 处理玩家移除
+		packetRdr := bytes.NewReader(packet.Payload)
+		playerRemove, err := protocol.ParsePlayerRemove(packetRdr)
+		if err != nil {
+			return err
+		}
+		for _, uuid := range playerRemove.Players {
+			b.worldState.RemovePlayer(uuid.String())
+		}
+	case protocol.S2CSpawnEntity:
+		packetRdr := bytes.NewReader(packet.Payload)
+		spawn, err := protocol.ParseSpawnEntity(packetRdr)
+		if err != nil {
+			slog.Warn("Failed to parse spawn entity", "error", err)
+			return nil
+		}
+		b.worldState.AddEntity(world.Entity{
+			EntityID: spawn.EntityID,
+			UUID:     spawn.ObjectUUID.String(),
+			Type:     spawn.Type,
+			X:        spawn.X,
+			Y:        spawn.Y,
+			Z:        spawn.Z,
+			VelX:     spawn.Velocity.X,
+			VelY:     spawn.Velocity.Y,
+			VelZ:     spawn.Velocity.Z,
+			HeadYaw:  protocol.AngleToDegrees(spawn.HeadYaw),
+		})
+	case protocol.S2CEntityMetadata:
+		b.handleEntityMetadata(packet.Payload)
+	case protocol.S2CEntityEquipment:
+		b.handleEntityEquipment(packet.Payload)
+	case protocol.S2CEntityVelocity:
+		b.handleEntityVelocity(packet.Payload)
+	case protocol.S2CEntityHeadRotation:
+		b.handleEntityHeadRotation(packet.Payload)
+	case protocol.S2CEntityUpdateAttributes:
+		b.handleEntityAttributes(packet.Payload)
+	case protocol.S2CExperience:
+		b.handleExperience(packet.Payload)
+	case protocol.S2CEntityEffect:
+		b.handleEntityEffect(packet.Payload)
+	case protocol.S2CRemoveEntityEffect:
+		b.handleRemoveEntityEffect(packet.Payload)
+	case protocol.S2CAbilities:
+		b.handleAbilities(packet.Payload)
+	case protocol.S2CGameEvent:
+		b.handleGameEvent(packet.Payload)
+	case protocol.S2CEntityDestroy:
+		packetRdr := bytes.NewReader(packet.Payload)
+		destroy, err := protocol.ParseEntityDestroy(packetRdr)
+		if err != nil {
+			slog.Warn("Failed to parse entity destroy", "error", err)
+			return nil
+		}
+		b.worldState.RemoveEntities(destroy.EntityIDs)
+	case protocol.S2CRelEntityMove:
+		packetRdr := bytes.NewReader(packet.Payload)
+		move, err := protocol.ParseRelEntityMove(packetRdr)
+		if err != nil {
+			slog.Warn("Failed to parse rel entity move", "error", err)
+			return nil
+		}
+		b.worldState.UpdateEntityPositionRelative(move.EntityID, move.DeltaX(), move.DeltaY(), move.DeltaZ())
+	case protocol.S2CEntityMoveLook:
+		packetRdr := bytes.NewReader(packet.Payload)
+		move, err := protocol.ParseEntityMoveLook(packetRdr)
+		if err != nil {
+			slog.Warn("Failed to parse entity move look", "error", err)
+			return nil
+		}
+		b.worldState.UpdateEntityPositionRelative(move.EntityID, move.DeltaX(), move.DeltaY(), move.DeltaZ())
+	case protocol.S2CEntityTeleport:
+		packetRdr := bytes.NewReader(packet.Payload)
+		tp, err := protocol.ParseEntityTeleport(packetRdr)
+		if err != nil {
+			slog.Warn("Failed to parse entity teleport", "error", err)
+			return nil
+		}
+		b.worldState.UpdateEntityPosition(tp.EntityID, tp.X, tp.Y, tp.Z)
+	case protocol.S2CWindowItems:
+		b.handleWindowItems(packet.Payload)
+	case protocol.S2CSetSlot:
+		b.handleSetSlot(packet.Payload)
+	case protocol.S2CHeldItemSlot:
+		b.handleHeldItemSlot(packet.Payload)
+	case protocol.S2CSetPlayerInventory:
+		b.handleSetPlayerInventory(packet.Payload)
+	case protocol.S2CSetCursorItem:
+		b.handleSetCursorItem(packet.Payload)
+	case protocol.S2COpenWindow:
+		b.handleOpenWindow(packet.Payload)
+	case protocol.S2CCloseWindow:
+		b.handleCloseWindow(packet.Payload)
+	case protocol.S2CSyncEntityPosition:
+		packetRdr := bytes.NewReader(packet.Payload)
+		syncPos, err := protocol.ParseSyncEntityPosition(packetRdr)
+		if err != nil {
+			slog.Warn("Failed to parse sync entity position", "error", err)
+			return nil
+		}
+		b.worldState.UpdateEntityPosition(syncPos.EntityID, syncPos.X, syncPos.Y, syncPos.Z)
+	default:
+		b.logUnhandledPlayPacket(packet.ID)
 	}
+	return nil
 }
@@ -0,0 +1,168 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+const (
+	mojangJoinEndpoint = "https://sessionserver.mojang.com/session/minecraft/join"
+	sessionJoinTimeout = 10 * time.Second
+)
+
+// SessionAuthenticator performs the session "join" step of an online-mode
+// login. serverHash is protocol.ServerHash of the Encryption Request; the
+// server later asks the session service whether we joined with that hash.
+type SessionAuthenticator interface {
+	JoinServer(ctx context.Context, serverHash string) error
+}
+
+// SetAuthenticator enables online-mode login. Without one, servers that set
+// should_authenticate are refused before any secret is sent.
+func (b *Bot) SetAuthenticator(auth SessionAuthenticator) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.authenticator = auth
+}
+
+func (b *Bot) sessionAuthenticator() SessionAuthenticator {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.authenticator
+}
+
+// handleEncryptionRequest answers the key exchange and switches b.conn to the
+// encrypted stream. Everything after the response, including Set Compression,
+// is encrypted.
+func (b *Bot) handleEncryptionRequest(ctx context.Context, payload []byte) error {
+	req, err := protocol.ParseEncryptionRequest(bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("parse encryption request: %w", err)
+	}
+	auth := b.sessionAuthenticator()
+	if req.ShouldAuthenticate && auth == nil {
+		return fmt.Errorf("server requires online-mode authentication but no session authenticator is configured")
+	}
+
+	secret, err := protocol.NewSharedSecret()
+	if err != nil {
+		return err
+	}
+	encSecret, err := protocol.EncryptForServer(req.PublicKey, secret)
+	if err != nil {
+		return err
+	}
+	encToken, err := protocol.EncryptForServer(req.PublicKey, req.VerifyToken)
+	if err != nil {
+		return err
+	}
+
+	if req.ShouldAuthenticate {
+		joinCtx, cancel := context.WithTimeout(ctx, sessionJoinTimeout)
+		err := auth.JoinServer(joinCtx, protocol.ServerHash(req.ServerID, secret, req.PublicKey))
+		cancel()
+		if err != nil {
+			return fmt.Errorf("session join failed: %w", err)
+		}
+	}
+
+	response := protocol.CreateEncryptionResponsePacket(encSecret, encToken)
+	if err := b.writePacket(b.conn, response, b.connState.GetThreshold()); err != nil {
+		return err
+	}
+	encrypted, err := protocol.EncryptConn(b.conn, secret)
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.conn = encrypted
+	b.mu.Unlock()
+	slog.Info("Enabled protocol encryption", "authenticated", req.ShouldAuthenticate)
+	return nil
+}
+
+// LocalSessionService is an in-process stand-in for the session server. It
+// records joins so a fake server can check them with HasJoined.
+type LocalSessionService struct {
+	mu     sync.Mutex
+	joined map[string]bool
+	// Err, when set, is returned from JoinServer to simulate an auth outage.
+	Err error
+}
+
+func NewLocalSessionService() *LocalSessionService {
+	return &LocalSessionService{joined: make(map[string]bool)}
+}
+
+func (s *LocalSessionService) JoinServer(ctx context.Context, serverHash string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return s.Err
+	}
+	s.joined[serverHash] = true
+	return nil
+}
+
+func (s *LocalSessionService) HasJoined(serverHash string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.joined[serverHash]
+}
+
+// MojangSessionAuthenticator joins through the Mojang session server with a
+// Minecraft access token. Obtaining the token is left to the caller.
+type MojangSessionAuthenticator struct {
+	AccessToken string
+	// ProfileID is the account UUID without dashes.
+	ProfileID string
+	// Endpoint overrides the join URL, mainly for tests.
+	Endpoint string
+	Client   *http.Client
+}
+
+func (m *MojangSessionAuthenticator) JoinServer(ctx context.Context, serverHash string) error {
+	body, err := json.Marshal(map[string]string{
+		"accessToken":     m.AccessToken,
+		"selectedProfile": strings.ReplaceAll(m.ProfileID, "-", ""),
+		"serverId":        serverHash,
+	})
+	if err != nil {
+		return err
+	}
+	endpoint := m.Endpoint
+	if endpoint == "" {
+		endpoint = mojangJoinEndpoint
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := m.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("session server returned %s", resp.Status)
+	}
+	return nil
+}
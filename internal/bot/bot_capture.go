@@ -0,0 +1,172 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+// SetCapture records every packet read or written from now on. Pass nil to
+// stop recording. The writer is shared across reconnects, so one capture can
+// hold several sessions.
+func (b *Bot) SetCapture(w *protocol.CaptureWriter) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.capture = w
+}
+
+// StartCapture creates a capture file at path and records into it until the
+// returned closer is called.
+func (b *Bot) StartCapture(path string) (io.Closer, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w, err := protocol.NewCaptureWriter(f, b.Version().ProtocolNumber())
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	b.SetCapture(w)
+	slog.Info("Recording packet capture", "file", path)
+	return closerFunc(func() error {
+		b.SetCapture(nil)
+		err := w.Close()
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	}), nil
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error { return f() }
+
+// recordPacket appends packet to capture. A failing capture is dropped so a
+// full disk does not take the session down with it.
+func (b *Bot) recordPacket(capture *protocol.CaptureWriter, dir protocol.Direction, packet *protocol.Packet) {
+	if capture == nil || b.connState == nil {
+		return
+	}
+	if err := capture.Write(dir, b.connState.Get(), packet); err != nil {
+		slog.Warn("Packet capture failed, recording stopped", "error", err)
+		// Writes hold b.mu, so the capture is dropped asynchronously.
+		go b.dropCapture(capture)
+	}
+}
+
+func (b *Bot) dropCapture(w *protocol.CaptureWriter) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.capture == w {
+		b.capture = nil
+	}
+}
+
+// ReplayStats summarises a Replay run.
+type ReplayStats struct {
+	Inbound  int
+	Outbound int
+	// Sessions counts entries into Play; captures made with reconnects
+	// contain more than one.
+	Sessions    int
+	Disconnects []*DisconnectError
+	// LoadedChunks is the block store's chunk count when the replay ended.
+	LoadedChunks int
+}
+
+// Replay feeds the packets the server sent in a capture through the same handlers
+// Start uses, without a network. Registries and tags from the configuration
+// phase are applied as well. Packets are applied in order as fast as
+// possible; world state, the block store and published events end up as
+// they were when the capture was recorded. Replies the handlers send go
+// nowhere, and outbound records are only counted.
+func (b *Bot) Replay(ctx context.Context, r io.Reader) (*ReplayStats, error) {
+	cr, err := protocol.NewCaptureReader(r)
+	if err != nil {
+		return nil, err
+	}
+	if cr.Protocol != b.Version().ProtocolNumber() {
+		slog.Warn("Replaying capture from another protocol version",
+			"capture_protocol", cr.Protocol, "bot_protocol", b.Version().ProtocolNumber())
+	}
+
+	server, client := net.Pipe()
+	go func() { _, _ = io.Copy(io.Discard, server) }()
+	b.mu.Lock()
+	b.conn = client
+	b.connState = protocol.NewConnState()
+	capture := b.capture
+	b.capture = nil
+	b.mu.Unlock()
+	defer func() {
+		client.Close()
+		server.Close()
+		b.mu.Lock()
+		b.conn = nil
+		b.capture = capture
+		b.mu.Unlock()
+	}()
+
+	stats := &ReplayStats{}
+	defer func() {
+		if b.blockStore != nil {
+			stats.LoadedChunks = b.blockStore.LoadedChunkCount()
+		}
+	}()
+	inPlay := false
+	for {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+		rec, err := cr.Next()
+		if err == io.EOF {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, fmt.Errorf("read capture record %d: %w", stats.Inbound+stats.Outbound, err)
+		}
+		if rec.Direction == protocol.ToServer {
+			stats.Outbound++
+			continue
+		}
+		stats.Inbound++
+
+		if inPlay && rec.State != protocol.Play {
+			// The capture continues with a reconnect.
+			b.endSession()
+			b.resetSession()
+			inPlay = false
+		}
+		b.connState.Set(rec.State)
+		if rec.State == protocol.Configuration {
+			// Registries and tags decide biome, damage type and dimension
+			// lookups in Play.
+			b.handleConfigurationData(rec.Packet)
+		}
+		if rec.State != protocol.Play {
+			continue
+		}
+		if !inPlay {
+			b.beginPlay()
+			inPlay = true
+			stats.Sessions++
+		}
+		if err := b.handlePlayPacket(ctx, rec.Packet); err != nil {
+			var disconnect *DisconnectError
+			if errors.As(err, &disconnect) {
+				stats.Disconnects = append(stats.Disconnects, disconnect)
+				continue
+			}
+			return stats, fmt.Errorf("replay %s at %s: %w",
+				protocol.PacketName(protocol.Play, protocol.ToClient, rec.Packet.ID), rec.Offset, err)
+		}
+	}
+}
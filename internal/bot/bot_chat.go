@@ -0,0 +1,123 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/Versifine/locus/internal/event"
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+const (
+	systemChatUsername = "SYSTEM"
+	// whisperIncomingKey is how servers without signed chat deliver /msg.
+	whisperIncomingKey = "commands.message.display.incoming"
+)
+
+func (b *Bot) handlePlayerChat(ctx context.Context, payload []byte) {
+	playerChat, err := protocol.ParsePlayerChat(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse player chat", "error", err)
+		return
+	}
+	b.resolveChatType(&playerChat.Type)
+	if playerChat.SenderUUID == b.uuid || playerChat.Type.IsOutgoing() {
+		// 忽略自己的消息
+		return
+	}
+	source := event.SourcePlayer
+	if playerChat.Type.IsIncomingWhisper() {
+		source = event.SourceWhisper
+	}
+	b.publishPlayerChat(event.NewChatEvent(ctx, protocol.FormatTextComponent(playerChat.NetworkName), playerChat.SenderUUID, playerChat.PlainMessage, source))
+}
+
+// handleDisguisedChat covers chat the server sends unsigned, e.g. /say or
+// player chat on servers with enforce-secure-profile off.
+func (b *Bot) handleDisguisedChat(ctx context.Context, payload []byte) {
+	chat, err := protocol.ParseDisguisedChat(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse disguised chat", "error", err)
+		return
+	}
+	b.resolveChatType(&chat.Type)
+	name := protocol.FormatTextComponent(&chat.Name)
+	if chat.Type.IsOutgoing() || (name != "" && name == b.username) {
+		return
+	}
+	source := event.SourcePlayer
+	if chat.Type.IsIncomingWhisper() {
+		source = event.SourceWhisper
+	}
+	b.publishPlayerChat(event.NewChatEvent(ctx, name, protocol.UUID{}, protocol.FormatTextComponent(&chat.Message), source))
+}
+
+// resolveChatType names a chat_type registry reference from the registry
+// the server synchronized, since datapacks can reorder its entries.
+func (b *Bot) resolveChatType(chatType *protocol.ChatType) {
+	if chatType.IsInline() {
+		return
+	}
+	if name, ok := b.registries.EntryName(world.RegistryChatType, chatType.RegistryID); ok {
+		chatType.Name = name
+	}
+}
+
+func (b *Bot) handleSystemChat(ctx context.Context, payload []byte) {
+	chat, err := protocol.ParseSystemChat(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse system chat", "error", err)
+		return
+	}
+	if chat.IsActionBar {
+		return
+	}
+
+	key, args, translated := protocol.TextTranslation(&chat.Content)
+	if translated && key == whisperIncomingKey && len(args) >= 2 {
+		b.publishPlayerChat(event.NewChatEvent(ctx, args[0], protocol.UUID{}, args[1], event.SourceWhisper))
+		return
+	}
+
+	message := protocol.FormatTextComponent(&chat.Content)
+	if message == "" {
+		return
+	}
+	evt := event.NewChatEvent(ctx, systemChatUsername, protocol.UUID{}, message, event.SourceSystem)
+	if translated {
+		evt.Notice = event.ClassifyTranslation(key, args)
+	}
+	b.eventBus.Publish(event.EventChat, evt)
+}
+
+// publishPlayerChat links the sender to their entity, by UUID or else by
+// name through the player list, before publishing.
+func (b *Bot) publishPlayerChat(evt *event.ChatEvent) {
+	uuid := ""
+	if evt.UUID != (protocol.UUID{}) {
+		uuid = evt.UUID.String()
+	} else if player, ok := b.worldState.GetState().PlayerByName(evt.Username); ok {
+		uuid = player.UUID
+	}
+	if uuid != "" {
+		evt.EntityID, _ = b.worldState.PlayerEntityID(uuid)
+	}
+	b.eventBus.Publish(event.EventChat, evt)
+}
+
+// SendWhisper sends msg privately to target with /msg.
+func (b *Bot) SendWhisper(target, msg string) error {
+	target = strings.TrimSpace(target)
+	if target == "" || strings.ContainsAny(target, " \t\n") {
+		return fmt.Errorf("invalid whisper target %q", target)
+	}
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return fmt.Errorf("empty whisper message")
+	}
+	return b.SendPacket(protocol.CreateChatCommandPacket("msg " + target + " " + msg))
+}
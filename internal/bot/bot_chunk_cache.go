@@ -0,0 +1,139 @@
+package bot
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Versifine/locus/internal/physics"
+	"github.com/Versifine/locus/internal/skill"
+	"github.com/Versifine/locus/internal/world"
+)
+
+// chunkCacheSaveInterval is how often an open chunk cache is written to disk.
+const chunkCacheSaveInterval = 2 * time.Minute
+
+// OpenChunkCache keeps the last-seen state of unloaded chunks under dir, in
+// a subdirectory per protocol version, until the returned closer saves and
+// detaches it. Call it after SetVersion, which starts a store without one.
+func (b *Bot) OpenChunkCache(dir string) (io.Closer, error) {
+	if b.blockStore == nil {
+		return nil, fmt.Errorf("block store unavailable")
+	}
+	cache, err := world.OpenChunkCache(filepath.Join(dir, b.Version().Name))
+	if err != nil {
+		return nil, err
+	}
+	blockStore := b.blockStore
+	blockStore.SetChunkCache(cache)
+	if b.worldState != nil {
+		if dimension := b.worldState.GetState().DimensionName; dimension != "" {
+			b.setChunkCacheDimension(dimension)
+		}
+	}
+	slog.Info("Caching explored chunks", "dir", dir)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		saveChunkCacheEvery(blockStore, chunkCacheSaveInterval, stop)
+	}()
+	var once sync.Once
+	return closerFunc(func() error {
+		var err error
+		once.Do(func() {
+			close(stop)
+			<-done
+			err = blockStore.FlushChunkCache()
+			blockStore.SetChunkCache(nil)
+		})
+		return err
+	}), nil
+}
+
+// saveChunkCacheEvery saves the chunk cache, loaded chunks included, until
+// stop is closed, so a crash loses at most one interval of exploring.
+func saveChunkCacheEvery(blockStore *world.BlockStore, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := blockStore.FlushChunkCache(); err != nil {
+				slog.Warn("Failed to save chunk cache", "error", err)
+			}
+		}
+	}
+}
+
+// LastKnownBlockState returns the state at a position and when it was seen,
+// falling back to the chunk cache for unloaded chunks.
+func (b *Bot) LastKnownBlockState(x, y, z int) (int32, time.Time, bool) {
+	if b.blockStore == nil {
+		return 0, time.Time{}, false
+	}
+	return b.blockStore.LastKnownBlockState(x, y, z)
+}
+
+// KnownBlocks is the bot's block access with unloaded chunks filled in from
+// the chunk cache. Pathfinding plans through it, so routes can cross terrain
+// the bot explored earlier, in this session or a previous one.
+func (b *Bot) KnownBlocks() skill.BlockAccess {
+	if b.blockStore == nil {
+		return b
+	}
+	return knownBlocks{Bot: b, known: b.blockStore.KnownBlocks()}
+}
+
+// knownBlocks answers position lookups from world.KnownBlocks and everything
+// else, such as state names and tags, from the Bot.
+type knownBlocks struct {
+	*Bot
+	known world.KnownBlocks
+}
+
+func (k knownBlocks) GetBlockState(x, y, z int) (int32, bool) {
+	return k.known.GetBlockState(x, y, z)
+}
+
+func (k knownBlocks) IsSolid(x, y, z int) bool {
+	return k.known.IsSolid(x, y, z)
+}
+
+func (k knownBlocks) CollisionBoxes(x, y, z int) []physics.AABB {
+	return physicsBoxes(k.known.CollisionBoxes(x, y, z))
+}
+
+func (k knownBlocks) FluidAt(x, y, z int) physics.Fluid {
+	return physicsFluid(k.known.FluidAt(x, y, z))
+}
+
+func (k knownBlocks) BlockMovement(x, y, z int) physics.BlockMovement {
+	return physicsMovement(k.known.BlockMovement(x, y, z))
+}
+
+// setChunkCacheDimension switches the chunk cache after the block store has
+// been cleared for a new dimension.
+func (b *Bot) setChunkCacheDimension(dimension string) {
+	if b.blockStore == nil {
+		return
+	}
+	if err := b.blockStore.SetChunkCacheDimension(dimension); err != nil {
+		slog.Warn("Chunk cache could not switch dimension", "dimension", dimension, "error", err)
+	}
+}
+
+// flushChunkCache saves the chunk cache, logging failures.
+func (b *Bot) flushChunkCache() {
+	if b.blockStore == nil {
+		return
+	}
+	if err := b.blockStore.FlushChunkCache(); err != nil {
+		slog.Warn("Failed to save chunk cache", "error", err)
+	}
+}
@@ -25,7 +25,7 @@ func (b *Bot) handleLevelChunkWithLight(payload []byte) {
 		return
 	}
 
-	sections, normalizeErr := normalizeSectionsForBlockStore(chunk.Sections)
+	sections, sectionOffset, normalizeErr := b.normalizeChunkSections(chunk)
 	if normalizeErr != nil {
 		slog.Warn(
 			"Failed to normalize chunk sections for block store",
@@ -52,6 +52,7 @@ func (b *Bot) handleLevelChunkWithLight(payload []byte) {
 		slog.Warn("Failed to store chunk", "chunk_x", chunk.ChunkX, "chunk_z", chunk.ChunkZ, "error", err)
 		return
 	}
+	b.blockStore.UpdateLight(chunk.ChunkX, chunk.ChunkZ, lightUpdateFromPacket(chunk.Light, sectionOffset))
 
 	slog.Debug(
 		"Stored chunk",
@@ -78,7 +79,12 @@ func (b *Bot) handlePlayLogin(payload []byte) {
 
 	b.setSelfEntityID(login.EntityID)
 	b.worldState.UpdateDimensionContext(login.WorldState.Name, login.SimulationDistance)
-	if bounds, ok := world.VanillaDimensionBounds(login.WorldState.Name); ok {
+	b.worldState.UpdateGameMode(world.GameModeName(int32(login.WorldState.Gamemode)))
+	if death, ok := lastDeathFromLogin(login.WorldState.Death); ok {
+		b.worldState.SetLastDeath(death)
+	}
+	b.setChunkCacheDimension(login.WorldState.Name)
+	if bounds, ok := b.updateDimensionBounds(login.WorldState.Dimension, login.WorldState.Name); ok {
 		slog.Info(
 			"Updated dimension context from play login",
 			"dimension", login.WorldState.Name,
@@ -109,18 +115,23 @@ func (b *Bot) handleRespawn(payload []byte) {
 
 	current := b.worldState.GetState()
 	b.worldState.UpdateDimensionContext(respawn.WorldState.Name, current.SimulationDistance)
+	b.worldState.UpdateGameMode(world.GameModeName(int32(respawn.WorldState.Gamemode)))
+	// The server resends the effects that survive the respawn.
+	b.worldState.ClearEffects()
 	b.worldState.ClearEntities()
+	b.worldState.ClearSounds()
 	b.resetPlayerLoaded()
 	b.resetPendingDigRequests("respawn")
 
 	if b.blockStore != nil {
 		b.blockStore.Clear()
 	}
+	b.setChunkCacheDimension(respawn.WorldState.Name)
 	b.footLogMu.Lock()
 	b.lastFootLogged = footBlockSnapshot{}
 	b.footLogMu.Unlock()
 
-	if bounds, ok := world.VanillaDimensionBounds(respawn.WorldState.Name); ok {
+	if bounds, ok := b.updateDimensionBounds(respawn.WorldState.Dimension, respawn.WorldState.Name); ok {
 		slog.Info(
 			"Handled respawn and cleared cached chunks",
 			"dimension", respawn.WorldState.Name,
@@ -190,6 +201,47 @@ func (b *Bot) handleChunkBatchFinished(payload []byte) {
 	)
 }
 
+func (b *Bot) handleUpdateLight(payload []byte) {
+	if b.blockStore == nil {
+		return
+	}
+	update, err := protocol.ParseUpdateLight(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse update light", "error", err)
+		return
+	}
+	if !b.blockStore.UpdateLight(update.ChunkX, update.ChunkZ, lightUpdateFromPacket(update.Light, b.sectionOffset())) {
+		slog.Debug("Skipping light update for unloaded chunk", "chunk_x", update.ChunkX, "chunk_z", update.ChunkZ)
+	}
+}
+
+// lightUpdateFromPacket pairs each light array with the section its mask bit
+// names; sections in the empty masks become dark. Mask bits count from the
+// section below the dimension's min_y, so they are shifted by the same
+// offset as the chunk's block sections.
+func lightUpdateFromPacket(light protocol.LightData, sectionOffset int) world.LightUpdate {
+	return world.LightUpdate{
+		Sky:   lightSections(light.SkyLightMask, light.EmptySkyLightMask, light.SkyLight, sectionOffset),
+		Block: lightSections(light.BlockLightMask, light.EmptyBlockLightMask, light.BlockLight, sectionOffset),
+	}
+}
+
+func lightSections(mask, emptyMask []int64, arrays [][]byte, sectionOffset int) []world.LightSection {
+	indices := protocol.BitSetIndices(mask)
+	empty := protocol.BitSetIndices(emptyMask)
+	sections := make([]world.LightSection, 0, len(indices)+len(empty))
+	for i, index := range indices {
+		if i >= len(arrays) {
+			break
+		}
+		sections = append(sections, world.LightSection{Index: index + sectionOffset, Data: arrays[i]})
+	}
+	for _, index := range empty {
+		sections = append(sections, world.LightSection{Index: index + sectionOffset})
+	}
+	return sections
+}
+
 func (b *Bot) handleUnloadChunk(payload []byte) {
 	if b.blockStore == nil {
 		slog.Warn("Skipping chunk unload because block store is not initialized")
@@ -14,20 +14,29 @@ import (
 	"github.com/Versifine/locus/internal/world"
 )
 
+// normalizeSectionsForBlockStore centers the parsed sections in the
+// overworld height, which places the vanilla nether and end at y=0. Use
+// normalizeSectionsAt when the dimension's min_y is known.
 func normalizeSectionsForBlockStore(parsed []protocol.ChunkSection) ([]world.ChunkSection, error) {
-	if len(parsed) == 0 {
-		return nil, fmt.Errorf("no parsed sections")
-	}
 	if len(parsed) > world.ChunkSectionCount {
 		return nil, fmt.Errorf("too many parsed sections: %d", len(parsed))
 	}
+	return normalizeSectionsAt(parsed, max((world.ChunkSectionCount-len(parsed))/2, 0), world.ChunkSectionCount)
+}
 
-	offset := (world.ChunkSectionCount - len(parsed)) / 2
-	if offset < 0 {
-		offset = 0
+// normalizeSectionsAt places the parsed sections starting at section index
+// offset of a block store with sectionCount sections and pads the rest with
+// air. Sections that fall outside the store are dropped; that only happens
+// while the store is not sized to the dimension.
+func normalizeSectionsAt(parsed []protocol.ChunkSection, offset, sectionCount int) ([]world.ChunkSection, error) {
+	if len(parsed) == 0 {
+		return nil, fmt.Errorf("no parsed sections")
+	}
+	if offset >= sectionCount || offset+len(parsed) <= 0 {
+		return nil, fmt.Errorf("sections %d..%d outside the block store", offset, offset+len(parsed)-1)
 	}
 
-	normalized := make([]world.ChunkSection, world.ChunkSectionCount)
+	normalized := make([]world.ChunkSection, sectionCount)
 	for i := range normalized {
 		normalized[i] = world.ChunkSection{BlockStates: make([]int32, world.BlocksPerSection)}
 	}
@@ -35,7 +44,7 @@ func normalizeSectionsForBlockStore(parsed []protocol.ChunkSection) ([]world.Chu
 	for i, section := range parsed {
 		target := i + offset
 		if target < 0 || target >= len(normalized) {
-			return nil, fmt.Errorf("section index out of range after normalize: parsed=%d target=%d", i, target)
+			continue
 		}
 		if len(section.BlockStates) != world.BlocksPerSection {
 			return nil, fmt.Errorf(
@@ -46,6 +55,9 @@ func normalizeSectionsForBlockStore(parsed []protocol.ChunkSection) ([]world.Chu
 			)
 		}
 		copy(normalized[target].BlockStates, section.BlockStates)
+		if len(section.Biomes) == world.BiomesPerSection {
+			normalized[target].Biomes = append([]int32(nil), section.Biomes...)
+		}
 	}
 
 	return normalized, nil
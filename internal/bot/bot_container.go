@@ -0,0 +1,536 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Versifine/locus/internal/agent"
+	"github.com/Versifine/locus/internal/crafting"
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+const (
+	containerOpenTimeout   = 3 * time.Second
+	containerClickTimeout  = 500 * time.Millisecond
+	containerSettlePeriod  = 40 * time.Millisecond
+	containerReachDistance = 5.0 // eyes to block centre, as open_container tells the model
+	playerEyeHeight        = 1.62
+	defaultMaxStackSize    = 64
+	// Container windows end with the 27 main + 9 hotbar player slots.
+	containerPlayerSlotCount = 36
+)
+
+const (
+	TransferDeposit  = "deposit"
+	TransferWithdraw = "withdraw"
+)
+
+type containerState struct {
+	containerMu      sync.Mutex
+	container        *containerWindow
+	containerChanged chan struct{}
+	nextUseSequence  int32
+}
+
+type containerWindow struct {
+	windowID   int32
+	windowType int32
+	title      string
+	stateID    int32
+	slots      []protocol.Slot
+	loaded     bool
+}
+
+func (c *containerWindow) containerSize() int {
+	size := len(c.slots) - containerPlayerSlotCount
+	if size < 0 {
+		return 0
+	}
+	return size
+}
+
+func (b *Bot) handleOpenWindow(payload []byte) {
+	packetRdr := bytes.NewReader(payload)
+	open, err := protocol.ParseOpenWindow(packetRdr)
+	if err != nil {
+		slog.Warn("Failed to parse open window", "error", err)
+		return
+	}
+
+	b.containerMu.Lock()
+	b.container = &containerWindow{
+		windowID:   open.WindowID,
+		windowType: open.InventoryType,
+		title:      open.Title,
+	}
+	b.notifyContainerChangedLocked()
+	b.containerMu.Unlock()
+
+	slog.Debug(
+		"Container opened",
+		"window_id", open.WindowID,
+		"type", protocol.WindowTypeName(open.InventoryType),
+		"title", open.Title,
+	)
+}
+
+func (b *Bot) handleCloseWindow(payload []byte) {
+	packetRdr := bytes.NewReader(payload)
+	closeWindow, err := protocol.ParseCloseWindow(packetRdr)
+	if err != nil {
+		slog.Warn("Failed to parse close window", "error", err)
+		return
+	}
+
+	b.containerMu.Lock()
+	if b.container != nil && b.container.windowID == closeWindow.WindowID {
+		b.container = nil
+		b.notifyContainerChangedLocked()
+	}
+	b.containerMu.Unlock()
+}
+
+// applyContainerItems stores window_items for a non-player window and mirrors
+// the trailing player slots into the tracked inventory.
+func (b *Bot) applyContainerItems(items *protocol.WindowItems) {
+	b.containerMu.Lock()
+	if b.container == nil || b.container.windowID != items.WindowID {
+		b.containerMu.Unlock()
+		return
+	}
+	b.container.slots = append(b.container.slots[:0], items.Items...)
+	b.container.stateID = items.StateID
+	b.container.loaded = true
+	size := b.container.containerSize()
+	b.notifyContainerChangedLocked()
+	b.containerMu.Unlock()
+
+	b.inventoryMu.Lock()
+	b.carriedItem = items.CarriedItem
+	for i := 0; i < containerPlayerSlotCount && size+i < len(items.Items); i++ {
+		b.playerWindow[protocol.PlayerSlotMainStart+i] = items.Items[size+i]
+	}
+	b.inventoryMu.Unlock()
+}
+
+func (b *Bot) applyContainerSlot(setSlot *protocol.SetSlot) {
+	b.containerMu.Lock()
+	if b.container == nil || b.container.windowID != setSlot.WindowID || !b.container.loaded {
+		b.containerMu.Unlock()
+		return
+	}
+	if setSlot.Slot < 0 || int(setSlot.Slot) >= len(b.container.slots) {
+		b.containerMu.Unlock()
+		slog.Warn("Container set slot index out of range", "window_id", setSlot.WindowID, "slot", setSlot.Slot)
+		return
+	}
+	b.container.slots[setSlot.Slot] = setSlot.Item
+	b.container.stateID = setSlot.StateID
+	size := b.container.containerSize()
+	b.notifyContainerChangedLocked()
+	b.containerMu.Unlock()
+
+	if playerIndex := int(setSlot.Slot) - size; playerIndex >= 0 && playerIndex < containerPlayerSlotCount {
+		b.inventoryMu.Lock()
+		b.playerWindow[protocol.PlayerSlotMainStart+playerIndex] = setSlot.Item
+		b.inventoryMu.Unlock()
+	}
+}
+
+// noteWindowChanged wakes waiters after player window or cursor updates.
+func (b *Bot) noteWindowChanged() {
+	b.containerMu.Lock()
+	b.notifyContainerChangedLocked()
+	b.containerMu.Unlock()
+}
+
+func (b *Bot) notifyContainerChangedLocked() {
+	if b.containerChanged != nil {
+		close(b.containerChanged)
+	}
+	b.containerChanged = make(chan struct{})
+}
+
+func (b *Bot) containerChangedCh() <-chan struct{} {
+	b.containerMu.Lock()
+	defer b.containerMu.Unlock()
+	if b.containerChanged == nil {
+		b.containerChanged = make(chan struct{})
+	}
+	return b.containerChanged
+}
+
+// waitContainerSettle waits for the first container update after ch was taken,
+// then keeps waiting until updates stop arriving for containerSettlePeriod.
+func (b *Bot) waitContainerSettle(ctx context.Context, ch <-chan struct{}, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return false
+	case <-ch:
+	}
+
+	for {
+		next := b.containerChangedCh()
+		select {
+		case <-ctx.Done():
+			return true
+		case <-next:
+		case <-time.After(containerSettlePeriod):
+			return true
+		}
+	}
+}
+
+func (b *Bot) containerView() (agent.ContainerView, bool) {
+	b.containerMu.Lock()
+	defer b.containerMu.Unlock()
+	if b.container == nil || !b.container.loaded {
+		return agent.ContainerView{}, false
+	}
+	c := b.container
+	size := c.containerSize()
+	view := agent.ContainerView{
+		WindowID: c.windowID,
+		Type:     protocol.WindowTypeName(c.windowType),
+		Title:    c.title,
+		Size:     size,
+		Slots:    make([]agent.InventoryItem, 0),
+	}
+	for i := 0; i < size; i++ {
+		if c.slots[i].IsEmpty() {
+			continue
+		}
+		view.Slots = append(view.Slots, inventoryItemFromSlot(i, c.slots[i]))
+	}
+	return view, true
+}
+
+// OpenContainer right-clicks the block at (x, y, z) and waits for the server to
+// open a window and send its contents.
+func (b *Bot) OpenContainer(ctx context.Context, x, y, z int) (agent.ContainerView, error) {
+	if b.worldState != nil {
+		pos := b.worldState.GetState().Position
+		dx := float64(x) + 0.5 - pos.X
+		dy := float64(y) + 0.5 - (pos.Y + playerEyeHeight)
+		dz := float64(z) + 0.5 - pos.Z
+		if dist := math.Sqrt(dx*dx + dy*dy + dz*dz); dist > containerReachDistance {
+			return agent.ContainerView{}, fmt.Errorf("container at (%d,%d,%d) is out of reach: distance %.1f", x, y, z, dist)
+		}
+	}
+	if _, open := b.containerView(); open {
+		if err := b.CloseContainer(); err != nil {
+			return agent.ContainerView{}, err
+		}
+	}
+
+	b.containerMu.Lock()
+	sequence := b.nextUseSequence
+	b.nextUseSequence++
+	b.containerMu.Unlock()
+
+	packet := protocol.CreateBlockPlacePacket(
+		protocol.BlockPos{X: int32(x), Y: int32(y), Z: int32(z)},
+		1,
+		0,
+		0.5,
+		1.0,
+		0.5,
+		false,
+		false,
+		sequence,
+	)
+	if err := b.SendPacket(packet); err != nil {
+		return agent.ContainerView{}, err
+	}
+
+	deadline := time.NewTimer(containerOpenTimeout)
+	defer deadline.Stop()
+	for {
+		ch := b.containerChangedCh()
+		if view, ok := b.containerView(); ok {
+			return view, nil
+		}
+		select {
+		case <-ctx.Done():
+			return agent.ContainerView{}, ctx.Err()
+		case <-deadline.C:
+			return agent.ContainerView{}, fmt.Errorf("no container opened at (%d,%d,%d)", x, y, z)
+		case <-ch:
+		}
+	}
+}
+
+func (b *Bot) CloseContainer() error {
+	b.containerMu.Lock()
+	if b.container == nil {
+		b.containerMu.Unlock()
+		return nil
+	}
+	windowID := b.container.windowID
+	b.container = nil
+	b.notifyContainerChangedLocked()
+	b.containerMu.Unlock()
+
+	return b.SendPacket(protocol.CreateCloseWindowPacket(windowID))
+}
+
+// ClickContainerSlot sends a window_click for the open container and waits for
+// the server to report the resulting slot changes.
+func (b *Bot) ClickContainerSlot(ctx context.Context, slot int16, button int8, mode int32) error {
+	b.containerMu.Lock()
+	if b.container == nil || !b.container.loaded {
+		b.containerMu.Unlock()
+		return fmt.Errorf("no container is open")
+	}
+	windowID := b.container.windowID
+	b.containerMu.Unlock()
+
+	return b.clickWindow(ctx, windowID, slot, button, mode)
+}
+
+// clickWindow sends a window_click for windowID (0 is the player inventory)
+// and waits for the resulting slot updates.
+//
+// changedSlots is left empty on purpose: the server then diffs against the
+// state it last sent us and pushes back every slot the click touched.
+func (b *Bot) clickWindow(ctx context.Context, windowID int32, slot int16, button int8, mode int32) error {
+	var stateID int32
+	if windowID == protocol.PlayerInventoryWindowID {
+		b.inventoryMu.RLock()
+		stateID = b.inventoryStateID
+		b.inventoryMu.RUnlock()
+	} else {
+		b.containerMu.Lock()
+		if b.container == nil || b.container.windowID != windowID {
+			b.containerMu.Unlock()
+			return fmt.Errorf("window %d is not open", windowID)
+		}
+		stateID = b.container.stateID
+		b.containerMu.Unlock()
+	}
+
+	b.inventoryMu.RLock()
+	cursor := protocol.HashedSlotFromSlot(b.carriedItem)
+	b.inventoryMu.RUnlock()
+
+	ch := b.containerChangedCh()
+	packet := protocol.CreateWindowClickPacket(windowID, stateID, slot, button, mode, nil, cursor)
+	if err := b.SendPacket(packet); err != nil {
+		return err
+	}
+	b.waitContainerSettle(ctx, ch, containerClickTimeout)
+	return ctx.Err()
+}
+
+func (b *Bot) containerSlot(index int) (protocol.Slot, bool) {
+	b.containerMu.Lock()
+	defer b.containerMu.Unlock()
+	if b.container == nil || index < 0 || index >= len(b.container.slots) {
+		return protocol.Slot{}, false
+	}
+	return b.container.slots[index], true
+}
+
+func (b *Bot) carried() protocol.Slot {
+	b.inventoryMu.RLock()
+	defer b.inventoryMu.RUnlock()
+	return b.carriedItem
+}
+
+// TransferItems moves matching stacks between the open container and the
+// player inventory. Whole stacks are shift-clicked; a partial count is placed
+// one item at a time with right clicks and the remainder is put back.
+func (b *Bot) TransferItems(ctx context.Context, req agent.ContainerTransfer) (agent.ContainerTransferResult, error) {
+	b.containerMu.Lock()
+	if b.container == nil || !b.container.loaded {
+		b.containerMu.Unlock()
+		return agent.ContainerTransferResult{}, fmt.Errorf("no container is open")
+	}
+	size := b.container.containerSize()
+	total := len(b.container.slots)
+	b.containerMu.Unlock()
+
+	var srcStart, srcEnd, dstStart, dstEnd int
+	switch req.Direction {
+	case TransferDeposit:
+		srcStart, srcEnd, dstStart, dstEnd = size, total, 0, size
+	case TransferWithdraw:
+		srcStart, srcEnd, dstStart, dstEnd = 0, size, size, total
+	default:
+		return agent.ContainerTransferResult{}, fmt.Errorf("unknown transfer direction %q", req.Direction)
+	}
+
+	want := normalizeItemName(req.Item)
+	remaining := req.Count
+	moved := 0
+	reason := "completed"
+
+	for remaining != 0 || req.Count <= 0 {
+		srcIndex := -1
+		var src protocol.Slot
+		for i := srcStart; i < srcEnd; i++ {
+			slot, _ := b.containerSlot(i)
+			if slot.IsEmpty() {
+				continue
+			}
+			if want != "" && normalizeItemName(world.ItemName(slot.ItemID)) != want {
+				continue
+			}
+			srcIndex, src = i, slot
+			break
+		}
+		if srcIndex < 0 {
+			if moved == 0 {
+				reason = "no_matching_items"
+			} else if req.Count > 0 {
+				reason = "partial"
+			}
+			break
+		}
+
+		if req.Count <= 0 || int(src.Count) <= remaining {
+			if err := b.ClickContainerSlot(ctx, int16(srcIndex), 0, protocol.WindowClickQuickMove); err != nil {
+				return b.transferResult(moved, "error"), err
+			}
+			after, _ := b.containerSlot(srcIndex)
+			delta := int(src.Count)
+			if !after.IsEmpty() && after.ItemID == src.ItemID {
+				delta -= int(after.Count)
+			}
+			if delta <= 0 {
+				reason = "destination_full"
+				break
+			}
+			moved += delta
+			if req.Count > 0 {
+				remaining -= delta
+			}
+			continue
+		}
+
+		placed, err := b.placePartialStack(ctx, srcIndex, src, remaining, dstStart, dstEnd)
+		moved += placed
+		if err != nil {
+			return b.transferResult(moved, "error"), err
+		}
+		if placed < remaining {
+			reason = "destination_full"
+		}
+		break
+	}
+
+	return b.transferResult(moved, reason), nil
+}
+
+func (b *Bot) placePartialStack(ctx context.Context, srcIndex int, src protocol.Slot, count int, dstStart, dstEnd int) (int, error) {
+	if err := b.ClickContainerSlot(ctx, int16(srcIndex), 0, protocol.WindowClickPickup); err != nil {
+		return 0, err
+	}
+
+	placed := 0
+	for placed < count {
+		cursor := b.carried()
+		if cursor.IsEmpty() || cursor.ItemID != src.ItemID {
+			break
+		}
+		target := b.findDepositSlot(src, dstStart, dstEnd)
+		if target < 0 {
+			break
+		}
+		if err := b.ClickContainerSlot(ctx, int16(target), 1, protocol.WindowClickPickup); err != nil {
+			return placed, err
+		}
+		after := b.carried()
+		if !after.IsEmpty() && after.Count >= cursor.Count {
+			break
+		}
+		placed++
+	}
+
+	if !b.carried().IsEmpty() {
+		if err := b.ClickContainerSlot(ctx, int16(srcIndex), 0, protocol.WindowClickPickup); err != nil {
+			return placed, err
+		}
+	}
+	return placed, nil
+}
+
+// findDepositSlot prefers topping up an existing stack of the same item before
+// falling back to the first empty slot.
+func (b *Bot) findDepositSlot(item protocol.Slot, start, end int) int {
+	maxStack := stackLimit(item)
+	empty := -1
+	for i := start; i < end; i++ {
+		slot, _ := b.containerSlot(i)
+		if slot.IsEmpty() {
+			if empty < 0 {
+				empty = i
+			}
+			continue
+		}
+		if slot.Count < maxStack && canStackOnto(slot, item) {
+			return i
+		}
+	}
+	return empty
+}
+
+// stackLimit is how many of an item fit in one slot. Servers leave default
+// components out, so items.json supplies the limit and a max_stack_size
+// component only overrides it.
+func stackLimit(item protocol.Slot) int32 {
+	if v, ok := item.IntComponent(protocol.SlotComponentMaxStackSize); ok && v > 0 {
+		return v
+	}
+	if book, err := crafting.DefaultBook(); err == nil {
+		return int32(book.StackSize(item.ItemID))
+	}
+	return defaultMaxStackSize
+}
+
+// canStackOnto reports whether item merges into slot: the same item with the
+// same components, so enchantments, names and damage must all match.
+func canStackOnto(slot, item protocol.Slot) bool {
+	if slot.ItemID != item.ItemID || len(slot.Components) != len(item.Components) {
+		return false
+	}
+	for _, c := range slot.Components {
+		other, ok := item.Component(c.Type)
+		if !ok || !bytes.Equal(c.Raw, other.Raw) {
+			return false
+		}
+	}
+	removed := slices.Clone(slot.RemovedComponents)
+	otherRemoved := slices.Clone(item.RemovedComponents)
+	slices.Sort(removed)
+	slices.Sort(otherRemoved)
+	return slices.Equal(removed, otherRemoved)
+}
+
+func (b *Bot) transferResult(moved int, reason string) agent.ContainerTransferResult {
+	view, _ := b.containerView()
+	return agent.ContainerTransferResult{
+		Moved:     moved,
+		Reason:    reason,
+		Container: view,
+	}
+}
+
+// normalizeItemName lets "Oak Log", "oak_log" and "minecraft:oak_log" match.
+func normalizeItemName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.TrimPrefix(name, "minecraft:")
+	return strings.ReplaceAll(name, " ", "_")
+}
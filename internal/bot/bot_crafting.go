@@ -0,0 +1,284 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Versifine/locus/internal/crafting"
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+const (
+	craftResultSlot = 0
+	craftGridStart  = 1
+	// craftMaxCursorReturns bounds attempts to put a held stack back.
+	craftMaxCursorReturns = 4
+)
+
+// craftGrid describes the window a recipe is laid out in. invStart and
+// invEnd bound the window slots holding the player's main inventory and hotbar.
+type craftGrid struct {
+	windowID int32
+	size     int
+	invStart int
+	invEnd   int
+}
+
+// InventoryCounts totals main inventory and hotbar items by ID, which is what
+// the crafting grid can draw from.
+func (b *Bot) InventoryCounts() (map[int32]int, bool) {
+	b.inventoryMu.RLock()
+	defer b.inventoryMu.RUnlock()
+	if !b.inventoryReady {
+		return nil, false
+	}
+	counts := make(map[int32]int)
+	for i := protocol.PlayerSlotMainStart; i < protocol.PlayerSlotOffhand; i++ {
+		slot := b.playerWindow[i]
+		if !slot.IsEmpty() {
+			counts[slot.ItemID] += int(slot.Count)
+		}
+	}
+	return counts, true
+}
+
+func (b *Bot) OpenCraftingTable(ctx context.Context, x, y, z int) error {
+	view, err := b.OpenContainer(ctx, x, y, z)
+	if err != nil {
+		return err
+	}
+	if view.Type != "crafting" {
+		_ = b.CloseContainer()
+		return fmt.Errorf("block at (%d,%d,%d) opened %q, not a crafting table", x, y, z, view.Type)
+	}
+	return nil
+}
+
+func (b *Bot) CloseCraftingTable() error {
+	b.containerMu.Lock()
+	isTable := b.container != nil && protocol.WindowTypeName(b.container.windowType) == "crafting"
+	b.containerMu.Unlock()
+	if !isTable {
+		return nil
+	}
+	return b.CloseContainer()
+}
+
+// CraftRecipe fills the grid for times crafts of recipe and shift-clicks the
+// result into the inventory. An open crafting table is used if there is one,
+// otherwise the 2x2 inventory grid.
+func (b *Bot) CraftRecipe(ctx context.Context, recipe crafting.Recipe, times int) error {
+	grid, err := b.activeCraftGrid()
+	if err != nil {
+		return err
+	}
+	cells := recipe.Cells(grid.size)
+	if cells == nil {
+		return fmt.Errorf("recipe does not fit the %dx%d grid", grid.size, grid.size)
+	}
+	if times <= 0 {
+		times = 1
+	}
+
+	if err := b.clearCraftGrid(ctx, grid); err != nil {
+		return err
+	}
+	before := b.countInWindow(grid, recipe.Result.ID)
+	for _, cell := range cells {
+		if err := b.fillCraftCell(ctx, grid, craftGridStart+cell.Index, cell.ItemID, times); err != nil {
+			_ = b.returnCursor(ctx, grid)
+			_ = b.clearCraftGrid(ctx, grid)
+			return err
+		}
+	}
+	if err := b.returnCursor(ctx, grid); err != nil {
+		return err
+	}
+
+	if result := b.windowSlotAt(grid, craftResultSlot); result.IsEmpty() || result.ItemID != recipe.Result.ID {
+		_ = b.clearCraftGrid(ctx, grid)
+		return fmt.Errorf("server did not accept the %s recipe", world.ItemName(recipe.Result.ID))
+	}
+	if err := b.clickWindow(ctx, grid.windowID, craftResultSlot, 0, protocol.WindowClickQuickMove); err != nil {
+		return err
+	}
+	if b.countInWindow(grid, recipe.Result.ID) <= before {
+		_ = b.clearCraftGrid(ctx, grid)
+		return fmt.Errorf("crafted %s did not reach the inventory", world.ItemName(recipe.Result.ID))
+	}
+	// Leftovers mean the inventory filled up part way; hand them back.
+	return b.clearCraftGrid(ctx, grid)
+}
+
+func (b *Bot) activeCraftGrid() (craftGrid, error) {
+	b.containerMu.Lock()
+	container := b.container
+	if container != nil {
+		defer b.containerMu.Unlock()
+		if !container.loaded || protocol.WindowTypeName(container.windowType) != "crafting" {
+			return craftGrid{}, fmt.Errorf("a %s window is open; close it before crafting", protocol.WindowTypeName(container.windowType))
+		}
+		return craftGrid{
+			windowID: container.windowID,
+			size:     crafting.TableGridSize,
+			invStart: container.containerSize(),
+			invEnd:   len(container.slots),
+		}, nil
+	}
+	b.containerMu.Unlock()
+
+	b.inventoryMu.RLock()
+	ready := b.inventoryReady
+	b.inventoryMu.RUnlock()
+	if !ready {
+		return craftGrid{}, fmt.Errorf("inventory not ready")
+	}
+	return craftGrid{
+		windowID: protocol.PlayerInventoryWindowID,
+		size:     crafting.InventoryGridSize,
+		invStart: protocol.PlayerSlotMainStart,
+		invEnd:   protocol.PlayerSlotOffhand,
+	}, nil
+}
+
+func (b *Bot) windowSlotAt(grid craftGrid, index int) protocol.Slot {
+	if grid.windowID == protocol.PlayerInventoryWindowID {
+		b.inventoryMu.RLock()
+		defer b.inventoryMu.RUnlock()
+		if index < 0 || index >= len(b.playerWindow) {
+			return protocol.Slot{}
+		}
+		return b.playerWindow[index]
+	}
+	slot, _ := b.containerSlot(index)
+	return slot
+}
+
+func (b *Bot) countInWindow(grid craftGrid, itemID int32) int {
+	total := 0
+	for i := grid.invStart; i < grid.invEnd; i++ {
+		if slot := b.windowSlotAt(grid, i); !slot.IsEmpty() && slot.ItemID == itemID {
+			total += int(slot.Count)
+		}
+	}
+	return total
+}
+
+// fillCraftCell brings the grid slot up to count items of itemID. Whole
+// cursor stacks are dropped with a left click, the last few one at a time.
+func (b *Bot) fillCraftCell(ctx context.Context, grid craftGrid, slot int, itemID int32, count int) error {
+	for {
+		current := b.windowSlotAt(grid, slot)
+		placed := 0
+		if !current.IsEmpty() {
+			if current.ItemID != itemID {
+				return fmt.Errorf("grid slot %d holds %s", slot, world.ItemName(current.ItemID))
+			}
+			placed = int(current.Count)
+		}
+		if placed >= count {
+			return nil
+		}
+
+		cursor := b.carried()
+		if cursor.IsEmpty() || cursor.ItemID != itemID {
+			if err := b.returnCursor(ctx, grid); err != nil {
+				return err
+			}
+			src := b.findWindowItem(grid, itemID)
+			if src < 0 {
+				return fmt.Errorf("not enough %s in inventory", world.ItemName(itemID))
+			}
+			if err := b.clickWindow(ctx, grid.windowID, int16(src), 0, protocol.WindowClickPickup); err != nil {
+				return err
+			}
+			if picked := b.carried(); picked.IsEmpty() || picked.ItemID != itemID {
+				return fmt.Errorf("failed to pick up %s", world.ItemName(itemID))
+			}
+			continue
+		}
+
+		button := int8(1)
+		if int(cursor.Count) <= count-placed {
+			button = 0
+		}
+		if err := b.clickWindow(ctx, grid.windowID, int16(slot), button, protocol.WindowClickPickup); err != nil {
+			return err
+		}
+		if after := b.windowSlotAt(grid, slot); after.IsEmpty() || int(after.Count) <= placed {
+			return fmt.Errorf("grid slot %d did not accept %s", slot, world.ItemName(itemID))
+		}
+	}
+}
+
+// findWindowItem returns the inventory slot with the smallest stack of itemID,
+// so partial stacks are used up first.
+func (b *Bot) findWindowItem(grid craftGrid, itemID int32) int {
+	best := -1
+	bestCount := int32(0)
+	for i := grid.invStart; i < grid.invEnd; i++ {
+		slot := b.windowSlotAt(grid, i)
+		if slot.IsEmpty() || slot.ItemID != itemID {
+			continue
+		}
+		if best < 0 || slot.Count < bestCount {
+			best, bestCount = i, slot.Count
+		}
+	}
+	return best
+}
+
+func (b *Bot) returnCursor(ctx context.Context, grid craftGrid) error {
+	for attempt := 0; attempt < craftMaxCursorReturns; attempt++ {
+		cursor := b.carried()
+		if cursor.IsEmpty() {
+			return nil
+		}
+		target := b.findWindowDepositSlot(grid, cursor)
+		if target < 0 {
+			return fmt.Errorf("inventory is full")
+		}
+		if err := b.clickWindow(ctx, grid.windowID, int16(target), 0, protocol.WindowClickPickup); err != nil {
+			return err
+		}
+	}
+	if !b.carried().IsEmpty() {
+		return fmt.Errorf("could not put %s back", world.ItemName(b.carried().ItemID))
+	}
+	return nil
+}
+
+func (b *Bot) findWindowDepositSlot(grid craftGrid, item protocol.Slot) int {
+	maxStack := stackLimit(item)
+	empty := -1
+	for i := grid.invStart; i < grid.invEnd; i++ {
+		slot := b.windowSlotAt(grid, i)
+		if slot.IsEmpty() {
+			if empty < 0 {
+				empty = i
+			}
+			continue
+		}
+		if slot.Count < maxStack && canStackOnto(slot, item) {
+			return i
+		}
+	}
+	return empty
+}
+
+// clearCraftGrid shift-clicks anything left in the grid back to the inventory.
+func (b *Bot) clearCraftGrid(ctx context.Context, grid craftGrid) error {
+	for i := craftGridStart; i < craftGridStart+grid.size*grid.size; i++ {
+		if b.windowSlotAt(grid, i).IsEmpty() {
+			continue
+		}
+		if err := b.clickWindow(ctx, grid.windowID, int16(i), 0, protocol.WindowClickQuickMove); err != nil {
+			return err
+		}
+		if !b.windowSlotAt(grid, i).IsEmpty() {
+			return fmt.Errorf("inventory is full, grid slot %d not cleared", i)
+		}
+	}
+	return nil
+}
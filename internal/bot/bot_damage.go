@@ -0,0 +1,44 @@
+package bot
+
+import (
+	"bytes"
+	"log/slog"
+
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+// handleDamageEvent records who hurt the bot. The server broadcasts the
+// packet for every living entity in view; only our own hits are kept.
+func (b *Bot) handleDamageEvent(payload []byte) {
+	damage, err := protocol.ParseDamageEvent(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse damage event", "error", err)
+		return
+	}
+	if self, ok := b.SelfEntityID(); !ok || damage.EntityID != self {
+		return
+	}
+	var pos *[3]float64
+	if damage.SourcePos != nil {
+		pos = &[3]float64{damage.SourcePos.X, damage.SourcePos.Y, damage.SourcePos.Z}
+	}
+	recorded := b.worldState.RecordDamage(damage.SourceTypeID, damage.SourceCauseID, damage.SourceDirectID, pos)
+	slog.Debug(
+		"Bot took damage",
+		"type", recorded.Type,
+		"source_entity", recorded.SourceEntityID,
+		"direct_entity", recorded.DirectEntityID,
+	)
+}
+
+func (b *Bot) handleHurtAnimation(payload []byte) {
+	hurt, err := protocol.ParseHurtAnimation(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse hurt animation", "error", err)
+		return
+	}
+	if self, ok := b.SelfEntityID(); !ok || hurt.EntityID != self {
+		return
+	}
+	b.worldState.RecordHurtDirection(hurt.Yaw)
+}
@@ -0,0 +1,80 @@
+package bot
+
+import (
+	"bytes"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/Versifine/locus/internal/event"
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+type deathState struct {
+	// manualRespawn leaves respawning to the agent's respawn tool instead
+	// of respawning as soon as health reaches zero.
+	manualRespawn atomic.Bool
+}
+
+// SetManualRespawn turns automatic respawning off or back on.
+func (b *Bot) SetManualRespawn(manual bool) {
+	b.manualRespawn.Store(manual)
+}
+
+// Respawn asks the server to respawn the bot after death.
+func (b *Bot) Respawn() error {
+	return b.SendPacket(protocol.CreateClientCommandPacket(protocol.ClientCommandRespawn))
+}
+
+func (b *Bot) handleUpdateHealth(payload []byte) error {
+	updateHealth, err := protocol.ParseUpdateHealth(bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	b.worldState.UpdateHealth(updateHealth.Health, updateHealth.Food)
+	if updateHealth.Health > 0 || b.manualRespawn.Load() {
+		return nil
+	}
+	slog.Info("Bot died, sending respawn")
+	return b.Respawn()
+}
+
+// handleDeathCombatEvent remembers where the bot died and tells the agent.
+// The server sends it before the health update that triggers the respawn,
+// so the position is still the death position.
+func (b *Bot) handleDeathCombatEvent(payload []byte) {
+	death, err := protocol.ParseDeathCombatEvent(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse death combat event", "error", err)
+		return
+	}
+	if self, ok := b.SelfEntityID(); !ok || death.PlayerID != self {
+		return
+	}
+	location := b.worldState.RecordDeath(death.Message)
+	slog.Info(
+		"Bot died",
+		"message", death.Message,
+		"dimension", location.Dimension,
+		"x", location.X, "y", location.Y, "z", location.Z,
+	)
+	b.publish(event.EventDeath, event.DeathEvent{
+		Message:   location.Message,
+		X:         location.X,
+		Y:         location.Y,
+		Z:         location.Z,
+		Dimension: location.Dimension,
+	})
+}
+
+func lastDeathFromLogin(pos *protocol.GlobalPos) (world.DeathLocation, bool) {
+	if pos == nil {
+		return world.DeathLocation{}, false
+	}
+	return world.DeathLocation{
+		X:         float64(pos.X),
+		Y:         float64(pos.Y),
+		Z:         float64(pos.Z),
+		Dimension: pos.DimensionName,
+	}, true
+}
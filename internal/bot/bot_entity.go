@@ -0,0 +1,117 @@
+package bot
+
+import (
+	"bytes"
+	"log/slog"
+
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+// Metadata indexes shared by every entity type.
+const (
+	metadataSharedFlags = 0
+	metadataCustomName  = 2
+	metadataPose        = 6
+	metadataItem        = 8
+)
+
+func (b *Bot) handleEntityMetadata(payload []byte) {
+	m, err := protocol.ParseEntityMetadata(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse entity metadata", "error", err)
+		return
+	}
+	if m.Truncated {
+		slog.Debug("Entity metadata has an unknown serializer, later entries skipped", "entity_id", m.EntityID)
+	}
+	if entry, ok := m.Entry(metadataItem); ok && entry.Type == protocol.MetadataItemStack {
+		if slot := entry.Value.(protocol.Slot); !slot.IsEmpty() {
+			b.worldState.UpdateEntityItemName(m.EntityID, world.ItemName(slot.ItemID))
+		}
+	}
+	typeID, ok := b.worldState.EntityType(m.EntityID)
+	if !ok {
+		return
+	}
+	b.worldState.UpdateEntityMetadata(m.EntityID, entityMetadataUpdate(typeID, m))
+}
+
+// entityMetadataUpdate picks the tracked fields out of a metadata packet.
+// Health and baby live at indexes that depend on the entity type, so they
+// are only read when the type declares them.
+func entityMetadataUpdate(typeID int32, m *protocol.EntityMetadata) world.EntityMetadata {
+	var update world.EntityMetadata
+	if entry, ok := m.Entry(metadataSharedFlags); ok && entry.Type == protocol.MetadataByte {
+		flags := entry.Value.(byte)
+		update.Flags = &flags
+	}
+	if entry, ok := m.Entry(metadataCustomName); ok && entry.Type == protocol.MetadataOptionalComponent {
+		name := entry.Value.(string)
+		update.CustomName = &name
+	}
+	if entry, ok := m.Entry(metadataPose); ok && entry.Type == protocol.MetadataPose {
+		pose := protocol.PoseName(entry.Value.(int32))
+		update.Pose = &pose
+	}
+	if index, ok := world.EntityMetadataIndex(typeID, "health"); ok {
+		if entry, ok := m.Entry(index); ok && entry.Type == protocol.MetadataFloat {
+			health := entry.Value.(float32)
+			update.Health = &health
+		}
+	}
+	if index, ok := world.EntityMetadataIndex(typeID, "baby"); ok {
+		if entry, ok := m.Entry(index); ok && entry.Type == protocol.MetadataBoolean {
+			baby := entry.Value.(bool)
+			update.Baby = &baby
+		}
+	}
+	return update
+}
+
+func (b *Bot) handleEntityEquipment(payload []byte) {
+	equipment, err := protocol.ParseEntityEquipment(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse entity equipment", "error", err)
+		return
+	}
+	for _, entry := range equipment.Equipment {
+		itemName := ""
+		if !entry.Item.IsEmpty() {
+			itemName = world.ItemName(entry.Item.ItemID)
+		}
+		b.worldState.UpdateEntityEquipment(equipment.EntityID, world.EquipmentSlot(entry.Slot), itemName)
+	}
+}
+
+func (b *Bot) handleEntityVelocity(payload []byte) {
+	velocity, err := protocol.ParseEntityVelocity(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse entity velocity", "error", err)
+		return
+	}
+	v := velocity.Velocity
+	b.worldState.UpdateEntityVelocity(velocity.EntityID, v.X, v.Y, v.Z)
+}
+
+func (b *Bot) handleEntityHeadRotation(payload []byte) {
+	head, err := protocol.ParseEntityHeadRotation(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse entity head rotation", "error", err)
+		return
+	}
+	b.worldState.UpdateEntityHeadYaw(head.EntityID, protocol.AngleToDegrees(head.HeadYaw))
+}
+
+func (b *Bot) handleEntityAttributes(payload []byte) {
+	attributes, err := protocol.ParseEntityAttributes(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse entity attributes", "error", err)
+		return
+	}
+	for _, attribute := range attributes.Attributes {
+		if attribute.ID == protocol.AttributeMaxHealth {
+			b.worldState.UpdateEntityMaxHealth(attributes.EntityID, float32(attribute.Value()))
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package bot
+
+import (
+	"bytes"
+	"log/slog"
+	"sync"
+
+	"github.com/Versifine/locus/internal/agent"
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+const (
+	inventoryHotbarSize    = 9
+	inventoryIndexArmor    = 36 // player inventory index of the feet slot
+	inventoryIndexOffhand  = 40
+	inventoryIndexCount    = 41
+	inventoryArmorSlotSize = 4
+)
+
+type inventoryState struct {
+	inventoryMu    sync.RWMutex
+	inventoryReady bool
+	// playerWindow is indexed by window 0 slot numbers (see protocol.PlayerSlot*).
+	playerWindow     [protocol.PlayerInventorySlotLen]protocol.Slot
+	carriedItem      protocol.Slot
+	inventoryStateID int32
+	heldSlot         int
+}
+
+func (b *Bot) handleWindowItems(payload []byte) {
+	packetRdr := bytes.NewReader(payload)
+	items, err := protocol.ParseWindowItems(packetRdr)
+	if err != nil {
+		slog.Warn("Failed to parse window items", "error", err)
+		return
+	}
+	if items.WindowID != protocol.PlayerInventoryWindowID {
+		b.applyContainerItems(items)
+		return
+	}
+
+	b.inventoryMu.Lock()
+	for i := range b.playerWindow {
+		if i < len(items.Items) {
+			b.playerWindow[i] = items.Items[i]
+		} else {
+			b.playerWindow[i] = protocol.Slot{}
+		}
+	}
+	b.carriedItem = items.CarriedItem
+	b.inventoryStateID = items.StateID
+	b.inventoryReady = true
+	b.inventoryMu.Unlock()
+	b.noteWindowChanged()
+}
+
+func (b *Bot) handleSetSlot(payload []byte) {
+	packetRdr := bytes.NewReader(payload)
+	setSlot, err := protocol.ParseSetSlot(packetRdr)
+	if err != nil {
+		slog.Warn("Failed to parse set slot", "error", err)
+		return
+	}
+
+	switch {
+	case setSlot.WindowID == -1 && setSlot.Slot == -1:
+		// Legacy cursor update form.
+		b.setCarriedItem(setSlot.Item)
+		return
+	case setSlot.WindowID != protocol.PlayerInventoryWindowID:
+		b.applyContainerSlot(setSlot)
+		return
+	}
+	if setSlot.Slot < 0 || int(setSlot.Slot) >= len(b.playerWindow) {
+		slog.Warn("Set slot index out of range", "slot", setSlot.Slot)
+		return
+	}
+
+	b.inventoryMu.Lock()
+	b.playerWindow[setSlot.Slot] = setSlot.Item
+	b.inventoryStateID = setSlot.StateID
+	b.inventoryMu.Unlock()
+	b.noteWindowChanged()
+}
+
+func (b *Bot) handleSetCursorItem(payload []byte) {
+	packetRdr := bytes.NewReader(payload)
+	cursor, err := protocol.ParseSetCursorItem(packetRdr)
+	if err != nil {
+		slog.Warn("Failed to parse set cursor item", "error", err)
+		return
+	}
+	b.setCarriedItem(cursor.Contents)
+}
+
+func (b *Bot) setCarriedItem(item protocol.Slot) {
+	b.inventoryMu.Lock()
+	b.carriedItem = item
+	b.inventoryMu.Unlock()
+	b.noteWindowChanged()
+}
+
+func (b *Bot) handleHeldItemSlot(payload []byte) {
+	packetRdr := bytes.NewReader(payload)
+	held, err := protocol.ParseHeldItemSlot(packetRdr)
+	if err != nil {
+		slog.Warn("Failed to parse held item slot", "error", err)
+		return
+	}
+	b.setHeldSlot(int(held.Slot))
+}
+
+func (b *Bot) handleSetPlayerInventory(payload []byte) {
+	packetRdr := bytes.NewReader(payload)
+	update, err := protocol.ParseSetPlayerInventory(packetRdr)
+	if err != nil {
+		slog.Warn("Failed to parse set player inventory", "error", err)
+		return
+	}
+
+	windowSlot, ok := playerWindowSlotForInventoryIndex(int(update.SlotID))
+	if !ok {
+		slog.Warn("Set player inventory index out of range", "slot", update.SlotID)
+		return
+	}
+
+	b.inventoryMu.Lock()
+	b.playerWindow[windowSlot] = update.Contents
+	b.inventoryMu.Unlock()
+	b.noteWindowChanged()
+}
+
+// observeOutgoingPacket keeps local state in sync with packets the bot sends itself.
+func (b *Bot) observeOutgoingPacket(packet *protocol.Packet) {
+	if packet.ID != protocol.C2SHeldItemSlot || len(packet.Payload) < 2 {
+		return
+	}
+	slot := int(int16(uint16(packet.Payload[0])<<8 | uint16(packet.Payload[1])))
+	b.setHeldSlot(slot)
+}
+
+func (b *Bot) setHeldSlot(slot int) {
+	if slot < 0 || slot >= inventoryHotbarSize {
+		slog.Warn("Held item slot out of range", "slot", slot)
+		return
+	}
+	b.inventoryMu.Lock()
+	b.heldSlot = slot
+	b.inventoryMu.Unlock()
+}
+
+func (b *Bot) GetInventorySnapshot() (agent.InventorySnapshot, bool) {
+	b.inventoryMu.RLock()
+	defer b.inventoryMu.RUnlock()
+
+	if !b.inventoryReady {
+		return agent.InventorySnapshot{}, false
+	}
+
+	snap := agent.InventorySnapshot{
+		Hotbar:   make([]agent.InventoryItem, 0, inventoryHotbarSize),
+		Main:     make([]agent.InventoryItem, 0),
+		Armor:    make([]agent.InventoryItem, 0, inventoryArmorSlotSize),
+		HeldSlot: b.heldSlot,
+	}
+	for index := 0; index < inventoryIndexCount; index++ {
+		windowSlot, _ := playerWindowSlotForInventoryIndex(index)
+		slot := b.playerWindow[windowSlot]
+		if slot.IsEmpty() {
+			continue
+		}
+		item := inventoryItemFromSlot(index, slot)
+		switch {
+		case index < inventoryHotbarSize:
+			snap.Hotbar = append(snap.Hotbar, item)
+		case index < inventoryIndexArmor:
+			snap.Main = append(snap.Main, item)
+		case index < inventoryIndexOffhand:
+			snap.Armor = append(snap.Armor, item)
+		default:
+			snap.Offhand = &item
+		}
+	}
+	return snap, true
+}
+
+// playerWindowSlotForInventoryIndex maps a player inventory index
+// (0-8 hotbar, 9-35 main, 36-39 armor feet→head, 40 offhand) to a window 0 slot.
+func playerWindowSlotForInventoryIndex(index int) (int, bool) {
+	switch {
+	case index >= 0 && index < inventoryHotbarSize:
+		return protocol.PlayerSlotHotbarStart + index, true
+	case index >= protocol.PlayerSlotMainStart && index < inventoryIndexArmor:
+		return index, true
+	case index >= inventoryIndexArmor && index < inventoryIndexOffhand:
+		// Window armor slots run head→feet.
+		return protocol.PlayerSlotArmorStart + (inventoryIndexOffhand - 1 - index), true
+	case index == inventoryIndexOffhand:
+		return protocol.PlayerSlotOffhand, true
+	default:
+		return 0, false
+	}
+}
+
+func inventoryItemFromSlot(index int, slot protocol.Slot) agent.InventoryItem {
+	return agent.InventoryItem{
+		Slot:       index,
+		ItemID:     slot.ItemID,
+		Name:       world.ItemName(slot.ItemID),
+		Count:      int(slot.Count),
+		Components: slotComponentSummary(slot),
+	}
+}
+
+// slotComponentSummary exposes component names with their decoded values;
+// components that are only skipped are reported as true.
+func slotComponentSummary(slot protocol.Slot) map[string]any {
+	if len(slot.Components) == 0 && len(slot.RemovedComponents) == 0 {
+		return nil
+	}
+	out := make(map[string]any, len(slot.Components)+len(slot.RemovedComponents))
+	for _, c := range slot.Components {
+		name := protocol.SlotComponentName(c.Type)
+		if name == "" {
+			continue
+		}
+		switch v := c.Value.(type) {
+		case nil:
+			out[name] = true
+		case []protocol.Enchantment:
+			enchantments := make([]map[string]int32, 0, len(v))
+			for _, e := range v {
+				enchantments = append(enchantments, map[string]int32{"id": e.ID, "level": e.Level})
+			}
+			out[name] = enchantments
+		case []protocol.Slot:
+			contents := make([]string, 0, len(v))
+			for _, nested := range v {
+				if nested.IsEmpty() {
+					continue
+				}
+				contents = append(contents, world.ItemName(nested.ItemID))
+			}
+			out[name] = contents
+		case *int32:
+			if v == nil {
+				out[name] = true
+			} else {
+				out[name] = *v
+			}
+		default:
+			out[name] = v
+		}
+	}
+	for _, removed := range slot.RemovedComponents {
+		if name := protocol.SlotComponentName(removed); name != "" {
+			out["!"+name] = true
+		}
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+package bot
+
+import (
+	"bytes"
+	"log/slog"
+
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+func (b *Bot) handleExperience(payload []byte) {
+	exp, err := protocol.ParseExperience(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse experience", "error", err)
+		return
+	}
+	b.worldState.UpdateExperience(world.Experience{
+		Level:    exp.Level,
+		Progress: exp.ExperienceBar,
+		Total:    exp.TotalExperience,
+	})
+}
+
+// handleEntityEffect only tracks the bot's own effects; the server also
+// sends them for other living entities in view.
+func (b *Bot) handleEntityEffect(payload []byte) {
+	effect, err := protocol.ParseEntityEffect(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse entity effect", "error", err)
+		return
+	}
+	if self, ok := b.SelfEntityID(); !ok || effect.EntityID != self {
+		return
+	}
+	b.worldState.AddEffect(effect.EffectID, effect.Amplifier, effect.Duration)
+}
+
+func (b *Bot) handleRemoveEntityEffect(payload []byte) {
+	remove, err := protocol.ParseRemoveEntityEffect(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse remove entity effect", "error", err)
+		return
+	}
+	if self, ok := b.SelfEntityID(); !ok || remove.EntityID != self {
+		return
+	}
+	b.worldState.RemoveEffect(remove.EffectID)
+}
+
+func (b *Bot) handleAbilities(payload []byte) {
+	abilities, err := protocol.ParsePlayerAbilities(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse player abilities", "error", err)
+		return
+	}
+	b.worldState.UpdateAbilities(world.Abilities{
+		Invulnerable: abilities.Flags&protocol.AbilityInvulnerable != 0,
+		Flying:       abilities.Flags&protocol.AbilityFlying != 0,
+		AllowFlying:  abilities.Flags&protocol.AbilityAllowFlying != 0,
+		CreativeMode: abilities.Flags&protocol.AbilityCreativeMode != 0,
+		FlySpeed:     abilities.FlyingSpeed,
+		WalkSpeed:    abilities.WalkingSpeed,
+	})
+}
+
+func (b *Bot) handleGameEvent(payload []byte) {
+	evt, err := protocol.ParseGameEvent(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse game event", "error", err)
+		return
+	}
+	switch evt.Event {
+	case protocol.GameEventChangeGameMode:
+		b.worldState.UpdateGameMode(world.GameModeName(int32(evt.Value)))
+	case protocol.GameEventBeginRaining:
+		b.worldState.SetRaining(true)
+	case protocol.GameEventEndRaining:
+		b.worldState.SetRaining(false)
+	case protocol.GameEventRainLevelChange:
+		b.worldState.SetRainLevel(evt.Value)
+	case protocol.GameEventThunderLevelChange:
+		b.worldState.SetThunderLevel(evt.Value)
+	}
+}
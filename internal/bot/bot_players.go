@@ -0,0 +1,44 @@
+package bot
+
+import (
+	"bytes"
+	"log/slog"
+
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+func (b *Bot) handlePlayerInfo(payload []byte) {
+	info, err := protocol.ParsePlayerInfo(bytes.NewReader(payload))
+	if err != nil {
+		slog.Error("Failed to parse player info", "error", err)
+		return
+	}
+	updates := make([]world.PlayerUpdate, 0, len(info.Players))
+	for _, p := range info.Players {
+		updates = append(updates, playerUpdate(info, p))
+	}
+	b.worldState.UpdatePlayers(updates)
+}
+
+// playerUpdate keeps only the fields of the actions the packet carries.
+func playerUpdate(info *protocol.PlayerInfo, p protocol.Player) world.PlayerUpdate {
+	update := world.PlayerUpdate{UUID: p.UUID.String()}
+	if info.Has(protocol.PlayerInfoAddPlayer) {
+		update.Name = &p.Name
+	}
+	if info.Has(protocol.PlayerInfoUpdateGameMode) {
+		mode := world.GameModeName(p.GameMode)
+		update.GameMode = &mode
+	}
+	if info.Has(protocol.PlayerInfoUpdateListed) {
+		update.Listed = &p.Listed
+	}
+	if info.Has(protocol.PlayerInfoUpdateLatency) {
+		update.Latency = &p.Latency
+	}
+	if info.Has(protocol.PlayerInfoUpdateDisplayName) {
+		update.DisplayName = &p.DisplayName
+	}
+	return update
+}
@@ -0,0 +1,159 @@
+package bot
+
+import (
+	"bytes"
+	"log/slog"
+	"sync"
+
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+// registryState keeps the registries and tags synchronized in the
+// configuration phase, and the height of the current dimension taken from
+// them.
+type registryState struct {
+	registries *world.Registries
+
+	dimensionMu        sync.RWMutex
+	dimensionBounds    world.DimensionBounds
+	hasDimensionBounds bool
+}
+
+// Registries returns the server's synchronized registries and tags.
+func (b *Bot) Registries() *world.Registries {
+	return b.registries
+}
+
+// handleRegistryData stores every registry's keys. The worldgen/biome order
+// lets chunk biome IDs resolve against the server's registry rather than the
+// bundled one, damage_type names damage events, and dimension_type gives the
+// height of each dimension.
+func (b *Bot) handleRegistryData(payload []byte) {
+	data, err := protocol.ParseRegistryData(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse registry data", "error", err)
+		return
+	}
+	if b.registries != nil {
+		b.registries.SetRegistry(data.ID, data.Keys())
+	}
+	switch data.ID {
+	case protocol.RegistryBiome:
+		if b.blockStore != nil {
+			b.blockStore.SetBiomeOrder(data.Keys())
+			slog.Debug("Stored biome registry", "count", len(data.Entries))
+		}
+	case protocol.RegistryDamageType:
+		if b.worldState != nil {
+			b.worldState.SetDamageTypes(data.Keys())
+			slog.Debug("Stored damage type registry", "count", len(data.Entries))
+		}
+	case protocol.RegistryDimensionType:
+		if b.registries == nil {
+			return
+		}
+		for _, entry := range data.Entries {
+			if dt, ok := entry.DimensionType(); ok {
+				b.registries.SetDimensionType(entry.Key, world.DimensionBounds{MinY: int(dt.MinY), Height: int(dt.Height)})
+			}
+		}
+		slog.Debug("Stored dimension type registry", "count", len(data.Entries))
+	}
+}
+
+// handleUpdateTags replaces the tags of each registry in the packet. It is
+// sent during configuration and again when the server reloads datapacks.
+func (b *Bot) handleUpdateTags(payload []byte) {
+	update, err := protocol.ParseUpdateTags(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse update tags", "error", err)
+		return
+	}
+	if b.registries == nil {
+		return
+	}
+	for _, registry := range update.Registries {
+		tags := make(map[string][]int32, len(registry.Tags))
+		for _, tag := range registry.Tags {
+			tags[tag.Name] = tag.Entries
+		}
+		b.registries.SetTags(registry.Registry, tags)
+	}
+	slog.Debug("Stored tags", "registries", len(update.Registries))
+}
+
+// handleConfigurationData applies the configuration packets that shape the
+// world: registries and tags. Other packets are ignored.
+func (b *Bot) handleConfigurationData(packet *protocol.Packet) {
+	switch packet.ID {
+	case protocol.S2CRegistryData:
+		b.handleRegistryData(packet.Payload)
+	case protocol.S2CConfigUpdateTags:
+		b.handleUpdateTags(packet.Payload)
+	}
+}
+
+// updateDimensionBounds looks up the height of the dimension the bot entered
+// and sizes the block store to it. A dimension of unknown height gets the
+// overworld's, with chunks centered in it.
+func (b *Bot) updateDimensionBounds(typeID int32, dimension string) (world.DimensionBounds, bool) {
+	bounds, ok := b.registries.DimensionBounds(typeID, dimension)
+	if b.blockStore != nil {
+		minY, height := world.ChunkMinY, world.ChunkSectionCount*world.ChunkSectionHeight
+		if ok {
+			minY, height = bounds.MinY, bounds.Height
+		}
+		if err := b.blockStore.SetHeight(minY, height); err != nil {
+			slog.Warn("Failed to size block store for dimension", "dimension", dimension, "error", err)
+		}
+	}
+	b.dimensionMu.Lock()
+	b.dimensionBounds, b.hasDimensionBounds = bounds, ok
+	b.dimensionMu.Unlock()
+	return bounds, ok
+}
+
+func (b *Bot) currentDimensionBounds() (world.DimensionBounds, bool) {
+	b.dimensionMu.RLock()
+	defer b.dimensionMu.RUnlock()
+	return b.dimensionBounds, b.hasDimensionBounds
+}
+
+// sectionOffset returns the block store section index of the current
+// dimension's lowest section: 0 once the store is sized to the dimension,
+// and 0 while its height is unknown.
+func (b *Bot) sectionOffset() int {
+	bounds, ok := b.currentDimensionBounds()
+	if !ok || b.blockStore == nil {
+		return 0
+	}
+	minY, _ := b.blockStore.Height()
+	return (bounds.MinY - minY) / world.ChunkSectionHeight
+}
+
+// normalizeChunkSections places a chunk's sections in the block store and
+// returns the store section index the chunk's lowest section went to. With
+// the dimension's height known, a chunk whose section count was guessed
+// wrong is parsed again with the right count, and its sections start at the
+// dimension's min_y.
+func (b *Bot) normalizeChunkSections(chunk *protocol.LevelChunkWithLight) ([]world.ChunkSection, int, error) {
+	_, storeSections := b.blockStore.Height()
+	bounds, ok := b.currentDimensionBounds()
+	if !ok {
+		offset := max((storeSections-len(chunk.Sections))/2, 0)
+		sections, err := normalizeSectionsAt(chunk.Sections, offset, storeSections)
+		return sections, offset, err
+	}
+	sections := chunk.Sections
+	if count := bounds.Height / world.ChunkSectionHeight; count != len(sections) {
+		reparsed, err := protocol.ParseChunkSections(chunk.ChunkData, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		sections = reparsed
+	}
+	offset := b.sectionOffset()
+	normalized, err := normalizeSectionsAt(sections, offset, storeSections)
+	return normalized, offset, err
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 
 	"github.com/Versifine/locus/internal/event"
+	"github.com/Versifine/locus/internal/physics"
 	"github.com/Versifine/locus/internal/protocol"
 	"github.com/Versifine/locus/internal/world"
 )
@@ -18,8 +19,7 @@ func (b *Bot) handleInjection(ctx context.Context) error {
 			return nil
 		case msg := <-b.injectCh:
 			slog.Info("Injecting message", "message", msg)
-			chatPacket := protocol.CreateChatMessagePacket(msg)
-			if err := b.writePacket(b.conn, chatPacket, b.connState.GetThreshold()); err != nil {
+			if err := b.SendPacket(protocol.CreateChatMessagePacket(msg)); err != nil {
 				slog.Error("Failed to inject message", "error", err)
 			}
 		}
@@ -29,7 +29,7 @@ func (b *Bot) handleInjection(ctx context.Context) error {
 func (b *Bot) writePacket(w io.Writer, packet *protocol.Packet, threshold int) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
-	return protocol.WritePacket(w, packet, threshold)
+	return b.writePacketLocked(w, packet, threshold)
 }
 
 func (b *Bot) Bus() *event.Bus {
@@ -45,10 +45,22 @@ func (b *Bot) SendPacket(packet *protocol.Packet) error {
 	if packet == nil {
 		return fmt.Errorf("packet is nil")
 	}
-	if b.conn == nil || b.connState == nil {
+	b.mu.RLock()
+	conn, connState := b.conn, b.connState
+	b.mu.RUnlock()
+	if conn == nil || connState == nil {
 		return fmt.Errorf("connection is not initialized")
 	}
-	return b.writePacket(b.conn, packet, b.connState.GetThreshold())
+	// Callers like the body loop keep ticking across reconnects; their
+	// packets must not leak into the login or configuration stream.
+	if state := connState.Get(); state != protocol.Play {
+		return fmt.Errorf("connection is not initialized for play (state %s)", state)
+	}
+	if err := b.writePacket(conn, packet, connState.GetThreshold()); err != nil {
+		return err
+	}
+	b.observeOutgoingPacket(packet)
+	return nil
 }
 
 func (b *Bot) SetLocalPositionSink(sink interface{ SetLocalPosition(pos world.Position) }) {
@@ -118,6 +130,79 @@ func (b *Bot) IsSolid(x, y, z int) bool {
 	return b.blockStore.IsSolid(x, y, z)
 }
 
+// CollisionBoxes lets physics and pathfinding use real block shapes.
+func (b *Bot) CollisionBoxes(x, y, z int) []physics.AABB {
+	if b.blockStore == nil {
+		return nil
+	}
+	return physicsBoxes(b.blockStore.CollisionBoxes(x, y, z))
+}
+
+func physicsBoxes(boxes []world.CollisionBox) []physics.AABB {
+	if len(boxes) == 0 {
+		return nil
+	}
+	out := make([]physics.AABB, len(boxes))
+	for i, box := range boxes {
+		out[i] = physics.AABB(box)
+	}
+	return out
+}
+
+// FluidAt lets physics and pathfinding see water and lava.
+func (b *Bot) FluidAt(x, y, z int) physics.Fluid {
+	if b.blockStore == nil {
+		return physics.Fluid{}
+	}
+	return physicsFluid(b.blockStore.FluidAt(x, y, z))
+}
+
+func physicsFluid(f world.Fluid) physics.Fluid {
+	// world and physics number fluid kinds the same way.
+	return physics.Fluid{Kind: physics.FluidKind(f.Kind), Level: f.Level}
+}
+
+// BlockMovement exposes per-block friction, climbing and traps to physics.
+func (b *Bot) BlockMovement(x, y, z int) physics.BlockMovement {
+	if b.blockStore == nil {
+		return physics.DefaultBlockMovement
+	}
+	return physicsMovement(b.blockStore.BlockMovement(x, y, z))
+}
+
+func physicsMovement(m world.BlockMovement) physics.BlockMovement {
+	return physics.BlockMovement{
+		Friction:      m.Friction,
+		SpeedFactor:   m.SpeedFactor,
+		JumpFactor:    m.JumpFactor,
+		Climbable:     m.Climbable,
+		SneakDescends: m.SneakDescends,
+		Bouncy:        m.Bouncy,
+		Stuck:         physics.Vec3{X: m.Stuck[0], Y: m.Stuck[1], Z: m.Stuck[2]},
+	}
+}
+
+func (b *Bot) GetLight(x, y, z int) (world.LightLevel, bool) {
+	if b.blockStore == nil {
+		return world.LightLevel{}, false
+	}
+	return b.blockStore.GetLight(x, y, z)
+}
+
+func (b *Bot) GetBiome(x, y, z int) (world.Biome, bool) {
+	if b.blockStore == nil {
+		return world.Biome{}, false
+	}
+	return b.blockStore.GetBiome(x, y, z)
+}
+
+func (b *Bot) FindNearestBiome(from world.BlockPos, maxDist int, match func(world.Biome) bool) (world.BlockPos, world.Biome, bool) {
+	if b.blockStore == nil {
+		return world.BlockPos{}, world.Biome{}, false
+	}
+	return b.blockStore.FindNearestBiome(from, maxDist, match)
+}
+
 func (b *Bot) GetBlockState(x, y, z int) (int32, bool) {
 	if b.blockStore == nil {
 		return 0, false
@@ -132,6 +217,37 @@ func (b *Bot) GetBlockNameByStateID(stateID int32) (string, bool) {
 	return b.blockStore.GetBlockNameByStateID(stateID)
 }
 
+func (b *Bot) DescribeBlockState(stateID int32) (world.BlockState, bool) {
+	if b.blockStore == nil {
+		return world.BlockState{}, false
+	}
+	return b.blockStore.DescribeBlockState(stateID)
+}
+
+func (b *Bot) LookupBlockState(block string, properties map[string]string) (int32, bool) {
+	if b.blockStore == nil {
+		return 0, false
+	}
+	return b.blockStore.LookupBlockState(block, properties)
+}
+
+// BlockHasTag reports whether a state's block is in a block tag sent by the
+// server, such as "logs" or "mineable/pickaxe".
+func (b *Bot) BlockHasTag(stateID int32, tag string) bool {
+	if b.blockStore == nil {
+		return false
+	}
+	return b.blockStore.BlockHasTag(stateID, tag)
+}
+
+// BlockTags returns the sorted block tags of a state's block.
+func (b *Bot) BlockTags(stateID int32) []string {
+	if b.blockStore == nil {
+		return nil
+	}
+	return b.blockStore.BlockTags(stateID)
+}
+
 func (b *Bot) logUnhandledPlayPacket(packetID int32) {
 	b.unhandledMu.Lock()
 	defer b.unhandledMu.Unlock()
@@ -160,8 +276,7 @@ func (b *Bot) maybeSendPlayerLoaded() error {
 		return nil
 	}
 
-	packet := protocol.CreatePlayerLoadedPacket()
-	if err := b.writePacket(b.conn, packet, b.connState.GetThreshold()); err != nil {
+	if err := b.SendPacket(protocol.CreatePlayerLoadedPacket()); err != nil {
 		b.playerLoadedMu.Lock()
 		b.sentPlayerLoaded = false
 		b.playerLoadedMu.Unlock()
@@ -0,0 +1,306 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Versifine/locus/internal/event"
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+// DisconnectKind classifies why a session ended so the supervisor can decide
+// whether reconnecting is worth it.
+type DisconnectKind string
+
+const (
+	DisconnectNetwork        DisconnectKind = "network"
+	DisconnectKicked         DisconnectKind = "kicked"
+	DisconnectServerClosed   DisconnectKind = "server_closed"
+	DisconnectServerFull     DisconnectKind = "server_full"
+	DisconnectTimeout        DisconnectKind = "timeout"
+	DisconnectThrottled      DisconnectKind = "throttled"
+	DisconnectDuplicateLogin DisconnectKind = "duplicate_login"
+	DisconnectBanned         DisconnectKind = "banned"
+	DisconnectNotWhitelisted DisconnectKind = "not_whitelisted"
+	DisconnectOutdated       DisconnectKind = "outdated"
+)
+
+// throttledMinDelay keeps us clear of Bukkit's connection-throttle window.
+const throttledMinDelay = 5 * time.Second
+
+// DisconnectError is returned by Start when the server closes the session
+// with a disconnect packet.
+type DisconnectError struct {
+	State          protocol.State
+	Kind           DisconnectKind
+	Reason         string
+	TranslationKey string
+}
+
+func (e *DisconnectError) Error() string {
+	return fmt.Sprintf("disconnected during %s (%s): %s", e.State, e.Kind, e.Reason)
+}
+
+// Retryable reports whether reconnecting can succeed without someone changing
+// the server or our configuration first.
+func (e *DisconnectError) Retryable() bool {
+	switch e.Kind {
+	case DisconnectBanned, DisconnectNotWhitelisted, DisconnectOutdated, DisconnectDuplicateLogin:
+		return false
+	default:
+		return true
+	}
+}
+
+func newDisconnectError(state protocol.State, d *protocol.Disconnect) *DisconnectError {
+	return &DisconnectError{
+		State:          state,
+		Kind:           classifyDisconnect(d.TranslationKey, d.Message),
+		Reason:         d.Message,
+		TranslationKey: d.TranslationKey,
+	}
+}
+
+func classifyDisconnect(key, message string) DisconnectKind {
+	switch {
+	case strings.HasPrefix(key, "multiplayer.disconnect.banned"):
+		return DisconnectBanned
+	case key == "multiplayer.disconnect.not_whitelisted":
+		return DisconnectNotWhitelisted
+	case key == "multiplayer.disconnect.outdated_client", key == "multiplayer.disconnect.incompatible",
+		strings.HasPrefix(key, "multiplayer.disconnect.outdated"):
+		return DisconnectOutdated
+	case key == "multiplayer.disconnect.duplicate_login":
+		return DisconnectDuplicateLogin
+	case key == "multiplayer.disconnect.server_full":
+		return DisconnectServerFull
+	case key == "multiplayer.disconnect.server_shutdown":
+		return DisconnectServerClosed
+	case key == "disconnect.timeout", key == "multiplayer.disconnect.idling":
+		return DisconnectTimeout
+	case key != "":
+		return DisconnectKicked
+	}
+
+	// Plugins and proxies usually send plain text.
+	lower := strings.ToLower(message)
+	switch {
+	case strings.Contains(lower, "throttle"):
+		return DisconnectThrottled
+	case strings.Contains(lower, "banned"):
+		return DisconnectBanned
+	case strings.Contains(lower, "whitelist"):
+		return DisconnectNotWhitelisted
+	case strings.Contains(lower, "outdated"):
+		return DisconnectOutdated
+	case strings.Contains(lower, "logged in from another location"):
+		return DisconnectDuplicateLogin
+	case strings.Contains(lower, "server is full"):
+		return DisconnectServerFull
+	case strings.Contains(lower, "server closed"), strings.Contains(lower, "restarting"):
+		return DisconnectServerClosed
+	default:
+		return DisconnectKicked
+	}
+}
+
+// ReconnectPolicy controls the exponential backoff between sessions.
+type ReconnectPolicy struct {
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+	Multiplier   float64
+	// MaxAttempts caps consecutive failed sessions; 0 retries forever.
+	MaxAttempts int
+	// StableAfter resets the backoff once a session has stayed in Play this long.
+	StableAfter time.Duration
+}
+
+func DefaultReconnectPolicy() ReconnectPolicy {
+	return ReconnectPolicy{
+		InitialDelay: 2 * time.Second,
+		MaxDelay:     2 * time.Minute,
+		Multiplier:   2,
+		StableAfter:  time.Minute,
+	}
+}
+
+// Delay returns the wait before the given consecutive attempt (1-based).
+func (p ReconnectPolicy) Delay(attempt int, kind DisconnectKind) time.Duration {
+	delay := p.InitialDelay
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	for i := 1; i < attempt; i++ {
+		delay = time.Duration(float64(delay) * multiplier)
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			delay = p.MaxDelay
+			break
+		}
+	}
+	if kind == DisconnectThrottled && delay < throttledMinDelay {
+		delay = throttledMinDelay
+	}
+	return delay
+}
+
+type sessionState struct {
+	sessionMu      sync.Mutex
+	playSince      time.Time
+	disconnectedAt time.Time
+	reconnects     int
+}
+
+// RunWithReconnect runs sessions until ctx is cancelled or the server refuses
+// us for a reason retrying cannot fix. Agents and behaviors attached to the
+// bot keep running across sessions; only per-connection world state is reset.
+func (b *Bot) RunWithReconnect(ctx context.Context, policy ReconnectPolicy) error {
+	failures := 0
+	for {
+		err := b.Start(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil {
+			err = errors.New("session ended")
+		}
+
+		played := b.endSession()
+		if policy.StableAfter > 0 && played >= policy.StableAfter {
+			failures = 0
+		}
+		failures++
+
+		kind := DisconnectNetwork
+		retry := true
+		var disconnect *DisconnectError
+		if errors.As(err, &disconnect) {
+			kind = disconnect.Kind
+			retry = disconnect.Retryable()
+		}
+		if policy.MaxAttempts > 0 && failures > policy.MaxAttempts {
+			retry = false
+		}
+		delay := policy.Delay(failures, kind)
+
+		b.publish(event.EventDisconnect, event.DisconnectEvent{
+			Reason:  err.Error(),
+			Kind:    string(kind),
+			Retry:   retry,
+			RetryIn: delay,
+		})
+		if !retry {
+			return err
+		}
+		slog.Warn("Session ended, reconnecting", "error", err, "kind", kind, "attempt", failures, "delay", delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+// beginPlay records that the session reached Play and announces reconnects.
+func (b *Bot) beginPlay() {
+	b.sessionMu.Lock()
+	b.playSince = time.Now()
+	disconnectedAt := b.disconnectedAt
+	b.disconnectedAt = time.Time{}
+	if !disconnectedAt.IsZero() {
+		b.reconnects++
+	}
+	reconnects := b.reconnects
+	b.sessionMu.Unlock()
+
+	if !disconnectedAt.IsZero() {
+		downtime := time.Since(disconnectedAt)
+		slog.Info("Reconnected", "attempt", reconnects, "downtime", downtime)
+		b.publish(event.EventReconnect, event.ReconnectEvent{Attempt: reconnects, Downtime: downtime})
+	}
+}
+
+// endSession returns how long the finished session spent in Play.
+func (b *Bot) endSession() time.Duration {
+	b.sessionMu.Lock()
+	defer b.sessionMu.Unlock()
+	var played time.Duration
+	if !b.playSince.IsZero() {
+		played = time.Since(b.playSince)
+	}
+	b.playSince = time.Time{}
+	if b.disconnectedAt.IsZero() {
+		b.disconnectedAt = time.Now()
+	}
+	return played
+}
+
+func (b *Bot) publish(name string, evt any) {
+	if b.eventBus != nil {
+		b.eventBus.Publish(name, evt)
+	}
+}
+
+// resetSession drops state that only made sense on the previous connection.
+// The server resends chunks, entities and inventory after login.
+func (b *Bot) resetSession() {
+	if b.worldState != nil {
+		b.worldState.ClearEntities()
+		b.worldState.ClearSounds()
+	}
+	if b.blockStore != nil {
+		b.blockStore.Clear()
+	}
+	b.flushChunkCache()
+	b.resetPlayerLoaded()
+	b.resetPendingDigRequests("reconnect")
+
+	b.footLogMu.Lock()
+	b.lastFootLogged = footBlockSnapshot{}
+	b.footLogMu.Unlock()
+
+	b.chunkBatchMu.Lock()
+	b.chunkBatchActive = false
+	b.chunkBatchMu.Unlock()
+
+	b.selfEntityMu.Lock()
+	b.hasSelfEntity = false
+	b.selfEntityMu.Unlock()
+
+	b.inventoryMu.Lock()
+	b.inventoryReady = false
+	b.playerWindow = [protocol.PlayerInventorySlotLen]protocol.Slot{}
+	b.carriedItem = protocol.Slot{}
+	b.inventoryStateID = 0
+	b.inventoryMu.Unlock()
+
+	b.containerMu.Lock()
+	b.container = nil
+	b.notifyContainerChangedLocked()
+	b.containerMu.Unlock()
+}
+
+func (b *Bot) handleLoginDisconnect(payload []byte) error {
+	d, err := protocol.ParseLoginDisconnect(bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("parse login disconnect: %w", err)
+	}
+	return newDisconnectError(protocol.Login, d)
+}
+
+func (b *Bot) handleDisconnect(state protocol.State, payload []byte) error {
+	d, err := protocol.ParseDisconnect(bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("parse %s disconnect: %w", state, err)
+	}
+	return newDisconnectError(state, d)
+}
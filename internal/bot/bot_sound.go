@@ -0,0 +1,74 @@
+package bot
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+func (b *Bot) handleSoundEffect(payload []byte) {
+	sound, err := protocol.ParseSoundEffect(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse sound effect", "error", err)
+		return
+	}
+	b.worldState.RecordSound(
+		soundName(sound.SoundID, sound.SoundName),
+		soundCategory(sound.Category),
+		sound.X, sound.Y, sound.Z,
+		sound.Volume,
+	)
+}
+
+func (b *Bot) handleEntitySoundEffect(payload []byte) {
+	sound, err := protocol.ParseEntitySoundEffect(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse entity sound effect", "error", err)
+		return
+	}
+	// Sounds of entities out of tracking range have no position to hear.
+	b.worldState.RecordEntitySound(
+		soundName(sound.SoundID, sound.SoundName),
+		soundCategory(sound.Category),
+		sound.EntityID,
+		sound.Volume,
+	)
+}
+
+const explosionSoundVolume = 4
+
+// handleExplosion records the blast's sound, which only arrives inside the
+// Explosion packet. Vanilla plays it in the block category at volume 4.
+func (b *Bot) handleExplosion(payload []byte) {
+	explosion, err := protocol.ParseExplosion(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse explosion", "error", err)
+		return
+	}
+	b.worldState.RecordSound(
+		soundName(explosion.SoundID, explosion.SoundName),
+		"block",
+		explosion.X, explosion.Y, explosion.Z,
+		explosionSoundVolume,
+	)
+}
+
+func soundName(id int32, inline string) string {
+	if id == 0 {
+		return inline
+	}
+	if name := world.SoundName(id); name != "" {
+		return name
+	}
+	return fmt.Sprintf("sound#%d", id)
+}
+
+func soundCategory(id int32) string {
+	if name := protocol.SoundCategoryName(id); name != "" {
+		return name
+	}
+	return fmt.Sprintf("category#%d", id)
+}
@@ -0,0 +1,115 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+const defaultServerPort = 25565
+
+// ServerStatus is the result of a Server List Ping.
+type ServerStatus struct {
+	Address  string
+	Response *protocol.StatusResponse
+	Latency  time.Duration
+}
+
+// Compatible reports whether reg has data for the server's protocol version,
+// the same check SelectVersion makes before logging in.
+func (s *ServerStatus) Compatible(reg *protocol.VersionRegistry) bool {
+	if s.Response == nil {
+		return false
+	}
+	_, ok := reg.ByProtocol(s.Response.Version.Protocol)
+	return ok
+}
+
+// PingServer runs the Status handshake against addr ("host" or "host:port"):
+// it reads the status JSON, then times a ping/pong round trip.
+func PingServer(ctx context.Context, addr string) (*ServerStatus, error) {
+	host, port, err := splitServerAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+	target := net.JoinHostPort(host, strconv.Itoa(int(port)))
+
+	d := net.Dialer{}
+	conn, err := d.DialContext(ctx, "tcp", target)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	// Status never enables compression.
+	const threshold = -1
+	handshake := protocol.CreateHandshakePacket(protocol.CurrentProtocolVersion, host, port, protocol.NextStateStatus)
+	if err := protocol.WritePacket(conn, handshake, threshold); err != nil {
+		return nil, err
+	}
+	if err := protocol.WritePacket(conn, protocol.CreateStatusRequestPacket(), threshold); err != nil {
+		return nil, err
+	}
+	packet, err := protocol.ReadPacket(conn, threshold)
+	if err != nil {
+		return nil, fmt.Errorf("read status response: %w", err)
+	}
+	if packet.ID != protocol.S2CStatusResponse {
+		return nil, fmt.Errorf("unexpected status packet 0x%02X", packet.ID)
+	}
+	response, err := protocol.ParseStatusResponse(bytes.NewReader(packet.Payload))
+	if err != nil {
+		return nil, err
+	}
+
+	status := &ServerStatus{Address: target, Response: response}
+	sentAt := time.Now()
+	payload := sentAt.UnixMilli()
+	if err := protocol.WritePacket(conn, protocol.CreateStatusPingPacket(payload), threshold); err != nil {
+		return status, err
+	}
+	packet, err = protocol.ReadPacket(conn, threshold)
+	if err != nil {
+		return status, fmt.Errorf("read pong: %w", err)
+	}
+	if packet.ID != protocol.S2CStatusPong {
+		return status, fmt.Errorf("unexpected pong packet 0x%02X", packet.ID)
+	}
+	echoed, err := protocol.ParseStatusPong(bytes.NewReader(packet.Payload))
+	if err != nil {
+		return status, err
+	}
+	if echoed != payload {
+		return status, fmt.Errorf("pong payload %d does not match ping %d", echoed, payload)
+	}
+	status.Latency = time.Since(sentAt)
+	return status, nil
+}
+
+// splitServerAddr accepts "host" or "host:port", defaulting to 25565.
+func splitServerAddr(addr string) (string, uint16, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		var addrErr *net.AddrError
+		if errors.As(err, &addrErr) && addrErr.Err == "missing port in address" {
+			return addr, defaultServerPort, nil
+		}
+		return "", 0, fmt.Errorf("invalid server address: %w", err)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid server port %q", portStr)
+	}
+	return host, uint16(port), nil
+}
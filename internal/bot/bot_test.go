@@ -3,16 +3,29 @@ package bot
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"math"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/Versifine/locus/internal/agent"
+	"github.com/Versifine/locus/internal/crafting"
+	"github.com/Versifine/locus/internal/event"
 	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/skill"
 	"github.com/Versifine/locus/internal/world"
 )
 
@@ -36,7 +49,7 @@ func TestBotLoginAndConfig(t *testing.T) {
 		defer close(done)
 		// We can't call Start because it calls Dial.
 		// Instead we manually call login and handleConfiguration.
-		if err := bot.login(); err != nil {
+		if err := bot.login(ctx); err != nil {
 			botErr = err
 			return
 		}
@@ -141,6 +154,234 @@ func TestBotLoginAndConfig(t *testing.T) {
 	}
 }
 
+// fakeOnlineServer plays the server side of an online-mode login up to Login
+// Acknowledged, returning the shared secret it decrypted.
+func fakeOnlineServer(t *testing.T, conn net.Conn, sessions *LocalSessionService, shouldAuth bool) ([]byte, error) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		return nil, err
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	verifyToken := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	for _, want := range []int32{protocol.C2SHandshake, protocol.C2SLoginStart} {
+		p, err := protocol.ReadPacket(conn, -1)
+		if err != nil {
+			return nil, err
+		}
+		if p.ID != want {
+			return nil, fmt.Errorf("expected packet 0x%02x, got 0x%02x", want, p.ID)
+		}
+	}
+
+	request := protocol.CreateEncryptionRequestPacket(protocol.EncryptionRequest{
+		ServerID:           "",
+		PublicKey:          pubDER,
+		VerifyToken:        verifyToken,
+		ShouldAuthenticate: shouldAuth,
+	})
+	if err := protocol.WritePacket(conn, request, -1); err != nil {
+		return nil, err
+	}
+
+	p, err := protocol.ReadPacket(conn, -1)
+	if err != nil {
+		return nil, err
+	}
+	if p.ID != protocol.C2SEncryptionResponse {
+		return nil, fmt.Errorf("expected encryption response, got 0x%02x", p.ID)
+	}
+	resp, err := protocol.ParseEncryptionResponse(bytes.NewReader(p.Payload))
+	if err != nil {
+		return nil, err
+	}
+	secret, err := rsa.DecryptPKCS1v15(rand.Reader, key, resp.SharedSecret)
+	if err != nil {
+		return nil, err
+	}
+	token, err := rsa.DecryptPKCS1v15(rand.Reader, key, resp.VerifyToken)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(token, verifyToken) {
+		return nil, fmt.Errorf("verify token = %x, want %x", token, verifyToken)
+	}
+	if shouldAuth && !sessions.HasJoined(protocol.ServerHash("", secret, pubDER)) {
+		return nil, errors.New("client did not join the session server")
+	}
+
+	encrypted, err := protocol.EncryptConn(conn, secret)
+	if err != nil {
+		return nil, err
+	}
+	threshold := 64
+	compression := new(bytes.Buffer)
+	_ = protocol.WriteVarint(compression, int32(threshold))
+	if err := protocol.WritePacket(encrypted, &protocol.Packet{ID: protocol.S2CSetCompression, Payload: compression.Bytes()}, -1); err != nil {
+		return nil, err
+	}
+
+	success := new(bytes.Buffer)
+	_ = protocol.WriteUUID(success, protocol.GenerateOfflineUUID("TestBot"))
+	_ = protocol.WriteString(success, "TestBot")
+	_ = protocol.WriteVarint(success, 0)
+	if err := protocol.WritePacket(encrypted, &protocol.Packet{ID: protocol.S2CLoginSuccess, Payload: success.Bytes()}, threshold); err != nil {
+		return nil, err
+	}
+
+	p, err = protocol.ReadPacket(encrypted, threshold)
+	if err != nil {
+		return nil, err
+	}
+	if p.ID != protocol.C2SLoginAcknowledged {
+		return nil, fmt.Errorf("expected login ack, got 0x%02x", p.ID)
+	}
+	return secret, nil
+}
+
+func TestBotOnlineModeLogin(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	sessions := NewLocalSessionService()
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.conn = client
+	bot.connState = protocol.NewConnState()
+	bot.SetAuthenticator(sessions)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		_, err := fakeOnlineServer(t, server, sessions, true)
+		serverErr <- err
+	}()
+
+	if err := bot.login(ctx); err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+	if err := <-serverErr; err != nil {
+		t.Fatalf("server: %v", err)
+	}
+	if bot.connState.Get() != protocol.Configuration {
+		t.Fatalf("state = %s, want Configuration", bot.connState.Get())
+	}
+	if bot.connState.GetThreshold() != 64 {
+		t.Fatalf("threshold = %d, want 64", bot.connState.GetThreshold())
+	}
+	if bot.conn == client {
+		t.Fatal("bot.conn should be wrapped in the encrypted stream")
+	}
+}
+
+func TestBotOnlineModeLoginRequiresAuthenticator(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.conn = client
+	bot.connState = protocol.NewConnState()
+
+	go func() {
+		_, _ = fakeOnlineServer(t, server, NewLocalSessionService(), true)
+	}()
+
+	err := bot.login(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "no session authenticator") {
+		t.Fatalf("login error = %v, want missing authenticator", err)
+	}
+}
+
+func TestBotOnlineModeLoginJoinFailure(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	sessions := NewLocalSessionService()
+	sessions.Err = errors.New("invalid session")
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.conn = client
+	bot.connState = protocol.NewConnState()
+	bot.SetAuthenticator(sessions)
+
+	go func() {
+		_, _ = fakeOnlineServer(t, server, sessions, true)
+	}()
+
+	err := bot.login(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "invalid session") {
+		t.Fatalf("login error = %v, want session join failure", err)
+	}
+}
+
+func TestBotEncryptionWithoutAuthentication(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.conn = client
+	bot.connState = protocol.NewConnState()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		_, err := fakeOnlineServer(t, server, nil, false)
+		serverErr <- err
+	}()
+
+	if err := bot.login(context.Background()); err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+	if err := <-serverErr; err != nil {
+		t.Fatalf("server: %v", err)
+	}
+}
+
+func TestMojangSessionAuthenticator(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		if got["accessToken"] == "bad" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	auth := &MojangSessionAuthenticator{
+		AccessToken: "token",
+		ProfileID:   "069a79f4-44e9-4726-a5be-fca90e38aaf5",
+		Endpoint:    srv.URL,
+	}
+	if err := auth.JoinServer(context.Background(), "-7c9d5b0044c130109a5d7b5fb5c317c02b4e28c1"); err != nil {
+		t.Fatalf("JoinServer failed: %v", err)
+	}
+	if got["selectedProfile"] != "069a79f444e94726a5befca90e38aaf5" {
+		t.Fatalf("selectedProfile = %q, want undashed UUID", got["selectedProfile"])
+	}
+	if got["serverId"] != "-7c9d5b0044c130109a5d7b5fb5c317c02b4e28c1" {
+		t.Fatalf("serverId = %q", got["serverId"])
+	}
+
+	auth.AccessToken = "bad"
+	if err := auth.JoinServer(context.Background(), "abc"); err == nil {
+		t.Fatal("JoinServer should fail on 403")
+	}
+}
+
 func TestHandleLevelChunkWithLightAndUnload(t *testing.T) {
 	blockStore, err := world.NewBlockStore()
 	if err != nil {
@@ -188,6 +429,154 @@ func TestHandleLevelChunkWithLightAndUnload(t *testing.T) {
 	}
 }
 
+func TestHandleUpdateLightStoresLight(t *testing.T) {
+	blockStore, err := world.NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	bot := &Bot{runtimeState: runtimeState{worldState: &world.WorldState{}, blockStore: blockStore}}
+	bot.handleLevelChunkWithLight(buildChunkPacketPayload(t, 0, 0, nil))
+
+	// y=64 is light section 9; section 8 (y=48..63) is reported dark.
+	light := protocol.LightData{
+		SkyLightMask:        protocol.NewBitSet(9),
+		BlockLightMask:      protocol.NewBitSet(9),
+		EmptySkyLightMask:   protocol.NewBitSet(8),
+		EmptyBlockLightMask: protocol.NewBitSet(8),
+		SkyLight:            [][]byte{bytes.Repeat([]byte{0xff}, protocol.LightSectionBytes)},
+		BlockLight:          [][]byte{bytes.Repeat([]byte{0x77}, protocol.LightSectionBytes)},
+	}
+	bot.handleUpdateLight(protocol.CreateUpdateLightPacket(0, 0, light).Payload)
+
+	if got, ok := bot.GetLight(3, 64, 3); !ok || got != (world.LightLevel{Sky: 15, Block: 7}) {
+		t.Fatalf("GetLight(3,64,3) = %+v, %v; want sky 15 block 7", got, ok)
+	}
+	if got, _ := bot.GetLight(3, 50, 3); got != (world.LightLevel{}) {
+		t.Fatalf("GetLight(3,50,3) = %+v, want dark", got)
+	}
+
+	// Updates for chunks that are not loaded are dropped.
+	bot.handleUpdateLight(protocol.CreateUpdateLightPacket(4, 4, light).Payload)
+	if _, ok := bot.GetLight(70, 64, 70); ok {
+		t.Fatal("light for an unloaded chunk should not be stored")
+	}
+}
+
+func TestLightFollowsDimensionMinY(t *testing.T) {
+	blockStore, err := world.NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	bot := &Bot{
+		runtimeState:  runtimeState{worldState: &world.WorldState{}, blockStore: blockStore},
+		registryState: registryState{registries: world.NewRegistries()},
+	}
+	if _, ok := bot.updateDimensionBounds(0, world.DimensionNether); !ok {
+		t.Fatal("nether bounds should fall back to vanilla")
+	}
+	chunk := buildChunkPacketPayloadWithSectionCount(t, 0, 0, 16, map[int]int32{4: 1}, nil)
+	bot.handleLevelChunkWithLight(chunk)
+
+	// The nether starts at y=0, so light section 5 covers y=64..79 and
+	// matches block section 4.
+	light := protocol.LightData{
+		SkyLightMask:        protocol.NewBitSet(5),
+		BlockLightMask:      protocol.NewBitSet(5),
+		EmptySkyLightMask:   protocol.NewBitSet(),
+		EmptyBlockLightMask: protocol.NewBitSet(),
+		SkyLight:            [][]byte{bytes.Repeat([]byte{0x00}, protocol.LightSectionBytes)},
+		BlockLight:          [][]byte{bytes.Repeat([]byte{0xbb}, protocol.LightSectionBytes)},
+	}
+	bot.handleUpdateLight(protocol.CreateUpdateLightPacket(0, 0, light).Payload)
+
+	if state, ok := bot.GetBlockState(3, 70, 3); !ok || state != 1 {
+		t.Fatalf("GetBlockState(3,70,3) = %d, %v; want 1", state, ok)
+	}
+	if got, ok := bot.GetLight(3, 70, 3); !ok || got.Block != 11 {
+		t.Fatalf("GetLight(3,70,3) = %+v, %v; want block light 11", got, ok)
+	}
+	if got, _ := bot.GetLight(3, 6, 3); got.Block != 0 {
+		t.Fatalf("GetLight(3,6,3) = %+v, want no block light 64 blocks below", got)
+	}
+}
+
+func TestDeepDimensionSizesBlockStore(t *testing.T) {
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.handleRegistryData(protocol.CreateDimensionTypeRegistryPacket(
+		[]string{"custom:deep", "minecraft:overworld"},
+		[]protocol.DimensionType{{MinY: -128, Height: 512, LogicalHeight: 512}, {MinY: -64, Height: 384, LogicalHeight: 384}},
+	).Payload)
+	if _, ok := bot.updateDimensionBounds(0, "custom:deep"); !ok {
+		t.Fatal("custom dimension bounds should come from the registry")
+	}
+	if minY, sections := bot.blockStore.Height(); minY != -128 || sections != 32 {
+		t.Fatalf("block store height = %d, %d sections; want -128 and 32", minY, sections)
+	}
+
+	// Section i starts at y=-128+16*i: 2 is y=-96, 4 is y=-64, 27 is y=304
+	// and 28 is y=320.
+	bot.handleLevelChunkWithLight(buildChunkPacketPayloadWithSectionCount(t, 0, 0, 32,
+		map[int]int32{2: 7, 4: 1, 27: 2, 28: 3}, nil))
+	if !bot.blockStore.IsLoaded(0, 0) {
+		t.Fatal("chunk of a dimension taller than the overworld should load")
+	}
+	for _, tc := range []struct {
+		y     int
+		state int32
+	}{{-96, 7}, {-64, 1}, {-48, 0}, {304, 2}, {320, 3}} {
+		if state, ok := bot.GetBlockState(0, tc.y, 0); !ok || state != tc.state {
+			t.Fatalf("GetBlockState(0,%d,0) = %d, %v; want %d", tc.y, state, ok, tc.state)
+		}
+	}
+
+	// Light section 3 is block section 2, at y=-96.
+	light := protocol.LightData{
+		BlockLightMask: protocol.NewBitSet(3),
+		BlockLight:     [][]byte{bytes.Repeat([]byte{0x99}, protocol.LightSectionBytes)},
+	}
+	bot.handleUpdateLight(protocol.CreateUpdateLightPacket(0, 0, light).Payload)
+	if got, _ := bot.GetLight(0, -96, 0); got.Block != 9 {
+		t.Fatalf("GetLight(0,-96,0) = %+v, want block light 9", got)
+	}
+
+	// Back in the overworld the store shrinks again.
+	bot.updateDimensionBounds(1, "minecraft:overworld")
+	if minY, sections := bot.blockStore.Height(); minY != world.ChunkMinY || sections != world.ChunkSectionCount {
+		t.Fatalf("overworld block store height = %d, %d sections", minY, sections)
+	}
+	if bot.blockStore.IsLoaded(0, 0) {
+		t.Fatal("resizing the store should drop chunks of the old height")
+	}
+}
+
+func TestChunkBiomesResolveThroughServerRegistry(t *testing.T) {
+	blockStore, err := world.NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	bot := &Bot{runtimeState: runtimeState{worldState: &world.WorldState{}, blockStore: blockStore}}
+	bot.handleLevelChunkWithLight(buildChunkPacketPayload(t, 0, 0, nil))
+
+	// Until the server sends its registry, IDs follow biomes.json.
+	if biome, ok := bot.GetBiome(1, 64, 1); !ok || biome.Name != "badlands" {
+		t.Fatalf("GetBiome before registry = %+v, %v; want badlands", biome, ok)
+	}
+
+	bot.handleRegistryData(protocol.CreateRegistryDataPacket(protocol.RegistryBiome, []string{"minecraft:plains", "minecraft:desert"}).Payload)
+	biome, ok := bot.GetBiome(1, 64, 1)
+	if !ok || biome.Name != "plains" || biome.Category != "plains" {
+		t.Fatalf("GetBiome after registry = %+v, %v; want plains", biome, ok)
+	}
+
+	pos, found, ok := bot.FindNearestBiome(world.BlockPos{X: 40, Y: 65, Z: 1}, 64, func(b world.Biome) bool { return b.Name == "plains" })
+	if !ok || found.Name != "plains" || pos != (world.BlockPos{X: 14, Y: 66, Z: 2}) {
+		t.Fatalf("FindNearestBiome = %+v %+v %v; want plains at (14,66,2)", pos, found, ok)
+	}
+	if _, _, ok := bot.FindNearestBiome(world.BlockPos{Y: 64}, 64, func(b world.Biome) bool { return b.Name == "desert" }); ok {
+		t.Fatal("desert should not be found")
+	}
+}
+
 type chunkBlockEntityPayload struct {
 	LocalX byte
 	LocalZ byte
@@ -208,9 +597,20 @@ func buildChunkPacketPayloadWithBlockEntities(
 	blockEntities []chunkBlockEntityPayload,
 ) []byte {
 	t.Helper()
+	return buildChunkPacketPayloadWithSectionCount(t, chunkX, chunkZ, protocol.ChunkSectionCount, sectionStates, blockEntities)
+}
+
+func buildChunkPacketPayloadWithSectionCount(
+	t *testing.T,
+	chunkX, chunkZ int32,
+	sectionCount int,
+	sectionStates map[int]int32,
+	blockEntities []chunkBlockEntityPayload,
+) []byte {
+	t.Helper()
 
 	chunkData := new(bytes.Buffer)
-	for section := 0; section < protocol.ChunkSectionCount; section++ {
+	for section := 0; section < sectionCount; section++ {
 		stateID := int32(0)
 		if v, ok := sectionStates[section]; ok {
 			stateID = v
@@ -844,7 +1244,7 @@ func TestMaybeSendPlayerLoadedOnlyOnce(t *testing.T) {
 	bot := &Bot{
 		connectionState: connectionState{
 			conn:      client,
-			connState: protocol.NewConnState(),
+			connState: newPlayConnState(),
 		},
 	}
 
@@ -1131,3 +1531,1817 @@ func signExtendInt32ForBotTest(value int64, bits uint) int32 {
 	shift := 64 - bits
 	return int32((value << shift) >> shift)
 }
+
+func writeSlotForBotTest(buf *bytes.Buffer, itemID, count int32) {
+	_ = protocol.WriteVarint(buf, count)
+	if count == 0 {
+		return
+	}
+	_ = protocol.WriteVarint(buf, itemID)
+	_ = protocol.WriteVarint(buf, 0)
+	_ = protocol.WriteVarint(buf, 0)
+}
+
+func TestInventoryTrackingFromWindowPackets(t *testing.T) {
+	bot := &Bot{}
+	if _, ok := bot.GetInventorySnapshot(); ok {
+		t.Fatalf("inventory should not be ready before window items")
+	}
+
+	windowItems := new(bytes.Buffer)
+	_ = protocol.WriteVarint(windowItems, 0)
+	_ = protocol.WriteVarint(windowItems, 3)
+	_ = protocol.WriteVarint(windowItems, protocol.PlayerInventorySlotLen)
+	for i := 0; i < protocol.PlayerInventorySlotLen; i++ {
+		switch i {
+		case protocol.PlayerSlotArmorStart: // head
+			writeSlotForBotTest(windowItems, 900, 1)
+		case protocol.PlayerSlotMainStart:
+			writeSlotForBotTest(windowItems, 1, 64)
+		case protocol.PlayerSlotHotbarStart + 2:
+			writeSlotForBotTest(windowItems, 840, 1)
+		case protocol.PlayerSlotOffhand:
+			writeSlotForBotTest(windowItems, 1154, 1)
+		default:
+			writeSlotForBotTest(windowItems, 0, 0)
+		}
+	}
+	writeSlotForBotTest(windowItems, 0, 0)
+	bot.handleWindowItems(windowItems.Bytes())
+
+	held := new(bytes.Buffer)
+	_ = protocol.WriteVarint(held, 2)
+	bot.handleHeldItemSlot(held.Bytes())
+
+	inv, ok := bot.GetInventorySnapshot()
+	if !ok {
+		t.Fatalf("inventory should be ready after window items")
+	}
+	if inv.HeldSlot != 2 {
+		t.Fatalf("HeldSlot = %d, want 2", inv.HeldSlot)
+	}
+	item, ok := inv.HeldItem()
+	if !ok || item.ItemID != 840 || item.Name != world.ItemName(840) {
+		t.Fatalf("held item mismatch: %+v ok=%v", item, ok)
+	}
+	if len(inv.Main) != 1 || inv.Main[0].Slot != 9 || inv.Main[0].Count != 64 {
+		t.Fatalf("main mismatch: %+v", inv.Main)
+	}
+	if len(inv.Armor) != 1 || inv.Armor[0].Slot != 39 || inv.Armor[0].ItemID != 900 {
+		t.Fatalf("armor mismatch: %+v", inv.Armor)
+	}
+	if inv.Offhand == nil || inv.Offhand.ItemID != 1154 {
+		t.Fatalf("offhand mismatch: %+v", inv.Offhand)
+	}
+
+	setSlot := new(bytes.Buffer)
+	_ = protocol.WriteVarint(setSlot, 0)
+	_ = protocol.WriteVarint(setSlot, 4)
+	_ = protocol.WriteInt16(setSlot, protocol.PlayerSlotHotbarStart+2)
+	writeSlotForBotTest(setSlot, 0, 0)
+	bot.handleSetSlot(setSlot.Bytes())
+
+	setInventory := new(bytes.Buffer)
+	_ = protocol.WriteVarint(setInventory, 36) // feet
+	writeSlotForBotTest(setInventory, 903, 1)
+	changed := bot.containerChangedCh()
+	bot.handleSetPlayerInventory(setInventory.Bytes())
+	select {
+	case <-changed:
+	default:
+		t.Fatal("set player inventory should wake window waiters")
+	}
+
+	inv, _ = bot.GetInventorySnapshot()
+	if _, ok := inv.HeldItem(); ok {
+		t.Fatalf("held slot should be empty after set slot: %+v", inv.Hotbar)
+	}
+	if len(inv.Armor) != 2 || inv.Armor[0].Slot != 36 || inv.Armor[0].ItemID != 903 {
+		t.Fatalf("armor after set player inventory mismatch: %+v", inv.Armor)
+	}
+}
+
+// newPlayConnState returns a connection state that SendPacket accepts.
+func newPlayConnState() *protocol.ConnState {
+	connState := protocol.NewConnState()
+	connState.Set(protocol.Play)
+	return connState
+}
+
+func TestSendPacketRejectsPacketsOutsidePlay(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := &Bot{
+		connectionState: connectionState{
+			conn:      client,
+			connState: protocol.NewConnState(),
+		},
+	}
+	bot.connState.Set(protocol.Configuration)
+
+	err := bot.SendPacket(protocol.CreateHeldItemSlotPacket(5))
+	if err == nil || !strings.Contains(err.Error(), "connection is not initialized") {
+		t.Fatalf("SendPacket during configuration error = %v, want a not-initialized error", err)
+	}
+	if err := bot.maybeSendPlayerLoaded(); err == nil {
+		t.Fatal("maybeSendPlayerLoaded during configuration should fail")
+	}
+	if bot.sentPlayerLoaded {
+		t.Fatal("a refused player loaded packet should be retried later")
+	}
+
+	// Between sessions Start clears the connection.
+	bot.conn, bot.connState = nil, nil
+	if err := bot.maybeSendPlayerLoaded(); err == nil {
+		t.Fatal("maybeSendPlayerLoaded without a connection should fail")
+	}
+}
+
+func TestSendPacketTracksHeldSlot(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := &Bot{
+		connectionState: connectionState{
+			conn:      client,
+			connState: newPlayConnState(),
+		},
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- bot.SendPacket(protocol.CreateHeldItemSlotPacket(5))
+	}()
+	packet, err := protocol.ReadPacket(server, -1)
+	if err != nil {
+		t.Fatalf("ReadPacket failed: %v", err)
+	}
+	if packet.ID != protocol.C2SHeldItemSlot {
+		t.Fatalf("packet ID = 0x%02x, want held item slot", packet.ID)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("SendPacket failed: %v", err)
+	}
+
+	bot.inventoryMu.RLock()
+	heldSlot := bot.heldSlot
+	bot.inventoryMu.RUnlock()
+	if heldSlot != 5 {
+		t.Fatalf("heldSlot = %d, want 5", heldSlot)
+	}
+}
+
+// fakeContainerServer applies window clicks to a simulated container and pushes
+// the resulting slot and cursor updates back through the bot handlers.
+type fakeContainerServer struct {
+	t        *testing.T
+	bot      *Bot
+	windowID int32
+	size     int
+	slots    []protocol.Slot
+	cursor   protocol.Slot
+	stateID  int32
+	clicks   []int32
+	// craft computes the result slot from the grid when set; slot 0 is then
+	// treated as a crafting result.
+	craft func(slots []protocol.Slot) protocol.Slot
+}
+
+func (s *fakeContainerServer) slotPayload(index int) []byte {
+	buf := new(bytes.Buffer)
+	_ = protocol.WriteVarint(buf, s.windowID)
+	_ = protocol.WriteVarint(buf, s.stateID)
+	_ = protocol.WriteInt16(buf, int16(index))
+	_ = protocol.WriteSlot(buf, s.slots[index])
+	return buf.Bytes()
+}
+
+func (s *fakeContainerServer) windowItemsPayload() []byte {
+	buf := new(bytes.Buffer)
+	_ = protocol.WriteVarint(buf, s.windowID)
+	_ = protocol.WriteVarint(buf, s.stateID)
+	_ = protocol.WriteVarint(buf, int32(len(s.slots)))
+	for _, slot := range s.slots {
+		_ = protocol.WriteSlot(buf, slot)
+	}
+	_ = protocol.WriteSlot(buf, s.cursor)
+	return buf.Bytes()
+}
+
+func (s *fakeContainerServer) apply(packet *protocol.Packet) {
+	r := bytes.NewReader(packet.Payload)
+	_, _ = protocol.ReadVarint(r)
+	_, _ = protocol.ReadVarint(r)
+	slotIndex, _ := protocol.ReadInt16(r)
+	button, _ := protocol.ReadByte(r)
+	mode, _ := protocol.ReadVarint(r)
+	s.clicks = append(s.clicks, mode)
+
+	before := append([]protocol.Slot(nil), s.slots...)
+	beforeCursor := s.cursor
+	i := int(slotIndex)
+
+	switch {
+	case s.craft != nil && i == 0 && mode == protocol.WindowClickQuickMove:
+		for !s.slots[0].IsEmpty() {
+			result := s.slots[0]
+			placed := false
+			for j := s.size; j < len(s.slots); j++ {
+				if s.slots[j].IsEmpty() {
+					s.slots[j] = result
+					placed = true
+					break
+				}
+				if s.slots[j].ItemID == result.ItemID && s.slots[j].Count+result.Count <= 64 {
+					s.slots[j].Count += result.Count
+					placed = true
+					break
+				}
+			}
+			if !placed {
+				break
+			}
+			for j := 1; j < s.size; j++ {
+				if !s.slots[j].IsEmpty() {
+					s.slots[j].Count--
+					if s.slots[j].Count == 0 {
+						s.slots[j] = protocol.Slot{}
+					}
+				}
+			}
+			s.slots[0] = s.craft(s.slots)
+		}
+	case mode == protocol.WindowClickQuickMove:
+		start, end := s.size, len(s.slots)
+		if i >= s.size {
+			start, end = 0, s.size
+		}
+		for j := start; j < end; j++ {
+			if s.slots[j].IsEmpty() {
+				s.slots[j] = s.slots[i]
+				s.slots[i] = protocol.Slot{}
+				break
+			}
+		}
+	case mode == protocol.WindowClickPickup:
+		switch {
+		case button == 0 && s.cursor.IsEmpty():
+			s.cursor, s.slots[i] = s.slots[i], protocol.Slot{}
+		case button == 0 && s.slots[i].IsEmpty():
+			s.slots[i], s.cursor = s.cursor, protocol.Slot{}
+		case button == 0 && s.slots[i].ItemID == s.cursor.ItemID:
+			s.slots[i].Count += s.cursor.Count
+			s.cursor = protocol.Slot{}
+		case button == 1 && !s.cursor.IsEmpty():
+			if s.slots[i].IsEmpty() {
+				s.slots[i] = protocol.Slot{ItemID: s.cursor.ItemID, Count: 1}
+			} else {
+				s.slots[i].Count++
+			}
+			s.cursor.Count--
+			if s.cursor.Count == 0 {
+				s.cursor = protocol.Slot{}
+			}
+		}
+	}
+
+	if s.craft != nil {
+		s.slots[0] = s.craft(s.slots)
+	}
+	s.stateID++
+	for j := range s.slots {
+		if before[j].ItemID != s.slots[j].ItemID || before[j].Count != s.slots[j].Count {
+			s.bot.handleSetSlot(s.slotPayload(j))
+		}
+	}
+	if beforeCursor.ItemID != s.cursor.ItemID || beforeCursor.Count != s.cursor.Count {
+		buf := new(bytes.Buffer)
+		_ = protocol.WriteSlot(buf, s.cursor)
+		s.bot.handleSetCursorItem(buf.Bytes())
+	}
+}
+
+func (s *fakeContainerServer) serve(conn net.Conn, done chan<- struct{}) {
+	defer close(done)
+	for {
+		packet, err := protocol.ReadPacket(conn, -1)
+		if err != nil {
+			return
+		}
+		switch packet.ID {
+		case protocol.C2SBlockPlace:
+			open := new(bytes.Buffer)
+			_ = protocol.WriteVarint(open, s.windowID)
+			_ = protocol.WriteVarint(open, 2)
+			_ = protocol.WriteByte(open, protocol.TagString)
+			_ = protocol.WriteUnsignedShort(open, 5)
+			open.WriteString("Chest")
+			s.bot.handleOpenWindow(open.Bytes())
+			s.bot.handleWindowItems(s.windowItemsPayload())
+		case protocol.C2SWindowClick:
+			s.apply(packet)
+		case protocol.C2SCloseWindow:
+			return
+		}
+	}
+}
+
+func TestContainerOpenTransferAndClose(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := &Bot{
+		connectionState: connectionState{
+			conn:      client,
+			connState: newPlayConnState(),
+		},
+		runtimeState: runtimeState{
+			worldState: &world.WorldState{},
+		},
+	}
+	bot.worldState.UpdatePosition(world.Position{X: 0.5, Y: 64, Z: 0.5})
+
+	fake := &fakeContainerServer{t: t, bot: bot, windowID: 1, size: 27, slots: make([]protocol.Slot, 27+36)}
+	fake.slots[0] = protocol.Slot{ItemID: 1, Count: 10}   // stone in the chest
+	fake.slots[27] = protocol.Slot{ItemID: 5, Count: 10}  // inventory main slot 9
+	fake.slots[28] = protocol.Slot{ItemID: 840, Count: 1} // inventory main slot 10
+	done := make(chan struct{})
+	go fake.serve(server, done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := bot.OpenContainer(ctx, 10, 64, 10); err == nil {
+		t.Fatalf("OpenContainer should reject out-of-reach blocks")
+	}
+
+	view, err := bot.OpenContainer(ctx, 1, 64, 1)
+	if err != nil {
+		t.Fatalf("OpenContainer failed: %v", err)
+	}
+	if view.WindowID != 1 || view.Type != "generic_9x3" || view.Size != 27 || view.Title != "Chest" {
+		t.Fatalf("unexpected container view: %+v", view)
+	}
+	if len(view.Slots) != 1 || view.Slots[0].Name != world.ItemName(1) || view.Slots[0].Count != 10 {
+		t.Fatalf("unexpected container slots: %+v", view.Slots)
+	}
+
+	withdraw, err := bot.TransferItems(ctx, agent.ContainerTransfer{Direction: TransferWithdraw, Item: "minecraft:stone"})
+	if err != nil {
+		t.Fatalf("withdraw failed: %v", err)
+	}
+	if withdraw.Moved != 10 || withdraw.Reason != "completed" || len(withdraw.Container.Slots) != 0 {
+		t.Fatalf("unexpected withdraw result: %+v", withdraw)
+	}
+
+	deposit, err := bot.TransferItems(ctx, agent.ContainerTransfer{Direction: TransferDeposit, Item: world.ItemName(5), Count: 3})
+	if err != nil {
+		t.Fatalf("deposit failed: %v", err)
+	}
+	if deposit.Moved != 3 || deposit.Reason != "completed" {
+		t.Fatalf("unexpected deposit result: %+v", deposit)
+	}
+	if fake.slots[0].ItemID != 5 || fake.slots[0].Count != 3 || fake.slots[27].Count != 7 || !fake.cursor.IsEmpty() {
+		t.Fatalf("server container state mismatch: chest0=%+v inv=%+v cursor=%+v", fake.slots[0], fake.slots[27], fake.cursor)
+	}
+	if !bot.carried().IsEmpty() {
+		t.Fatalf("bot cursor should be empty after partial deposit: %+v", bot.carried())
+	}
+
+	bot.inventoryMu.RLock()
+	mirrored := bot.playerWindow[protocol.PlayerSlotMainStart]
+	bot.inventoryMu.RUnlock()
+	if mirrored.ItemID != 5 || mirrored.Count != 7 {
+		t.Fatalf("player inventory should mirror container player slots: %+v", mirrored)
+	}
+
+	if err := bot.CloseContainer(); err != nil {
+		t.Fatalf("CloseContainer failed: %v", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("fake server did not receive close window")
+	}
+	if _, ok := bot.containerView(); ok {
+		t.Fatalf("container should be closed")
+	}
+}
+
+func TestFindDepositSlotRespectsStackSizeAndComponents(t *testing.T) {
+	book, err := crafting.DefaultBook()
+	if err != nil {
+		t.Fatalf("DefaultBook failed: %v", err)
+	}
+	sword, _ := book.ItemID("diamond_sword")
+	pearl, _ := book.ItemID("ender_pearl")
+	stick, _ := book.ItemID("stick")
+	named := []protocol.SlotComponent{{Type: protocol.SlotComponentCustomName, Raw: []byte{0x08, 0x00, 0x03, 'B', 'o', 'b'}}}
+
+	bot := &Bot{}
+	bot.container = &containerWindow{loaded: true, slots: []protocol.Slot{
+		{ItemID: sword, Count: 1},
+		{ItemID: pearl, Count: 16},
+		{ItemID: stick, Count: 5},
+		{},
+		{ItemID: stick, Count: 5, Components: named},
+	}}
+	tests := []struct {
+		name string
+		item protocol.Slot
+		want int
+	}{
+		{"unstackable sword", protocol.Slot{ItemID: sword, Count: 1}, 3},
+		{"full 16 stack", protocol.Slot{ItemID: pearl, Count: 1}, 3},
+		{"plain stick", protocol.Slot{ItemID: stick, Count: 1}, 2},
+		{"named stick", protocol.Slot{ItemID: stick, Count: 1, Components: named}, 4},
+	}
+	for _, tt := range tests {
+		if got := bot.findDepositSlot(tt.item, 0, 5); got != tt.want {
+			t.Errorf("%s: findDepositSlot = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+	if got := stackLimit(protocol.Slot{ItemID: sword, Components: []protocol.SlotComponent{{Type: protocol.SlotComponentMaxStackSize, Value: int32(4)}}}); got != 4 {
+		t.Errorf("stackLimit with override = %d, want 4", got)
+	}
+}
+
+func TestFindWindowDepositSlotSkipsFullToolAndPearlStacks(t *testing.T) {
+	book, err := crafting.DefaultBook()
+	if err != nil {
+		t.Fatalf("DefaultBook failed: %v", err)
+	}
+	pickaxe, _ := book.ItemID("wooden_pickaxe")
+	pearl, _ := book.ItemID("ender_pearl")
+
+	bot := &Bot{}
+	start := protocol.PlayerSlotMainStart
+	bot.playerWindow[start] = protocol.Slot{ItemID: pickaxe, Count: 1}
+	bot.playerWindow[start+1] = protocol.Slot{ItemID: pearl, Count: 16}
+	bot.playerWindow[start+2] = protocol.Slot{ItemID: pearl, Count: 3}
+	grid := craftGrid{
+		windowID: protocol.PlayerInventoryWindowID,
+		size:     crafting.InventoryGridSize,
+		invStart: start,
+		invEnd:   protocol.PlayerSlotOffhand,
+	}
+
+	if got := bot.findWindowDepositSlot(grid, protocol.Slot{ItemID: pickaxe, Count: 1}); got != start+3 {
+		t.Fatalf("pickaxe deposit slot = %d, want first empty %d", got, start+3)
+	}
+	if got := bot.findWindowDepositSlot(grid, protocol.Slot{ItemID: pearl, Count: 1}); got != start+2 {
+		t.Fatalf("pearl deposit slot = %d, want partial stack %d", got, start+2)
+	}
+}
+
+func TestCraftRecipeInInventoryGrid(t *testing.T) {
+	book, err := crafting.DefaultBook()
+	if err != nil {
+		t.Fatalf("DefaultBook failed: %v", err)
+	}
+	oakLog, _ := book.ItemID("oak_log")
+	oakPlanks, _ := book.ItemID("oak_planks")
+	recipe := book.Recipes(oakPlanks)[0]
+
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := &Bot{
+		connectionState: connectionState{
+			conn:      client,
+			connState: newPlayConnState(),
+		},
+	}
+	fake := &fakeContainerServer{
+		t:        t,
+		bot:      bot,
+		windowID: protocol.PlayerInventoryWindowID,
+		size:     protocol.PlayerSlotMainStart,
+		slots:    make([]protocol.Slot, protocol.PlayerInventorySlotLen),
+		craft: func(slots []protocol.Slot) protocol.Slot {
+			logs := 0
+			for j := protocol.PlayerSlotCraftStart; j < protocol.PlayerSlotArmorStart; j++ {
+				if slots[j].IsEmpty() {
+					continue
+				}
+				if slots[j].ItemID != oakLog || logs > 0 {
+					return protocol.Slot{}
+				}
+				logs++
+			}
+			if logs == 0 {
+				return protocol.Slot{}
+			}
+			return protocol.Slot{ItemID: oakPlanks, Count: 4}
+		},
+	}
+	fake.slots[protocol.PlayerSlotHotbarStart] = protocol.Slot{ItemID: oakLog, Count: 3}
+	bot.handleWindowItems(fake.windowItemsPayload())
+
+	done := make(chan struct{})
+	go fake.serve(server, done)
+
+	counts, ok := bot.InventoryCounts()
+	if !ok || counts[oakLog] != 3 {
+		t.Fatalf("InventoryCounts=%v,%v want 3 oak logs", counts, ok)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := bot.CraftRecipe(ctx, recipe, 2); err != nil {
+		t.Fatalf("CraftRecipe failed: %v", err)
+	}
+
+	counts, _ = bot.InventoryCounts()
+	if counts[oakLog] != 1 || counts[oakPlanks] != 8 {
+		t.Fatalf("inventory after craft=%v want 1 log and 8 planks", counts)
+	}
+	for j := protocol.PlayerSlotCraftResult; j < protocol.PlayerSlotArmorStart; j++ {
+		if !fake.slots[j].IsEmpty() {
+			t.Fatalf("crafting grid slot %d not cleared: %+v", j, fake.slots[j])
+		}
+	}
+	if !bot.carried().IsEmpty() {
+		t.Fatalf("cursor should be empty after crafting: %+v", bot.carried())
+	}
+
+	// A 3x3 recipe cannot be laid out in the inventory grid.
+	pickaxe, _ := book.ItemID("wooden_pickaxe")
+	if err := bot.CraftRecipe(ctx, book.Recipes(pickaxe)[0], 1); err == nil {
+		t.Fatalf("expected 3x3 recipe to be rejected without a crafting table")
+	}
+}
+
+func writeChatNBTString(buf *bytes.Buffer, name, value string) {
+	buf.WriteByte(protocol.TagString)
+	_ = binary.Write(buf, binary.BigEndian, uint16(len(name)))
+	buf.WriteString(name)
+	_ = binary.Write(buf, binary.BigEndian, uint16(len(value)))
+	buf.WriteString(value)
+}
+
+// writeChatTranslate writes an anonymous {"translate": key, "with": [{"text": arg}...]}.
+func writeChatTranslate(buf *bytes.Buffer, key string, args ...string) {
+	buf.WriteByte(protocol.TagCompound)
+	writeChatNBTString(buf, "translate", key)
+	if len(args) > 0 {
+		buf.WriteByte(protocol.TagList)
+		_ = binary.Write(buf, binary.BigEndian, uint16(len("with")))
+		buf.WriteString("with")
+		buf.WriteByte(protocol.TagCompound)
+		_ = binary.Write(buf, binary.BigEndian, int32(len(args)))
+		for _, arg := range args {
+			writeChatNBTString(buf, "text", arg)
+			buf.WriteByte(protocol.TagEnd)
+		}
+	}
+	buf.WriteByte(protocol.TagEnd)
+}
+
+func writeChatText(buf *bytes.Buffer, text string) {
+	buf.WriteByte(protocol.TagString)
+	_ = binary.Write(buf, binary.BigEndian, uint16(len(text)))
+	buf.WriteString(text)
+}
+
+func newChatTestBot() *Bot {
+	return &Bot{
+		connectionState: connectionState{username: "Locus"},
+		runtimeState:    runtimeState{eventBus: event.NewBus(), worldState: &world.WorldState{}},
+	}
+}
+
+func subscribeChat(bot *Bot) <-chan *event.ChatEvent {
+	ch := make(chan *event.ChatEvent, 8)
+	bot.eventBus.Subscribe(event.EventChat, func(raw any) {
+		if evt, ok := raw.(*event.ChatEvent); ok {
+			ch <- evt
+		}
+	})
+	return ch
+}
+
+func waitChat(t *testing.T, ch <-chan *event.ChatEvent) *event.ChatEvent {
+	t.Helper()
+	select {
+	case evt := <-ch:
+		return evt
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for chat event")
+		return nil
+	}
+}
+
+func TestHandleSystemChatPublishesNotices(t *testing.T) {
+	bot := newChatTestBot()
+	chats := subscribeChat(bot)
+
+	var death bytes.Buffer
+	writeChatTranslate(&death, "death.attack.mob", "Steve", "Zombie")
+	_ = protocol.WriteBool(&death, false)
+	bot.handleSystemChat(context.Background(), death.Bytes())
+
+	evt := waitChat(t, chats)
+	if evt.Source != event.SourceSystem || evt.Username != "SYSTEM" {
+		t.Fatalf("unexpected death event: %+v", evt)
+	}
+	if evt.Notice == nil || evt.Notice.Kind != event.NoticeDeath || evt.Notice.Player != "Steve" {
+		t.Fatalf("unexpected death notice: %+v", evt.Notice)
+	}
+	if len(evt.Notice.Args) != 1 || evt.Notice.Args[0] != "Zombie" {
+		t.Fatalf("unexpected death args: %v", evt.Notice.Args)
+	}
+
+	var join bytes.Buffer
+	writeChatTranslate(&join, "multiplayer.player.joined", "Alex")
+	_ = protocol.WriteBool(&join, false)
+	bot.handleSystemChat(context.Background(), join.Bytes())
+	evt = waitChat(t, chats)
+	if evt.Notice == nil || evt.Notice.Kind != event.NoticeJoin || evt.Notice.Player != "Alex" {
+		t.Fatalf("unexpected join notice: %+v", evt.Notice)
+	}
+
+	var actionBar bytes.Buffer
+	writeChatText(&actionBar, "ignored")
+	_ = protocol.WriteBool(&actionBar, true)
+	bot.handleSystemChat(context.Background(), actionBar.Bytes())
+
+	var plain bytes.Buffer
+	writeChatText(&plain, "Server restarting")
+	_ = protocol.WriteBool(&plain, false)
+	bot.handleSystemChat(context.Background(), plain.Bytes())
+	evt = waitChat(t, chats)
+	if evt.Message != "Server restarting" || evt.Notice != nil {
+		t.Fatalf("action bar should be skipped and plain text published: %+v", evt)
+	}
+}
+
+func TestHandleSystemChatWhisper(t *testing.T) {
+	bot := newChatTestBot()
+	chats := subscribeChat(bot)
+
+	var buf bytes.Buffer
+	writeChatTranslate(&buf, "commands.message.display.incoming", "Steve", "come here")
+	_ = protocol.WriteBool(&buf, false)
+	bot.handleSystemChat(context.Background(), buf.Bytes())
+
+	evt := waitChat(t, chats)
+	if evt.Source != event.SourceWhisper || evt.Username != "Steve" || evt.Message != "come here" {
+		t.Fatalf("unexpected whisper event: %+v", evt)
+	}
+}
+
+func TestHandleDisguisedChat(t *testing.T) {
+	bot := newChatTestBot()
+	chats := subscribeChat(bot)
+
+	disguised := func(chatType int32, name, message string) []byte {
+		var buf bytes.Buffer
+		writeChatText(&buf, message)
+		_ = protocol.WriteVarint(&buf, chatType+1)
+		writeChatText(&buf, name)
+		_ = protocol.WriteBool(&buf, false)
+		return buf.Bytes()
+	}
+
+	bot.handleDisguisedChat(context.Background(), disguised(protocol.ChatTypeMsgCommandOutgoing, "Locus", "echo"))
+	bot.handleDisguisedChat(context.Background(), disguised(protocol.ChatTypeMsgCommandIncoming, "Steve", "psst"))
+	evt := waitChat(t, chats)
+	if evt.Source != event.SourceWhisper || evt.Username != "Steve" || evt.Message != "psst" {
+		t.Fatalf("unexpected disguised whisper: %+v", evt)
+	}
+
+	bot.handleDisguisedChat(context.Background(), disguised(protocol.ChatTypeSayCommand, "Server", "hello all"))
+	evt = waitChat(t, chats)
+	if evt.Source != event.SourcePlayer || evt.Username != "Server" || evt.Message != "hello all" {
+		t.Fatalf("unexpected disguised chat: %+v", evt)
+	}
+
+	select {
+	case extra := <-chats:
+		t.Fatalf("outgoing echo should be ignored, got %+v", extra)
+	default:
+	}
+}
+
+func TestDisguisedChatResolvesChatTypesFromRegistry(t *testing.T) {
+	bot := newChatTestBot()
+	bot.registries = world.NewRegistries()
+	// A datapack chat type ahead of the vanilla entries shifts every index.
+	bot.handleRegistryData(protocol.CreateRegistryDataPacket("minecraft:chat_type", []string{
+		"custom:shout", "minecraft:chat", "minecraft:emote_command", "minecraft:msg_command_incoming",
+		"minecraft:msg_command_outgoing", "minecraft:say_command",
+	}).Payload)
+	chats := subscribeChat(bot)
+
+	disguised := func(chatType int32, name, message string) []byte {
+		var buf bytes.Buffer
+		writeChatText(&buf, message)
+		_ = protocol.WriteVarint(&buf, chatType+1)
+		writeChatText(&buf, name)
+		_ = protocol.WriteBool(&buf, false)
+		return buf.Bytes()
+	}
+
+	bot.handleDisguisedChat(context.Background(), disguised(4, "Locus", "echo"))
+	bot.handleDisguisedChat(context.Background(), disguised(3, "Steve", "psst"))
+	evt := waitChat(t, chats)
+	if evt.Source != event.SourceWhisper || evt.Username != "Steve" || evt.Message != "psst" {
+		t.Fatalf("unexpected disguised whisper: %+v", evt)
+	}
+
+	// Index 2 is msg_command_incoming in vanilla order but emote_command here.
+	bot.handleDisguisedChat(context.Background(), disguised(2, "Alex", "waves"))
+	evt = waitChat(t, chats)
+	if evt.Source != event.SourcePlayer || evt.Username != "Alex" {
+		t.Fatalf("unexpected disguised emote: %+v", evt)
+	}
+
+	select {
+	case extra := <-chats:
+		t.Fatalf("outgoing echo should be ignored, got %+v", extra)
+	default:
+	}
+}
+
+func TestSendWhisper(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := &Bot{
+		connectionState: connectionState{conn: client, connState: newPlayConnState()},
+		runtimeState:    runtimeState{worldState: &world.WorldState{}},
+	}
+	if err := bot.SendWhisper("Steve Jobs", "hi"); err == nil {
+		t.Fatal("expected error for target with spaces")
+	}
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- bot.SendWhisper("Steve", "on my way") }()
+
+	packet, err := protocol.ReadPacket(server, bot.connState.GetThreshold())
+	if err != nil {
+		t.Fatalf("ReadPacket failed: %v", err)
+	}
+	if packet.ID != protocol.C2SChatCommand {
+		t.Fatalf("packet ID = 0x%02X, want chat_command", packet.ID)
+	}
+	command, err := protocol.ReadString(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ReadString failed: %v", err)
+	}
+	if command != "msg Steve on my way" {
+		t.Fatalf("command = %q", command)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("SendWhisper failed: %v", err)
+	}
+}
+
+func TestClassifyDisconnect(t *testing.T) {
+	tests := []struct {
+		key     string
+		message string
+		want    DisconnectKind
+		retry   bool
+	}{
+		{"multiplayer.disconnect.banned.reason", "", DisconnectBanned, false},
+		{"multiplayer.disconnect.not_whitelisted", "", DisconnectNotWhitelisted, false},
+		{"multiplayer.disconnect.outdated_client", "", DisconnectOutdated, false},
+		{"multiplayer.disconnect.duplicate_login", "", DisconnectDuplicateLogin, false},
+		{"multiplayer.disconnect.server_shutdown", "", DisconnectServerClosed, true},
+		{"multiplayer.disconnect.server_full", "", DisconnectServerFull, true},
+		{"disconnect.timeout", "", DisconnectTimeout, true},
+		{"multiplayer.disconnect.kicked", "", DisconnectKicked, true},
+		{"", "Connection throttled! Please wait before reconnecting.", DisconnectThrottled, true},
+		{"", "You are banned from this server", DisconnectBanned, false},
+		{"", "Kicked for spamming", DisconnectKicked, true},
+	}
+	for _, tt := range tests {
+		got := classifyDisconnect(tt.key, tt.message)
+		if got != tt.want {
+			t.Errorf("classifyDisconnect(%q, %q) = %s, want %s", tt.key, tt.message, got, tt.want)
+		}
+		if retry := (&DisconnectError{Kind: got}).Retryable(); retry != tt.retry {
+			t.Errorf("%s retryable = %v, want %v", got, retry, tt.retry)
+		}
+	}
+}
+
+func TestReconnectPolicyDelay(t *testing.T) {
+	policy := ReconnectPolicy{InitialDelay: time.Second, MaxDelay: 5 * time.Second, Multiplier: 2}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		if got := policy.Delay(i+1, DisconnectNetwork); got != w {
+			t.Errorf("Delay(%d) = %s, want %s", i+1, got, w)
+		}
+	}
+	if got := policy.Delay(1, DisconnectThrottled); got != throttledMinDelay {
+		t.Errorf("throttled delay = %s, want %s", got, throttledMinDelay)
+	}
+}
+
+func TestPlayStateKickReturnsDisconnectError(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := newChatTestBot()
+	bot.conn = client
+	bot.connState = protocol.NewConnState()
+
+	go func() {
+		var payload bytes.Buffer
+		writeChatText(&payload, "Kicked by an operator")
+		_ = protocol.WritePacket(server, &protocol.Packet{ID: protocol.S2CKickDisconnect, Payload: payload.Bytes()}, -1)
+	}()
+
+	err := bot.handlePlayState(context.Background())
+	var disconnect *DisconnectError
+	if !errors.As(err, &disconnect) {
+		t.Fatalf("expected DisconnectError, got %v", err)
+	}
+	if disconnect.State != protocol.Play || disconnect.Kind != DisconnectKicked || disconnect.Reason != "Kicked by an operator" {
+		t.Fatalf("unexpected disconnect: %+v", disconnect)
+	}
+}
+
+func TestRunWithReconnectRetriesUntilBanned(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	defer ln.Close()
+
+	reasons := []string{
+		`{"translate":"multiplayer.disconnect.server_shutdown"}`,
+		`{"translate":"multiplayer.disconnect.banned"}`,
+	}
+	go func() {
+		for _, reason := range reasons {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			// Handshake and login start.
+			for i := 0; i < 2; i++ {
+				if _, err := protocol.ReadPacket(conn, -1); err != nil {
+					conn.Close()
+					return
+				}
+			}
+			var payload bytes.Buffer
+			_ = protocol.WriteString(&payload, reason)
+			_ = protocol.WritePacket(conn, &protocol.Packet{ID: protocol.S2CLoginDisconnect, Payload: payload.Bytes()}, -1)
+			conn.Close()
+		}
+	}()
+
+	bot := NewBot(ln.Addr().String(), "TestBot")
+	disconnects := make(chan event.DisconnectEvent, 4)
+	bot.Bus().Subscribe(event.EventDisconnect, func(raw any) {
+		if evt, ok := raw.(event.DisconnectEvent); ok {
+			disconnects <- evt
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err = bot.RunWithReconnect(ctx, ReconnectPolicy{InitialDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond, Multiplier: 2})
+
+	var disconnect *DisconnectError
+	if !errors.As(err, &disconnect) {
+		t.Fatalf("expected DisconnectError, got %v", err)
+	}
+	if disconnect.Kind != DisconnectBanned || disconnect.State != protocol.Login {
+		t.Fatalf("unexpected final disconnect: %+v", disconnect)
+	}
+
+	retried, gaveUp := false, false
+	for i := 0; i < 2; i++ {
+		select {
+		case evt := <-disconnects:
+			switch DisconnectKind(evt.Kind) {
+			case DisconnectServerClosed:
+				retried = evt.Retry
+			case DisconnectBanned:
+				gaveUp = !evt.Retry
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for disconnect events")
+		}
+	}
+	if !retried || !gaveUp {
+		t.Fatalf("expected a retry after shutdown and no retry after ban (retried=%v gaveUp=%v)", retried, gaveUp)
+	}
+}
+
+func TestBeginPlayPublishesReconnect(t *testing.T) {
+	bot := newChatTestBot()
+	reconnects := make(chan event.ReconnectEvent, 2)
+	bot.eventBus.Subscribe(event.EventReconnect, func(raw any) {
+		if evt, ok := raw.(event.ReconnectEvent); ok {
+			reconnects <- evt
+		}
+	})
+
+	bot.beginPlay()
+	bot.endSession()
+	bot.beginPlay()
+
+	select {
+	case evt := <-reconnects:
+		if evt.Attempt != 1 {
+			t.Fatalf("Attempt = %d, want 1", evt.Attempt)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for reconnect event")
+	}
+	select {
+	case evt := <-reconnects:
+		t.Fatalf("first session should not count as a reconnect: %+v", evt)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestPingServer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	defer ln.Close()
+
+	const statusJSON = `{"version":{"name":"1.21.11","protocol":774},"players":{"max":20,"online":1,"sample":[{"name":"Steve","id":"069a79f4-44e9-4726-a5be-fca90e38aaf5"}]},"description":{"text":"Locus test"}}`
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+
+		p, err := protocol.ReadPacket(conn, -1)
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		handshake, err := protocol.ParseHandshake(bytes.NewReader(p.Payload))
+		if err != nil || handshake.NextState != protocol.NextStateStatus {
+			serverErr <- errors.New("expected status handshake")
+			return
+		}
+		if p, err = protocol.ReadPacket(conn, -1); err != nil || p.ID != protocol.C2SStatusRequest {
+			serverErr <- errors.New("expected status request")
+			return
+		}
+		var resp bytes.Buffer
+		_ = protocol.WriteString(&resp, statusJSON)
+		_ = protocol.WritePacket(conn, &protocol.Packet{ID: protocol.S2CStatusResponse, Payload: resp.Bytes()}, -1)
+
+		p, err = protocol.ReadPacket(conn, -1)
+		if err != nil || p.ID != protocol.C2SStatusPing {
+			serverErr <- errors.New("expected ping")
+			return
+		}
+		_ = protocol.WritePacket(conn, &protocol.Packet{ID: protocol.S2CStatusPong, Payload: p.Payload}, -1)
+		serverErr <- nil
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	status, err := PingServer(ctx, ln.Addr().String())
+	if err != nil {
+		t.Fatalf("PingServer failed: %v", err)
+	}
+	if err := <-serverErr; err != nil {
+		t.Fatalf("server: %v", err)
+	}
+	if !status.Compatible(protocol.NewVersionRegistry(protocol.DefaultVersion())) {
+		t.Fatalf("expected compatible protocol, got %+v", status.Response.Version)
+	}
+	if status.Compatible(protocol.NewVersionRegistry(&protocol.Version{Protocol: 999, Name: "1.21.99"})) {
+		t.Fatal("a registry without the server's protocol should be incompatible")
+	}
+	if status.Response.Description != "Locus test" || status.Response.Players.Online != 1 {
+		t.Fatalf("unexpected status: %+v", status.Response)
+	}
+	if status.Latency <= 0 {
+		t.Fatalf("latency = %s, want > 0", status.Latency)
+	}
+}
+
+func TestSplitServerAddr(t *testing.T) {
+	tests := []struct {
+		addr    string
+		host    string
+		port    uint16
+		wantErr bool
+	}{
+		{"mc.example.com", "mc.example.com", 25565, false},
+		{"mc.example.com:25566", "mc.example.com", 25566, false},
+		{"127.0.0.1:70000", "", 0, true},
+	}
+	for _, tt := range tests {
+		host, port, err := splitServerAddr(tt.addr)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("splitServerAddr(%q) err = %v", tt.addr, err)
+		}
+		if !tt.wantErr && (host != tt.host || port != tt.port) {
+			t.Fatalf("splitServerAddr(%q) = %s:%d", tt.addr, host, port)
+		}
+	}
+}
+
+// writeTestVersionDir creates a version directory whose play keep-alive
+// packets swap IDs with another packet in both directions, so translation is
+// observable on the wire.
+func writeTestVersionDir(t *testing.T, root, name string, protocolNumber int32) string {
+	t.Helper()
+	src := protocol.DefaultVersion().DataDir
+	dir := filepath.Join(root, name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"blocks.json", "items.json", "entities.json"} {
+		data, err := os.ReadFile(filepath.Join(src, file))
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, file), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(src, "protocol.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatal(err)
+	}
+	play := schema["play"].(map[string]any)
+	for _, dir := range []string{"toClient", "toServer"} {
+		packet := play[dir].(map[string]any)["types"].(map[string]any)["packet"].([]any)
+		mapper := packet[1].([]any)[0].(map[string]any)["type"].([]any)[1].(map[string]any)
+		mappings := mapper["mappings"].(map[string]any)
+		var keepAliveID string
+		for id, name := range mappings {
+			if name == "keep_alive" {
+				keepAliveID = id
+			}
+		}
+		mappings[keepAliveID], mappings["0x00"] = mappings["0x00"], mappings[keepAliveID]
+	}
+	data, _ = json.Marshal(schema)
+	if err := os.WriteFile(filepath.Join(dir, "protocol.json"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	version := fmt.Sprintf(`{"version":%d,"minecraftVersion":%q}`, protocolNumber, name)
+	if err := os.WriteFile(filepath.Join(dir, "version.json"), []byte(version), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestSetVersionTranslatesPacketIDs(t *testing.T) {
+	v, err := protocol.LoadVersion(writeTestVersionDir(t, t.TempDir(), "1.21.99", 999))
+	if err != nil {
+		t.Fatalf("LoadVersion failed: %v", err)
+	}
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := NewBot("localhost:25565", "TestBot")
+	if err := bot.SetVersion(v); err != nil {
+		t.Fatalf("SetVersion failed: %v", err)
+	}
+	t.Cleanup(func() { world.UseGameData(nil) })
+	if bot.Version() != v {
+		t.Fatalf("Version() = %s, want %s", bot.Version(), v)
+	}
+	bot.conn = client
+	bot.connState = protocol.NewConnState()
+	bot.connState.Set(protocol.Play)
+
+	go func() {
+		_ = protocol.WritePacket(server, &protocol.Packet{ID: 0x00, Payload: []byte{1, 2, 3, 4, 5, 6, 7, 8}}, -1)
+	}()
+	packet, err := bot.readPacket()
+	if err != nil {
+		t.Fatalf("readPacket failed: %v", err)
+	}
+	if packet.ID != protocol.S2CPlayKeepAlive {
+		t.Fatalf("read ID = 0x%02X, want canonical keep alive 0x%02X", packet.ID, protocol.S2CPlayKeepAlive)
+	}
+
+	keepAlive := protocol.CreateKeepAlivePacket(42, protocol.C2SPlayKeepAlive)
+	go func() {
+		_ = bot.writePacket(bot.conn, keepAlive, -1)
+	}()
+	got, err := protocol.ReadPacket(server, -1)
+	if err != nil {
+		t.Fatalf("server read failed: %v", err)
+	}
+	if got.ID != 0x00 {
+		t.Fatalf("wire ID = 0x%02X, want 0x00", got.ID)
+	}
+	if keepAlive.ID != protocol.C2SPlayKeepAlive {
+		t.Fatal("writePacket must not modify the caller's packet")
+	}
+}
+
+func TestLoginHandshakeUsesSelectedProtocol(t *testing.T) {
+	v, err := protocol.LoadVersion(writeTestVersionDir(t, t.TempDir(), "1.21.99", 999))
+	if err != nil {
+		t.Fatalf("LoadVersion failed: %v", err)
+	}
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := NewBot("localhost:25565", "TestBot")
+	if err := bot.SetVersion(v); err != nil {
+		t.Fatalf("SetVersion failed: %v", err)
+	}
+	t.Cleanup(func() { world.UseGameData(nil) })
+	bot.conn = client
+	bot.connState = protocol.NewConnState()
+
+	go func() { _ = bot.login(context.Background()) }()
+	p, err := protocol.ReadPacket(server, -1)
+	if err != nil {
+		t.Fatalf("read handshake failed: %v", err)
+	}
+	handshake, err := protocol.ParseHandshake(bytes.NewReader(p.Payload))
+	if err != nil {
+		t.Fatalf("parse handshake failed: %v", err)
+	}
+	if handshake.ProtocolVersion != 999 {
+		t.Fatalf("handshake protocol = %d, want 999", handshake.ProtocolVersion)
+	}
+}
+
+func TestSelectVersionByName(t *testing.T) {
+	root := t.TempDir()
+	writeTestVersionDir(t, root, "1.21.99", 999)
+	reg, err := protocol.DiscoverVersions(root)
+	if err != nil {
+		t.Fatalf("DiscoverVersions failed: %v", err)
+	}
+
+	v, err := SelectVersion(context.Background(), "unused:25565", reg, "1.21.99")
+	if err != nil || v.Protocol != 999 {
+		t.Fatalf("SelectVersion = %v, %v, want 1.21.99", v, err)
+	}
+	v, err = SelectVersion(context.Background(), "unused:25565", reg, "774")
+	if err != nil || !v.IsCanonical() {
+		t.Fatalf("SelectVersion(774) = %v, %v, want canonical", v, err)
+	}
+	if _, err := SelectVersion(context.Background(), "unused:25565", reg, "1.8.9"); err == nil {
+		t.Fatal("SelectVersion should fail for a version without data")
+	}
+}
+
+func TestCaptureRecordsBothDirections(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	var buf bytes.Buffer
+	capture, err := protocol.NewCaptureWriter(&buf, protocol.CurrentProtocolVersion)
+	if err != nil {
+		t.Fatalf("NewCaptureWriter failed: %v", err)
+	}
+	bot := newChatTestBot()
+	bot.conn = client
+	bot.connState = protocol.NewConnState()
+	bot.connState.Set(protocol.Play)
+	bot.SetCapture(capture)
+
+	go func() {
+		_ = protocol.WritePacket(server, protocol.CreateKeepAlivePacket(7, protocol.S2CPlayKeepAlive), -1)
+		_, _ = protocol.ReadPacket(server, -1)
+	}()
+	packet, err := bot.readPacket()
+	if err != nil {
+		t.Fatalf("readPacket failed: %v", err)
+	}
+	if err := bot.handlePlayPacket(context.Background(), packet); err != nil {
+		t.Fatalf("handlePlayPacket failed: %v", err)
+	}
+
+	reader, err := protocol.NewCaptureReader(&buf)
+	if err != nil {
+		t.Fatalf("NewCaptureReader failed: %v", err)
+	}
+	want := []struct {
+		dir protocol.Direction
+		id  int32
+	}{
+		{protocol.ToClient, protocol.S2CPlayKeepAlive},
+		{protocol.ToServer, protocol.C2SPlayKeepAlive},
+	}
+	for i, w := range want {
+		rec, err := reader.Next()
+		if err != nil {
+			t.Fatalf("record %d: %v", i, err)
+		}
+		if rec.Direction != w.dir || rec.State != protocol.Play || rec.Packet.ID != w.id {
+			t.Fatalf("record %d = %s %s 0x%02X, want %s play 0x%02X", i, rec.Direction, rec.State, rec.Packet.ID, w.dir, w.id)
+		}
+	}
+	if _, err := reader.Next(); err != io.EOF {
+		t.Fatalf("expected end of capture, got %v", err)
+	}
+}
+
+func TestReplayCaptureRebuildsWorld(t *testing.T) {
+	var buf bytes.Buffer
+	capture, err := protocol.NewCaptureWriter(&buf, protocol.CurrentProtocolVersion)
+	if err != nil {
+		t.Fatalf("NewCaptureWriter failed: %v", err)
+	}
+	record := func(dir protocol.Direction, state protocol.State, id int32, payload []byte) {
+		t.Helper()
+		if err := capture.Write(dir, state, &protocol.Packet{ID: id, Payload: payload}); err != nil {
+			t.Fatalf("capture write failed: %v", err)
+		}
+	}
+
+	// First session: join, load a chunk, spawn, see an entity and a notice, get kicked.
+	record(protocol.ToClient, protocol.Login, protocol.S2CLoginSuccess, nil)
+	record(protocol.ToClient, protocol.Play, protocol.S2CLogin, buildPlayLoginPayloadForTest("minecraft:overworld", 10))
+	record(protocol.ToClient, protocol.Play, protocol.S2CLevelChunkWithLight, buildChunkPacketPayload(t, 0, 0, map[int]int32{7: 1}))
+	var pos bytes.Buffer
+	_ = protocol.WriteVarint(&pos, 1)
+	for _, v := range []float64{8.5, 64, 8.5, 0, 0, 0} {
+		_ = protocol.WriteDouble(&pos, v)
+	}
+	_ = protocol.WriteFloat(&pos, 90)
+	_ = protocol.WriteFloat(&pos, 0)
+	_ = protocol.WriteInt32(&pos, 0)
+	record(protocol.ToClient, protocol.Play, protocol.S2CPlayerPosition, pos.Bytes())
+	record(protocol.ToServer, protocol.Play, protocol.C2STeleportConfirm, []byte{1})
+	var spawn bytes.Buffer
+	_ = protocol.WriteVarint(&spawn, 42)
+	_ = protocol.WriteUUID(&spawn, protocol.GenerateOfflineUUID("zombie"))
+	_ = protocol.WriteVarint(&spawn, 5)
+	for _, v := range []float64{10, 64, 10} {
+		_ = protocol.WriteDouble(&spawn, v)
+	}
+	_ = protocol.WriteLpVec3(&spawn, protocol.Vec3{})
+	spawn.Write([]byte{0, 0, 0})
+	_ = protocol.WriteVarint(&spawn, 0)
+	record(protocol.ToClient, protocol.Play, protocol.S2CSpawnEntity, spawn.Bytes())
+	var join bytes.Buffer
+	writeChatTranslate(&join, "multiplayer.player.joined", "Alex")
+	_ = protocol.WriteBool(&join, false)
+	record(protocol.ToClient, protocol.Play, protocol.S2CSystemChatMessage, join.Bytes())
+	var kick bytes.Buffer
+	writeChatText(&kick, "Server closed")
+	record(protocol.ToClient, protocol.Play, protocol.S2CKickDisconnect, kick.Bytes())
+
+	// Second session after a reconnect: a different chunk.
+	record(protocol.ToClient, protocol.Login, protocol.S2CLoginSuccess, nil)
+	record(protocol.ToClient, protocol.Play, protocol.S2CLevelChunkWithLight, buildChunkPacketPayload(t, 1, 1, map[int]int32{7: 1}))
+
+	bot := NewBot("replay", "Locus")
+	chats := subscribeChat(bot)
+	stats, err := bot.Replay(context.Background(), bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if stats.Inbound != 9 || stats.Outbound != 1 || stats.Sessions != 2 {
+		t.Fatalf("stats = %+v, want 9 inbound, 1 outbound, 2 sessions", stats)
+	}
+	if len(stats.Disconnects) != 1 || stats.Disconnects[0].Reason != "Server closed" {
+		t.Fatalf("disconnects = %+v, want the kick", stats.Disconnects)
+	}
+
+	if evt := waitChat(t, chats); evt.Notice == nil || evt.Notice.Player != "Alex" {
+		t.Fatalf("unexpected chat event: %+v", evt)
+	}
+	if got := bot.worldState.GetState().Position; got.X != 8.5 || got.Y != 64 || got.Yaw != 90 {
+		t.Fatalf("position = %+v, want replayed teleport", got)
+	}
+	if bot.blockStore.IsLoaded(0, 0) {
+		t.Fatal("chunk (0,0) should be cleared by the reconnect")
+	}
+	if !bot.blockStore.IsLoaded(1, 1) {
+		t.Fatal("chunk (1,1) from the second session should be loaded")
+	}
+	if bot.conn != nil {
+		t.Fatal("Replay should detach its fake connection")
+	}
+}
+
+func TestReplayAppliesConfigurationRegistriesAndTags(t *testing.T) {
+	var buf bytes.Buffer
+	capture, err := protocol.NewCaptureWriter(&buf, protocol.CurrentProtocolVersion)
+	if err != nil {
+		t.Fatalf("NewCaptureWriter failed: %v", err)
+	}
+	record := func(state protocol.State, packet *protocol.Packet) {
+		t.Helper()
+		if err := capture.Write(protocol.ToClient, state, packet); err != nil {
+			t.Fatalf("capture write failed: %v", err)
+		}
+	}
+	record(protocol.Configuration, protocol.CreateRegistryDataPacket(protocol.RegistryBiome, []string{"minecraft:plains", "minecraft:desert"}))
+	record(protocol.Configuration, protocol.CreateUpdateTagsPacket([]protocol.RegistryTags{
+		{Registry: "minecraft:block", Tags: []protocol.Tag{{Name: "minecraft:logs", Entries: []int32{49}}}},
+	}))
+	record(protocol.Play, &protocol.Packet{ID: protocol.S2CLevelChunkWithLight, Payload: buildChunkPacketPayload(t, 0, 0, nil)})
+
+	bot := NewBot("replay", "Locus")
+	if _, err := bot.Replay(context.Background(), bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if biome, ok := bot.GetBiome(1, 64, 1); !ok || biome.Name != "plains" {
+		t.Fatalf("GetBiome = %+v, %v; want plains from the replayed registry", biome, ok)
+	}
+	const oakLogState = 137
+	if !bot.BlockHasTag(oakLogState, "logs") {
+		t.Fatal("replayed block tags were not applied")
+	}
+}
+
+func TestEntityPacketsPopulateWorldEntity(t *testing.T) {
+	bot := NewBot("localhost:25565", "TestBot")
+	handle := func(packet *protocol.Packet) {
+		t.Helper()
+		if err := bot.handlePlayPacket(context.Background(), packet); err != nil {
+			t.Fatalf("handlePlayPacket(0x%02x) failed: %v", packet.ID, err)
+		}
+	}
+
+	var spawn bytes.Buffer
+	_ = protocol.WriteVarint(&spawn, 42)
+	_ = protocol.WriteUUID(&spawn, protocol.GenerateOfflineUUID("zombie"))
+	_ = protocol.WriteVarint(&spawn, 150) // zombie
+	for _, v := range []float64{10, 64, 10} {
+		_ = protocol.WriteDouble(&spawn, v)
+	}
+	_ = protocol.WriteLpVec3(&spawn, protocol.Vec3{Y: -0.0784})
+	spawn.Write([]byte{0, 0, 64})
+	_ = protocol.WriteVarint(&spawn, 0)
+	handle(&protocol.Packet{ID: protocol.S2CSpawnEntity, Payload: spawn.Bytes()})
+
+	handle(protocol.CreateEntityMetadataPacket(42, []protocol.EntityMetadataEntry{
+		{Index: 0, Type: protocol.MetadataByte, Value: world.EntityFlagOnFire},
+		{Index: 2, Type: protocol.MetadataOptionalComponent, Value: "Bob"},
+		{Index: 9, Type: protocol.MetadataFloat, Value: float32(12)},
+		{Index: 16, Type: protocol.MetadataBoolean, Value: true},
+	}))
+	handle(protocol.CreateEntityEquipmentPacket(42, []protocol.EquipmentEntry{
+		{Slot: protocol.EquipmentMainHand, Item: protocol.Slot{ItemID: 1031, Count: 1}},
+		{Slot: protocol.EquipmentHead, Item: protocol.Slot{ItemID: 1031, Count: 1}},
+	}))
+	handle(protocol.CreateEntityAttributesPacket(42, []protocol.Attribute{{ID: protocol.AttributeMaxHealth, Base: 20}}))
+	var velocity bytes.Buffer
+	_ = protocol.WriteVarint(&velocity, 42)
+	_ = protocol.WriteLpVec3(&velocity, protocol.Vec3{X: 0.5})
+	handle(&protocol.Packet{ID: protocol.S2CEntityVelocity, Payload: velocity.Bytes()})
+	handle(&protocol.Packet{ID: protocol.S2CEntityHeadRotation, Payload: []byte{42, 0xc0}})
+
+	entities := bot.worldState.GetState().Entities
+	if len(entities) != 1 {
+		t.Fatalf("entities = %+v, want the zombie", entities)
+	}
+	e := entities[0]
+	if !e.Baby || !e.OnFire || e.CustomName != "Bob" || e.Health != 12 || e.MaxHealth != 20 {
+		t.Fatalf("metadata not applied: %+v", e)
+	}
+	if e.MainHand != "Egg" || e.Armor[world.EquipmentHead-world.EquipmentFeet] != "Egg" {
+		t.Fatalf("equipment not applied: %+v", e)
+	}
+	if math.Abs(e.VelX-0.5) > 1e-3 || e.VelY != 0 || e.HeadYaw != -90 {
+		t.Fatalf("velocity/head yaw = (%v, %v) %v, want (0.5, 0) -90", e.VelX, e.VelY, e.HeadYaw)
+	}
+}
+
+func TestPlayerInfoUpdatesAndChatSenderEntity(t *testing.T) {
+	bot := newChatTestBot()
+	chats := subscribeChat(bot)
+	steve := protocol.GenerateOfflineUUID("Steve")
+
+	bot.handlePlayerInfo(protocol.CreatePlayerInfoPacket(
+		protocol.PlayerInfoAddPlayer|protocol.PlayerInfoUpdateGameMode|protocol.PlayerInfoUpdateListed,
+		[]protocol.Player{{UUID: steve, Name: "Steve", GameMode: 0, Listed: true}},
+	).Payload)
+	bot.handlePlayerInfo(protocol.CreatePlayerInfoPacket(
+		protocol.PlayerInfoUpdateLatency|protocol.PlayerInfoUpdateGameMode,
+		[]protocol.Player{{UUID: steve, Latency: 35, GameMode: 1}},
+	).Payload)
+	bot.worldState.AddEntity(world.Entity{EntityID: 12, UUID: steve.String(), Type: 155})
+
+	player, ok := bot.worldState.GetState().PlayerByName("Steve")
+	if !ok || player.GameMode != "creative" || !player.Listed || player.Latency != 35 || player.EntityID != 12 {
+		t.Fatalf("player = %+v, %v, want creative, listed, 35ms, entity 12", player, ok)
+	}
+
+	// Disguised chat has no sender UUID; the name resolves through the player list.
+	var buf bytes.Buffer
+	writeChatText(&buf, "follow me")
+	_ = protocol.WriteVarint(&buf, protocol.ChatTypeChat+1)
+	writeChatText(&buf, "Steve")
+	_ = protocol.WriteBool(&buf, false)
+	bot.handleDisguisedChat(context.Background(), buf.Bytes())
+	if evt := waitChat(t, chats); evt.EntityID != 12 {
+		t.Fatalf("chat EntityID = %d, want 12", evt.EntityID)
+	}
+}
+
+func TestPlayerStatusPacketsUpdateWorldState(t *testing.T) {
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.setSelfEntityID(3)
+	handle := func(packet *protocol.Packet) {
+		t.Helper()
+		if err := bot.handlePlayPacket(context.Background(), packet); err != nil {
+			t.Fatalf("handlePlayPacket(0x%02x) failed: %v", packet.ID, err)
+		}
+	}
+
+	var exp bytes.Buffer
+	_ = protocol.WriteFloat(&exp, 0.5)
+	_ = protocol.WriteVarint(&exp, 4)
+	_ = protocol.WriteVarint(&exp, 60)
+	handle(&protocol.Packet{ID: protocol.S2CExperience, Payload: exp.Bytes()})
+	handle(protocol.CreateEntityEffectPacket(protocol.EntityEffect{EntityID: 3, EffectID: 0, Amplifier: 1, Duration: 200}))
+	handle(protocol.CreateEntityEffectPacket(protocol.EntityEffect{EntityID: 3, EffectID: 7, Duration: -1}))
+	// Another entity's effect is not ours.
+	handle(protocol.CreateEntityEffectPacket(protocol.EntityEffect{EntityID: 9, EffectID: 1, Duration: 200}))
+	handle(&protocol.Packet{ID: protocol.S2CRemoveEntityEffect, Payload: []byte{3, 7}})
+	handle(protocol.CreatePlayerAbilitiesPacket(protocol.PlayerAbilities{
+		Flags:       protocol.AbilityFlying | protocol.AbilityAllowFlying | protocol.AbilityCreativeMode,
+		FlyingSpeed: 0.05, WalkingSpeed: 0.1,
+	}))
+	handle(protocol.CreateGameEventPacket(protocol.GameEventChangeGameMode, 1))
+
+	snap := bot.worldState.GetState()
+	if snap.Experience != (world.Experience{Level: 4, Progress: 0.5, Total: 60}) {
+		t.Fatalf("experience = %+v", snap.Experience)
+	}
+	if len(snap.Effects) != 1 || snap.EffectLevel("speed") != 2 {
+		t.Fatalf("effects = %+v, want only Speed II", snap.Effects)
+	}
+	if !snap.Abilities.Flying || !snap.Abilities.CreativeMode || snap.Abilities.FlySpeed != 0.05 {
+		t.Fatalf("abilities = %+v", snap.Abilities)
+	}
+	if snap.GameMode != "creative" {
+		t.Fatalf("game mode = %q, want creative", snap.GameMode)
+	}
+}
+
+func healthPacket(health float32) *protocol.Packet {
+	var buf bytes.Buffer
+	_ = protocol.WriteFloat(&buf, health)
+	_ = protocol.WriteVarint(&buf, 20)
+	_ = protocol.WriteFloat(&buf, 5)
+	return &protocol.Packet{ID: protocol.S2CUpdateHealth, Payload: buf.Bytes()}
+}
+
+func TestDeathCombatEventRecordsDeathAndPublishes(t *testing.T) {
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.setSelfEntityID(3)
+	bot.SetManualRespawn(true)
+	bot.worldState.UpdateDimensionContext("minecraft:overworld", 10)
+	bot.worldState.UpdatePosition(world.Position{X: 12, Y: 40, Z: -7})
+
+	deaths := make(chan event.DeathEvent, 4)
+	bot.eventBus.Subscribe(event.EventDeath, func(raw any) {
+		deaths <- raw.(event.DeathEvent)
+	})
+
+	ctx := context.Background()
+	// Another player's death is only a chat message for us.
+	if err := bot.handlePlayPacket(ctx, protocol.CreateDeathCombatEventPacket(8, "Alex drowned")); err != nil {
+		t.Fatalf("handlePlayPacket failed: %v", err)
+	}
+	if err := bot.handlePlayPacket(ctx, protocol.CreateDeathCombatEventPacket(3, "TestBot fell from a high place")); err != nil {
+		t.Fatalf("handlePlayPacket failed: %v", err)
+	}
+	// With manual respawn no connection is needed: nothing is sent.
+	if err := bot.handlePlayPacket(ctx, healthPacket(0)); err != nil {
+		t.Fatalf("zero health with manual respawn: %v", err)
+	}
+
+	want := event.DeathEvent{Message: "TestBot fell from a high place", X: 12, Y: 40, Z: -7, Dimension: "minecraft:overworld"}
+	select {
+	case got := <-deaths:
+		if got != want {
+			t.Fatalf("death = %+v, want %+v", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for death event")
+	}
+	select {
+	case got := <-deaths:
+		t.Fatalf("unexpected second death event %+v", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+	last := bot.worldState.GetState().LastDeath
+	if last == nil || last.X != 12 || last.Message != want.Message {
+		t.Fatalf("last death = %+v", last)
+	}
+}
+
+func TestZeroHealthRespawnsAutomatically(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.conn = client
+	bot.connState = newPlayConnState()
+
+	errCh := make(chan error, 1)
+	go func() {
+		packet, err := protocol.ReadPacket(server, bot.connState.GetThreshold())
+		if err == nil && (packet.ID != protocol.C2SClientCommand || !bytes.Equal(packet.Payload, []byte{0})) {
+			err = fmt.Errorf("got packet 0x%02x %v, want respawn client command", packet.ID, packet.Payload)
+		}
+		errCh <- err
+	}()
+
+	if err := bot.handlePlayPacket(context.Background(), healthPacket(0)); err != nil {
+		t.Fatalf("handlePlayPacket failed: %v", err)
+	}
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for respawn packet")
+	}
+}
+
+func TestDamagePacketsRecordSource(t *testing.T) {
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.setSelfEntityID(3)
+	bot.handleRegistryData(protocol.CreateRegistryDataPacket(protocol.RegistryDamageType, []string{"minecraft:arrow", "minecraft:mob_attack"}).Payload)
+	bot.worldState.AddEntity(world.Entity{EntityID: 40, Type: 1, X: 4, Y: 64, Z: 0})
+
+	ctx := context.Background()
+	for _, packet := range []*protocol.Packet{
+		// A hit on another entity is not ours.
+		protocol.CreateDamageEventPacket(protocol.DamageEvent{EntityID: 9, SourceTypeID: 0, SourceCauseID: 3, SourceDirectID: 3}),
+		protocol.CreateDamageEventPacket(protocol.DamageEvent{EntityID: 3, SourceTypeID: 1, SourceCauseID: 40, SourceDirectID: 40}),
+		protocol.CreateHurtAnimationPacket(3, 90),
+	} {
+		if err := bot.handlePlayPacket(ctx, packet); err != nil {
+			t.Fatalf("handlePlayPacket(0x%02x) failed: %v", packet.ID, err)
+		}
+	}
+
+	damage := bot.worldState.GetState().LastDamage
+	if damage == nil {
+		t.Fatal("expected a recorded damage source")
+	}
+	if damage.Type != "mob_attack" || damage.SourceEntityID != 40 || damage.DirectEntityID != 40 {
+		t.Fatalf("damage = %+v, want mob_attack from entity 40", damage)
+	}
+	if !damage.HasSourcePos || damage.SourceX != 4 || !damage.HasHurtYaw || damage.HurtYaw != 90 {
+		t.Fatalf("damage position/direction = %+v", damage)
+	}
+}
+
+func TestWeatherAndWorldBorderPackets(t *testing.T) {
+	bot := NewBot("localhost:25565", "TestBot")
+	ctx := context.Background()
+	var center bytes.Buffer
+	_ = protocol.WriteDouble(&center, 50)
+	_ = protocol.WriteDouble(&center, -50)
+	for _, packet := range []*protocol.Packet{
+		protocol.CreateGameEventPacket(protocol.GameEventBeginRaining, 0),
+		protocol.CreateGameEventPacket(protocol.GameEventRainLevelChange, 1),
+		protocol.CreateGameEventPacket(protocol.GameEventThunderLevelChange, 0.5),
+		protocol.CreateInitializeWorldBorderPacket(protocol.InitializeWorldBorder{OldDiameter: 1000, NewDiameter: 1000}),
+		{ID: protocol.S2CWorldBorderCenter, Payload: center.Bytes()},
+		protocol.CreateWorldBorderLerpSizePacket(1000, 200, 600),
+	} {
+		if err := bot.handlePlayPacket(ctx, packet); err != nil {
+			t.Fatalf("handlePlayPacket(0x%02x) failed: %v", packet.ID, err)
+		}
+	}
+
+	snap := bot.worldState.GetState()
+	if !snap.Weather.Raining || snap.Weather.Name() != "rain" || snap.Weather.ThunderLevel != 0.5 {
+		t.Fatalf("weather = %+v (%s), want rain", snap.Weather, snap.Weather.Name())
+	}
+	// The 600 tick resize has only just started.
+	border := snap.WorldBorder
+	if !border.Known || border.CenterX != 50 || border.CenterZ != -50 || border.Diameter < 999 || border.TargetDiameter != 200 {
+		t.Fatalf("world border = %+v, want 1000 shrinking to 200 around 50,-50", border)
+	}
+}
+
+func TestSoundPacketsAreRemembered(t *testing.T) {
+	bot := NewBot("localhost:25565", "TestBot")
+	ctx := context.Background()
+	bot.worldState.AddEntity(world.Entity{EntityID: 12, Type: 32, X: 4, Y: 65, Z: 4})
+	for _, packet := range []*protocol.Packet{
+		protocol.CreateSoundEffectPacket(protocol.SoundEffect{SoundID: 669, Category: 4, X: -8, Y: 64, Z: 2, Volume: 4}),
+		protocol.CreateEntitySoundEffectPacket(protocol.EntitySoundEffect{SoundName: "minecraft:entity.creeper.primed", Category: 5, EntityID: 12, Volume: 1}),
+		// Entity 13 is not tracked, so its sound is dropped.
+		protocol.CreateEntitySoundEffectPacket(protocol.EntitySoundEffect{SoundID: 451, Category: 5, EntityID: 13, Volume: 1}),
+	} {
+		if err := bot.handlePlayPacket(ctx, packet); err != nil {
+			t.Fatalf("handlePlayPacket(0x%02x) failed: %v", packet.ID, err)
+		}
+	}
+
+	sounds := bot.worldState.GetState().Sounds
+	if len(sounds) != 2 {
+		t.Fatalf("sounds = %+v, want 2", sounds)
+	}
+	if s := sounds[0]; s.Name != "entity.generic.explode" || s.Category != "block" || s.X != -8 || s.Volume != 4 {
+		t.Fatalf("positional sound = %+v", s)
+	}
+	if s := sounds[1]; s.Name != "entity.creeper.primed" || s.Category != "hostile" || s.EntityID != 12 || s.X != 4 {
+		t.Fatalf("entity sound = %+v", s)
+	}
+}
+
+func TestExplosionPacketIsHeard(t *testing.T) {
+	bot := NewBot("localhost:25565", "TestBot")
+	packet := protocol.CreateExplosionPacket(protocol.Explosion{
+		X: 10, Y: 64, Z: -3, Radius: 4, Particle: protocol.Particle{Type: 23}, SoundID: 669,
+	})
+	if err := bot.handlePlayPacket(context.Background(), packet); err != nil {
+		t.Fatalf("handlePlayPacket(explosion) failed: %v", err)
+	}
+
+	sounds := bot.worldState.GetState().Sounds
+	if len(sounds) != 1 {
+		t.Fatalf("sounds = %+v, want the explosion", sounds)
+	}
+	if s := sounds[0]; s.Name != "entity.generic.explode" || s.Category != "block" || s.X != 10 || s.Z != -3 {
+		t.Fatalf("explosion sound = %+v", s)
+	}
+}
+
+func TestRegistryDataAndTagsAreStored(t *testing.T) {
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.handleRegistryData(protocol.CreateDimensionTypeRegistryPacket(
+		[]string{"minecraft:overworld", "custom:sky"},
+		[]protocol.DimensionType{{MinY: -64, Height: 384, LogicalHeight: 384}, {MinY: 64, Height: 256, LogicalHeight: 256}},
+	).Payload)
+	bot.handleUpdateTags(protocol.CreateUpdateTagsPacket([]protocol.RegistryTags{
+		{Registry: "minecraft:block", Tags: []protocol.Tag{{Name: "minecraft:logs", Entries: []int32{49}}}},
+	}).Payload)
+
+	const oakLogState = 137
+	if !bot.BlockHasTag(oakLogState, "logs") || bot.BlockHasTag(1, "logs") {
+		t.Fatal("block tags from configuration were not applied")
+	}
+	if keys := bot.Registries().Keys("dimension_type"); len(keys) != 2 || keys[1] != "custom:sky" {
+		t.Fatalf("dimension_type keys = %v", keys)
+	}
+
+	// A datapack reload in Play replaces the block tags.
+	reload := protocol.CreateUpdateTagsPacket([]protocol.RegistryTags{
+		{Registry: "minecraft:block", Tags: []protocol.Tag{{Name: "minecraft:logs", Entries: []int32{1}}}},
+	})
+	reload.ID = protocol.S2CUpdateTags
+	if err := bot.handlePlayPacket(context.Background(), reload); err != nil {
+		t.Fatalf("handlePlayPacket(update tags) failed: %v", err)
+	}
+	if bot.BlockHasTag(oakLogState, "logs") || !bot.BlockHasTag(1, "logs") {
+		t.Fatal("block tags were not replaced on reload")
+	}
+
+	bounds, ok := bot.updateDimensionBounds(1, "custom:sky_realm")
+	if !ok || bounds != (world.DimensionBounds{MinY: 64, Height: 256}) {
+		t.Fatalf("dimension bounds = %+v, %v", bounds, ok)
+	}
+	parsed := make([]protocol.ChunkSection, 16)
+	for i := range parsed {
+		states := make([]int32, world.BlocksPerSection)
+		states[0] = int32(100 + i)
+		parsed[i] = protocol.ChunkSection{BlockStates: states}
+	}
+	sections, _, err := bot.normalizeChunkSections(&protocol.LevelChunkWithLight{Sections: parsed, SectionCount: len(parsed)})
+	if err != nil {
+		t.Fatalf("normalizeChunkSections failed: %v", err)
+	}
+	// The store is sized to the dimension, so its sections start at min_y 64.
+	if len(sections) != 16 || sections[0].BlockStates[0] != 100 || sections[15].BlockStates[0] != 115 {
+		t.Fatalf("sections not placed at min_y: len=%d", len(sections))
+	}
+	if minY, _ := bot.blockStore.Height(); minY != 64 {
+		t.Fatalf("block store min_y = %d, want 64", minY)
+	}
+}
+
+func TestChunkCacheRemembersUnloadedChunksAcrossSessions(t *testing.T) {
+	dir := t.TempDir()
+	bot := NewBot("localhost:25565", "TestBot")
+	bot.worldState.UpdateDimensionContext(world.DimensionOverworld, 10)
+	closer, err := bot.OpenChunkCache(dir)
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+
+	sections := make([]world.ChunkSection, world.ChunkSectionCount)
+	for i := range sections {
+		sections[i] = world.ChunkSection{BlockStates: make([]int32, world.BlocksPerSection)}
+	}
+	// Global (1,63,2) is section 7, local y 15.
+	sections[7].BlockStates[15*16*16+2*16+1] = 1234
+	if err := bot.blockStore.StoreChunk(0, 0, sections); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	unloadPayload := new(bytes.Buffer)
+	_ = protocol.WriteInt32(unloadPayload, 0) // chunkZ first
+	_ = protocol.WriteInt32(unloadPayload, 0) // chunkX
+	bot.handleUnloadChunk(unloadPayload.Bytes())
+
+	if _, ok := bot.GetBlockState(1, 63, 2); ok {
+		t.Fatal("unloaded chunk should not be live")
+	}
+	if state, _, ok := bot.LastKnownBlockState(1, 63, 2); !ok || state != 1234 {
+		t.Fatalf("LastKnownBlockState = %d, %v; want 1234", state, ok)
+	}
+	if err := closer.Close(); err != nil {
+		t.Fatalf("closing chunk cache failed: %v", err)
+	}
+
+	restarted := NewBot("localhost:25565", "TestBot")
+	restarted.worldState.UpdateDimensionContext(world.DimensionOverworld, 10)
+	if _, err := restarted.OpenChunkCache(dir); err != nil {
+		t.Fatalf("OpenChunkCache after restart failed: %v", err)
+	}
+	if state, _, ok := restarted.LastKnownBlockState(1, 63, 2); !ok || state != 1234 {
+		t.Fatalf("LastKnownBlockState after restart = %d, %v; want 1234", state, ok)
+	}
+	// Pathfinding plans through the remembered chunk.
+	if state, ok := skill.KnownBlocks(restarted).GetBlockState(1, 63, 2); !ok || state != 1234 {
+		t.Fatalf("KnownBlocks state after restart = %d, %v; want 1234", state, ok)
+	}
+}
+
+func TestChunkCacheIsSavedPeriodically(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := world.OpenChunkCache(dir)
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	if err := cache.SetDimension(world.DimensionOverworld); err != nil {
+		t.Fatalf("SetDimension failed: %v", err)
+	}
+	blockStore, err := world.NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	blockStore.SetChunkCache(cache)
+	sections := make([]world.ChunkSection, world.ChunkSectionCount)
+	for i := range sections {
+		sections[i] = world.ChunkSection{BlockStates: make([]int32, world.BlocksPerSection)}
+	}
+	if err := blockStore.StoreChunk(3, 4, sections); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		saveChunkCacheEvery(blockStore, 10*time.Millisecond, stop)
+	}()
+	regionFile := filepath.Join(dir, "overworld.chunks")
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if _, err := os.Stat(regionFile); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("loaded chunk was not saved before the session ended")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	close(stop)
+	<-done
+
+	// A fresh cache, as after a crash, sees the still-loaded chunk.
+	restarted, err := world.OpenChunkCache(dir)
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	if err := restarted.SetDimension(world.DimensionOverworld); err != nil {
+		t.Fatalf("SetDimension failed: %v", err)
+	}
+	if _, ok := restarted.SeenAt(3, 4); !ok {
+		t.Fatal("periodically saved cache should hold chunk (3,4)")
+	}
+}
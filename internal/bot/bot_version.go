@@ -0,0 +1,101 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+// SetVersion selects the protocol version to log in with. Packet IDs are
+// translated to and from the canonical constants, and block, item and entity
+// tables are reloaded from the version's data directory. Call it before Start.
+func (b *Bot) SetVersion(v *protocol.Version) error {
+	if v == nil {
+		return fmt.Errorf("version is nil")
+	}
+	data, err := world.LoadGameData(v.DataDir)
+	if err != nil {
+		return fmt.Errorf("load %s data: %w", v, err)
+	}
+	blockStore, err := data.NewBlockStore()
+	if err != nil {
+		return fmt.Errorf("load %s blocks: %w", v, err)
+	}
+	blockStore.SetRegistries(b.registries)
+
+	b.mu.Lock()
+	b.version = v
+	b.blockStore = blockStore
+	b.mu.Unlock()
+	// Item, entity and sound names and the recipe book are package-level
+	// lookups shared by the agent.
+	world.UseGameData(data)
+	slog.Info("Using protocol version", "version", v.String(), "data_dir", v.DataDir)
+	return nil
+}
+
+// Version returns the protocol version used for the next login.
+func (b *Bot) Version() *protocol.Version {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.version == nil {
+		return protocol.DefaultVersion()
+	}
+	return b.version
+}
+
+// readPacket reads the next packet and rewrites its ID to the canonical
+// constant, so handlers never see version-specific IDs.
+func (b *Bot) readPacket() (*protocol.Packet, error) {
+	b.mu.RLock()
+	conn, version, capture := b.conn, b.version, b.capture
+	b.mu.RUnlock()
+	packet, err := protocol.ReadPacket(conn, b.connState.GetThreshold())
+	if err != nil {
+		return nil, err
+	}
+	packet.ID = version.CanonicalID(b.connState.Get(), protocol.ToClient, packet.ID)
+	b.recordPacket(capture, protocol.ToClient, packet)
+	return packet, nil
+}
+
+// writePacketLocked translates a canonical packet ID for the server's version.
+// b.mu must be held.
+func (b *Bot) writePacketLocked(w io.Writer, packet *protocol.Packet, threshold int) error {
+	b.recordPacket(b.capture, protocol.ToServer, packet)
+	if !b.version.IsCanonical() && b.connState != nil {
+		id, err := b.version.WireID(b.connState.Get(), protocol.ToServer, packet.ID)
+		if err != nil {
+			return err
+		}
+		packet = &protocol.Packet{ID: id, Payload: packet.Payload}
+	}
+	return protocol.WritePacket(w, packet, threshold)
+}
+
+// SelectVersion picks the version to log in with. An empty or "auto"
+// requested version pings the server and matches its protocol number.
+func SelectVersion(ctx context.Context, addr string, reg *protocol.VersionRegistry, requested string) (*protocol.Version, error) {
+	if requested != "" && requested != "auto" {
+		v, ok := reg.Lookup(requested)
+		if !ok {
+			return nil, fmt.Errorf("no data for version %q", requested)
+		}
+		return v, nil
+	}
+	status, err := PingServer(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("ping server for version: %w", err)
+	}
+	serverVersion := status.Response.Version
+	v, ok := reg.ByProtocol(serverVersion.Protocol)
+	if !ok {
+		return nil, fmt.Errorf("server runs %s (protocol %d), which has no data directory",
+			serverVersion.Name, serverVersion.Protocol)
+	}
+	return v, nil
+}
@@ -0,0 +1,50 @@
+package bot
+
+import (
+	"bytes"
+	"log/slog"
+
+	"github.com/Versifine/locus/internal/protocol"
+	"github.com/Versifine/locus/internal/world"
+)
+
+func (b *Bot) handleInitializeWorldBorder(payload []byte) {
+	border, err := protocol.ParseInitializeWorldBorder(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse world border", "error", err)
+		return
+	}
+	b.worldState.UpdateWorldBorder(world.WorldBorder{
+		CenterX:        border.X,
+		CenterZ:        border.Z,
+		Diameter:       border.OldDiameter,
+		TargetDiameter: border.NewDiameter,
+	}, border.Speed)
+}
+
+func (b *Bot) handleWorldBorderCenter(payload []byte) {
+	center, err := protocol.ParseWorldBorderCenter(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse world border center", "error", err)
+		return
+	}
+	b.worldState.UpdateWorldBorderCenter(center.X, center.Z)
+}
+
+func (b *Bot) handleWorldBorderLerpSize(payload []byte) {
+	lerp, err := protocol.ParseWorldBorderLerpSize(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse world border resize", "error", err)
+		return
+	}
+	b.worldState.UpdateWorldBorderSize(lerp.OldDiameter, lerp.NewDiameter, lerp.Speed)
+}
+
+func (b *Bot) handleWorldBorderSize(payload []byte) {
+	diameter, err := protocol.ParseWorldBorderSize(bytes.NewReader(payload))
+	if err != nil {
+		slog.Warn("Failed to parse world border size", "error", err)
+		return
+	}
+	b.worldState.UpdateWorldBorderSize(diameter, diameter, 0)
+}
@@ -17,7 +17,40 @@ type Config struct {
 	AgentLegacyChat bool          `yaml:"agent_legacy_chat"`
 }
 type BotConfig struct {
-	Username string `yaml:"username"`
+	Username  string          `yaml:"username"`
+	Reconnect ReconnectConfig `yaml:"reconnect"`
+	Auth      AuthConfig      `yaml:"auth"`
+	// Version is a Minecraft version name or protocol number; empty or
+	// "auto" asks the server.
+	Version string `yaml:"version"`
+	// DataDir holds one minecraft-data directory per version. Empty looks
+	// for the bundled 1.21.11 directory.
+	DataDir string `yaml:"data_dir"`
+	// CaptureFile, when set, records every packet for `locus replay`.
+	CaptureFile string `yaml:"capture_file"`
+	// ChunkCacheDir, when set, keeps explored chunks on disk so the bot
+	// remembers areas it has left, across restarts.
+	ChunkCacheDir string `yaml:"chunk_cache_dir"`
+	// ManualRespawn leaves respawning after death to the agent's respawn
+	// tool instead of respawning right away.
+	ManualRespawn bool `yaml:"manual_respawn"`
+}
+
+// AuthConfig enables online-mode login. Leave AccessToken empty for offline
+// servers.
+type AuthConfig struct {
+	AccessToken     string `yaml:"access_token"`
+	ProfileID       string `yaml:"profile_id"`
+	SessionEndpoint string `yaml:"session_endpoint"`
+}
+
+// ReconnectConfig tunes automatic reconnects. Zero values keep the defaults;
+// reconnecting is on unless Disabled is set.
+type ReconnectConfig struct {
+	Disabled       bool `yaml:"disabled"`
+	InitialDelayMs int  `yaml:"initial_delay_ms"`
+	MaxDelayMs     int  `yaml:"max_delay_ms"`
+	MaxAttempts    int  `yaml:"max_attempts"`
 }
 
 type LLMConfig struct {
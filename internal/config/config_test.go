@@ -31,6 +31,17 @@ logging:
 mode: "bot"
 bot:
   username: "TestBot"
+  reconnect:
+    initial_delay_ms: 500
+    max_attempts: 3
+  auth:
+    access_token: "mc-token"
+    profile_id: "069a79f444e94726a5befca90e38aaf5"
+  version: "1.21.11"
+  data_dir: "/opt/locus/data"
+  capture_file: "session.lcap"
+  chunk_cache_dir: "cache/chunks"
+  manual_respawn: true
 llm:
   model: "gpt-4"
   api_key: "secret"
@@ -63,6 +74,24 @@ llm:
 				if cfg.Bot.Username != "TestBot" {
 					t.Errorf("Bot.Username = %q, 期望 %q", cfg.Bot.Username, "TestBot")
 				}
+				if cfg.Bot.Reconnect.Disabled || cfg.Bot.Reconnect.InitialDelayMs != 500 || cfg.Bot.Reconnect.MaxAttempts != 3 {
+					t.Errorf("Bot.Reconnect = %+v, 期望启用且 initial_delay_ms=500 max_attempts=3", cfg.Bot.Reconnect)
+				}
+				if cfg.Bot.Auth.AccessToken != "mc-token" || cfg.Bot.Auth.ProfileID != "069a79f444e94726a5befca90e38aaf5" {
+					t.Errorf("Bot.Auth = %+v, 期望 access_token 和 profile_id 被读取", cfg.Bot.Auth)
+				}
+				if cfg.Bot.Version != "1.21.11" || cfg.Bot.DataDir != "/opt/locus/data" {
+					t.Errorf("Bot.Version/DataDir = %q/%q, 期望 1.21.11 和 /opt/locus/data", cfg.Bot.Version, cfg.Bot.DataDir)
+				}
+				if cfg.Bot.CaptureFile != "session.lcap" {
+					t.Errorf("Bot.CaptureFile = %q, 期望 %q", cfg.Bot.CaptureFile, "session.lcap")
+				}
+				if cfg.Bot.ChunkCacheDir != "cache/chunks" {
+					t.Errorf("Bot.ChunkCacheDir = %q, 期望 %q", cfg.Bot.ChunkCacheDir, "cache/chunks")
+				}
+				if !cfg.Bot.ManualRespawn {
+					t.Errorf("Bot.ManualRespawn = false, 期望 true")
+				}
 				if cfg.LLM.Model != "gpt-4" {
 					t.Errorf("LLM.Model = %q, 期望 %q", cfg.LLM.Model, "gpt-4")
 				}
@@ -0,0 +1,192 @@
+package crafting
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	maxPlanDepth = 8
+	// maxPlanExpansions bounds backtracking over recipe variants.
+	maxPlanExpansions = 4096
+)
+
+// Node is one item in the recipe tree. Count is split between what is taken
+// from the inventory and what Times crafts of Recipe produce.
+type Node struct {
+	ItemID        int32
+	Count         int
+	FromInventory int
+	Recipe        *Recipe
+	Times         int
+	Children      []*Node
+}
+
+// Step crafts Recipe Times times. Steps are ordered so every input is either
+// in the inventory or produced by an earlier step.
+type Step struct {
+	Recipe *Recipe
+	Times  int
+}
+
+type Plan struct {
+	Target     int32
+	Count      int
+	Root       *Node
+	Steps      []Step
+	NeedsTable bool
+}
+
+// MissingError lists the raw materials the closest plan still lacked.
+type MissingError struct {
+	Target  int32
+	Missing map[int32]int
+	names   func(int32) string
+}
+
+func (e *MissingError) Error() string {
+	ids := make([]int32, 0, len(e.Missing))
+	for id := range e.Missing {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, fmt.Sprintf("%s x%d", e.names(id), e.Missing[id]))
+	}
+	return fmt.Sprintf("cannot craft %s: missing %s", e.names(e.Target), strings.Join(parts, ", "))
+}
+
+type planner struct {
+	book       *Book
+	inv        map[int32]int
+	visiting   map[int32]bool
+	steps      []Step
+	expansions int
+}
+
+// Plan resolves count of target into a recipe tree, consuming inventory first
+// and crafting intermediates as needed. inventory is not modified.
+func (b *Book) Plan(target int32, count int, inventory map[int32]int) (*Plan, error) {
+	if count <= 0 {
+		count = 1
+	}
+	if len(b.recipes[target]) == 0 {
+		return nil, fmt.Errorf("no recipe for %s", b.ItemName(target))
+	}
+
+	p := &planner{
+		book:     b,
+		inv:      cloneCounts(inventory),
+		visiting: make(map[int32]bool),
+	}
+	// The target itself is always crafted, even when some are already held.
+	root, missing := p.craft(target, count, 0)
+	if len(missing) > 0 {
+		return nil, &MissingError{Target: target, Missing: missing, names: b.ItemName}
+	}
+
+	plan := &Plan{Target: target, Count: count, Root: root, Steps: p.steps}
+	for _, step := range plan.Steps {
+		if !step.Recipe.FitsGrid(InventoryGridSize) {
+			plan.NeedsTable = true
+			break
+		}
+	}
+	return plan, nil
+}
+
+func (p *planner) resolve(item int32, need int, depth int) (*Node, map[int32]int) {
+	if have := p.inv[item]; have > 0 {
+		use := min(have, need)
+		p.inv[item] -= use
+		if need == use {
+			return &Node{ItemID: item, Count: need, FromInventory: use}, nil
+		}
+		node, missing := p.craft(item, need-use, depth)
+		node.Count = need
+		node.FromInventory = use
+		return node, missing
+	}
+	return p.craft(item, need, depth)
+}
+
+func (p *planner) craft(item int32, need int, depth int) (*Node, map[int32]int) {
+	node := &Node{ItemID: item, Count: need}
+	recipes := p.book.recipes[item]
+	if len(recipes) == 0 || p.visiting[item] || depth >= maxPlanDepth {
+		return node, map[int32]int{item: need}
+	}
+
+	p.visiting[item] = true
+	defer delete(p.visiting, item)
+
+	var best map[int32]int
+	for i := range recipes {
+		if p.expansions >= maxPlanExpansions {
+			break
+		}
+		p.expansions++
+
+		recipe := &recipes[i]
+		times := (need + recipe.Result.Count - 1) / recipe.Result.Count
+		savedInv := cloneCounts(p.inv)
+		savedSteps := len(p.steps)
+
+		children := make([]*Node, 0, len(recipe.Inputs()))
+		missing := make(map[int32]int)
+		for _, in := range recipe.Inputs() {
+			child, childMissing := p.resolve(in.ItemID, in.Count*times, depth+1)
+			children = append(children, child)
+			for id, n := range childMissing {
+				missing[id] += n
+			}
+		}
+
+		if len(missing) == 0 {
+			p.inv[item] += times*recipe.Result.Count - need
+			p.addStep(recipe, times)
+			node.Recipe = recipe
+			node.Times = times
+			node.Children = children
+			return node, nil
+		}
+
+		p.inv = savedInv
+		p.steps = p.steps[:savedSteps]
+		if best == nil || totalCount(missing) < totalCount(best) {
+			best = missing
+		}
+	}
+	if best == nil {
+		best = map[int32]int{item: need}
+	}
+	return node, best
+}
+
+func (p *planner) addStep(recipe *Recipe, times int) {
+	if n := len(p.steps); n > 0 && p.steps[n-1].Recipe == recipe {
+		p.steps[n-1].Times += times
+		return
+	}
+	p.steps = append(p.steps, Step{Recipe: recipe, Times: times})
+}
+
+func cloneCounts(src map[int32]int) map[int32]int {
+	out := make(map[int32]int, len(src))
+	for id, n := range src {
+		if n > 0 {
+			out[id] = n
+		}
+	}
+	return out
+}
+
+func totalCount(counts map[int32]int) int {
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	return total
+}
@@ -0,0 +1,116 @@
+package crafting
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestPlanCraftsIntermediates(t *testing.T) {
+	book := testBook(t)
+
+	plan, err := book.Plan(4, 1, map[int32]int{1: 2})
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if !plan.NeedsTable {
+		t.Fatalf("pickaxe plan should need a crafting table")
+	}
+
+	// 3 planks for the head plus 2 for sticks: two log crafts give 8 planks.
+	var got []string
+	for _, step := range plan.Steps {
+		got = append(got, fmt.Sprintf("%s*%d", book.ItemName(step.Recipe.Result.ID), step.Times))
+	}
+	if strings.Join(got, ",") != "oak_planks*2,stick*1,wooden_pickaxe*1" {
+		t.Fatalf("steps=%v", got)
+	}
+
+	if plan.Root.ItemID != 4 || plan.Root.Times != 1 || len(plan.Root.Children) != 2 {
+		t.Fatalf("unexpected root node: %+v", plan.Root)
+	}
+	planks := plan.Root.Children[0]
+	if planks.ItemID != 2 || planks.Count != 3 || planks.FromInventory != 0 {
+		t.Fatalf("unexpected planks node: %+v", planks)
+	}
+}
+
+func TestPlanUsesInventoryFirst(t *testing.T) {
+	book := testBook(t)
+
+	plan, err := book.Plan(4, 1, map[int32]int{2: 3, 3: 2})
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if len(plan.Steps) != 1 || plan.Steps[0].Recipe.Result.ID != 4 {
+		t.Fatalf("expected a single pickaxe step, got %+v", plan.Steps)
+	}
+	for _, child := range plan.Root.Children {
+		if child.Recipe != nil || child.FromInventory != child.Count {
+			t.Fatalf("child should come from inventory: %+v", child)
+		}
+	}
+}
+
+func TestPlanInventoryGridOnly(t *testing.T) {
+	book := testBook(t)
+
+	plan, err := book.Plan(5, 1, map[int32]int{1: 1})
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if plan.NeedsTable {
+		t.Fatalf("crafting table plan should fit the inventory grid")
+	}
+	if len(plan.Steps) != 2 || plan.Steps[0].Times != 1 || plan.Steps[1].Times != 1 {
+		t.Fatalf("unexpected steps: %+v", plan.Steps)
+	}
+}
+
+func TestPlanReportsMissingMaterials(t *testing.T) {
+	book := testBook(t)
+
+	_, err := book.Plan(4, 1, map[int32]int{1: 1})
+	var missing *MissingError
+	if !errors.As(err, &missing) {
+		t.Fatalf("expected MissingError, got %v", err)
+	}
+	if missing.Missing[1] != 1 {
+		t.Fatalf("missing=%v want one oak_log", missing.Missing)
+	}
+	if !strings.Contains(err.Error(), "missing oak_log x1") {
+		t.Fatalf("error=%q", err.Error())
+	}
+
+	if _, err := book.Plan(1, 1, nil); err == nil {
+		t.Fatalf("expected error for item without recipe")
+	}
+}
+
+func TestPlanBundledWoodenPickaxe(t *testing.T) {
+	book, err := DefaultBook()
+	if err != nil {
+		t.Fatalf("DefaultBook failed: %v", err)
+	}
+	pickaxe, _ := book.ItemID("wooden_pickaxe")
+	oakLog, _ := book.ItemID("oak_log")
+
+	plan, err := book.Plan(pickaxe, 1, map[int32]int{oakLog: 2})
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if !plan.NeedsTable {
+		t.Fatalf("wooden pickaxe should need a crafting table")
+	}
+	last := plan.Steps[len(plan.Steps)-1]
+	if last.Recipe.Result.ID != pickaxe {
+		t.Fatalf("last step should craft the pickaxe, got %s", book.ItemName(last.Recipe.Result.ID))
+	}
+	oakPlanks, _ := book.ItemID("oak_planks")
+	for _, in := range last.Recipe.Inputs() {
+		if in.ItemID != oakPlanks && in.Count == 3 {
+			t.Fatalf("pickaxe head should use oak planks, got %s", book.ItemName(in.ItemID))
+		}
+	}
+}
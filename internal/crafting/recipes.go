@@ -0,0 +1,314 @@
+package crafting
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Versifine/locus/internal/world"
+)
+
+const (
+	// InventoryGridSize is the width of the 2x2 grid in the player inventory.
+	InventoryGridSize = 2
+	// TableGridSize is the width of the crafting table grid.
+	TableGridSize = 3
+
+	defaultStackSize = 64
+)
+
+type ItemStack struct {
+	ID    int32
+	Count int
+}
+
+// Recipe is one crafting recipe from recipes.json. minecraft-data expands item
+// tags into one recipe per variant, so every cell holds a single item ID.
+type Recipe struct {
+	Result ItemStack
+	// InShape lists rows top to bottom; 0 marks an empty cell.
+	InShape [][]int32
+	// Ingredients is set for shapeless recipes instead of InShape.
+	Ingredients []int32
+}
+
+type Ingredient struct {
+	ItemID int32
+	Count  int
+}
+
+// Cell is one grid position a recipe needs filled. Index is row-major within a
+// grid of the width passed to Cells.
+type Cell struct {
+	Index  int
+	ItemID int32
+}
+
+func (r Recipe) Shaped() bool {
+	return len(r.InShape) > 0
+}
+
+func (r Recipe) Width() int {
+	width := 0
+	for _, row := range r.InShape {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+	return width
+}
+
+func (r Recipe) Height() int {
+	return len(r.InShape)
+}
+
+// FitsGrid reports whether the recipe can be laid out in a size x size grid.
+func (r Recipe) FitsGrid(size int) bool {
+	if r.Shaped() {
+		return r.Width() <= size && r.Height() <= size
+	}
+	return len(r.Ingredients) > 0 && len(r.Ingredients) <= size*size
+}
+
+// Inputs returns the items consumed by one craft, sorted by item ID.
+func (r Recipe) Inputs() []Ingredient {
+	counts := make(map[int32]int)
+	if r.Shaped() {
+		for _, row := range r.InShape {
+			for _, id := range row {
+				if id != 0 {
+					counts[id]++
+				}
+			}
+		}
+	} else {
+		for _, id := range r.Ingredients {
+			counts[id]++
+		}
+	}
+
+	out := make([]Ingredient, 0, len(counts))
+	for id, count := range counts {
+		out = append(out, Ingredient{ItemID: id, Count: count})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ItemID < out[j].ItemID })
+	return out
+}
+
+// Cells lays the recipe out in the top-left corner of a size x size grid.
+// Shapeless ingredients fill cells in reading order.
+func (r Recipe) Cells(size int) []Cell {
+	if !r.FitsGrid(size) {
+		return nil
+	}
+	cells := make([]Cell, 0, size*size)
+	if r.Shaped() {
+		for row, ids := range r.InShape {
+			for col, id := range ids {
+				if id != 0 {
+					cells = append(cells, Cell{Index: row*size + col, ItemID: id})
+				}
+			}
+		}
+		return cells
+	}
+	for i, id := range r.Ingredients {
+		cells = append(cells, Cell{Index: i, ItemID: id})
+	}
+	return cells
+}
+
+type Item struct {
+	ID          int32  `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	StackSize   int    `json:"stackSize"`
+}
+
+// Book indexes recipes by result item.
+type Book struct {
+	recipes    map[int32][]Recipe
+	items      map[int32]Item
+	itemByName map[string]int32
+}
+
+type recipeJSON struct {
+	InShape     [][]*int32 `json:"inShape"`
+	Ingredients []int32    `json:"ingredients"`
+	Result      struct {
+		ID    int32 `json:"id"`
+		Count int   `json:"count"`
+	} `json:"result"`
+}
+
+type loadedBook struct {
+	book *Book
+	err  error
+}
+
+var (
+	booksMu sync.Mutex
+	books   = make(map[string]loadedBook)
+)
+
+// DefaultBook returns the recipes of the active game data version, or the
+// bundled 1.21.11 data when none is active. Each version is loaded once and
+// shared.
+func DefaultBook() (*Book, error) {
+	if d := world.ActiveGameData(); d != nil {
+		return BookForDir(d.Dir)
+	}
+	return BookForDir(filepath.Dir(defaultDataPath("recipes.json")))
+}
+
+// BookForDir loads recipes.json and items.json from a minecraft-data
+// version directory once and shares the result.
+func BookForDir(dir string) (*Book, error) {
+	booksMu.Lock()
+	defer booksMu.Unlock()
+	loaded, ok := books[dir]
+	if !ok {
+		loaded.book, loaded.err = LoadBook(filepath.Join(dir, "recipes.json"), filepath.Join(dir, "items.json"))
+		books[dir] = loaded
+	}
+	return loaded.book, loaded.err
+}
+
+func LoadBook(recipesJSONPath, itemsJSONPath string) (*Book, error) {
+	itemsData, err := os.ReadFile(itemsJSONPath)
+	if err != nil {
+		return nil, fmt.Errorf("read items.json: %w", err)
+	}
+	recipesData, err := os.ReadFile(recipesJSONPath)
+	if err != nil {
+		return nil, fmt.Errorf("read recipes.json: %w", err)
+	}
+	return ParseBook(recipesData, itemsData)
+}
+
+func ParseBook(recipesData, itemsData []byte) (*Book, error) {
+	var items []Item
+	if err := json.Unmarshal(itemsData, &items); err != nil {
+		return nil, fmt.Errorf("parse items.json: %w", err)
+	}
+	var raw map[string][]recipeJSON
+	if err := json.Unmarshal(recipesData, &raw); err != nil {
+		return nil, fmt.Errorf("parse recipes.json: %w", err)
+	}
+
+	book := &Book{
+		recipes:    make(map[int32][]Recipe, len(raw)),
+		items:      make(map[int32]Item, len(items)),
+		itemByName: make(map[string]int32, len(items)*2),
+	}
+	for _, item := range items {
+		book.items[item.ID] = item
+		book.itemByName[normalizeName(item.Name)] = item.ID
+		if item.DisplayName != "" {
+			if _, exists := book.itemByName[normalizeName(item.DisplayName)]; !exists {
+				book.itemByName[normalizeName(item.DisplayName)] = item.ID
+			}
+		}
+	}
+
+	// Sort keys so recipe order, and therefore planning, is deterministic.
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, entry := range raw[key] {
+			recipe := Recipe{
+				Result:      ItemStack{ID: entry.Result.ID, Count: entry.Result.Count},
+				Ingredients: entry.Ingredients,
+			}
+			if recipe.Result.Count <= 0 {
+				recipe.Result.Count = 1
+			}
+			for _, row := range entry.InShape {
+				ids := make([]int32, len(row))
+				for i, id := range row {
+					if id != nil {
+						ids[i] = *id
+					}
+				}
+				recipe.InShape = append(recipe.InShape, ids)
+			}
+			if !recipe.Shaped() && len(recipe.Ingredients) == 0 {
+				return nil, fmt.Errorf("recipe for item %s has no ingredients", key)
+			}
+			book.recipes[recipe.Result.ID] = append(book.recipes[recipe.Result.ID], recipe)
+		}
+	}
+	return book, nil
+}
+
+func (b *Book) Recipes(itemID int32) []Recipe {
+	return b.recipes[itemID]
+}
+
+// ItemID resolves "oak_log", "minecraft:oak_log" or "Oak Log".
+func (b *Book) ItemID(name string) (int32, bool) {
+	id, ok := b.itemByName[normalizeName(name)]
+	return id, ok
+}
+
+func (b *Book) ItemName(itemID int32) string {
+	if item, ok := b.items[itemID]; ok {
+		return item.Name
+	}
+	return fmt.Sprintf("item#%d", itemID)
+}
+
+func (b *Book) StackSize(itemID int32) int {
+	if item, ok := b.items[itemID]; ok && item.StackSize > 0 {
+		return item.StackSize
+	}
+	return defaultStackSize
+}
+
+// MaxBatch is how many times a recipe can be crafted in one grid fill: each
+// cell holds at most one stack of its ingredient.
+func (b *Book) MaxBatch(r Recipe) int {
+	batch := defaultStackSize
+	for _, in := range r.Inputs() {
+		if size := b.StackSize(in.ItemID); size < batch {
+			batch = size
+		}
+	}
+	if batch < 1 {
+		batch = 1
+	}
+	return batch
+}
+
+func normalizeName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.TrimPrefix(name, "minecraft:")
+	return strings.ReplaceAll(name, " ", "_")
+}
+
+func defaultDataPath(file string) string {
+	candidates := []string{
+		filepath.Join("1.21.11", file),
+	}
+	if exePath, err := os.Executable(); err == nil {
+		candidates = append(candidates, filepath.Join(filepath.Dir(exePath), "1.21.11", file))
+	}
+	if _, src, _, ok := runtime.Caller(0); ok {
+		candidates = append(candidates, filepath.Join(filepath.Dir(src), "..", "..", "1.21.11", file))
+	}
+	for _, candidate := range candidates {
+		clean := filepath.Clean(candidate)
+		if _, err := os.Stat(clean); err == nil {
+			return clean
+		}
+	}
+	return filepath.Clean(candidates[0])
+}
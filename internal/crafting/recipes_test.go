@@ -0,0 +1,157 @@
+package crafting
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Versifine/locus/internal/world"
+)
+
+const testItemsJSON = `[
+	{"id": 1, "name": "oak_log", "displayName": "Oak Log", "stackSize": 64},
+	{"id": 2, "name": "oak_planks", "displayName": "Oak Planks", "stackSize": 64},
+	{"id": 3, "name": "stick", "displayName": "Stick", "stackSize": 64},
+	{"id": 4, "name": "wooden_pickaxe", "displayName": "Wooden Pickaxe", "stackSize": 1},
+	{"id": 5, "name": "crafting_table", "displayName": "Crafting Table", "stackSize": 64},
+	{"id": 6, "name": "ender_pearl", "displayName": "Ender Pearl", "stackSize": 16}
+]`
+
+const testRecipesJSON = `{
+	"2": [{"ingredients": [1], "result": {"id": 2, "count": 4}}],
+	"3": [{"inShape": [[2], [2]], "result": {"id": 3, "count": 4}}],
+	"4": [{"inShape": [[2, 2, 2], [null, 3, null], [null, 3, null]], "result": {"id": 4, "count": 1}}],
+	"5": [{"inShape": [[2, 2], [2, 2]], "result": {"id": 5, "count": 1}}]
+}`
+
+func testBook(t *testing.T) *Book {
+	t.Helper()
+	book, err := ParseBook([]byte(testRecipesJSON), []byte(testItemsJSON))
+	if err != nil {
+		t.Fatalf("ParseBook failed: %v", err)
+	}
+	return book
+}
+
+func TestParseBook(t *testing.T) {
+	book := testBook(t)
+
+	for _, name := range []string{"wooden_pickaxe", "minecraft:wooden_pickaxe", "Wooden Pickaxe"} {
+		if id, ok := book.ItemID(name); !ok || id != 4 {
+			t.Fatalf("ItemID(%q)=%d,%v want 4,true", name, id, ok)
+		}
+	}
+	if got := book.ItemName(3); got != "stick" {
+		t.Fatalf("ItemName(3)=%q want stick", got)
+	}
+
+	pickaxe := book.Recipes(4)
+	if len(pickaxe) != 1 {
+		t.Fatalf("len(Recipes(4))=%d want 1", len(pickaxe))
+	}
+	want := [][]int32{{2, 2, 2}, {0, 3, 0}, {0, 3, 0}}
+	if !reflect.DeepEqual(pickaxe[0].InShape, want) {
+		t.Fatalf("InShape=%v want %v", pickaxe[0].InShape, want)
+	}
+	if !reflect.DeepEqual(pickaxe[0].Inputs(), []Ingredient{{ItemID: 2, Count: 3}, {ItemID: 3, Count: 2}}) {
+		t.Fatalf("Inputs=%v", pickaxe[0].Inputs())
+	}
+}
+
+func TestRecipeGridFit(t *testing.T) {
+	book := testBook(t)
+
+	pickaxe := book.Recipes(4)[0]
+	if pickaxe.FitsGrid(InventoryGridSize) || !pickaxe.FitsGrid(TableGridSize) {
+		t.Fatalf("pickaxe should need a 3x3 grid")
+	}
+	cells := pickaxe.Cells(TableGridSize)
+	wantCells := []Cell{{0, 2}, {1, 2}, {2, 2}, {4, 3}, {7, 3}}
+	if !reflect.DeepEqual(cells, wantCells) {
+		t.Fatalf("Cells(3)=%v want %v", cells, wantCells)
+	}
+
+	table := book.Recipes(5)[0]
+	if !table.FitsGrid(InventoryGridSize) {
+		t.Fatalf("crafting table recipe should fit 2x2")
+	}
+	if got := table.Cells(InventoryGridSize); !reflect.DeepEqual(got, []Cell{{0, 2}, {1, 2}, {2, 2}, {3, 2}}) {
+		t.Fatalf("Cells(2)=%v", got)
+	}
+	// The same shape in a 3x3 grid skips the third column.
+	if got := table.Cells(TableGridSize); !reflect.DeepEqual(got, []Cell{{0, 2}, {1, 2}, {3, 2}, {4, 2}}) {
+		t.Fatalf("Cells(3)=%v", got)
+	}
+
+	planks := book.Recipes(2)[0]
+	if planks.Shaped() || !planks.FitsGrid(InventoryGridSize) {
+		t.Fatalf("planks should be a shapeless 2x2 recipe")
+	}
+}
+
+func TestMaxBatch(t *testing.T) {
+	book := testBook(t)
+	if got := book.MaxBatch(book.Recipes(3)[0]); got != 64 {
+		t.Fatalf("MaxBatch(stick)=%d want 64", got)
+	}
+	pearls := Recipe{Result: ItemStack{ID: 5, Count: 1}, Ingredients: []int32{6, 2}}
+	if got := book.MaxBatch(pearls); got != 16 {
+		t.Fatalf("MaxBatch(pearls)=%d want 16", got)
+	}
+}
+
+func TestDefaultBookLoadsBundledData(t *testing.T) {
+	book, err := DefaultBook()
+	if err != nil {
+		t.Fatalf("DefaultBook failed: %v", err)
+	}
+	id, ok := book.ItemID("wooden_pickaxe")
+	if !ok {
+		t.Fatalf("wooden_pickaxe not found in items.json")
+	}
+	recipes := book.Recipes(id)
+	if len(recipes) == 0 {
+		t.Fatalf("no recipes for wooden_pickaxe")
+	}
+	if recipes[0].FitsGrid(InventoryGridSize) {
+		t.Fatalf("wooden_pickaxe should need a crafting table")
+	}
+}
+
+func TestDefaultBookFollowsActiveGameData(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"items.json":    testItemsJSON,
+		"recipes.json":  testRecipesJSON,
+		"entities.json": `[]`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s failed: %v", name, err)
+		}
+	}
+	data, err := world.LoadGameData(dir)
+	if err != nil {
+		t.Fatalf("LoadGameData failed: %v", err)
+	}
+	world.UseGameData(data)
+	t.Cleanup(func() { world.UseGameData(nil) })
+
+	book, err := DefaultBook()
+	if err != nil {
+		t.Fatalf("DefaultBook failed: %v", err)
+	}
+	if id, ok := book.ItemID("ender_pearl"); !ok || id != 6 {
+		t.Fatalf("ItemID(ender_pearl) = %d, %v; want the active version's 6", id, ok)
+	}
+
+	world.UseGameData(nil)
+	bundled, err := DefaultBook()
+	if err != nil {
+		t.Fatalf("DefaultBook without game data failed: %v", err)
+	}
+	if bundled == book {
+		t.Fatal("DefaultBook should fall back to the bundled 1.21.11 data")
+	}
+}
@@ -256,10 +256,10 @@ func (c *Console) executeCommand(cmd string) {
 		c.printHelp()
 	case "state":
 		ps := c.body.PhysicsState()
-		fmt.Printf("[debug] physics pos=(%.3f,%.3f,%.3f) vel=(%.3f,%.3f,%.3f) ground=%t\r\n",
+		fmt.Printf("[debug] physics pos=(%.3f,%.3f,%.3f) vel=(%.3f,%.3f,%.3f) ground=%t water=%t lava=%t\r\n",
 			ps.Position.X, ps.Position.Y, ps.Position.Z,
 			ps.Velocity.X, ps.Velocity.Y, ps.Velocity.Z,
-			ps.OnGround,
+			ps.OnGround, ps.InWater, ps.InLava,
 		)
 	case "snap":
 		fmt.Printf("[debug] %s\r\n", c.stateProvider.GetState().String())
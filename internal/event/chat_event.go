@@ -2,6 +2,7 @@ package event
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Versifine/locus/internal/protocol"
 )
@@ -16,6 +17,8 @@ const (
 	SourcePlayer
 	SourcePlayerSend
 	SourcePlayerCmd
+	// SourceWhisper is a private /msg addressed to the bot.
+	SourceWhisper
 )
 
 func (st SourceType) String() string {
@@ -28,17 +31,67 @@ func (st SourceType) String() string {
 		return "PlayerSend"
 	case SourcePlayerCmd:
 		return "PlayerCmd"
+	case SourceWhisper:
+		return "Whisper"
 	default:
 		return "Unknown"
 	}
 }
 
+// NoticeKind classifies system messages the bot reacts to.
+type NoticeKind string
+
+const (
+	NoticeDeath       NoticeKind = "death"
+	NoticeJoin        NoticeKind = "join"
+	NoticeLeave       NoticeKind = "leave"
+	NoticeAdvancement NoticeKind = "advancement"
+)
+
+// ChatNotice is the structured form of a translated system message. Player is
+// the subject of the message, Args the remaining translation arguments.
+type ChatNotice struct {
+	Kind   NoticeKind
+	Key    string
+	Player string
+	Args   []string
+}
+
+// ClassifyTranslation recognises death, join/leave and advancement keys and
+// returns nil for anything else.
+func ClassifyTranslation(key string, args []string) *ChatNotice {
+	var kind NoticeKind
+	switch {
+	case key == "multiplayer.player.joined" || key == "multiplayer.player.joined.renamed":
+		kind = NoticeJoin
+	case key == "multiplayer.player.left":
+		kind = NoticeLeave
+	case strings.HasPrefix(key, "chat.type.advancement."):
+		kind = NoticeAdvancement
+	case strings.HasPrefix(key, "death."):
+		kind = NoticeDeath
+	default:
+		return nil
+	}
+
+	notice := &ChatNotice{Kind: kind, Key: key}
+	if len(args) > 0 {
+		notice.Player = args[0]
+		notice.Args = append([]string(nil), args[1:]...)
+	}
+	return notice
+}
+
 type ChatEvent struct {
 	Username string
 	UUID     protocol.UUID
 	Message  string
 	Source   SourceType
-	Ctx      context.Context
+	// EntityID is the sender's entity while it is in view, 0 otherwise.
+	EntityID int32
+	// Notice is set for recognised system messages.
+	Notice *ChatNotice
+	Ctx    context.Context
 }
 
 func NewChatEvent(ctx context.Context, username string, uuid protocol.UUID, message string, source SourceType) *ChatEvent {
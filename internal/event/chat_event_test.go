@@ -18,6 +18,7 @@ func TestSourceTypeString(t *testing.T) {
 		{"Player", SourcePlayer, "Player"},
 		{"PlayerSend", SourcePlayerSend, "PlayerSend"},
 		{"PlayerCmd", SourcePlayerCmd, "PlayerCmd"},
+		{"Whisper", SourceWhisper, "Whisper"},
 		{"Unknown", SourceType(99), "Unknown"},
 	}
 
@@ -81,4 +82,44 @@ func TestSourceTypeIotaValues(t *testing.T) {
 	if SourcePlayerCmd != 3 {
 		t.Errorf("SourcePlayerCmd = %d, 期望 3", SourcePlayerCmd)
 	}
+	if SourceWhisper != 4 {
+		t.Errorf("SourceWhisper = %d, 期望 4", SourceWhisper)
+	}
+}
+
+// TestClassifyTranslation 测试系统消息翻译键的分类
+func TestClassifyTranslation(t *testing.T) {
+	tests := []struct {
+		key    string
+		args   []string
+		kind   NoticeKind
+		player string
+		rest   int
+	}{
+		{"multiplayer.player.joined", []string{"Steve"}, NoticeJoin, "Steve", 0},
+		{"multiplayer.player.joined.renamed", []string{"Steve", "OldSteve"}, NoticeJoin, "Steve", 1},
+		{"multiplayer.player.left", []string{"Alex"}, NoticeLeave, "Alex", 0},
+		{"chat.type.advancement.task", []string{"Steve", "Stone Age"}, NoticeAdvancement, "Steve", 1},
+		{"chat.type.advancement.challenge", []string{"Steve", "Hot Tourist Destinations"}, NoticeAdvancement, "Steve", 1},
+		{"death.attack.mob", []string{"Steve", "Zombie"}, NoticeDeath, "Steve", 1},
+		{"death.fell.accident.generic", []string{"Alex"}, NoticeDeath, "Alex", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			notice := ClassifyTranslation(tt.key, tt.args)
+			if notice == nil {
+				t.Fatalf("ClassifyTranslation(%q) = nil", tt.key)
+			}
+			if notice.Kind != tt.kind || notice.Key != tt.key {
+				t.Errorf("notice = %+v, 期望 kind %q", notice, tt.kind)
+			}
+			if notice.Player != tt.player || len(notice.Args) != tt.rest {
+				t.Errorf("Player = %q Args = %v, 期望 %q 和 %d 个参数", notice.Player, notice.Args, tt.player, tt.rest)
+			}
+		})
+	}
+
+	if notice := ClassifyTranslation("commands.message.display.incoming", []string{"Steve", "hi"}); notice != nil {
+		t.Errorf("私聊不应被分类为通知: %+v", notice)
+	}
 }
@@ -1,15 +1,88 @@
 package event
 
+import "time"
+
 const (
 	EventDamage       = "damage"
 	EventBehaviorEnd  = "behavior.end"
 	EventEntityAppear = "entity.appear"
 	EventEntityLeave  = "entity.leave"
+	EventDisconnect   = "session.disconnect"
+	EventReconnect    = "session.reconnect"
+	EventStatusChange = "status.change"
+	EventDeath        = "death"
+	EventTimePhase    = "time.phase_changed"
+	EventWeather      = "weather.changed"
+	EventSound        = "sound"
 )
 
+// DamageEvent is published when the bot loses health. The source fields are
+// only filled when the server told us about the hit: Type is the damage type
+// such as "mob_attack", and entity IDs are zero when unknown. Direction is
+// where the hit came from relative to the bot's facing: "front", "behind",
+// "left" or "right".
 type DamageEvent struct {
 	Amount float32
 	NewHP  float32
+
+	Type           string
+	SourceEntityID int32
+	SourceName     string
+	DirectEntityID int32
+	HasSourcePos   bool
+	SourceX        float64
+	SourceY        float64
+	SourceZ        float64
+	Direction      string
+}
+
+// StatusChangeEvent reports a change in the bot's own status. Kind is
+// "gamemode", "level", "flying" or "effect"; Name is the effect for "effect",
+// where an empty From or To means it was added or removed.
+type StatusChangeEvent struct {
+	Kind string
+	Name string
+	From string
+	To   string
+}
+
+// DeathEvent is published when the bot dies. Message is the formatted death
+// message, e.g. "death.attack.mob(Bot, entity.minecraft.zombie)".
+type DeathEvent struct {
+	Message   string
+	X         float64
+	Y         float64
+	Z         float64
+	Dimension string
+}
+
+// TimePhaseEvent is published when the day phase changes between "day",
+// "dusk", "night" and "dawn". MoonPhase is the moon of the current day.
+type TimePhaseEvent struct {
+	From      string
+	To        string
+	MoonPhase string
+}
+
+// WeatherEvent is published when the weather changes between "clear",
+// "rain" and "thunder".
+type WeatherEvent struct {
+	From string
+	To   string
+}
+
+// SoundEvent is published for urgent sounds such as a creeper about to
+// explode. Direction is front, right, left or behind relative to where the
+// bot faces, empty when the sound is on top of it.
+type SoundEvent struct {
+	Name      string
+	Category  string
+	EntityID  int32
+	X         float64
+	Y         float64
+	Z         float64
+	Distance  float64
+	Direction string
 }
 
 type BehaviorEndEvent struct {
@@ -23,3 +96,18 @@ type EntityEvent struct {
 	Name     string
 	Type     int32
 }
+
+// DisconnectEvent is published when a session ends. Retry is false when the
+// supervisor gave up, e.g. after a ban.
+type DisconnectEvent struct {
+	Reason  string
+	Kind    string
+	Retry   bool
+	RetryIn time.Duration
+}
+
+// ReconnectEvent is published once a new session reaches the Play state.
+type ReconnectEvent struct {
+	Attempt  int
+	Downtime time.Duration
+}
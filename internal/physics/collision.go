@@ -32,39 +32,74 @@ func PlayerAABB(x, y, z float64) AABB {
 	}
 }
 
-func CollidesWithBlock(aabb AABB, blockStore BlockStore) bool {
-	if blockStore == nil {
-		return false
+// CollisionShapeSource is implemented by block stores that know each block's
+// collision boxes. Boxes are in block-local coordinates: a bottom slab is
+// 0..0.5 in Y and a fence post reaches 1.5. Stores without it are treated as
+// full cubes wherever IsSolid is true.
+type CollisionShapeSource interface {
+	CollisionBoxes(x, y, z int) []AABB
+}
+
+func (a AABB) offset(x, y, z float64) AABB {
+	return AABB{
+		MinX: a.MinX + x,
+		MinY: a.MinY + y,
+		MinZ: a.MinZ + z,
+		MaxX: a.MaxX + x,
+		MaxY: a.MaxY + y,
+		MaxZ: a.MaxZ + z,
 	}
+}
+
+var unitCube = []AABB{{MaxX: 1, MaxY: 1, MaxZ: 1}}
 
-	minX := floorForMin(aabb.MinX)
-	maxX := floorForMax(aabb.MaxX)
-	minY := floorForMin(aabb.MinY)
-	maxY := floorForMax(aabb.MaxY)
-	minZ := floorForMin(aabb.MinZ)
-	maxZ := floorForMax(aabb.MaxZ)
+// BlockCollisionBoxes returns the block-local collision boxes at x, y, z.
+func BlockCollisionBoxes(blockStore BlockStore, x, y, z int) []AABB {
+	if blockStore == nil {
+		return nil
+	}
+	if shapes, ok := blockStore.(CollisionShapeSource); ok {
+		return shapes.CollisionBoxes(x, y, z)
+	}
+	if blockStore.IsSolid(x, y, z) {
+		return unitCube
+	}
+	return nil
+}
 
+// collectBoxes gathers the world-space boxes of every block that can touch
+// region. The cell below is included because fences and walls reach 0.5
+// above their own block.
+func collectBoxes(region AABB, blockStore BlockStore) []AABB {
+	minX := floorForMin(region.MinX)
+	maxX := floorForMax(region.MaxX)
+	minY := floorForMin(region.MinY) - 1
+	maxY := floorForMax(region.MaxY)
+	minZ := floorForMin(region.MinZ)
+	maxZ := floorForMax(region.MaxZ)
+
+	var boxes []AABB
 	for y := minY; y <= maxY; y++ {
 		for x := minX; x <= maxX; x++ {
 			for z := minZ; z <= maxZ; z++ {
-				if !blockStore.IsSolid(x, y, z) {
-					continue
-				}
-				block := AABB{
-					MinX: float64(x),
-					MinY: float64(y),
-					MinZ: float64(z),
-					MaxX: float64(x + 1),
-					MaxY: float64(y + 1),
-					MaxZ: float64(z + 1),
-				}
-				if intersects(aabb, block) {
-					return true
+				for _, box := range BlockCollisionBoxes(blockStore, x, y, z) {
+					boxes = append(boxes, box.offset(float64(x), float64(y), float64(z)))
 				}
 			}
 		}
 	}
+	return boxes
+}
 
+func CollidesWithBlock(aabb AABB, blockStore BlockStore) bool {
+	if blockStore == nil {
+		return false
+	}
+	for _, box := range collectBoxes(aabb, blockStore) {
+		if intersects(aabb, box) {
+			return true
+		}
+	}
 	return false
 }
 
@@ -79,181 +114,128 @@ func ResolveMovement(pos, velocity Vec3, blockStore BlockStore) (Vec3, Vec3) {
 	return newPos, newVel
 }
 
+// ResolveMovementWithStep is ResolveMovement plus the vanilla step-up: a
+// grounded player blocked horizontally walks up ledges of at most StepHeight
+// (slabs, stairs, carpets, snow layers) without jumping.
+func ResolveMovementWithStep(pos, velocity Vec3, blockStore BlockStore, onGround bool) (Vec3, Vec3) {
+	newPos, newVel := ResolveMovement(pos, velocity, blockStore)
+	blocked := !nearlyEqual(newVel.X, velocity.X) || !nearlyEqual(newVel.Z, velocity.Z)
+	if !onGround || !blocked || blockStore == nil {
+		return newPos, newVel
+	}
+
+	stepPos := pos
+	stepPos.Y, _ = resolveAxisY(stepPos, StepHeight, blockStore)
+	raised := stepPos.Y - pos.Y
+	if nearlyZero(raised) {
+		return newPos, newVel
+	}
+	stepVel := velocity
+	stepPos.X, stepVel.X = resolveAxisX(stepPos, velocity.X, blockStore)
+	stepPos.Z, stepVel.Z = resolveAxisZ(stepPos, velocity.Z, blockStore)
+	stepPos.Y, stepVel.Y = resolveAxisY(stepPos, -raised+math.Min(velocity.Y, 0), blockStore)
+
+	normalDist := sq(newPos.X-pos.X) + sq(newPos.Z-pos.Z)
+	stepDist := sq(stepPos.X-pos.X) + sq(stepPos.Z-pos.Z)
+	if stepDist <= normalDist+CollisionAxisTolerance {
+		return newPos, newVel
+	}
+	return stepPos, stepVel
+}
+
 func resolveAxisY(pos Vec3, delta float64, blockStore BlockStore) (float64, float64) {
 	if blockStore == nil || nearlyZero(delta) {
 		return pos.Y + delta, delta
 	}
-
 	aabb := PlayerAABB(pos.X, pos.Y, pos.Z)
 	allowed := delta
-
-	if delta > 0 {
-		minX := floorForMin(aabb.MinX)
-		maxX := floorForMax(aabb.MaxX)
-		minZ := floorForMin(aabb.MinZ)
-		maxZ := floorForMax(aabb.MaxZ)
-
-		startY := int(math.Floor(aabb.MaxY))
-		endY := int(math.Floor(aabb.MaxY + delta))
-		for y := startY; y <= endY; y++ {
-			for x := minX; x <= maxX; x++ {
-				for z := minZ; z <= maxZ; z++ {
-					if !blockStore.IsSolid(x, y, z) {
-						continue
-					}
-					candidate := float64(y) - aabb.MaxY
-					if candidate < allowed {
-						allowed = candidate
-					}
-				}
-			}
+	for _, box := range collectBoxes(expand(aabb, 0, delta, 0), blockStore) {
+		if !overlaps(aabb.MinX, aabb.MaxX, box.MinX, box.MaxX) || !overlaps(aabb.MinZ, aabb.MaxZ, box.MinZ, box.MaxZ) {
+			continue
 		}
-	} else {
-		minX := floorForMin(aabb.MinX)
-		maxX := floorForMax(aabb.MaxX)
-		minZ := floorForMin(aabb.MinZ)
-		maxZ := floorForMax(aabb.MaxZ)
-
-		startY := int(math.Floor(aabb.MinY + delta))
-		endY := int(math.Floor(aabb.MinY - CollisionAxisTolerance))
-		for y := endY; y >= startY; y-- {
-			for x := minX; x <= maxX; x++ {
-				for z := minZ; z <= maxZ; z++ {
-					if !blockStore.IsSolid(x, y, z) {
-						continue
-					}
-					candidate := float64(y+1) - aabb.MinY
-					if candidate > allowed {
-						allowed = candidate
-					}
-				}
-			}
-		}
-	}
-
-	newY := pos.Y + allowed
-	if !nearlyEqual(allowed, delta) {
-		return newY, 0
+		allowed = clipAxis(allowed, aabb.MinY, aabb.MaxY, box.MinY, box.MaxY)
 	}
-	return newY, delta
+	return finishAxis(pos.Y, allowed, delta)
 }
 
 func resolveAxisX(pos Vec3, delta float64, blockStore BlockStore) (float64, float64) {
 	if blockStore == nil || nearlyZero(delta) {
 		return pos.X + delta, delta
 	}
-
 	aabb := PlayerAABB(pos.X, pos.Y, pos.Z)
 	allowed := delta
-
-	if delta > 0 {
-		minY := floorForMin(aabb.MinY)
-		maxY := floorForMax(aabb.MaxY)
-		minZ := floorForMin(aabb.MinZ)
-		maxZ := floorForMax(aabb.MaxZ)
-
-		startX := int(math.Floor(aabb.MaxX))
-		endX := int(math.Floor(aabb.MaxX + delta))
-		for x := startX; x <= endX; x++ {
-			for y := minY; y <= maxY; y++ {
-				for z := minZ; z <= maxZ; z++ {
-					if !blockStore.IsSolid(x, y, z) {
-						continue
-					}
-					candidate := float64(x) - aabb.MaxX
-					if candidate < allowed {
-						allowed = candidate
-					}
-				}
-			}
-		}
-	} else {
-		minY := floorForMin(aabb.MinY)
-		maxY := floorForMax(aabb.MaxY)
-		minZ := floorForMin(aabb.MinZ)
-		maxZ := floorForMax(aabb.MaxZ)
-
-		startX := int(math.Floor(aabb.MinX + delta))
-		endX := int(math.Floor(aabb.MinX - CollisionAxisTolerance))
-		for x := endX; x >= startX; x-- {
-			for y := minY; y <= maxY; y++ {
-				for z := minZ; z <= maxZ; z++ {
-					if !blockStore.IsSolid(x, y, z) {
-						continue
-					}
-					candidate := float64(x+1) - aabb.MinX
-					if candidate > allowed {
-						allowed = candidate
-					}
-				}
-			}
+	for _, box := range collectBoxes(expand(aabb, delta, 0, 0), blockStore) {
+		if !overlaps(aabb.MinY, aabb.MaxY, box.MinY, box.MaxY) || !overlaps(aabb.MinZ, aabb.MaxZ, box.MinZ, box.MaxZ) {
+			continue
 		}
+		allowed = clipAxis(allowed, aabb.MinX, aabb.MaxX, box.MinX, box.MaxX)
 	}
-
-	newX := pos.X + allowed
-	if !nearlyEqual(allowed, delta) {
-		return newX, 0
-	}
-	return newX, delta
+	return finishAxis(pos.X, allowed, delta)
 }
 
 func resolveAxisZ(pos Vec3, delta float64, blockStore BlockStore) (float64, float64) {
 	if blockStore == nil || nearlyZero(delta) {
 		return pos.Z + delta, delta
 	}
-
 	aabb := PlayerAABB(pos.X, pos.Y, pos.Z)
 	allowed := delta
-
-	if delta > 0 {
-		minX := floorForMin(aabb.MinX)
-		maxX := floorForMax(aabb.MaxX)
-		minY := floorForMin(aabb.MinY)
-		maxY := floorForMax(aabb.MaxY)
-
-		startZ := int(math.Floor(aabb.MaxZ))
-		endZ := int(math.Floor(aabb.MaxZ + delta))
-		for z := startZ; z <= endZ; z++ {
-			for y := minY; y <= maxY; y++ {
-				for x := minX; x <= maxX; x++ {
-					if !blockStore.IsSolid(x, y, z) {
-						continue
-					}
-					candidate := float64(z) - aabb.MaxZ
-					if candidate < allowed {
-						allowed = candidate
-					}
-				}
-			}
+	for _, box := range collectBoxes(expand(aabb, 0, 0, delta), blockStore) {
+		if !overlaps(aabb.MinX, aabb.MaxX, box.MinX, box.MaxX) || !overlaps(aabb.MinY, aabb.MaxY, box.MinY, box.MaxY) {
+			continue
 		}
-	} else {
-		minX := floorForMin(aabb.MinX)
-		maxX := floorForMax(aabb.MaxX)
-		minY := floorForMin(aabb.MinY)
-		maxY := floorForMax(aabb.MaxY)
+		allowed = clipAxis(allowed, aabb.MinZ, aabb.MaxZ, box.MinZ, box.MaxZ)
+	}
+	return finishAxis(pos.Z, allowed, delta)
+}
 
-		startZ := int(math.Floor(aabb.MinZ + delta))
-		endZ := int(math.Floor(aabb.MinZ - CollisionAxisTolerance))
-		for z := endZ; z >= startZ; z-- {
-			for y := minY; y <= maxY; y++ {
-				for x := minX; x <= maxX; x++ {
-					if !blockStore.IsSolid(x, y, z) {
-						continue
-					}
-					candidate := float64(z+1) - aabb.MinZ
-					if candidate > allowed {
-						allowed = candidate
-					}
-				}
-			}
+// clipAxis shortens a move along one axis so the moving span [min, max]
+// stops at the face of a box span [boxMin, boxMax] it would enter. Boxes the
+// player already overlaps are ignored so it can walk out of them.
+func clipAxis(delta, min, max, boxMin, boxMax float64) float64 {
+	if delta > 0 && boxMin >= max-CollisionAxisTolerance {
+		if gap := boxMin - max; gap < delta {
+			return gap
+		}
+	} else if delta < 0 && boxMax <= min+CollisionAxisTolerance {
+		if gap := boxMax - min; gap > delta {
+			return gap
 		}
 	}
+	return delta
+}
 
-	newZ := pos.Z + allowed
+func finishAxis(start, allowed, delta float64) (float64, float64) {
 	if !nearlyEqual(allowed, delta) {
-		return newZ, 0
+		return start + allowed, 0
 	}
-	return newZ, delta
+	return start + allowed, delta
+}
+
+func expand(a AABB, dx, dy, dz float64) AABB {
+	if dx < 0 {
+		a.MinX += dx
+	} else {
+		a.MaxX += dx
+	}
+	if dy < 0 {
+		a.MinY += dy
+	} else {
+		a.MaxY += dy
+	}
+	if dz < 0 {
+		a.MinZ += dz
+	} else {
+		a.MaxZ += dz
+	}
+	return a
+}
+
+func overlaps(aMin, aMax, bMin, bMax float64) bool {
+	return aMin < bMax-CollisionAxisTolerance && aMax > bMin+CollisionAxisTolerance
+}
+
+func sq(v float64) float64 {
+	return v * v
 }
 
 func floorForMin(v float64) int {
@@ -18,6 +18,47 @@ const (
 	MinimumResidualHorizontalSpeed = 1e-4
 	MinimumResidualVerticalSpeed   = 1e-4
 	CollisionAxisTolerance         = 1e-9
+	// StepHeight is how high a grounded player walks up without jumping.
+	StepHeight = 0.6
+
+	// MovementProbeDepth finds the block under the feet that sets friction.
+	MovementProbeDepth      = 0.500001
+	ClimbMaxHorizontalSpeed = 0.15
+	ClimbMaxDescentSpeed    = 0.15
+	ClimbUpVelocity         = 0.2
+	SlimeStepThreshold      = 0.1
+	SlimeStepBaseDrag       = 0.4
+	SlimeStepVerticalScale  = 0.2
+
+	// Fluid movement, from vanilla LivingEntity.travel.
+	FluidAcceleration       = 0.02
+	WaterSlowdown           = 0.8
+	WaterSprintSlowdown     = 0.9
+	LavaSlowdown            = 0.5
+	FluidVerticalDrag       = 0.8
+	FluidSwimUpAcceleration = 0.04
+	// FluidJumpThreshold is the depth above which jumping swims up instead
+	// of jumping off the bottom.
+	FluidJumpThreshold     = 0.4
+	FluidLedgeClearance    = 0.6
+	FluidLedgeExitVelocity = 0.3
+	FluidContactInset      = 0.001
+	FluidShallowDepth      = 0.4
+	WaterCurrentStrength   = 0.014
+	// LavaCurrentStrength is the overworld value; the nether uses 0.007.
+	LavaCurrentStrength       = 0.0023333333333333335
+	FluidMinimumDriftVelocity = 0.003
+	FluidMinimumPush          = 0.0045
+
+	// Status effects and creative flight, from vanilla MobEffects and
+	// Player.travel.
+	SpeedEffectPerLevel    = 0.2
+	SlownessEffectPerLevel = 0.15
+	JumpBoostPerLevel      = 0.1
+	DefaultFlySpeed        = 0.05
+	FlyVerticalFactor      = 3.0
+	FlySprintMultiplier    = 2.0
+	FlyVerticalDrag        = 0.6
 
 	PlayerWidth     = 0.6
 	PlayerDepth     = 0.6
@@ -0,0 +1,281 @@
+package physics
+
+import "math"
+
+type FluidKind uint8
+
+const (
+	FluidNone FluidKind = iota
+	FluidWater
+	FluidLava
+)
+
+// Fluid is the fluid in one block. Level is the block's "level" property:
+// 0 is a source, 1-7 flowing, 8 and up falling.
+type Fluid struct {
+	Kind  FluidKind
+	Level int
+}
+
+// FluidSource is implemented by block stores that know where fluids are.
+// Stores without it have no fluids, and the tick only models ground and air.
+type FluidSource interface {
+	FluidAt(x, y, z int) Fluid
+}
+
+// BlockFluid returns the fluid at x, y, z, or none when blockStore has no fluids.
+func BlockFluid(blockStore BlockStore, x, y, z int) Fluid {
+	if fluids, ok := blockStore.(FluidSource); ok {
+		return fluids.FluidAt(x, y, z)
+	}
+	return Fluid{}
+}
+
+// ownHeight is the fluid surface inside its block, ignoring fluid above.
+func (f Fluid) ownHeight() float64 {
+	switch {
+	case f.Kind == FluidNone:
+		return 0
+	case f.Level == 0 || f.Level >= 8:
+		return 8.0 / 9.0
+	default:
+		return float64(8-f.Level) / 9.0
+	}
+}
+
+func fluidHeight(blockStore BlockStore, x, y, z int, f Fluid) float64 {
+	if f.Kind != FluidNone && BlockFluid(blockStore, x, y+1, z).Kind == f.Kind {
+		return 1
+	}
+	return f.ownHeight()
+}
+
+// fluidContact is how deep the player is in each fluid and the summed
+// current pushing it.
+type fluidContact struct {
+	waterHeight float64
+	lavaHeight  float64
+	waterFlow   Vec3
+	lavaFlow    Vec3
+}
+
+func (c fluidContact) inWater() bool { return c.waterHeight > 0 }
+func (c fluidContact) inLava() bool  { return c.lavaHeight > 0 }
+
+// touchFluids mirrors vanilla's updateFluidHeightAndDoFluidPushing for both
+// fluids: heights are measured from the feet, and shallow cells push less.
+func touchFluids(pos Vec3, blockStore BlockStore) fluidContact {
+	var c fluidContact
+	if blockStore == nil {
+		return c
+	}
+	if _, ok := blockStore.(FluidSource); !ok {
+		return c
+	}
+	box := PlayerAABB(pos.X, pos.Y, pos.Z)
+	box.MinX += FluidContactInset
+	box.MinY += FluidContactInset
+	box.MinZ += FluidContactInset
+	box.MaxX -= FluidContactInset
+	box.MaxY -= FluidContactInset
+	box.MaxZ -= FluidContactInset
+
+	var waterCells, lavaCells int
+	for x := floorForMin(box.MinX); x <= floorForMax(box.MaxX); x++ {
+		for y := floorForMin(box.MinY); y <= floorForMax(box.MaxY); y++ {
+			for z := floorForMin(box.MinZ); z <= floorForMax(box.MaxZ); z++ {
+				f := BlockFluid(blockStore, x, y, z)
+				if f.Kind == FluidNone {
+					continue
+				}
+				top := float64(y) + fluidHeight(blockStore, x, y, z, f)
+				if top < box.MinY {
+					continue
+				}
+				depth := top - box.MinY
+				flow := fluidFlow(blockStore, x, y, z, f)
+				if depth < FluidShallowDepth {
+					flow = scale(flow, depth)
+				}
+				if f.Kind == FluidWater {
+					c.waterHeight = math.Max(c.waterHeight, depth)
+					c.waterFlow = add(c.waterFlow, flow)
+					waterCells++
+				} else {
+					c.lavaHeight = math.Max(c.lavaHeight, depth)
+					c.lavaFlow = add(c.lavaFlow, flow)
+					lavaCells++
+				}
+			}
+		}
+	}
+	if waterCells > 0 {
+		c.waterFlow = scale(c.waterFlow, 1/float64(waterCells))
+	}
+	if lavaCells > 0 {
+		c.lavaFlow = scale(c.lavaFlow, 1/float64(lavaCells))
+	}
+	return c
+}
+
+// fluidFlow is vanilla FlowingFluid.getFlow: a unit vector down the height
+// gradient towards lower neighbours, bent downwards for falling fluid.
+func fluidFlow(blockStore BlockStore, x, y, z int, f Fluid) Vec3 {
+	own := f.ownHeight()
+	var flow Vec3
+	for _, d := range [4][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+		nx, nz := x+d[0], z+d[1]
+		n := BlockFluid(blockStore, nx, y, nz)
+		if n.Kind != FluidNone && n.Kind != f.Kind {
+			continue
+		}
+		var diff float64
+		if h := n.ownHeight(); h > 0 {
+			diff = own - h
+		} else if len(BlockCollisionBoxes(blockStore, nx, y, nz)) == 0 {
+			below := BlockFluid(blockStore, nx, y-1, nz)
+			if below.Kind == FluidNone || below.Kind == f.Kind {
+				if h := below.ownHeight(); h > 0 {
+					diff = own - (h - 8.0/9.0)
+				}
+			}
+		}
+		flow.X += float64(d[0]) * diff
+		flow.Z += float64(d[1]) * diff
+	}
+	flow = normalize(flow)
+	if f.Falling() {
+		flow = normalize(add(flow, Vec3{Y: -6}))
+	}
+	return flow
+}
+
+func (f Fluid) Falling() bool {
+	return f.Kind != FluidNone && f.Level >= 8
+}
+
+// applyCurrent adds the fluid push to the velocity. Players are not
+// normalised like mobs; a tiny push is raised to a minimum so still players
+// still drift.
+func applyCurrent(velocity *Vec3, flow Vec3, strength float64) {
+	push := scale(flow, strength)
+	if length(push) == 0 {
+		return
+	}
+	if math.Abs(velocity.X) < FluidMinimumDriftVelocity && math.Abs(velocity.Z) < FluidMinimumDriftVelocity &&
+		length(push) < FluidMinimumPush {
+		push = scale(normalize(push), FluidMinimumPush)
+	}
+	*velocity = add(*velocity, push)
+}
+
+// fluidTick is vanilla LivingEntity.travel for a player in water or lava.
+func fluidTick(state *PhysicsState, input InputState, blockStore BlockStore, entities []EntityCollider, contact fluidContact) {
+	inWater := contact.inWater()
+	height := contact.lavaHeight
+	if inWater {
+		height = contact.waterHeight
+	}
+
+	if input.Jump {
+		if !state.OnGround || height > FluidJumpThreshold {
+			state.Velocity.Y += FluidSwimUpAcceleration
+		} else {
+			state.Velocity.Y = jumpVelocity(JumpInitialVelocity, state.Status)
+		}
+	}
+	if inWater {
+		applyCurrent(&state.Velocity, contact.waterFlow, WaterCurrentStrength)
+	}
+	if contact.inLava() {
+		applyCurrent(&state.Velocity, contact.lavaFlow, LavaCurrentStrength)
+	}
+
+	moveX, moveZ := desiredMoveVector(input)
+	accel := FluidAcceleration
+	if input.Sneak {
+		accel *= SneakSpeedMultiplier
+	}
+	state.Velocity.X += moveX * accel
+	state.Velocity.Z += moveZ * accel
+
+	startY := state.Position.Y
+	wanted := state.Velocity
+	state.Position, state.Velocity = ResolveMovementWithStep(state.Position, state.Velocity, blockStore, state.OnGround)
+	horizontalCollision := !nearlyEqual(state.Velocity.X, wanted.X) || !nearlyEqual(state.Velocity.Z, wanted.Z)
+	state.Position = ApplyEntityPush(state.Position, blockStore, entities)
+	state.OnGround = isStandingOnSolidBlock(state.Position, blockStore)
+
+	if inWater {
+		slowdown := WaterSlowdown
+		if input.Sprint {
+			slowdown = WaterSprintSlowdown
+		}
+		state.Velocity.X *= slowdown
+		state.Velocity.Y *= FluidVerticalDrag
+		state.Velocity.Z *= slowdown
+		if !input.Sprint {
+			state.Velocity.Y -= GravityAcceleration / 16
+		}
+	} else if height <= FluidJumpThreshold {
+		state.Velocity.X *= LavaSlowdown
+		state.Velocity.Y *= FluidVerticalDrag
+		state.Velocity.Z *= LavaSlowdown
+		if !input.Sprint {
+			state.Velocity.Y -= GravityAcceleration / 16
+		}
+	} else {
+		state.Velocity = scale(state.Velocity, LavaSlowdown)
+	}
+	if !inWater {
+		state.Velocity.Y -= GravityAcceleration / 4
+	}
+
+	// Swimming into a ledge lifts the player out, as vanilla does when the
+	// spot just above the ledge is free.
+	if horizontalCollision {
+		probe := PlayerAABB(state.Position.X+state.Velocity.X, state.Position.Y, state.Position.Z+state.Velocity.Z)
+		lift := state.Velocity.Y + FluidLedgeClearance - state.Position.Y + startY
+		probe.MinY += lift
+		probe.MaxY += lift
+		if !CollidesWithBlock(probe, blockStore) && !fluidContactAt(probe, blockStore) {
+			state.Velocity.Y = FluidLedgeExitVelocity
+		}
+	}
+	zeroResidualVelocity(&state.Velocity)
+}
+
+// fluidContactAt reports whether box touches any fluid block, which vanilla's
+// isFree check counts as blocked for the ledge exit.
+func fluidContactAt(box AABB, blockStore BlockStore) bool {
+	for x := floorForMin(box.MinX); x <= floorForMax(box.MaxX); x++ {
+		for y := floorForMin(box.MinY); y <= floorForMax(box.MaxY); y++ {
+			for z := floorForMin(box.MinZ); z <= floorForMax(box.MaxZ); z++ {
+				if BlockFluid(blockStore, x, y, z).Kind != FluidNone {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func add(a, b Vec3) Vec3 {
+	return Vec3{X: a.X + b.X, Y: a.Y + b.Y, Z: a.Z + b.Z}
+}
+
+func scale(v Vec3, f float64) Vec3 {
+	return Vec3{X: v.X * f, Y: v.Y * f, Z: v.Z * f}
+}
+
+func length(v Vec3) float64 {
+	return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+}
+
+func normalize(v Vec3) Vec3 {
+	l := length(v)
+	if l < 1e-4 {
+		return Vec3{}
+	}
+	return scale(v, 1/l)
+}
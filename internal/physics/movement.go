@@ -0,0 +1,112 @@
+package physics
+
+import "math"
+
+// BlockMovement is how a block changes movement on or inside it.
+type BlockMovement struct {
+	// Friction is the block's slipperiness: 0.6 for most blocks, 0.98 for ice.
+	Friction    float64
+	SpeedFactor float64
+	JumpFactor  float64
+	// Climbable blocks (ladders, vines, scaffolding) hold the player up.
+	Climbable bool
+	// SneakDescends marks scaffolding, where sneaking climbs down instead of
+	// holding on.
+	SneakDescends bool
+	// Bouncy blocks (slime) reflect a landing upwards.
+	Bouncy bool
+	// Stuck scales movement while inside the block (cobwebs, berry bushes);
+	// the zero value means the block does not trap.
+	Stuck Vec3
+}
+
+var DefaultBlockMovement = BlockMovement{Friction: DefaultGroundSlippery, SpeedFactor: 1, JumpFactor: 1}
+
+// MovementSource is implemented by block stores that know per-block movement
+// modifiers. Stores without it use DefaultBlockMovement everywhere.
+type MovementSource interface {
+	BlockMovement(x, y, z int) BlockMovement
+}
+
+func BlockMovementAt(blockStore BlockStore, x, y, z int) BlockMovement {
+	if source, ok := blockStore.(MovementSource); ok {
+		return source.BlockMovement(x, y, z)
+	}
+	return DefaultBlockMovement
+}
+
+func (m BlockMovement) traps() bool {
+	return m.Stuck != (Vec3{})
+}
+
+// blockBelow is vanilla's getBlockPosBelowThatAffectsMyMovement: the block
+// just under the feet, which decides friction.
+func blockBelow(pos Vec3, blockStore BlockStore) BlockMovement {
+	return BlockMovementAt(blockStore,
+		int(math.Floor(pos.X)), int(math.Floor(pos.Y-MovementProbeDepth)), int(math.Floor(pos.Z)))
+}
+
+// feetOrBelow returns the block at the feet when it changes speed or jump,
+// otherwise the block below, as vanilla's getBlockSpeedFactor does.
+func feetOrBelow(pos Vec3, blockStore BlockStore, factor func(BlockMovement) float64) float64 {
+	x, z := int(math.Floor(pos.X)), int(math.Floor(pos.Z))
+	if f := factor(BlockMovementAt(blockStore, x, int(math.Floor(pos.Y)), z)); f != 1 {
+		return f
+	}
+	return factor(blockBelow(pos, blockStore))
+}
+
+func speedFactor(pos Vec3, blockStore BlockStore) float64 {
+	return feetOrBelow(pos, blockStore, func(m BlockMovement) float64 { return m.SpeedFactor })
+}
+
+func jumpFactor(pos Vec3, blockStore BlockStore) float64 {
+	return feetOrBelow(pos, blockStore, func(m BlockMovement) float64 { return m.JumpFactor })
+}
+
+// feetBlock is the block the feet are in; vanilla checks it for climbing.
+func feetBlock(pos Vec3, blockStore BlockStore) BlockMovement {
+	return BlockMovementAt(blockStore, int(math.Floor(pos.X)), int(math.Floor(pos.Y)), int(math.Floor(pos.Z)))
+}
+
+// stuckMultiplier returns the trap of the blocks the player is inside.
+// Vanilla applies the last one touched; the strongest is close enough.
+func stuckMultiplier(pos Vec3, blockStore BlockStore) (Vec3, bool) {
+	if _, ok := blockStore.(MovementSource); !ok {
+		return Vec3{}, false
+	}
+	box := PlayerAABB(pos.X, pos.Y, pos.Z)
+	var stuck Vec3
+	found := false
+	for x := floorForMin(box.MinX); x <= floorForMax(box.MaxX); x++ {
+		for y := floorForMin(box.MinY); y <= floorForMax(box.MaxY); y++ {
+			for z := floorForMin(box.MinZ); z <= floorForMax(box.MaxZ); z++ {
+				m := BlockMovementAt(blockStore, x, y, z)
+				if !m.traps() {
+					continue
+				}
+				if !found || length(m.Stuck) < length(stuck) {
+					stuck = m.Stuck
+				}
+				found = true
+			}
+		}
+	}
+	return stuck, found
+}
+
+// climbVelocity is vanilla handleOnClimbable: limit speed on a ladder and
+// hold position while sneaking.
+func climbVelocity(v Vec3, sneak bool, climb BlockMovement) Vec3 {
+	v.X = clamp(v.X, -ClimbMaxHorizontalSpeed, ClimbMaxHorizontalSpeed)
+	v.Z = clamp(v.Z, -ClimbMaxHorizontalSpeed, ClimbMaxHorizontalSpeed)
+	v.Y = math.Max(v.Y, -ClimbMaxDescentSpeed)
+	if sneak && v.Y < 0 && !climb.SneakDescends {
+		v.Y = 0
+	}
+	return v
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
@@ -153,3 +153,405 @@ func TestPhysicsTick_StrafeDirectionAtYawZero(t *testing.T) {
 		t.Fatalf("left strafe x = %.6f, want > 0.5", left.Position.X)
 	}
 }
+
+type shapeBlockStore struct {
+	*mockBlockStore
+	shapes map[[3]int][]AABB
+}
+
+func newShapeBlockStore() *shapeBlockStore {
+	return &shapeBlockStore{mockBlockStore: newMockBlockStore(), shapes: make(map[[3]int][]AABB)}
+}
+
+func (s *shapeBlockStore) setShape(x, y, z int, boxes ...AABB) {
+	s.shapes[[3]int{x, y, z}] = boxes
+}
+
+func (s *shapeBlockStore) CollisionBoxes(x, y, z int) []AABB {
+	if boxes, ok := s.shapes[[3]int{x, y, z}]; ok {
+		return boxes
+	}
+	if s.IsSolid(x, y, z) {
+		return unitCube
+	}
+	return nil
+}
+
+var (
+	bottomSlab = AABB{MaxX: 1, MaxY: 0.5, MaxZ: 1}
+	fencePost  = AABB{MinX: 0.375, MinZ: 0.375, MaxX: 0.625, MaxY: 1.5, MaxZ: 0.625}
+	carpet     = AABB{MaxX: 1, MaxY: 0.0625, MaxZ: 1}
+)
+
+func TestPhysicsTick_StepsUpSlabWithoutJump(t *testing.T) {
+	store := newShapeBlockStore()
+	addFloor(store.mockBlockStore, -2, 4, -2, 2, -1)
+	for x := 1; x <= 4; x++ {
+		store.setShape(x, 0, 0, bottomSlab)
+	}
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0.0, Z: 0.5}, OnGround: true}
+	for i := 0; i < 10; i++ {
+		PhysicsTick(state, InputState{Left: true, Yaw: 0}, store)
+	}
+
+	approxEqual(t, state.Position.Y, 0.5, 1e-9, "position.y")
+	if state.Position.X < 1.5 {
+		t.Fatalf("position.x = %.6f, want past the slab edge", state.Position.X)
+	}
+	if !state.OnGround {
+		t.Fatal("onGround = false on top of the slab")
+	}
+}
+
+func TestPhysicsTick_FenceBlocksEvenWithJump(t *testing.T) {
+	store := newShapeBlockStore()
+	addFloor(store.mockBlockStore, -2, 4, -2, 2, -1)
+	// A fence run across z = -1..1 at x = 1, posts joined by side bars.
+	for z := -1; z <= 1; z++ {
+		store.setShape(1, 0, z, fencePost, AABB{MinX: 0.375, MaxX: 0.625, MaxY: 1.5, MaxZ: 1})
+	}
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0.0, Z: 0.5}, OnGround: true}
+	for i := 0; i < 40; i++ {
+		PhysicsTick(state, InputState{Left: true, Jump: true, Yaw: 0}, store)
+	}
+	if state.Position.X > 1.375-PlayerHalfWidth+1e-9 {
+		t.Fatalf("position.x = %.6f, fence should stop the player at %.3f", state.Position.X, 1.375-PlayerHalfWidth)
+	}
+}
+
+func TestPhysicsTick_StandsOnCarpet(t *testing.T) {
+	store := newShapeBlockStore()
+	addFloor(store.mockBlockStore, -2, 2, -2, 2, -1)
+	store.setShape(0, 0, 0, carpet)
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 1.0, Z: 0.5}}
+	for i := 0; i < 40; i++ {
+		PhysicsTick(state, InputState{}, store)
+	}
+	approxEqual(t, state.Position.Y, 0.0625, 1e-9, "position.y")
+	if !state.OnGround {
+		t.Fatal("onGround = false on carpet")
+	}
+}
+
+func TestPhysicsTick_FullBlockShapeMatchesIsSolid(t *testing.T) {
+	plain := newMockBlockStore()
+	addFloor(plain, -2, 4, -2, 2, -1)
+	plain.setSolid(1, 0, 0)
+	shaped := newShapeBlockStore()
+	addFloor(shaped.mockBlockStore, -2, 4, -2, 2, -1)
+	shaped.setSolid(1, 0, 0)
+
+	a := &PhysicsState{Position: Vec3{X: 0.5, Y: 0.0, Z: 0.5}, OnGround: true}
+	b := &PhysicsState{Position: Vec3{X: 0.5, Y: 0.0, Z: 0.5}, OnGround: true}
+	for i := 0; i < 20; i++ {
+		PhysicsTick(a, InputState{Left: true, Yaw: 0}, plain)
+		PhysicsTick(b, InputState{Left: true, Yaw: 0}, shaped)
+	}
+	if a.Position != b.Position {
+		t.Fatalf("shaped store position %+v differs from plain %+v", b.Position, a.Position)
+	}
+}
+
+type fluidBlockStore struct {
+	*mockBlockStore
+	fluids map[[3]int]Fluid
+}
+
+func newFluidBlockStore() *fluidBlockStore {
+	return &fluidBlockStore{mockBlockStore: newMockBlockStore(), fluids: make(map[[3]int]Fluid)}
+}
+
+func (s *fluidBlockStore) FluidAt(x, y, z int) Fluid {
+	return s.fluids[[3]int{x, y, z}]
+}
+
+func (s *fluidBlockStore) fill(minX, maxX, minY, maxY, minZ, maxZ int, f Fluid) {
+	for x := minX; x <= maxX; x++ {
+		for y := minY; y <= maxY; y++ {
+			for z := minZ; z <= maxZ; z++ {
+				s.fluids[[3]int{x, y, z}] = f
+			}
+		}
+	}
+}
+
+func TestPhysicsTick_SinksSlowlyInStillWater(t *testing.T) {
+	store := newFluidBlockStore()
+	addFloor(store.mockBlockStore, -4, 4, -4, 4, -1)
+	store.fill(-4, 4, 0, 9, -4, 4, Fluid{Kind: FluidWater})
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 5, Z: 0.5}}
+	for i := 0; i < 40; i++ {
+		PhysicsTick(state, InputState{}, store)
+	}
+	if !state.InWater || state.InLava {
+		t.Fatalf("InWater=%v InLava=%v, want water only", state.InWater, state.InLava)
+	}
+	// Drag 0.8 and gravity/16 settle at -0.005/(1-0.8).
+	approxEqual(t, state.Velocity.Y, -0.025, 1e-3, "velocity.y")
+	if state.Position.Y < 3.9 {
+		t.Fatalf("position.y = %.3f, sank too fast for water", state.Position.Y)
+	}
+}
+
+func TestPhysicsTick_JumpSwimsUp(t *testing.T) {
+	store := newFluidBlockStore()
+	addFloor(store.mockBlockStore, -4, 4, -4, 4, -1)
+	store.fill(-4, 4, 0, 9, -4, 4, Fluid{Kind: FluidWater})
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 2, Z: 0.5}}
+	for i := 0; i < 20; i++ {
+		PhysicsTick(state, InputState{Jump: true}, store)
+	}
+	if state.Position.Y <= 3 {
+		t.Fatalf("position.y = %.3f, want swimming upwards", state.Position.Y)
+	}
+}
+
+func TestPhysicsTick_WaterCurrentPushesDownstream(t *testing.T) {
+	store := newFluidBlockStore()
+	addFloor(store.mockBlockStore, -2, 10, -2, 2, -1)
+	store.fill(-2, -2, 0, 0, -2, 2, Fluid{Kind: FluidWater})
+	for x := -1; x <= 6; x++ {
+		store.fill(x, x, 0, 0, -2, 2, Fluid{Kind: FluidWater, Level: x + 2})
+	}
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0, Z: 0.5}, OnGround: true}
+	for i := 0; i < 20; i++ {
+		PhysicsTick(state, InputState{}, store)
+	}
+	if state.Position.X <= 0.6 {
+		t.Fatalf("position.x = %.3f, want pushed towards lower water", state.Position.X)
+	}
+	approxEqual(t, state.Position.Z, 0.5, 1e-9, "position.z")
+}
+
+func TestPhysicsTick_LavaIsSlowerThanWater(t *testing.T) {
+	travel := func(kind FluidKind) float64 {
+		store := newFluidBlockStore()
+		addFloor(store.mockBlockStore, -2, 20, -2, 2, -1)
+		store.fill(-2, 20, 0, 2, -2, 2, Fluid{Kind: kind})
+		state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0, Z: 0.5}, OnGround: true}
+		for i := 0; i < 20; i++ {
+			PhysicsTick(state, InputState{Left: true}, store)
+		}
+		return state.Position.X - 0.5
+	}
+	water, lava := travel(FluidWater), travel(FluidLava)
+	if water <= 0 || lava <= 0 || lava >= water/2 {
+		t.Fatalf("distance in water=%.3f lava=%.3f, want lava much slower", water, lava)
+	}
+}
+
+func TestPhysicsTick_SwimsOutOverLedge(t *testing.T) {
+	store := newFluidBlockStore()
+	addFloor(store.mockBlockStore, -2, 4, -2, 2, -1)
+	addFloor(store.mockBlockStore, 1, 4, -2, 2, 0)
+	store.fill(-2, 0, 0, 0, -2, 2, Fluid{Kind: FluidWater})
+
+	state := &PhysicsState{Position: Vec3{X: 0.7, Y: 0.5, Z: 0.5}}
+	PhysicsTick(state, InputState{Left: true}, store)
+	approxEqual(t, state.Velocity.Y, FluidLedgeExitVelocity, 1e-9, "velocity.y")
+}
+
+type movementBlockStore struct {
+	*mockBlockStore
+	movement map[[3]int]BlockMovement
+}
+
+func newMovementBlockStore() *movementBlockStore {
+	return &movementBlockStore{mockBlockStore: newMockBlockStore(), movement: make(map[[3]int]BlockMovement)}
+}
+
+func (s *movementBlockStore) BlockMovement(x, y, z int) BlockMovement {
+	if m, ok := s.movement[[3]int{x, y, z}]; ok {
+		return m
+	}
+	return DefaultBlockMovement
+}
+
+func (s *movementBlockStore) floor(minX, maxX, minZ, maxZ, y int, m BlockMovement) {
+	addFloor(s.mockBlockStore, minX, maxX, minZ, maxZ, y)
+	for x := minX; x <= maxX; x++ {
+		for z := minZ; z <= maxZ; z++ {
+			s.movement[[3]int{x, y, z}] = m
+		}
+	}
+}
+
+func walkDistance(store BlockStore, walkTicks, coastTicks int) float64 {
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0, Z: 0.5}, OnGround: true}
+	for i := 0; i < walkTicks+coastTicks; i++ {
+		PhysicsTick(state, InputState{Left: i < walkTicks}, store)
+	}
+	return state.Position.X - 0.5
+}
+
+func TestPhysicsTick_WalkSpeedMatchesVanilla(t *testing.T) {
+	store := newMockBlockStore()
+	addFloor(store, -2, 40, -2, 2, -1)
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0, Z: 0.5}, OnGround: true}
+	for i := 0; i < 30; i++ {
+		PhysicsTick(state, InputState{Left: true}, store)
+	}
+	before := state.Position.X
+	PhysicsTick(state, InputState{Left: true}, store)
+	// 0.1 acceleration against 0.6*0.91 drag settles at 0.1/(1-0.546).
+	approxEqual(t, state.Position.X-before, 0.1/(1-0.546), 1e-3, "blocks per tick")
+}
+
+func TestPhysicsTick_IceSlidesFurther(t *testing.T) {
+	stone := newMovementBlockStore()
+	stone.floor(-2, 60, -2, 2, -1, DefaultBlockMovement)
+	ice := newMovementBlockStore()
+	ice.floor(-2, 60, -2, 2, -1, BlockMovement{Friction: 0.98, SpeedFactor: 1, JumpFactor: 1})
+
+	stoneCoast := walkDistance(stone, 20, 20) - walkDistance(stone, 20, 0)
+	iceCoast := walkDistance(ice, 20, 20) - walkDistance(ice, 20, 0)
+	if iceCoast < 4*stoneCoast {
+		t.Fatalf("coasting on ice = %.3f, stone = %.3f; ice should slide much further", iceCoast, stoneCoast)
+	}
+}
+
+func TestPhysicsTick_SoulSandSlowsWalking(t *testing.T) {
+	stone := newMovementBlockStore()
+	stone.floor(-2, 40, -2, 2, -1, DefaultBlockMovement)
+	soulSand := newMovementBlockStore()
+	soulSand.floor(-2, 40, -2, 2, -1, BlockMovement{Friction: 0.6, SpeedFactor: 0.4, JumpFactor: 1})
+
+	normal, slow := walkDistance(stone, 20, 0), walkDistance(soulSand, 20, 0)
+	// Vanilla soul sand walking is a bit over half normal speed.
+	if slow > normal*0.65 {
+		t.Fatalf("soul sand distance = %.3f, stone = %.3f; want much slower", slow, normal)
+	}
+}
+
+func TestPhysicsTick_HoneyHalvesJump(t *testing.T) {
+	store := newMovementBlockStore()
+	store.floor(-2, 2, -2, 2, -1, BlockMovement{Friction: 0.6, SpeedFactor: 0.4, JumpFactor: 0.5})
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0, Z: 0.5}, OnGround: true}
+	PhysicsTick(state, InputState{Jump: true}, store)
+	approxEqual(t, state.Position.Y, JumpInitialVelocity*0.5, 1e-9, "position.y")
+}
+
+func TestPhysicsTick_ClimbsLadder(t *testing.T) {
+	ladder := BlockMovement{Friction: 0.6, SpeedFactor: 1, JumpFactor: 1, Climbable: true}
+	store := newMovementBlockStore()
+	addFloor(store.mockBlockStore, -2, 2, -2, 2, -1)
+	for y := 0; y < 10; y++ {
+		store.setSolid(1, y, 0)
+		store.movement[[3]int{0, y, 0}] = ladder
+	}
+
+	state := &PhysicsState{Position: Vec3{X: 0.7, Y: 0, Z: 0.5}, OnGround: true}
+	for i := 0; i < 30; i++ {
+		PhysicsTick(state, InputState{Left: true}, store)
+	}
+	// Vanilla climbs at 0.1176 blocks per tick.
+	if state.Position.Y < 3 {
+		t.Fatalf("position.y = %.3f after climbing, want above 3", state.Position.Y)
+	}
+
+	held := state.Position.Y
+	for i := 0; i < 10; i++ {
+		PhysicsTick(state, InputState{Sneak: true}, store)
+	}
+	if state.Position.Y < held-0.2 {
+		t.Fatalf("sneaking slid from %.3f to %.3f, want to hold on", held, state.Position.Y)
+	}
+
+	top := state.Position.Y
+	for i := 0; i < 10; i++ {
+		PhysicsTick(state, InputState{}, store)
+		if state.Velocity.Y < -ClimbMaxDescentSpeed-GravityAcceleration {
+			t.Fatalf("velocity.y = %.3f, ladder should limit descent", state.Velocity.Y)
+		}
+	}
+	if state.Position.Y >= top {
+		t.Fatalf("position.y = %.3f, want sliding down from %.3f", state.Position.Y, top)
+	}
+}
+
+func TestPhysicsTick_CobwebSlowsFall(t *testing.T) {
+	store := newMovementBlockStore()
+	addFloor(store.mockBlockStore, -2, 2, -2, 2, -1)
+	for y := 0; y < 10; y++ {
+		store.movement[[3]int{0, y, 0}] = BlockMovement{Friction: 0.6, SpeedFactor: 1, JumpFactor: 1, Stuck: Vec3{X: 0.25, Y: 0.05, Z: 0.25}}
+	}
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 8, Z: 0.5}, Velocity: Vec3{Y: -1}}
+	for i := 0; i < 10; i++ {
+		PhysicsTick(state, InputState{}, store)
+	}
+	if state.Position.Y < 7.8 {
+		t.Fatalf("position.y = %.3f, cobweb should nearly stop the fall", state.Position.Y)
+	}
+}
+
+func TestPhysicsTick_SlimeBounces(t *testing.T) {
+	store := newMovementBlockStore()
+	store.floor(-2, 2, -2, 2, -1, BlockMovement{Friction: 0.8, SpeedFactor: 1, JumpFactor: 1, Bouncy: true})
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0.3, Z: 0.5}, Velocity: Vec3{Y: -0.5}}
+	PhysicsTick(state, InputState{}, store)
+	if state.Velocity.Y <= 0.3 {
+		t.Fatalf("velocity.y = %.3f after landing on slime, want a bounce", state.Velocity.Y)
+	}
+
+	sneaking := &PhysicsState{Position: Vec3{X: 0.5, Y: 0.3, Z: 0.5}, Velocity: Vec3{Y: -0.5}}
+	PhysicsTick(sneaking, InputState{Sneak: true}, store)
+	if sneaking.Velocity.Y > 0 {
+		t.Fatalf("velocity.y = %.3f, sneaking should land without bouncing", sneaking.Velocity.Y)
+	}
+}
+
+func walkDistanceWithStatus(status MovementStatus, ticks int) float64 {
+	store := newMockBlockStore()
+	addFloor(store, -2, 40, -2, 2, -1)
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0, Z: 0.5}, OnGround: true, Status: status}
+	for i := 0; i < ticks; i++ {
+		PhysicsTick(state, InputState{Left: true}, store)
+	}
+	return state.Position.X - 0.5
+}
+
+func TestPhysicsTick_SpeedAndSlownessEffects(t *testing.T) {
+	normal := walkDistanceWithStatus(MovementStatus{}, 20)
+	fast := walkDistanceWithStatus(MovementStatus{Speed: 2}, 20)
+	slow := walkDistanceWithStatus(MovementStatus{Slowness: 2}, 20)
+
+	approxEqual(t, fast/normal, 1.4, 0.02, "speed II ratio")
+	approxEqual(t, slow/normal, 0.7, 0.02, "slowness II ratio")
+}
+
+func TestPhysicsTick_JumpBoostRaisesJump(t *testing.T) {
+	store := newMockBlockStore()
+	addFloor(store, -2, 2, -2, 2, -1)
+
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 0, Z: 0.5}, OnGround: true, Status: MovementStatus{JumpBoost: 1}}
+	PhysicsTick(state, InputState{Jump: true}, store)
+	approxEqual(t, state.Position.Y, JumpInitialVelocity+JumpBoostPerLevel, 1e-9, "position.y")
+}
+
+func TestPhysicsTick_CreativeFlightHovers(t *testing.T) {
+	store := newMockBlockStore()
+	state := &PhysicsState{Position: Vec3{X: 0.5, Y: 10, Z: 0.5}, Status: MovementStatus{Flying: true}}
+	for i := 0; i < 20; i++ {
+		PhysicsTick(state, InputState{}, store)
+	}
+	approxEqual(t, state.Position.Y, 10, 1e-9, "hovering position.y")
+
+	PhysicsTick(state, InputState{Jump: true}, store)
+	approxEqual(t, state.Position.Y, 10+DefaultFlySpeed*FlyVerticalFactor, 1e-9, "rising position.y")
+	for i := 0; i < 40; i++ {
+		PhysicsTick(state, InputState{}, store)
+	}
+	if state.Velocity.Y != 0 {
+		t.Fatalf("velocity.y = %.4f, flight should stop drifting", state.Velocity.Y)
+	}
+}
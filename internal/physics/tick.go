@@ -6,6 +6,21 @@ type PhysicsState struct {
 	Position Vec3
 	Velocity Vec3
 	OnGround bool
+	InWater  bool
+	InLava   bool
+	// Status is refreshed by the body from the world state before each tick.
+	Status MovementStatus
+}
+
+// MovementStatus holds the effects and abilities that change how the player
+// moves. Effect levels are 0 when the effect is absent.
+type MovementStatus struct {
+	Speed     int
+	Slowness  int
+	JumpBoost int
+	Flying    bool
+	// FlySpeed is the abilities flying speed; 0 means DefaultFlySpeed.
+	FlySpeed float64
 }
 
 type InputState struct {
@@ -56,24 +71,39 @@ func PhysicsTickWithEntities(state *PhysicsState, input InputState, blockStore B
 		return
 	}
 
-	state.OnGround = isStandingOnSolidBlock(state.Position, blockStore)
+	if state.Status.Flying {
+		flyTick(state, input, blockStore, entities)
+		return
+	}
 
-	moveX, moveZ := desiredMoveVector(input)
-	friction := HorizontalDragBase
-	if state.OnGround {
-		friction *= DefaultGroundSlippery
+	state.OnGround = isStandingOnSolidBlock(state.Position, blockStore)
+	contact := touchFluids(state.Position, blockStore)
+	state.InWater = contact.inWater()
+	state.InLava = contact.inLava()
+	if state.InWater || state.InLava {
+		fluidTick(state, input, blockStore, entities, contact)
+		return
 	}
 
+	below := blockBelow(state.Position, blockStore)
+	friction := HorizontalDragBase
 	accel := AirAcceleration
 	if state.OnGround {
-		accel = groundAcceleration(moveSpeedMultiplier(input), friction)
+		friction *= below.Friction
+		accel = groundAcceleration(moveSpeed(input, state.Status), below.Friction)
 	}
 
+	moveX, moveZ := desiredMoveVector(input)
 	state.Velocity.X += moveX * accel
 	state.Velocity.Z += moveZ * accel
 
 	if state.OnGround && input.Jump {
-		state.Velocity.Y = JumpInitialVelocity
+		state.Velocity.Y = jumpVelocity(JumpInitialVelocity*jumpFactor(state.Position, blockStore), state.Status)
+	}
+
+	climb := feetBlock(state.Position, blockStore)
+	if climb.Climbable {
+		state.Velocity = climbVelocity(state.Velocity, input.Sneak, climb)
 	}
 
 	if state.OnGround && input.Sneak {
@@ -85,10 +115,41 @@ func PhysicsTickWithEntities(state *PhysicsState, input InputState, blockStore B
 		)
 	}
 
-	state.Position, state.Velocity = ResolveMovement(state.Position, state.Velocity, blockStore)
-	state.Position = ApplyEntityPush(state.Position, blockStore, entities)
+	// Cobwebs and berry bushes scale this tick's motion and swallow the
+	// momentum, like vanilla's stuckSpeedMultiplier.
+	motion := state.Velocity
+	stuck, trapped := stuckMultiplier(state.Position, blockStore)
+	if trapped {
+		motion = Vec3{X: motion.X * stuck.X, Y: motion.Y * stuck.Y, Z: motion.Z * stuck.Z}
+	}
+
+	newPos, moved := ResolveMovementWithStep(state.Position, motion, blockStore, state.OnGround)
+	horizontalCollision := !nearlyEqual(moved.X, motion.X) || !nearlyEqual(moved.Z, motion.Z)
+	state.Position = ApplyEntityPush(newPos, blockStore, entities)
+	if trapped {
+		state.Velocity = Vec3{}
+	} else {
+		state.Velocity = moved
+	}
 	state.OnGround = isStandingOnSolidBlock(state.Position, blockStore)
 
+	landedOn := blockBelow(state.Position, blockStore)
+	if landedOn.Bouncy && !input.Sneak && state.OnGround && motion.Y < 0 && nearlyZero(moved.Y) {
+		state.Velocity.Y = -motion.Y
+	}
+	if landedOn.Bouncy && !input.Sneak && state.OnGround && math.Abs(state.Velocity.Y) < SlimeStepThreshold {
+		damp := SlimeStepBaseDrag + math.Abs(state.Velocity.Y)*SlimeStepVerticalScale
+		state.Velocity.X *= damp
+		state.Velocity.Z *= damp
+	}
+	speed := speedFactor(state.Position, blockStore)
+	state.Velocity.X *= speed
+	state.Velocity.Z *= speed
+
+	if climb.Climbable && (horizontalCollision || input.Jump) {
+		state.Velocity.Y = ClimbUpVelocity
+	}
+
 	state.Velocity.Y = (state.Velocity.Y - GravityAcceleration) * VerticalDrag
 	state.Velocity.X *= friction
 	state.Velocity.Z *= friction
@@ -125,8 +186,11 @@ func desiredMoveVector(input InputState) (float64, float64) {
 	return worldX, worldZ
 }
 
-func moveSpeedMultiplier(input InputState) float64 {
-	speed := WalkBaseSpeed
+// moveSpeed is the movement_speed attribute: Speed and Slowness scale the
+// base walking speed before sprinting does.
+func moveSpeed(input InputState, status MovementStatus) float64 {
+	speed := WalkBaseSpeed * (1 + SpeedEffectPerLevel*float64(status.Speed))
+	speed *= max(0, 1-SlownessEffectPerLevel*float64(status.Slowness))
 	if input.Sprint {
 		speed *= SprintSpeedMultiplier
 	}
@@ -139,11 +203,53 @@ func moveSpeedMultiplier(input InputState) float64 {
 	return speed
 }
 
-func groundAcceleration(speed, friction float64) float64 {
-	if friction < CollisionAxisTolerance {
+// jumpVelocity adds Jump Boost to a jump's initial velocity.
+func jumpVelocity(base float64, status MovementStatus) float64 {
+	return base + JumpBoostPerLevel*float64(status.JumpBoost)
+}
+
+// flyTick is creative flight: jump and sneak move straight up and down,
+// there is no gravity, and vertical motion decays quickly.
+func flyTick(state *PhysicsState, input InputState, blockStore BlockStore, entities []EntityCollider) {
+	speed := state.Status.FlySpeed
+	if speed <= 0 {
+		speed = DefaultFlySpeed
+	}
+	if input.Sneak {
+		state.Velocity.Y -= speed * FlyVerticalFactor
+	}
+	if input.Jump {
+		state.Velocity.Y += speed * FlyVerticalFactor
+	}
+	accel := speed
+	if input.Sprint {
+		accel *= FlySprintMultiplier
+	}
+	moveX, moveZ := desiredMoveVector(input)
+	state.Velocity.X += moveX * accel
+	state.Velocity.Z += moveZ * accel
+
+	newPos, moved := ResolveMovement(state.Position, state.Velocity, blockStore)
+	state.Position = ApplyEntityPush(newPos, blockStore, entities)
+	state.Velocity = moved
+	state.OnGround = isStandingOnSolidBlock(state.Position, blockStore)
+	contact := touchFluids(state.Position, blockStore)
+	state.InWater = contact.inWater()
+	state.InLava = contact.inLava()
+
+	state.Velocity.Y *= FlyVerticalDrag
+	state.Velocity.X *= HorizontalDragBase
+	state.Velocity.Z *= HorizontalDragBase
+	zeroResidualVelocity(&state.Velocity)
+}
+
+// groundAcceleration is vanilla getFrictionInfluencedSpeed: slippery blocks
+// accelerate less so the top speed stays about the same.
+func groundAcceleration(speed, slipperiness float64) float64 {
+	if slipperiness < CollisionAxisTolerance {
 		return speed
 	}
-	return speed * (GroundAccelerationFactor / (friction * friction * friction))
+	return speed * (GroundAccelerationFactor / (slipperiness * slipperiness * slipperiness))
 }
 
 func clampSneakEdgeVelocity(pos Vec3, velX, velZ float64, blockStore BlockStore) (float64, float64) {
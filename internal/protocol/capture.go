@@ -0,0 +1,187 @@
+package protocol
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Capture files record a session's packets for offline replay. Layout:
+//
+//	header: "LOCUSCAP" | format byte | protocol VarInt | start Int64 (unix ns)
+//	record: offset VarLong (µs since start) | direction byte | state byte |
+//	        packet ID VarInt | payload length VarInt | payload
+//
+// From format 2 the records after the header form one zlib stream, flushed
+// after every record so a capture cut short by a crash stays readable.
+// Format 1 files store the records uncompressed.
+//
+// Packet IDs are canonical (see Version.CanonicalID), so a capture replays
+// against the constants in this package whatever the server version was.
+const (
+	captureMagic     = "LOCUSCAP"
+	captureFormat    = 2
+	captureFormatRaw = 1
+	// maxCapturePayload matches the vanilla limit on decompressed packets.
+	maxCapturePayload = 8 << 20
+)
+
+var ErrBadCapture = errors.New("not a locus capture file")
+
+type CaptureRecord struct {
+	// Offset is the time since the capture started.
+	Offset    time.Duration
+	Direction Direction
+	State     State
+	Packet    *Packet
+}
+
+// CaptureWriter appends compressed packet records to w. It is safe for
+// concurrent use; each record is flushed to w before Write returns. Close
+// ends the compressed stream but leaves w open.
+type CaptureWriter struct {
+	mu    sync.Mutex
+	zw    *zlib.Writer
+	start time.Time
+	now   func() time.Time
+}
+
+func NewCaptureWriter(w io.Writer, protocolVersion int32) (*CaptureWriter, error) {
+	c := &CaptureWriter{now: time.Now}
+	c.start = c.now()
+	buf := new(bytes.Buffer)
+	buf.WriteString(captureMagic)
+	_ = WriteByte(buf, captureFormat)
+	_ = WriteVarint(buf, protocolVersion)
+	_ = WriteInt64(buf, c.start.UnixNano())
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return nil, err
+	}
+	c.zw = zlib.NewWriter(w)
+	// Write the zlib header now so a capture without records still reads.
+	if err := c.zw.Flush(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *CaptureWriter) Write(dir Direction, state State, packet *Packet) error {
+	if len(packet.Payload) > maxCapturePayload {
+		return fmt.Errorf("capture: payload of 0x%02x is %d bytes", packet.ID, len(packet.Payload))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	buf := new(bytes.Buffer)
+	_ = WriteVarLong(buf, c.now().Sub(c.start).Microseconds())
+	_ = WriteByte(buf, byte(dir))
+	_ = WriteByte(buf, byte(state))
+	_ = WriteVarint(buf, packet.ID)
+	_ = WriteByteArray(buf, packet.Payload)
+	if _, err := c.zw.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return c.zw.Flush()
+}
+
+// Close finishes the compressed stream. It does not close the underlying
+// writer.
+func (c *CaptureWriter) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.zw.Close()
+}
+
+type CaptureReader struct {
+	r        *bufio.Reader
+	Protocol int32
+	Start    time.Time
+	// compressed captures whose writer was never closed end without a zlib
+	// trailer, which reads as io.ErrUnexpectedEOF between records.
+	compressed bool
+}
+
+func NewCaptureReader(r io.Reader) (*CaptureReader, error) {
+	// Varints are read a byte at a time.
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(captureMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != captureMagic {
+		return nil, ErrBadCapture
+	}
+	format, err := ReadByte(br)
+	if err != nil {
+		return nil, err
+	}
+	if format != captureFormat && format != captureFormatRaw {
+		return nil, fmt.Errorf("capture format %d is not supported", format)
+	}
+	c := &CaptureReader{r: br}
+	if c.Protocol, err = ReadVarint(br); err != nil {
+		return nil, err
+	}
+	start, err := ReadInt64(br)
+	if err != nil {
+		return nil, err
+	}
+	c.Start = time.Unix(0, start)
+	if format == captureFormat {
+		zr, err := zlib.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("capture: %w", err)
+		}
+		c.r = bufio.NewReader(zr)
+		c.compressed = true
+	}
+	return c, nil
+}
+
+// Next returns the next record, or io.EOF after the last complete one. A
+// record cut short by a crash reports io.ErrUnexpectedEOF.
+func (c *CaptureReader) Next() (*CaptureRecord, error) {
+	if _, err := c.r.Peek(1); err != nil {
+		if err == io.ErrUnexpectedEOF && c.compressed {
+			// The writer flushed every record but was never closed.
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	offset, err := ReadVarLong(c.r)
+	if err != nil {
+		return nil, err
+	}
+	rec := &CaptureRecord{Offset: time.Duration(offset) * time.Microsecond}
+	var header [2]byte
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	rec.Direction = Direction(header[0])
+	rec.State = State(header[1])
+	id, err := ReadVarint(c.r)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	length, err := ReadVarint(c.r)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if length < 0 || length > maxCapturePayload {
+		return nil, fmt.Errorf("capture: invalid payload length %d", length)
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	rec.Packet = &Packet{ID: id, Payload: payload}
+	return rec, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
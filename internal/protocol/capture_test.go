@@ -0,0 +1,164 @@
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestCaptureRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCaptureWriter(&buf, CurrentProtocolVersion)
+	if err != nil {
+		t.Fatalf("NewCaptureWriter 失败: %v", err)
+	}
+	clock := w.start
+	w.now = func() time.Time { return clock }
+
+	clock = clock.Add(1500 * time.Microsecond)
+	if err := w.Write(ToClient, Play, &Packet{ID: S2CPlayKeepAlive, Payload: []byte{1, 2, 3}}); err != nil {
+		t.Fatalf("写入记录失败: %v", err)
+	}
+	clock = clock.Add(time.Second)
+	if err := w.Write(ToServer, Configuration, &Packet{ID: C2SConfigKeepAlive}); err != nil {
+		t.Fatalf("写入记录失败: %v", err)
+	}
+
+	r, err := NewCaptureReader(&buf)
+	if err != nil {
+		t.Fatalf("NewCaptureReader 失败: %v", err)
+	}
+	if r.Protocol != CurrentProtocolVersion || !r.Start.Equal(w.start) {
+		t.Errorf("文件头 = %d %v, 期望 %d %v", r.Protocol, r.Start, CurrentProtocolVersion, w.start)
+	}
+
+	first, err := r.Next()
+	if err != nil {
+		t.Fatalf("读取第一条记录失败: %v", err)
+	}
+	if first.Offset != 1500*time.Microsecond || first.Direction != ToClient || first.State != Play ||
+		first.Packet.ID != S2CPlayKeepAlive || !bytes.Equal(first.Packet.Payload, []byte{1, 2, 3}) {
+		t.Errorf("第一条记录 = %+v %+v", first, first.Packet)
+	}
+	second, err := r.Next()
+	if err != nil {
+		t.Fatalf("读取第二条记录失败: %v", err)
+	}
+	if second.Direction != ToServer || second.State != Configuration || len(second.Packet.Payload) != 0 {
+		t.Errorf("第二条记录 = %+v %+v", second, second.Packet)
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("文件结尾应返回 io.EOF, 得到 %v", err)
+	}
+}
+
+func TestCaptureCompressesRecords(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := NewCaptureWriter(&buf, CurrentProtocolVersion)
+	payload := bytes.Repeat([]byte("chunk data "), 1000)
+	for i := 0; i < 10; i++ {
+		if err := w.Write(ToClient, Play, &Packet{ID: S2CLevelChunkWithLight, Payload: payload}); err != nil {
+			t.Fatalf("写入记录失败: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close 失败: %v", err)
+	}
+	if buf.Len() >= len(payload) {
+		t.Errorf("录制文件 %d 字节, 未被压缩 (单个负载 %d 字节)", buf.Len(), len(payload))
+	}
+
+	r, err := NewCaptureReader(&buf)
+	if err != nil {
+		t.Fatalf("NewCaptureReader 失败: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		rec, err := r.Next()
+		if err != nil {
+			t.Fatalf("读取第 %d 条记录失败: %v", i, err)
+		}
+		if !bytes.Equal(rec.Packet.Payload, payload) {
+			t.Fatalf("第 %d 条记录负载不一致", i)
+		}
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("文件结尾应返回 io.EOF, 得到 %v", err)
+	}
+}
+
+func TestCaptureReaderReadsFormat1(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString(captureMagic)
+	_ = WriteByte(&buf, captureFormatRaw)
+	_ = WriteVarint(&buf, CurrentProtocolVersion)
+	_ = WriteInt64(&buf, 0)
+	_ = WriteVarLong(&buf, 250)
+	_ = WriteByte(&buf, byte(ToClient))
+	_ = WriteByte(&buf, byte(Play))
+	_ = WriteVarint(&buf, S2CPlayKeepAlive)
+	_ = WriteByteArray(&buf, []byte{7})
+
+	r, err := NewCaptureReader(&buf)
+	if err != nil {
+		t.Fatalf("NewCaptureReader 失败: %v", err)
+	}
+	rec, err := r.Next()
+	if err != nil {
+		t.Fatalf("读取记录失败: %v", err)
+	}
+	if rec.Offset != 250*time.Microsecond || rec.Packet.ID != S2CPlayKeepAlive || !bytes.Equal(rec.Packet.Payload, []byte{7}) {
+		t.Errorf("记录 = %+v %+v", rec, rec.Packet)
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("文件结尾应返回 io.EOF, 得到 %v", err)
+	}
+}
+
+func TestCaptureReaderTruncatedRecord(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := NewCaptureWriter(&buf, CurrentProtocolVersion)
+	headerLen := buf.Len()
+	payload := make([]byte, 4096)
+	for i := range payload {
+		payload[i] = byte(i * 7 % 251)
+	}
+	_ = w.Write(ToClient, Play, &Packet{ID: 1, Payload: payload})
+	data := buf.Bytes()[:headerLen+(buf.Len()-headerLen)/2]
+
+	r, err := NewCaptureReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("NewCaptureReader 失败: %v", err)
+	}
+	if _, err := r.Next(); err != io.ErrUnexpectedEOF {
+		t.Errorf("截断的记录应返回 io.ErrUnexpectedEOF, 得到 %v", err)
+	}
+}
+
+func TestCaptureReaderUnclosedWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := NewCaptureWriter(&buf, CurrentProtocolVersion)
+	_ = w.Write(ToClient, Play, &Packet{ID: 1, Payload: []byte("hello")})
+
+	// Without Close the stream has no trailer, as after a crash.
+	r, err := NewCaptureReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("NewCaptureReader 失败: %v", err)
+	}
+	if rec, err := r.Next(); err != nil || string(rec.Packet.Payload) != "hello" {
+		t.Fatalf("读取记录失败: %+v %v", rec, err)
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("未关闭的录制文件结尾应返回 io.EOF, 得到 %v", err)
+	}
+}
+
+func TestCaptureReaderRejectsOtherFiles(t *testing.T) {
+	if _, err := NewCaptureReader(bytes.NewReader([]byte("PK\x03\x04 not a capture"))); !errors.Is(err, ErrBadCapture) {
+		t.Errorf("期望 ErrBadCapture, 得到 %v", err)
+	}
+	if _, err := NewCaptureReader(bytes.NewReader([]byte(captureMagic + "\x09"))); err == nil {
+		t.Error("不支持的格式版本应返回错误")
+	}
+}
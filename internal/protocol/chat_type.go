@@ -0,0 +1,103 @@
+package protocol
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Vanilla minecraft:chat_type registry order, used when the entry name of
+// a registry reference is unknown. Servers that add chat types through
+// datapacks may shift these indexes.
+const (
+	ChatTypeChat = iota
+	ChatTypeEmoteCommand
+	ChatTypeMsgCommandIncoming
+	ChatTypeMsgCommandOutgoing
+	ChatTypeSayCommand
+	ChatTypeTeamMsgCommandIncoming
+	ChatTypeTeamMsgCommandOutgoing
+)
+
+// ChatType is a ChatTypesHolder: either a chat_type registry index or an
+// inline definition, in which case RegistryID is -1.
+type ChatType struct {
+	RegistryID     int32
+	TranslationKey string
+	// Name is the chat_type entry key of a registry reference. The packet
+	// only carries the index, so callers fill it in from the synchronized
+	// registry; left empty, the vanilla order is assumed.
+	Name string
+}
+
+func (c ChatType) IsInline() bool {
+	return c.RegistryID < 0
+}
+
+// ReadChatTypeHolder reads a registry entry holder: 0 means an inline
+// definition follows, otherwise the value is registry index + 1.
+func ReadChatTypeHolder(r io.Reader) (ChatType, error) {
+	holder, err := ReadVarint(r)
+	if err != nil {
+		return ChatType{}, err
+	}
+	if holder > 0 {
+		return ChatType{RegistryID: holder - 1}, nil
+	}
+
+	// Inline ChatTypes: chat decoration followed by narration decoration.
+	chatType := ChatType{RegistryID: -1}
+	for i := 0; i < 2; i++ {
+		key, err := ReadString(r)
+		if err != nil {
+			return ChatType{}, err
+		}
+		if i == 0 {
+			chatType.TranslationKey = key
+		}
+		count, err := ReadVarint(r)
+		if err != nil {
+			return ChatType{}, err
+		}
+		if count < 0 {
+			return ChatType{}, fmt.Errorf("invalid chat type parameter count %d", count)
+		}
+		for j := int32(0); j < count; j++ {
+			if _, err := ReadVarint(r); err != nil {
+				return ChatType{}, err
+			}
+		}
+		if _, err := ReadAnonymousNBT(r); err != nil {
+			return ChatType{}, err
+		}
+	}
+	return chatType, nil
+}
+
+// IsIncomingWhisper reports whether the chat type is a /msg or /teammsg
+// delivered to us.
+func (c ChatType) IsIncomingWhisper() bool {
+	if c.IsInline() {
+		return c.TranslationKey == "commands.message.display.incoming"
+	}
+	if c.Name != "" {
+		return chatTypeName(c.Name) == "msg_command_incoming"
+	}
+	return c.RegistryID == ChatTypeMsgCommandIncoming
+}
+
+// IsOutgoing reports whether the message is the echo of something we sent.
+func (c ChatType) IsOutgoing() bool {
+	if c.IsInline() {
+		return c.TranslationKey == "commands.message.display.outgoing"
+	}
+	if c.Name != "" {
+		name := chatTypeName(c.Name)
+		return name == "msg_command_outgoing" || name == "team_msg_command_outgoing"
+	}
+	return c.RegistryID == ChatTypeMsgCommandOutgoing || c.RegistryID == ChatTypeTeamMsgCommandOutgoing
+}
+
+func chatTypeName(name string) string {
+	return strings.TrimPrefix(name, "minecraft:")
+}
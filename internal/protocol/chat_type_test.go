@@ -0,0 +1,50 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestReadChatTypeHolderRegistry(t *testing.T) {
+	var buf bytes.Buffer
+	writeVarInt(&buf, ChatTypeMsgCommandIncoming+1)
+
+	chatType, err := ReadChatTypeHolder(&buf)
+	if err != nil {
+		t.Fatalf("ReadChatTypeHolder() 返回错误: %v", err)
+	}
+	if chatType.IsInline() || chatType.RegistryID != ChatTypeMsgCommandIncoming {
+		t.Fatalf("chatType = %+v, 期望 registry %d", chatType, ChatTypeMsgCommandIncoming)
+	}
+	if !chatType.IsIncomingWhisper() || chatType.IsOutgoing() {
+		t.Errorf("msg_command_incoming 应识别为收到的私聊")
+	}
+}
+
+func TestReadChatTypeHolderInline(t *testing.T) {
+	var buf bytes.Buffer
+	writeVarInt(&buf, 0)
+	for _, key := range []string{"commands.message.display.incoming", "chat.type.text.narrate"} {
+		_ = WriteString(&buf, key)
+		writeVarInt(&buf, 2)
+		writeVarInt(&buf, 1) // sender
+		writeVarInt(&buf, 0) // content
+		buf.WriteByte(TagCompound)
+		buf.WriteByte(TagEnd)
+	}
+	buf.WriteByte(0x7f) // 后续字段
+
+	chatType, err := ReadChatTypeHolder(&buf)
+	if err != nil {
+		t.Fatalf("ReadChatTypeHolder() 返回错误: %v", err)
+	}
+	if !chatType.IsInline() || chatType.TranslationKey != "commands.message.display.incoming" {
+		t.Fatalf("chatType = %+v", chatType)
+	}
+	if !chatType.IsIncomingWhisper() {
+		t.Error("内联私聊类型应识别为收到的私聊")
+	}
+	if next, _ := buf.ReadByte(); next != 0x7f {
+		t.Errorf("内联 chat type 未完整读取, 下一个字节 = %#x", next)
+	}
+}
@@ -17,6 +17,9 @@ const (
 type ChunkSection struct {
 	BlockCount  int16
 	BlockStates []int32
+	// Biomes holds BiomesPerSection biome registry IDs indexed by
+	// (y/4)*16 + (z/4)*4 + x/4. It is nil when the payload had no biomes.
+	Biomes []int32
 }
 
 // ChunkBlockEntity represents a block entity embedded in map_chunk payload.
@@ -39,6 +42,7 @@ type LevelChunkWithLight struct {
 	HasBiomeData     bool
 	BlockEntityCount int32
 	BlockEntities    []ChunkBlockEntity
+	Light            LightData
 }
 
 type Heightmap struct {
@@ -94,8 +98,9 @@ func ParseLevelChunkWithLight(r io.Reader) (*LevelChunkWithLight, error) {
 		blockEntities = append(blockEntities, blockEntity)
 	}
 
-	if err := skipLightData(r); err != nil {
-		return nil, err
+	light, err := ParseLightData(r)
+	if err != nil {
+		return nil, fmt.Errorf("parse light data: %w", err)
 	}
 
 	return &LevelChunkWithLight{
@@ -108,6 +113,7 @@ func ParseLevelChunkWithLight(r io.Reader) (*LevelChunkWithLight, error) {
 		HasBiomeData:     hasBiomeData,
 		BlockEntityCount: blockEntityCount,
 		BlockEntities:    blockEntities,
+		Light:            *light,
 	}, nil
 }
 
@@ -239,8 +245,9 @@ func parseChunkSections(
 			return nil, fmt.Errorf("failed to read section %d block states: %w", i, err)
 		}
 
+		var biomes []int32
 		if withBiomes {
-			if _, err := parseChunkPalettedContainer(reader, BiomesPerSection, maxBiomePaletteBits, encoding); err != nil {
+			if biomes, err = parseChunkPalettedContainer(reader, BiomesPerSection, maxBiomePaletteBits, encoding); err != nil {
 				return nil, fmt.Errorf("failed to read section %d biomes: %w", i, err)
 			}
 		}
@@ -248,6 +255,7 @@ func parseChunkSections(
 		sections[i] = ChunkSection{
 			BlockCount:  blockCount,
 			BlockStates: blockStates,
+			Biomes:      biomes,
 		}
 	}
 
@@ -499,51 +507,3 @@ func parseChunkBlockEntity(r io.Reader, chunkX, chunkZ int32) (ChunkBlockEntity,
 		NBTData: nbtData,
 	}, nil
 }
-
-func skipLightData(r io.Reader) error {
-	// skyLightMask, blockLightMask, emptySkyLightMask, emptyBlockLightMask
-	for i := 0; i < 4; i++ {
-		if err := skipInt64Array(r); err != nil {
-			return err
-		}
-	}
-	// skyLight, blockLight
-	for i := 0; i < 2; i++ {
-		if err := skipByteArrayArray(r); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func skipInt64Array(r io.Reader) error {
-	count, err := ReadVarint(r)
-	if err != nil {
-		return err
-	}
-	if count < 0 {
-		return fmt.Errorf("invalid int64 array length: %d", count)
-	}
-	for i := int32(0); i < count; i++ {
-		if _, err := ReadInt64(r); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func skipByteArrayArray(r io.Reader) error {
-	count, err := ReadVarint(r)
-	if err != nil {
-		return err
-	}
-	if count < 0 {
-		return fmt.Errorf("invalid byte-array array length: %d", count)
-	}
-	for i := int32(0); i < count; i++ {
-		if _, err := readVarIntByteArray(r); err != nil {
-			return err
-		}
-	}
-	return nil
-}
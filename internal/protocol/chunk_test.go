@@ -19,9 +19,9 @@ func TestParseLevelChunkWithLight(t *testing.T) {
 		_ = WriteVarint(chunkData, blockStateID)
 		_ = WriteVarint(chunkData, 0)
 
-		// Biomes: single-value paletted container (ignored by parser).
+		// Biomes: single-value paletted container.
 		_ = WriteByte(chunkData, 0)
-		_ = WriteVarint(chunkData, 0)
+		_ = WriteVarint(chunkData, int32(section%3))
 		_ = WriteVarint(chunkData, 0)
 	}
 
@@ -75,6 +75,9 @@ func TestParseLevelChunkWithLight(t *testing.T) {
 				t.Fatalf("section %d state[%d] = %d, want %d", i, idx, state, wantState)
 			}
 		}
+		if len(section.Biomes) != BiomesPerSection || section.Biomes[BiomesPerSection-1] != int32(i%3) {
+			t.Fatalf("section %d biomes = %v, want %d x %d", i, section.Biomes, BiomesPerSection, i%3)
+		}
 	}
 }
 
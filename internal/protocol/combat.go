@@ -0,0 +1,126 @@
+package protocol
+
+import (
+	"bytes"
+	"io"
+)
+
+// DeathCombatEvent is the Combat Death packet, sent to a player when it
+// dies. Message is formatted with FormatTextComponent, so vanilla messages
+// look like "death.attack.mob(Steve, entity.minecraft.zombie)".
+type DeathCombatEvent struct {
+	PlayerID int32
+	Message  string
+}
+
+func ParseDeathCombatEvent(r io.Reader) (*DeathCombatEvent, error) {
+	playerID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	message, err := readTextComponent(r)
+	if err != nil {
+		return nil, err
+	}
+	return &DeathCombatEvent{PlayerID: playerID, Message: message}, nil
+}
+
+func CreateDeathCombatEventPacket(playerID int32, message string) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, playerID)
+	writeTextComponent(buf, message)
+	return &Packet{ID: S2CDeathCombatEvent, Payload: buf.Bytes()}
+}
+
+// DamageEvent is the Damage Event packet, sent when a living entity takes
+// damage. SourceTypeID indexes the damage_type registry. SourceCauseID is the
+// entity responsible (the skeleton) and SourceDirectID the entity that hit
+// (its arrow); both are -1 when absent. SourcePos is only set for damage with
+// an explicit position, such as a bed exploding in the nether.
+type DamageEvent struct {
+	EntityID       int32
+	SourceTypeID   int32
+	SourceCauseID  int32
+	SourceDirectID int32
+	SourcePos      *Vec3
+}
+
+func ParseDamageEvent(r io.Reader) (*DamageEvent, error) {
+	var p DamageEvent
+	var err error
+	if p.EntityID, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	if p.SourceTypeID, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	// Both entity IDs are sent plus one so zero can mean none.
+	if p.SourceCauseID, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	p.SourceCauseID--
+	if p.SourceDirectID, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	p.SourceDirectID--
+	hasPos, err := ReadBool(r)
+	if err != nil {
+		return nil, err
+	}
+	if hasPos {
+		var pos Vec3
+		if pos.X, err = ReadDouble(r); err != nil {
+			return nil, err
+		}
+		if pos.Y, err = ReadDouble(r); err != nil {
+			return nil, err
+		}
+		if pos.Z, err = ReadDouble(r); err != nil {
+			return nil, err
+		}
+		p.SourcePos = &pos
+	}
+	return &p, nil
+}
+
+func CreateDamageEventPacket(p DamageEvent) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, p.EntityID)
+	_ = WriteVarint(buf, p.SourceTypeID)
+	_ = WriteVarint(buf, p.SourceCauseID+1)
+	_ = WriteVarint(buf, p.SourceDirectID+1)
+	_ = WriteBool(buf, p.SourcePos != nil)
+	if p.SourcePos != nil {
+		_ = WriteDouble(buf, p.SourcePos.X)
+		_ = WriteDouble(buf, p.SourcePos.Y)
+		_ = WriteDouble(buf, p.SourcePos.Z)
+	}
+	return &Packet{ID: S2CDamageEvent, Payload: buf.Bytes()}
+}
+
+// HurtAnimation is the Hurt Animation packet. Yaw is the direction the hit
+// came from in degrees, relative to where the entity was facing: 90 is
+// straight ahead and 270 (or -90) behind.
+type HurtAnimation struct {
+	EntityID int32
+	Yaw      float32
+}
+
+func ParseHurtAnimation(r io.Reader) (*HurtAnimation, error) {
+	entityID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	yaw, err := ReadFloat(r)
+	if err != nil {
+		return nil, err
+	}
+	return &HurtAnimation{EntityID: entityID, Yaw: yaw}, nil
+}
+
+func CreateHurtAnimationPacket(entityID int32, yaw float32) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, entityID)
+	_ = WriteFloat(buf, yaw)
+	return &Packet{ID: S2CHurtAnimation, Payload: buf.Bytes()}
+}
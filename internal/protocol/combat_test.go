@@ -0,0 +1,57 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDeathCombatEventRoundTrip(t *testing.T) {
+	packet := CreateDeathCombatEventPacket(12, "Steve fell from a high place")
+	got, err := ParseDeathCombatEvent(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ParseDeathCombatEvent failed: %v", err)
+	}
+	if got.PlayerID != 12 || got.Message != "Steve fell from a high place" {
+		t.Fatalf("ParseDeathCombatEvent = %+v", got)
+	}
+}
+
+func TestDamageEventRoundTrip(t *testing.T) {
+	tests := []DamageEvent{
+		{EntityID: 3, SourceTypeID: 1, SourceCauseID: 40, SourceDirectID: 41},
+		{EntityID: 3, SourceTypeID: 9, SourceCauseID: -1, SourceDirectID: -1, SourcePos: &Vec3{X: 1.5, Y: 64, Z: -2.5}},
+	}
+	for _, want := range tests {
+		got, err := ParseDamageEvent(bytes.NewReader(CreateDamageEventPacket(want).Payload))
+		if err != nil {
+			t.Fatalf("ParseDamageEvent failed: %v", err)
+		}
+		if got.EntityID != want.EntityID || got.SourceTypeID != want.SourceTypeID ||
+			got.SourceCauseID != want.SourceCauseID || got.SourceDirectID != want.SourceDirectID {
+			t.Fatalf("ParseDamageEvent = %+v, want %+v", got, want)
+		}
+		if (got.SourcePos == nil) != (want.SourcePos == nil) || (got.SourcePos != nil && *got.SourcePos != *want.SourcePos) {
+			t.Fatalf("SourcePos = %v, want %v", got.SourcePos, want.SourcePos)
+		}
+	}
+}
+
+func TestParseDamageEventNoSourceIsZeroOnWire(t *testing.T) {
+	got, err := ParseDamageEvent(bytes.NewReader([]byte{3, 5, 0, 0, 0}))
+	if err != nil {
+		t.Fatalf("ParseDamageEvent failed: %v", err)
+	}
+	if got.SourceCauseID != -1 || got.SourceDirectID != -1 || got.SourcePos != nil {
+		t.Fatalf("ParseDamageEvent = %+v, want no source", got)
+	}
+}
+
+func TestHurtAnimationRoundTrip(t *testing.T) {
+	got, err := ParseHurtAnimation(bytes.NewReader(CreateHurtAnimationPacket(7, 270).Payload))
+	if err != nil {
+		t.Fatalf("ParseHurtAnimation failed: %v", err)
+	}
+	if got.EntityID != 7 || got.Yaw != 270 {
+		t.Fatalf("ParseHurtAnimation = %+v", got)
+	}
+}
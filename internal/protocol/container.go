@@ -0,0 +1,157 @@
+package protocol
+
+import (
+	"bytes"
+	"io"
+)
+
+// Menu type IDs sent in open_window (minecraft:menu registry order).
+var windowTypeNames = []string{
+	"generic_9x1", "generic_9x2", "generic_9x3", "generic_9x4", "generic_9x5", "generic_9x6",
+	"generic_3x3", "crafter_3x3", "anvil", "beacon", "blast_furnace", "brewing_stand",
+	"crafting", "enchantment", "furnace", "grindstone", "hopper", "lectern",
+	"loom", "merchant", "shulker_box", "smithing", "smoker", "cartography_table",
+	"stonecutter",
+}
+
+// Window click modes.
+const (
+	WindowClickPickup     int32 = 0
+	WindowClickQuickMove  int32 = 1
+	WindowClickSwap       int32 = 2
+	WindowClickClone      int32 = 3
+	WindowClickThrow      int32 = 4
+	WindowClickQuickCraft int32 = 5
+	WindowClickPickupAll  int32 = 6
+)
+
+// WindowClickOutside is the slot index used for clicks outside the window.
+const WindowClickOutside int16 = -999
+
+// WindowTypeName returns the menu registry name for an open_window inventory type.
+func WindowTypeName(inventoryType int32) string {
+	if inventoryType < 0 || int(inventoryType) >= len(windowTypeNames) {
+		return ""
+	}
+	return windowTypeNames[inventoryType]
+}
+
+type OpenWindow struct {
+	WindowID      int32
+	InventoryType int32
+	Title         string
+}
+
+func ParseOpenWindow(r io.Reader) (*OpenWindow, error) {
+	windowID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	inventoryType, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	title, err := ReadAnonymousNBT(r)
+	if err != nil {
+		return nil, err
+	}
+	return &OpenWindow{
+		WindowID:      windowID,
+		InventoryType: inventoryType,
+		Title:         FormatTextComponent(title),
+	}, nil
+}
+
+type CloseWindow struct {
+	WindowID int32
+}
+
+func ParseCloseWindow(r io.Reader) (*CloseWindow, error) {
+	windowID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	return &CloseWindow{WindowID: windowID}, nil
+}
+
+// HashedSlot is the client's view of a slot in window_click. Components are
+// identified by a CRC32C hash of their value, which the server only uses to
+// detect desyncs; a mismatch just triggers a resync of that slot.
+type HashedSlot struct {
+	ItemID            int32
+	Count             int32
+	Components        []HashedComponent
+	RemovedComponents []int32
+}
+
+type HashedComponent struct {
+	Type int32
+	Hash int32
+}
+
+// HashedSlotFromSlot converts a known stack into a HashedSlot. Component hashes
+// are not computed, so stacks with added components will be resynced by the server.
+func HashedSlotFromSlot(slot Slot) *HashedSlot {
+	if slot.IsEmpty() {
+		return nil
+	}
+	return &HashedSlot{
+		ItemID:            slot.ItemID,
+		Count:             slot.Count,
+		RemovedComponents: append([]int32(nil), slot.RemovedComponents...),
+	}
+}
+
+type ChangedSlot struct {
+	Location int16
+	Item     *HashedSlot // nil means empty
+}
+
+func CreateWindowClickPacket(
+	windowID int32,
+	stateID int32,
+	slot int16,
+	button int8,
+	mode int32,
+	changedSlots []ChangedSlot,
+	cursor *HashedSlot,
+) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, windowID)
+	_ = WriteVarint(buf, stateID)
+	_ = WriteInt16(buf, slot)
+	_ = WriteByte(buf, byte(button))
+	_ = WriteVarint(buf, mode)
+	_ = WriteVarint(buf, int32(len(changedSlots)))
+	for _, changed := range changedSlots {
+		_ = WriteInt16(buf, changed.Location)
+		writeOptionalHashedSlot(buf, changed.Item)
+	}
+	writeOptionalHashedSlot(buf, cursor)
+	return &Packet{ID: C2SWindowClick, Payload: buf.Bytes()}
+}
+
+func writeOptionalHashedSlot(buf *bytes.Buffer, slot *HashedSlot) {
+	if slot == nil {
+		_ = WriteBool(buf, false)
+		return
+	}
+	_ = WriteBool(buf, true)
+	_ = WriteVarint(buf, slot.ItemID)
+	_ = WriteVarint(buf, slot.Count)
+	_ = WriteVarint(buf, int32(len(slot.Components)))
+	for _, c := range slot.Components {
+		_ = WriteVarint(buf, c.Type)
+		_ = WriteInt32(buf, c.Hash)
+	}
+	_ = WriteVarint(buf, int32(len(slot.RemovedComponents)))
+	for _, componentType := range slot.RemovedComponents {
+		_ = WriteVarint(buf, componentType)
+	}
+}
+
+func CreateCloseWindowPacket(windowID int32) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, windowID)
+	return &Packet{ID: C2SCloseWindow, Payload: buf.Bytes()}
+}
@@ -0,0 +1,129 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseOpenWindow(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 3)
+	_ = WriteVarint(buf, 2)
+	_ = WriteByte(buf, TagString)
+	_ = WriteUnsignedShort(buf, uint16(len("Chest")))
+	buf.WriteString("Chest")
+
+	parsed, err := ParseOpenWindow(buf)
+	if err != nil {
+		t.Fatalf("ParseOpenWindow failed: %v", err)
+	}
+	if parsed.WindowID != 3 || parsed.InventoryType != 2 || parsed.Title != "Chest" {
+		t.Fatalf("OpenWindow mismatch: %+v", parsed)
+	}
+	if name := WindowTypeName(parsed.InventoryType); name != "generic_9x3" {
+		t.Fatalf("WindowTypeName = %q, want generic_9x3", name)
+	}
+	if name := WindowTypeName(14); name != "furnace" {
+		t.Fatalf("WindowTypeName(14) = %q, want furnace", name)
+	}
+}
+
+func TestParseCloseWindow(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 7)
+
+	parsed, err := ParseCloseWindow(buf)
+	if err != nil {
+		t.Fatalf("ParseCloseWindow failed: %v", err)
+	}
+	if parsed.WindowID != 7 {
+		t.Fatalf("WindowID = %d, want 7", parsed.WindowID)
+	}
+}
+
+func TestCreateWindowClickPacket(t *testing.T) {
+	packet := CreateWindowClickPacket(
+		2,
+		11,
+		5,
+		0,
+		WindowClickQuickMove,
+		[]ChangedSlot{
+			{Location: 5},
+			{Location: 40, Item: &HashedSlot{ItemID: 1, Count: 64, Components: []HashedComponent{{Type: 3, Hash: -5}}}},
+		},
+		nil,
+	)
+	if packet.ID != C2SWindowClick {
+		t.Fatalf("packet ID = 0x%02x, want 0x%02x", packet.ID, C2SWindowClick)
+	}
+
+	r := bytes.NewReader(packet.Payload)
+	mustVarint := func(want int32) {
+		t.Helper()
+		got, err := ReadVarint(r)
+		if err != nil || got != want {
+			t.Fatalf("varint = %d (err=%v), want %d", got, err, want)
+		}
+	}
+	mustInt16 := func(want int16) {
+		t.Helper()
+		got, err := ReadInt16(r)
+		if err != nil || got != want {
+			t.Fatalf("int16 = %d (err=%v), want %d", got, err, want)
+		}
+	}
+	mustBool := func(want bool) {
+		t.Helper()
+		got, err := ReadBool(r)
+		if err != nil || got != want {
+			t.Fatalf("bool = %v (err=%v), want %v", got, err, want)
+		}
+	}
+
+	mustVarint(2)
+	mustVarint(11)
+	mustInt16(5)
+	if button, _ := ReadByte(r); button != 0 {
+		t.Fatalf("button = %d, want 0", button)
+	}
+	mustVarint(WindowClickQuickMove)
+	mustVarint(2)
+	mustInt16(5)
+	mustBool(false)
+	mustInt16(40)
+	mustBool(true)
+	mustVarint(1)
+	mustVarint(64)
+	mustVarint(1)
+	mustVarint(3)
+	if hash, _ := ReadInt32(r); hash != -5 {
+		t.Fatalf("hash = %d, want -5", hash)
+	}
+	mustVarint(0)
+	mustBool(false)
+	if r.Len() != 0 {
+		t.Fatalf("unexpected trailing bytes: %d", r.Len())
+	}
+}
+
+func TestHashedSlotFromSlot(t *testing.T) {
+	if HashedSlotFromSlot(Slot{}) != nil {
+		t.Fatal("empty slot should map to nil")
+	}
+	hashed := HashedSlotFromSlot(Slot{ItemID: 9, Count: 3, RemovedComponents: []int32{19}})
+	if hashed == nil || hashed.ItemID != 9 || hashed.Count != 3 || len(hashed.RemovedComponents) != 1 {
+		t.Fatalf("HashedSlotFromSlot mismatch: %+v", hashed)
+	}
+}
+
+func TestCreateCloseWindowPacket(t *testing.T) {
+	packet := CreateCloseWindowPacket(4)
+	if packet.ID != C2SCloseWindow {
+		t.Fatalf("packet ID = 0x%02x, want 0x%02x", packet.ID, C2SCloseWindow)
+	}
+	windowID, err := ReadVarint(bytes.NewReader(packet.Payload))
+	if err != nil || windowID != 4 {
+		t.Fatalf("window ID = %d (err=%v), want 4", windowID, err)
+	}
+}
@@ -46,6 +46,19 @@ func CreateBlockDigPacket(status int32, location BlockPos, face int8, sequence i
 	}
 }
 
+// ReadBlockPos reads a position packed into a single int64.
+func ReadBlockPos(r io.Reader) (BlockPos, error) {
+	x, y, z, err := readPackedBlockPosition(r)
+	if err != nil {
+		return BlockPos{}, err
+	}
+	return BlockPos{X: x, Y: y, Z: z}, nil
+}
+
+func WriteBlockPos(w io.Writer, pos BlockPos) error {
+	return WriteInt64(w, encodeBlockPosition(pos.X, pos.Y, pos.Z))
+}
+
 func encodeBlockPosition(x, y, z int32) int64 {
 	ux := uint64(int64(x) & 0x3FFFFFF)
 	uy := uint64(int64(y) & 0xFFF)
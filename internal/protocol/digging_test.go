@@ -72,3 +72,24 @@ func decodePackedPosition(raw int64) (int32, int32, int32) {
 	y := signExtendInt32(int64(v&0xFFF), 12)
 	return x, y, z
 }
+
+func TestBlockPosRoundTrip(t *testing.T) {
+	for _, pos := range []BlockPos{
+		{X: 0, Y: 0, Z: 0},
+		{X: -1, Y: -64, Z: -1},
+		{X: 33554431, Y: 2047, Z: -33554432},
+		{X: 18357644, Y: 831, Z: -20882616},
+	} {
+		buf := new(bytes.Buffer)
+		if err := WriteBlockPos(buf, pos); err != nil {
+			t.Fatalf("WriteBlockPos(%+v) failed: %v", pos, err)
+		}
+		got, err := ReadBlockPos(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatalf("ReadBlockPos failed: %v", err)
+		}
+		if got != pos {
+			t.Errorf("ReadBlockPos = %+v, want %+v", got, pos)
+		}
+	}
+}
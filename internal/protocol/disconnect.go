@@ -0,0 +1,96 @@
+package protocol
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Disconnect is the reason a server gave for closing the connection. Login
+// sends it as a JSON text component, Configuration and Play as NBT.
+type Disconnect struct {
+	Message        string
+	TranslationKey string
+	Args           []string
+}
+
+func ParseLoginDisconnect(r io.Reader) (*Disconnect, error) {
+	raw, err := ReadString(r)
+	if err != nil {
+		return nil, err
+	}
+	var component any
+	if err := json.Unmarshal([]byte(raw), &component); err != nil {
+		// Some proxies send a bare string rather than JSON.
+		return &Disconnect{Message: raw}, nil
+	}
+	d := &Disconnect{Message: formatJSONText(component)}
+	if c, ok := component.(map[string]any); ok {
+		if key, ok := c["translate"].(string); ok {
+			d.TranslationKey = key
+			if with, ok := c["with"].([]any); ok {
+				for _, arg := range with {
+					d.Args = append(d.Args, formatJSONText(arg))
+				}
+			}
+		}
+	}
+	return d, nil
+}
+
+func ParseDisconnect(r io.Reader) (*Disconnect, error) {
+	reason, err := ReadAnonymousNBT(r)
+	if err != nil {
+		return nil, fmt.Errorf("read disconnect reason: %w", err)
+	}
+	d := &Disconnect{Message: FormatTextComponent(reason)}
+	if key, args, ok := TextTranslation(reason); ok {
+		d.TranslationKey = key
+		d.Args = args
+	}
+	return d, nil
+}
+
+// formatJSONText flattens a JSON text component the same way
+// FormatTextComponent flattens NBT ones.
+func formatJSONText(v any) string {
+	switch c := v.(type) {
+	case string:
+		return c
+	case []any:
+		var b strings.Builder
+		for _, child := range c {
+			b.WriteString(formatJSONText(child))
+		}
+		return b.String()
+	case map[string]any:
+		var b strings.Builder
+		if text, ok := c["text"].(string); ok {
+			b.WriteString(text)
+		}
+		if key, ok := c["translate"].(string); ok {
+			b.WriteString(key)
+			if with, ok := c["with"].([]any); ok && len(with) > 0 {
+				b.WriteByte('(')
+				for i, arg := range with {
+					if i > 0 {
+						b.WriteString(", ")
+					}
+					b.WriteString(formatJSONText(arg))
+				}
+				b.WriteByte(')')
+			}
+		}
+		if extra, ok := c["extra"].([]any); ok {
+			for _, child := range extra {
+				b.WriteString(formatJSONText(child))
+			}
+		}
+		return b.String()
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(c)
+	}
+}
@@ -0,0 +1,97 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseLoginDisconnect(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		message string
+		key     string
+		args    []string
+	}{
+		{
+			name:    "纯文本 JSON",
+			raw:     `"Server closed"`,
+			message: "Server closed",
+		},
+		{
+			name:    "翻译组件",
+			raw:     `{"translate":"multiplayer.disconnect.banned.reason","with":["griefing"]}`,
+			message: "multiplayer.disconnect.banned.reason(griefing)",
+			key:     "multiplayer.disconnect.banned.reason",
+			args:    []string{"griefing"},
+		},
+		{
+			name:    "extra 子节点",
+			raw:     `{"text":"You are ","extra":[{"text":"banned"},"!"]}`,
+			message: "You are banned!",
+		},
+		{
+			name:    "非 JSON 字符串",
+			raw:     "Connection throttled! Please wait before reconnecting.",
+			message: "Connection throttled! Please wait before reconnecting.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteString(&buf, tt.raw); err != nil {
+				t.Fatalf("WriteString() 返回错误: %v", err)
+			}
+			d, err := ParseLoginDisconnect(&buf)
+			if err != nil {
+				t.Fatalf("ParseLoginDisconnect() 返回错误: %v", err)
+			}
+			if d.Message != tt.message {
+				t.Errorf("Message = %q, 期望 %q", d.Message, tt.message)
+			}
+			if d.TranslationKey != tt.key {
+				t.Errorf("TranslationKey = %q, 期望 %q", d.TranslationKey, tt.key)
+			}
+			if len(d.Args) != len(tt.args) {
+				t.Fatalf("Args = %v, 期望 %v", d.Args, tt.args)
+			}
+			for i := range tt.args {
+				if d.Args[i] != tt.args[i] {
+					t.Errorf("Args[%d] = %q, 期望 %q", i, d.Args[i], tt.args[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseDisconnect(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteByte(TagCompound)
+	writeTestTranslate(&buf, "multiplayer.disconnect.duplicate_login")
+
+	d, err := ParseDisconnect(&buf)
+	if err != nil {
+		t.Fatalf("ParseDisconnect() 返回错误: %v", err)
+	}
+	if d.TranslationKey != "multiplayer.disconnect.duplicate_login" {
+		t.Errorf("TranslationKey = %q", d.TranslationKey)
+	}
+	if d.Message != "multiplayer.disconnect.duplicate_login" {
+		t.Errorf("Message = %q", d.Message)
+	}
+
+	buf.Reset()
+	writeTestNBTString(&buf, "Kicked by an operator")
+	d, err = ParseDisconnect(&buf)
+	if err != nil {
+		t.Fatalf("ParseDisconnect() 返回错误: %v", err)
+	}
+	if d.Message != "Kicked by an operator" || d.TranslationKey != "" {
+		t.Errorf("Disconnect = %+v", d)
+	}
+
+	if _, err := ParseDisconnect(bytes.NewReader(nil)); err == nil {
+		t.Error("ParseDisconnect() 空数据应返回错误")
+	}
+}
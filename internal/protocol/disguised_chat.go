@@ -0,0 +1,46 @@
+package protocol
+
+import "io"
+
+// DisguisedChat is profileless_chat: a message with a sender name but no
+// signature, e.g. /say from the console or chat from unsigned servers.
+type DisguisedChat struct {
+	Message    NBTNode
+	Type       ChatType
+	Name       NBTNode
+	TargetName *NBTNode
+}
+
+func ParseDisguisedChat(r io.Reader) (*DisguisedChat, error) {
+	var chat DisguisedChat
+	message, err := ReadAnonymousNBT(r)
+	if err != nil {
+		return nil, err
+	}
+	chat.Message = *message
+
+	chatType, err := ReadChatTypeHolder(r)
+	if err != nil {
+		return nil, err
+	}
+	chat.Type = chatType
+
+	name, err := ReadAnonymousNBT(r)
+	if err != nil {
+		return nil, err
+	}
+	chat.Name = *name
+
+	hasTarget, err := ReadBool(r)
+	if err != nil {
+		return nil, err
+	}
+	if hasTarget {
+		target, err := ReadAnonymousNBT(r)
+		if err != nil {
+			return nil, err
+		}
+		chat.TargetName = target
+	}
+	return &chat, nil
+}
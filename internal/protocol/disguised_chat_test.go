@@ -0,0 +1,40 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseDisguisedChat(t *testing.T) {
+	var buf bytes.Buffer
+	writeTestNBTString(&buf, "hello there")
+	writeVarInt(&buf, ChatTypeSayCommand+1)
+	writeTestNBTString(&buf, "Server")
+	buf.WriteByte(1) // has target
+	writeTestNBTString(&buf, "Locus")
+
+	chat, err := ParseDisguisedChat(&buf)
+	if err != nil {
+		t.Fatalf("ParseDisguisedChat() 返回错误: %v", err)
+	}
+	if got := FormatTextComponent(&chat.Message); got != "hello there" {
+		t.Errorf("Message = %q", got)
+	}
+	if chat.Type.RegistryID != ChatTypeSayCommand {
+		t.Errorf("Type = %+v, 期望 say_command", chat.Type)
+	}
+	if got := FormatTextComponent(&chat.Name); got != "Server" {
+		t.Errorf("Name = %q", got)
+	}
+	if chat.TargetName == nil || FormatTextComponent(chat.TargetName) != "Locus" {
+		t.Errorf("TargetName = %v", chat.TargetName)
+	}
+}
+
+func TestParseDisguisedChatTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	writeTestNBTString(&buf, "hi")
+	if _, err := ParseDisguisedChat(&buf); err == nil {
+		t.Error("ParseDisguisedChat() 应该返回错误")
+	}
+}
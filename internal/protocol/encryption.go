@@ -0,0 +1,205 @@
+package protocol
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"sync"
+)
+
+// SharedSecretLen is the AES-128 key size used for protocol encryption.
+const SharedSecretLen = 16
+
+type EncryptionRequest struct {
+	ServerID           string
+	PublicKey          []byte // DER-encoded SubjectPublicKeyInfo
+	VerifyToken        []byte
+	ShouldAuthenticate bool
+}
+
+type EncryptionResponse struct {
+	SharedSecret []byte
+	VerifyToken  []byte
+}
+
+func ParseEncryptionRequest(r io.Reader) (*EncryptionRequest, error) {
+	var req EncryptionRequest
+	var err error
+	if req.ServerID, err = ReadString(r); err != nil {
+		return nil, err
+	}
+	if req.PublicKey, err = ReadByteArray(r); err != nil {
+		return nil, err
+	}
+	if req.VerifyToken, err = ReadByteArray(r); err != nil {
+		return nil, err
+	}
+	if req.ShouldAuthenticate, err = ReadBool(r); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+func CreateEncryptionRequestPacket(req EncryptionRequest) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteString(buf, req.ServerID)
+	_ = WriteByteArray(buf, req.PublicKey)
+	_ = WriteByteArray(buf, req.VerifyToken)
+	_ = WriteBool(buf, req.ShouldAuthenticate)
+	return &Packet{
+		ID:      S2CEncryptionRequest,
+		Payload: buf.Bytes(),
+	}
+}
+
+func ParseEncryptionResponse(r io.Reader) (*EncryptionResponse, error) {
+	var resp EncryptionResponse
+	var err error
+	if resp.SharedSecret, err = ReadByteArray(r); err != nil {
+		return nil, err
+	}
+	if resp.VerifyToken, err = ReadByteArray(r); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateEncryptionResponsePacket takes the secret and token already encrypted
+// with the server's public key.
+func CreateEncryptionResponsePacket(sharedSecret, verifyToken []byte) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteByteArray(buf, sharedSecret)
+	_ = WriteByteArray(buf, verifyToken)
+	return &Packet{
+		ID:      C2SEncryptionResponse,
+		Payload: buf.Bytes(),
+	}
+}
+
+func NewSharedSecret() ([]byte, error) {
+	secret := make([]byte, SharedSecretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// EncryptForServer encrypts data with the server's DER public key using
+// PKCS#1 v1.5, as the login handshake requires.
+func EncryptForServer(publicKeyDER, data []byte) ([]byte, error) {
+	key, err := x509.ParsePKIXPublicKey(publicKeyDER)
+	if err != nil {
+		return nil, fmt.Errorf("parse server public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("server public key is %T, not RSA", key)
+	}
+	return rsa.EncryptPKCS1v15(rand.Reader, rsaKey, data)
+}
+
+// ServerHash is the session server id: SHA-1 over server ID, shared secret
+// and public key, printed as a signed two's-complement hex number.
+func ServerHash(serverID string, sharedSecret, publicKey []byte) string {
+	h := sha1.New()
+	h.Write([]byte(serverID))
+	h.Write(sharedSecret)
+	h.Write(publicKey)
+	sum := h.Sum(nil)
+
+	n := new(big.Int).SetBytes(sum)
+	if sum[0]&0x80 != 0 {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(sum)*8)))
+	}
+	return n.Text(16)
+}
+
+// EncryptConn wraps conn in AES/CFB8 keyed and seeded with sharedSecret.
+// Framing and compression in ReadPacket/WritePacket sit on top unchanged.
+func EncryptConn(conn net.Conn, sharedSecret []byte) (net.Conn, error) {
+	// The protocol uses the shared secret as the IV too.
+	enc, err := newCFB8(sharedSecret, sharedSecret, false)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := newCFB8(sharedSecret, sharedSecret, true)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedConn{Conn: conn, enc: enc, dec: dec}, nil
+}
+
+type encryptedConn struct {
+	net.Conn
+	readMu  sync.Mutex
+	writeMu sync.Mutex
+	enc     cipher.Stream
+	dec     cipher.Stream
+}
+
+func (c *encryptedConn) Read(p []byte) (int, error) {
+	c.readMu.Lock()
+	defer c.readMu.Unlock()
+	n, err := c.Conn.Read(p)
+	if n > 0 {
+		c.dec.XORKeyStream(p[:n], p[:n])
+	}
+	return n, err
+}
+
+func (c *encryptedConn) Write(p []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	buf := make([]byte, len(p))
+	c.enc.XORKeyStream(buf, p)
+	return c.Conn.Write(buf)
+}
+
+// cfb8 is CFB mode with an 8-bit segment size, which crypto/cipher lacks.
+type cfb8 struct {
+	block    cipher.Block
+	register []byte
+	out      []byte
+	decrypt  bool
+}
+
+func newCFB8(key, iv []byte, decrypt bool) (cipher.Stream, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("cfb8: IV length %d, want %d", len(iv), block.BlockSize())
+	}
+	register := make([]byte, block.BlockSize())
+	copy(register, iv)
+	return &cfb8{
+		block:    block,
+		register: register,
+		out:      make([]byte, block.BlockSize()),
+		decrypt:  decrypt,
+	}, nil
+}
+
+func (c *cfb8) XORKeyStream(dst, src []byte) {
+	last := len(c.register) - 1
+	for i, in := range src {
+		c.block.Encrypt(c.out, c.register)
+		out := in ^ c.out[0]
+		copy(c.register, c.register[1:])
+		if c.decrypt {
+			c.register[last] = in
+		} else {
+			c.register[last] = out
+		}
+		dst[i] = out
+	}
+}
@@ -0,0 +1,153 @@
+package protocol
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"net"
+	"testing"
+)
+
+// TestServerHash 使用 wiki.vg 公布的测试向量
+func TestServerHash(t *testing.T) {
+	tests := map[string]string{
+		"Notch": "4ed1f46bbe04bc756bcb17c0c7ce3e4632f06a48",
+		"jeb_":  "-7c9d5b0044c130109a5d7b5fb5c317c02b4e28c1",
+		"simon": "88e16a1019277b15d58faf0541e11910eb756f6",
+	}
+	for name, want := range tests {
+		if got := ServerHash(name, nil, nil); got != want {
+			t.Errorf("ServerHash(%q) = %s, 期望 %s", name, got, want)
+		}
+	}
+}
+
+// TestCFB8Vector 使用 NIST SP 800-38A 的 CFB8-AES128 向量
+func TestCFB8Vector(t *testing.T) {
+	key, _ := hex.DecodeString("2b7e151628aed2a6abf7158809cf4f3c")
+	iv, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	plain, _ := hex.DecodeString("6bc1bee22e409f96e93d7e117393172aae2d")
+	want, _ := hex.DecodeString("3b79424c9c0dd436bace9e0ed4586a4f32b9")
+
+	enc, err := newCFB8(key, iv, false)
+	if err != nil {
+		t.Fatalf("newCFB8() 返回错误: %v", err)
+	}
+	got := make([]byte, len(plain))
+	// 分两段加密，验证流状态在调用之间保持
+	enc.XORKeyStream(got[:5], plain[:5])
+	enc.XORKeyStream(got[5:], plain[5:])
+	if !bytes.Equal(got, want) {
+		t.Fatalf("密文 = %x, 期望 %x", got, want)
+	}
+
+	dec, _ := newCFB8(key, iv, true)
+	dec.XORKeyStream(got, got)
+	if !bytes.Equal(got, plain) {
+		t.Fatalf("解密结果 = %x, 期望 %x", got, plain)
+	}
+}
+
+func TestEncryptionRequestRoundTrip(t *testing.T) {
+	req := EncryptionRequest{
+		ServerID:           "",
+		PublicKey:          []byte{1, 2, 3},
+		VerifyToken:        []byte{9, 8, 7, 6},
+		ShouldAuthenticate: true,
+	}
+	packet := CreateEncryptionRequestPacket(req)
+	if packet.ID != S2CEncryptionRequest {
+		t.Errorf("ID = 0x%02X, 期望 0x%02X", packet.ID, S2CEncryptionRequest)
+	}
+	got, err := ParseEncryptionRequest(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ParseEncryptionRequest() 返回错误: %v", err)
+	}
+	if !bytes.Equal(got.PublicKey, req.PublicKey) || !bytes.Equal(got.VerifyToken, req.VerifyToken) || !got.ShouldAuthenticate {
+		t.Errorf("解析结果 = %+v", got)
+	}
+
+	resp, err := ParseEncryptionResponse(bytes.NewReader(CreateEncryptionResponsePacket([]byte{1}, []byte{2, 3}).Payload))
+	if err != nil {
+		t.Fatalf("ParseEncryptionResponse() 返回错误: %v", err)
+	}
+	if !bytes.Equal(resp.SharedSecret, []byte{1}) || !bytes.Equal(resp.VerifyToken, []byte{2, 3}) {
+		t.Errorf("解析结果 = %+v", resp)
+	}
+}
+
+func TestEncryptForServer(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("GenerateKey() 返回错误: %v", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey() 返回错误: %v", err)
+	}
+	secret, err := NewSharedSecret()
+	if err != nil {
+		t.Fatalf("NewSharedSecret() 返回错误: %v", err)
+	}
+	encrypted, err := EncryptForServer(der, secret)
+	if err != nil {
+		t.Fatalf("EncryptForServer() 返回错误: %v", err)
+	}
+	plain, err := rsa.DecryptPKCS1v15(rand.Reader, key, encrypted)
+	if err != nil {
+		t.Fatalf("DecryptPKCS1v15() 返回错误: %v", err)
+	}
+	if !bytes.Equal(plain, secret) {
+		t.Errorf("解密结果 = %x, 期望 %x", plain, secret)
+	}
+
+	if _, err := EncryptForServer([]byte("not a key"), secret); err == nil {
+		t.Error("EncryptForServer() 非法公钥应返回错误")
+	}
+}
+
+// TestEncryptConnWithCompression 验证加密层与压缩、分帧正确叠加
+func TestEncryptConnWithCompression(t *testing.T) {
+	clientRaw, serverRaw := net.Pipe()
+	defer clientRaw.Close()
+	defer serverRaw.Close()
+
+	secret, _ := NewSharedSecret()
+	client, err := EncryptConn(clientRaw, secret)
+	if err != nil {
+		t.Fatalf("EncryptConn() 返回错误: %v", err)
+	}
+	server, _ := EncryptConn(serverRaw, secret)
+
+	const threshold = 64
+	payloads := [][]byte{
+		[]byte("short"),
+		bytes.Repeat([]byte("compressible "), 100),
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		for i, payload := range payloads {
+			if err := WritePacket(client, &Packet{ID: int32(i + 1), Payload: payload}, threshold); err != nil {
+				errCh <- err
+				return
+			}
+		}
+		errCh <- nil
+	}()
+
+	for i, payload := range payloads {
+		packet, err := ReadPacket(server, threshold)
+		if err != nil {
+			t.Fatalf("ReadPacket() 返回错误: %v", err)
+		}
+		if packet.ID != int32(i+1) || !bytes.Equal(packet.Payload, payload) {
+			t.Fatalf("包 %d 内容不匹配: id=%d len=%d", i, packet.ID, len(packet.Payload))
+		}
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("WritePacket() 返回错误: %v", err)
+	}
+}
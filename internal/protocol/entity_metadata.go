@@ -0,0 +1,402 @@
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Entity metadata serializer IDs (entityMetadataEntry in protocol.json).
+const (
+	MetadataByte                       int32 = 0
+	MetadataVarInt                     int32 = 1
+	MetadataVarLong                    int32 = 2
+	MetadataFloat                      int32 = 3
+	MetadataString                     int32 = 4
+	MetadataComponent                  int32 = 5
+	MetadataOptionalComponent          int32 = 6
+	MetadataItemStack                  int32 = 7
+	MetadataBoolean                    int32 = 8
+	MetadataRotations                  int32 = 9
+	MetadataBlockPos                   int32 = 10
+	MetadataOptionalBlockPos           int32 = 11
+	MetadataDirection                  int32 = 12
+	MetadataOptionalUUID               int32 = 13
+	MetadataBlockState                 int32 = 14
+	MetadataOptionalBlockState         int32 = 15
+	MetadataParticle                   int32 = 16
+	MetadataParticles                  int32 = 17
+	MetadataVillagerData               int32 = 18
+	MetadataOptionalUnsignedInt        int32 = 19
+	MetadataPose                       int32 = 20
+	MetadataCatVariant                 int32 = 21
+	MetadataCowVariant                 int32 = 22
+	MetadataWolfVariant                int32 = 23
+	MetadataWolfSoundVariant           int32 = 24
+	MetadataFrogVariant                int32 = 25
+	MetadataPigVariant                 int32 = 26
+	MetadataChickenVariant             int32 = 27
+	MetadataZombieNautilusVariant      int32 = 28
+	MetadataOptionalGlobalPos          int32 = 29
+	MetadataPaintingVariant            int32 = 30
+	MetadataSnifferState               int32 = 31
+	MetadataArmadilloState             int32 = 32
+	MetadataCopperGolemState           int32 = 33
+	MetadataWeatheringCopperGolemState int32 = 34
+	MetadataVector3                    int32 = 35
+	MetadataQuaternion                 int32 = 36
+	MetadataResolvableProfile          int32 = 37
+	MetadataHumanoidArm                int32 = 38
+
+	metadataEnd = 0xFF
+)
+
+var poseNames = [...]string{
+	"standing", "fall_flying", "sleeping", "swimming", "spin_attack",
+	"crouching", "long_jumping", "dying", "croaking", "using_tongue",
+	"sitting", "roaring", "sniffing", "emerging", "digging",
+	"sliding", "shooting", "inhaling",
+}
+
+// PoseName returns the name of an entity pose ID, or "" if unknown.
+func PoseName(pose int32) string {
+	if pose < 0 || int(pose) >= len(poseNames) {
+		return ""
+	}
+	return poseNames[pose]
+}
+
+// EntityMetadataEntry is one decoded metadata value. Value holds:
+//   - byte, int32, int64, float32, string, bool for the plain serializers
+//   - string for components, with optional components "" when absent
+//   - Slot for item stacks
+//   - [3]float32 for rotations and vector3, [4]float32 for quaternions
+//   - [3]int32 for block positions; *[3]int32 for the optional one
+//   - *UUID, *GlobalPos, *int32 for the other optional serializers
+//   - int32 for directions, block states, poses, variants and states;
+//     an absent optional block state is 0
+//   - Particle, []Particle and VillagerData
+//   - nil for resolvable profiles, which are skipped
+type EntityMetadataEntry struct {
+	Index uint8
+	Type  int32
+	Value any
+}
+
+// EntityMetadata is the Set Entity Metadata packet. Truncated is set when a
+// serializer this version does not know stopped decoding early; Entries
+// holds everything before it.
+type EntityMetadata struct {
+	EntityID  int32
+	Entries   []EntityMetadataEntry
+	Truncated bool
+}
+
+// Entry returns the entry at a metadata index.
+func (m *EntityMetadata) Entry(index uint8) (EntityMetadataEntry, bool) {
+	for _, entry := range m.Entries {
+		if entry.Index == index {
+			return entry, true
+		}
+	}
+	return EntityMetadataEntry{}, false
+}
+
+// Particle is a particle option. Only the type is kept; its data is skipped.
+type Particle struct {
+	Type int32
+}
+
+type VillagerData struct {
+	Type       int32
+	Profession int32
+	Level      int32
+}
+
+func ParseEntityMetadata(r io.Reader) (*EntityMetadata, error) {
+	entityID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	m := &EntityMetadata{EntityID: entityID}
+	for {
+		index, err := ReadByte(r)
+		if err != nil {
+			return nil, err
+		}
+		if index == metadataEnd {
+			return m, nil
+		}
+		metaType, err := ReadVarint(r)
+		if err != nil {
+			return nil, err
+		}
+		value, known, err := readEntityMetadataValue(r, metaType)
+		if err != nil {
+			return nil, fmt.Errorf("metadata index %d type %d: %w", index, metaType, err)
+		}
+		if !known {
+			m.Truncated = true
+			return m, nil
+		}
+		m.Entries = append(m.Entries, EntityMetadataEntry{Index: index, Type: metaType, Value: value})
+	}
+}
+
+func readEntityMetadataValue(r io.Reader, metaType int32) (any, bool, error) {
+	var value any
+	var err error
+	switch metaType {
+	case MetadataByte:
+		value, err = ReadByte(r)
+	case MetadataVarInt, MetadataDirection, MetadataBlockState, MetadataOptionalBlockState, MetadataPose,
+		MetadataCatVariant, MetadataCowVariant, MetadataWolfVariant, MetadataWolfSoundVariant,
+		MetadataFrogVariant, MetadataPigVariant, MetadataChickenVariant, MetadataZombieNautilusVariant,
+		MetadataSnifferState, MetadataArmadilloState, MetadataCopperGolemState,
+		MetadataWeatheringCopperGolemState, MetadataHumanoidArm:
+		value, err = ReadVarint(r)
+	case MetadataVarLong:
+		value, err = ReadVarLong(r)
+	case MetadataFloat:
+		value, err = ReadFloat(r)
+	case MetadataString:
+		value, err = ReadString(r)
+	case MetadataComponent:
+		value, err = readTextComponent(r)
+	case MetadataOptionalComponent:
+		var present bool
+		if present, err = ReadBool(r); err == nil {
+			value = ""
+			if present {
+				value, err = readTextComponent(r)
+			}
+		}
+	case MetadataItemStack:
+		value, err = ReadSlot(r)
+	case MetadataBoolean:
+		value, err = ReadBool(r)
+	case MetadataRotations, MetadataVector3:
+		var v [3]float32
+		err = readFloats(r, v[:])
+		value = v
+	case MetadataQuaternion:
+		var v [4]float32
+		err = readFloats(r, v[:])
+		value = v
+	case MetadataBlockPos:
+		var x, y, z int32
+		x, y, z, err = readPackedBlockPosition(r)
+		value = [3]int32{x, y, z}
+	case MetadataOptionalBlockPos:
+		var present bool
+		var pos *[3]int32
+		if present, err = ReadBool(r); err == nil && present {
+			var x, y, z int32
+			x, y, z, err = readPackedBlockPosition(r)
+			pos = &[3]int32{x, y, z}
+		}
+		value = pos
+	case MetadataOptionalUUID:
+		var present bool
+		var id *UUID
+		if present, err = ReadBool(r); err == nil && present {
+			var u UUID
+			u, err = ReadUUID(r)
+			id = &u
+		}
+		value = id
+	case MetadataParticle:
+		value, err = readParticle(r)
+	case MetadataParticles:
+		var n int
+		if n, err = readCollectionLength(r); err == nil {
+			particles := make([]Particle, n)
+			for i := range particles {
+				if particles[i], err = readParticle(r); err != nil {
+					break
+				}
+			}
+			value = particles
+		}
+	case MetadataVillagerData:
+		var v VillagerData
+		if v.Type, err = ReadVarint(r); err == nil {
+			if v.Profession, err = ReadVarint(r); err == nil {
+				v.Level, err = ReadVarint(r)
+			}
+		}
+		value = v
+	case MetadataOptionalUnsignedInt:
+		var raw int32
+		var v *int32
+		if raw, err = ReadVarint(r); err == nil && raw != 0 {
+			raw--
+			v = &raw
+		}
+		value = v
+	case MetadataOptionalGlobalPos:
+		var present bool
+		var pos *GlobalPos
+		if present, err = ReadBool(r); err == nil && present {
+			pos = &GlobalPos{}
+			if pos.DimensionName, err = ReadString(r); err == nil {
+				pos.X, pos.Y, pos.Z, err = readPackedBlockPosition(r)
+			}
+		}
+		value = pos
+	case MetadataPaintingVariant:
+		// Registry ID, or -1 for an inline variant.
+		var holder int32
+		if holder, err = ReadVarint(r); err == nil {
+			value = holder - 1
+			if holder == 0 {
+				err = skipSequence(r, skipFixed(4+4), skipString, skipOptional(skipNBT), skipOptional(skipNBT))
+			}
+		}
+	case MetadataResolvableProfile:
+		err = skipResolvableProfile(r)
+	default:
+		return nil, false, nil
+	}
+	return value, true, err
+}
+
+func readTextComponent(r io.Reader) (string, error) {
+	node, err := ReadAnonymousNBT(r)
+	if err != nil {
+		return "", err
+	}
+	return FormatTextComponent(node), nil
+}
+
+func readFloats(r io.Reader, dst []float32) error {
+	for i := range dst {
+		v, err := ReadFloat(r)
+		if err != nil {
+			return err
+		}
+		dst[i] = v
+	}
+	return nil
+}
+
+// Particle type IDs whose options carry data (Particle in protocol.json).
+// They are the 1.21.11 registry IDs from particles.json and are not remapped
+// for other versions, so on a version that renumbered particles an explosion
+// or particle metadata may be misread. Like other payload layouts they need
+// updating along with CurrentVersionName.
+const (
+	particleBlock               int32 = 1
+	particleBlockMarker         int32 = 2
+	particleDragonBreath        int32 = 8
+	particleDust                int32 = 14
+	particleDustColorTransition int32 = 15
+	particleEffect              int32 = 16
+	particleEntityEffect        int32 = 21
+	particleFallingDust         int32 = 29
+	particleTintedLeaves        int32 = 36
+	particleSculkCharge         int32 = 38
+	particleFlash               int32 = 42
+	particleInstantEffect       int32 = 46
+	particleItem                int32 = 47
+	particleVibration           int32 = 48
+	particleTrail               int32 = 49
+	particleShriek              int32 = 103
+	particleDustPillar          int32 = 109
+	particleBlockCrumble        int32 = 113
+)
+
+func readParticle(r io.Reader) (Particle, error) {
+	particleType, err := ReadVarint(r)
+	if err != nil {
+		return Particle{}, err
+	}
+	p := Particle{Type: particleType}
+	switch particleType {
+	case particleBlock, particleBlockMarker, particleFallingDust, particleShriek, particleDustPillar, particleBlockCrumble:
+		err = skipVarint(r)
+	case particleDragonBreath, particleEntityEffect, particleTintedLeaves, particleSculkCharge, particleFlash:
+		err = discardBytes(r, 4)
+	case particleDust:
+		err = discardBytes(r, 4*4)
+	case particleDustColorTransition:
+		err = discardBytes(r, 7*4)
+	case particleEffect, particleInstantEffect:
+		err = discardBytes(r, 4+4)
+	case particleItem:
+		_, err = ReadSlot(r)
+	case particleVibration:
+		var positionType int32
+		if positionType, err = ReadVarint(r); err != nil {
+			break
+		}
+		if positionType == 0 {
+			err = discardBytes(r, 8)
+		} else {
+			err = skipSequence(r, skipVarint, skipFixed(4))
+		}
+		if err == nil {
+			err = skipVarint(r)
+		}
+	case particleTrail:
+		err = discardBytes(r, 3*8+1)
+	}
+	return p, err
+}
+
+// CreateEntityMetadataPacket encodes entries with the plain serializers:
+// byte, varint-valued types, float, string, boolean, optional component
+// (a plain-text string, "" for none) and item stack. Other types are skipped.
+func CreateEntityMetadataPacket(entityID int32, entries []EntityMetadataEntry) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, entityID)
+	for _, entry := range entries {
+		value := new(bytes.Buffer)
+		if !writeEntityMetadataValue(value, entry) {
+			continue
+		}
+		_ = WriteByte(buf, entry.Index)
+		_ = WriteVarint(buf, entry.Type)
+		buf.Write(value.Bytes())
+	}
+	_ = WriteByte(buf, metadataEnd)
+	return &Packet{ID: S2CEntityMetadata, Payload: buf.Bytes()}
+}
+
+func writeEntityMetadataValue(w *bytes.Buffer, entry EntityMetadataEntry) bool {
+	switch v := entry.Value.(type) {
+	case byte:
+		_ = WriteByte(w, v)
+	case int32:
+		_ = WriteVarint(w, v)
+	case float32:
+		_ = WriteFloat(w, v)
+	case bool:
+		_ = WriteBool(w, v)
+	case Slot:
+		_ = WriteSlot(w, v)
+	case string:
+		if entry.Type == MetadataString {
+			_ = WriteString(w, v)
+			return true
+		}
+		if entry.Type != MetadataOptionalComponent {
+			return false
+		}
+		_ = WriteBool(w, v != "")
+		if v != "" {
+			writeTextComponent(w, v)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// writeTextComponent writes text as a plain string tag, the shortest form of
+// an anonymous NBT text component.
+func writeTextComponent(w *bytes.Buffer, text string) {
+	_ = WriteByte(w, TagString)
+	_ = WriteUnsignedShort(w, uint16(len(text)))
+	w.WriteString(text)
+}
@@ -0,0 +1,158 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseEntityMetadataAllCommonSerializers(t *testing.T) {
+	var payload bytes.Buffer
+	_ = WriteVarint(&payload, 31)
+	writeMetadataEntry(&payload, 0, MetadataByte, func(buf *bytes.Buffer) { _ = WriteByte(buf, 0x01) })
+	writeMetadataEntry(&payload, 2, MetadataOptionalComponent, func(buf *bytes.Buffer) {
+		_ = WriteBool(buf, true)
+		_ = WriteByte(buf, TagString)
+		_ = WriteUnsignedShort(buf, 3)
+		buf.WriteString("Bob")
+	})
+	writeMetadataEntry(&payload, 6, MetadataPose, func(buf *bytes.Buffer) { _ = WriteVarint(buf, 5) })
+	writeMetadataEntry(&payload, 9, MetadataFloat, func(buf *bytes.Buffer) { _ = WriteFloat(buf, 12.5) })
+	writeMetadataEntry(&payload, 10, MetadataParticles, func(buf *bytes.Buffer) {
+		_ = WriteVarint(buf, 2)
+		_ = WriteVarint(buf, particleEntityEffect)
+		_ = WriteInt32(buf, 0x00ff00)
+		_ = WriteVarint(buf, particleBlock)
+		_ = WriteVarint(buf, 1)
+	})
+	writeMetadataEntry(&payload, 14, MetadataOptionalBlockPos, func(buf *bytes.Buffer) {
+		_ = WriteBool(buf, true)
+		_ = WriteInt64(buf, packBlockPosition(3, -60, -7))
+	})
+	writeMetadataEntry(&payload, 16, MetadataBoolean, func(buf *bytes.Buffer) { _ = WriteBool(buf, true) })
+	writeMetadataEntry(&payload, 18, MetadataVillagerData, func(buf *bytes.Buffer) {
+		_ = WriteVarint(buf, 2)
+		_ = WriteVarint(buf, 5)
+		_ = WriteVarint(buf, 3)
+	})
+	writeMetadataEntry(&payload, 19, MetadataOptionalUnsignedInt, func(buf *bytes.Buffer) { _ = WriteVarint(buf, 8) })
+	writeMetadataEntry(&payload, 20, MetadataOptionalUUID, func(buf *bytes.Buffer) { _ = WriteBool(buf, false) })
+	writeMetadataEntry(&payload, 21, MetadataQuaternion, func(buf *bytes.Buffer) {
+		for _, v := range []float32{0, 0, 0, 1} {
+			_ = WriteFloat(buf, v)
+		}
+	})
+	_ = WriteByte(&payload, 0xFF)
+
+	m, err := ParseEntityMetadata(bytes.NewReader(payload.Bytes()))
+	if err != nil {
+		t.Fatalf("ParseEntityMetadata() error = %v", err)
+	}
+	if m.EntityID != 31 || m.Truncated || len(m.Entries) != 11 {
+		t.Fatalf("ParseEntityMetadata() = id %d truncated %v entries %d, want 31 false 11", m.EntityID, m.Truncated, len(m.Entries))
+	}
+	want := map[uint8]any{
+		0:  byte(0x01),
+		2:  "Bob",
+		6:  int32(5),
+		9:  float32(12.5),
+		10: []Particle{{Type: particleEntityEffect}, {Type: particleBlock}},
+		16: true,
+		18: VillagerData{Type: 2, Profession: 5, Level: 3},
+		20: (*UUID)(nil),
+		21: [4]float32{0, 0, 0, 1},
+	}
+	for index, value := range want {
+		entry, ok := m.Entry(index)
+		if !ok || !reflect.DeepEqual(entry.Value, value) {
+			t.Fatalf("entry %d = %#v, want %#v", index, entry.Value, value)
+		}
+	}
+	if entry, _ := m.Entry(14); *entry.Value.(*[3]int32) != [3]int32{3, -60, -7} {
+		t.Fatalf("sleeping pos = %v, want [3 -60 -7]", entry.Value)
+	}
+	if entry, _ := m.Entry(19); *entry.Value.(*int32) != 7 {
+		t.Fatalf("optional unsigned int = %v, want 7", entry.Value)
+	}
+	if PoseName(5) != "crouching" {
+		t.Fatalf("PoseName(5) = %q, want crouching", PoseName(5))
+	}
+}
+
+func TestParseEntityMetadataStopsAtUnknownSerializer(t *testing.T) {
+	var payload bytes.Buffer
+	_ = WriteVarint(&payload, 3)
+	writeMetadataEntry(&payload, 9, MetadataFloat, func(buf *bytes.Buffer) { _ = WriteFloat(buf, 4) })
+	writeMetadataEntry(&payload, 10, 99, func(buf *bytes.Buffer) { _ = WriteVarint(buf, 1) })
+
+	m, err := ParseEntityMetadata(bytes.NewReader(payload.Bytes()))
+	if err != nil {
+		t.Fatalf("ParseEntityMetadata() error = %v", err)
+	}
+	if !m.Truncated || len(m.Entries) != 1 {
+		t.Fatalf("ParseEntityMetadata() truncated=%v entries=%d, want true 1", m.Truncated, len(m.Entries))
+	}
+}
+
+func TestEntityMetadataPacketRoundTrip(t *testing.T) {
+	entries := []EntityMetadataEntry{
+		{Index: 0, Type: MetadataByte, Value: byte(0x02)},
+		{Index: 2, Type: MetadataOptionalComponent, Value: "Steve"},
+		{Index: 9, Type: MetadataFloat, Value: float32(8)},
+		{Index: 16, Type: MetadataBoolean, Value: false},
+		{Index: 8, Type: MetadataItemStack, Value: Slot{ItemID: 840, Count: 1}},
+	}
+	packet := CreateEntityMetadataPacket(5, entries)
+	m, err := ParseEntityMetadata(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ParseEntityMetadata() error = %v", err)
+	}
+	if !reflect.DeepEqual(m.Entries, entries) {
+		t.Fatalf("entries = %#v, want %#v", m.Entries, entries)
+	}
+}
+
+func TestParticleIDsMatchCurrentVersion(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", CurrentVersionName, "particles.json"))
+	if err != nil {
+		t.Fatalf("read particles.json failed: %v", err)
+	}
+	var particles []struct {
+		ID   int32  `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &particles); err != nil {
+		t.Fatalf("parse particles.json failed: %v", err)
+	}
+	ids := make(map[string]int32, len(particles))
+	for _, p := range particles {
+		ids[p.Name] = p.ID
+	}
+	for name, id := range map[string]int32{
+		"block":                 particleBlock,
+		"block_marker":          particleBlockMarker,
+		"dragon_breath":         particleDragonBreath,
+		"dust":                  particleDust,
+		"dust_color_transition": particleDustColorTransition,
+		"effect":                particleEffect,
+		"entity_effect":         particleEntityEffect,
+		"falling_dust":          particleFallingDust,
+		"tinted_leaves":         particleTintedLeaves,
+		"sculk_charge":          particleSculkCharge,
+		"flash":                 particleFlash,
+		"instant_effect":        particleInstantEffect,
+		"item":                  particleItem,
+		"vibration":             particleVibration,
+		"trail":                 particleTrail,
+		"shriek":                particleShriek,
+		"dust_pillar":           particleDustPillar,
+		"block_crumble":         particleBlockCrumble,
+	} {
+		if got, ok := ids[name]; !ok || got != id {
+			t.Errorf("particle %s = %d, %v in particles.json, want %d", name, got, ok, id)
+		}
+	}
+}
@@ -0,0 +1,335 @@
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Vec3 is a double-precision vector such as an entity velocity in blocks
+// per tick.
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+const (
+	lpVec3MaxQuantized = 32766.0
+	lpVec3MinMagnitude = 3.051944088384301e-5
+)
+
+// ReadLpVec3 reads the low-precision vector used for velocities since
+// 1.21.9: three 15-bit components and a scale packed into 6 bytes, with a
+// varint extension for scales above 3. A zero first byte is the zero vector.
+func ReadLpVec3(r io.Reader) (Vec3, error) {
+	b0, err := ReadByte(r)
+	if err != nil {
+		return Vec3{}, err
+	}
+	if b0 == 0 {
+		return Vec3{}, nil
+	}
+	b1, err := ReadByte(r)
+	if err != nil {
+		return Vec3{}, err
+	}
+	high, err := ReadInt32(r)
+	if err != nil {
+		return Vec3{}, err
+	}
+	packed := uint64(uint32(high))<<16 | uint64(b1)<<8 | uint64(b0)
+	scale := uint64(b0 & 3)
+	if b0&4 != 0 {
+		ext, err := ReadVarint(r)
+		if err != nil {
+			return Vec3{}, err
+		}
+		scale |= uint64(uint32(ext)) << 2
+	}
+	s := float64(scale)
+	return Vec3{
+		X: unpackLpComponent(packed>>3) * s,
+		Y: unpackLpComponent(packed>>18) * s,
+		Z: unpackLpComponent(packed>>33) * s,
+	}, nil
+}
+
+// WriteLpVec3 is the inverse of ReadLpVec3.
+func WriteLpVec3(w io.Writer, v Vec3) error {
+	x, y, z := sanitizeLpComponent(v.X), sanitizeLpComponent(v.Y), sanitizeLpComponent(v.Z)
+	magnitude := math.Max(math.Abs(x), math.Max(math.Abs(y), math.Abs(z)))
+	if magnitude < lpVec3MinMagnitude {
+		return WriteByte(w, 0)
+	}
+	scale := uint64(math.Ceil(magnitude))
+	extended := scale&3 != scale
+	header := scale
+	if extended {
+		header = scale&3 | 4
+	}
+	s := float64(scale)
+	packed := header | packLpComponent(x/s)<<3 | packLpComponent(y/s)<<18 | packLpComponent(z/s)<<33
+	if err := WriteByte(w, byte(packed)); err != nil {
+		return err
+	}
+	if err := WriteByte(w, byte(packed>>8)); err != nil {
+		return err
+	}
+	if err := WriteInt32(w, int32(uint32(packed>>16))); err != nil {
+		return err
+	}
+	if extended {
+		return WriteVarint(w, int32(scale>>2))
+	}
+	return nil
+}
+
+func unpackLpComponent(v uint64) float64 {
+	return math.Min(float64(v&0x7fff), lpVec3MaxQuantized)*2/lpVec3MaxQuantized - 1
+}
+
+func packLpComponent(v float64) uint64 {
+	return uint64(math.Round((v*0.5 + 0.5) * lpVec3MaxQuantized))
+}
+
+func sanitizeLpComponent(v float64) float64 {
+	if math.IsNaN(v) {
+		return 0
+	}
+	return math.Max(-1.7179869183e10, math.Min(v, 1.7179869183e10))
+}
+
+// EntityVelocity is the Set Entity Velocity packet.
+type EntityVelocity struct {
+	EntityID int32
+	Velocity Vec3
+}
+
+func ParseEntityVelocity(r io.Reader) (*EntityVelocity, error) {
+	entityID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	velocity, err := ReadLpVec3(r)
+	if err != nil {
+		return nil, err
+	}
+	return &EntityVelocity{EntityID: entityID, Velocity: velocity}, nil
+}
+
+// EntityHeadRotation is the Set Head Rotation packet. HeadYaw is in 1/256ths
+// of a full turn.
+type EntityHeadRotation struct {
+	EntityID int32
+	HeadYaw  int8
+}
+
+func ParseEntityHeadRotation(r io.Reader) (*EntityHeadRotation, error) {
+	entityID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	yaw, err := ReadByte(r)
+	if err != nil {
+		return nil, err
+	}
+	return &EntityHeadRotation{EntityID: entityID, HeadYaw: int8(yaw)}, nil
+}
+
+// AngleToDegrees converts a protocol angle byte to degrees.
+func AngleToDegrees(angle int8) float32 {
+	return float32(angle) * 360 / 256
+}
+
+// Equipment slots of the Set Equipment packet.
+const (
+	EquipmentMainHand int8 = 0
+	EquipmentOffHand  int8 = 1
+	EquipmentFeet     int8 = 2
+	EquipmentLegs     int8 = 3
+	EquipmentChest    int8 = 4
+	EquipmentHead     int8 = 5
+	EquipmentBody     int8 = 6
+	EquipmentSaddle   int8 = 7
+)
+
+type EquipmentEntry struct {
+	Slot int8
+	Item Slot
+}
+
+// EntityEquipment is the Set Equipment packet. An empty Item clears a slot.
+type EntityEquipment struct {
+	EntityID  int32
+	Equipment []EquipmentEntry
+}
+
+func ParseEntityEquipment(r io.Reader) (*EntityEquipment, error) {
+	entityID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	p := &EntityEquipment{EntityID: entityID}
+	// The top bit of each slot byte says another entry follows.
+	for {
+		slotByte, err := ReadByte(r)
+		if err != nil {
+			return nil, err
+		}
+		item, err := ReadSlot(r)
+		if err != nil {
+			return nil, fmt.Errorf("equipment slot %d: %w", slotByte&0x7f, err)
+		}
+		p.Equipment = append(p.Equipment, EquipmentEntry{Slot: int8(slotByte & 0x7f), Item: item})
+		if slotByte&0x80 == 0 {
+			return p, nil
+		}
+	}
+}
+
+func CreateEntityEquipmentPacket(entityID int32, equipment []EquipmentEntry) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, entityID)
+	for i, entry := range equipment {
+		slotByte := byte(entry.Slot) & 0x7f
+		if i < len(equipment)-1 {
+			slotByte |= 0x80
+		}
+		_ = WriteByte(buf, slotByte)
+		_ = WriteSlot(buf, entry.Item)
+	}
+	return &Packet{ID: S2CEntityEquipment, Payload: buf.Bytes()}
+}
+
+// Attribute IDs of the Update Attributes packet.
+const (
+	AttributeArmor         int32 = 0
+	AttributeAttackDamage  int32 = 2
+	AttributeMaxHealth     int32 = 19
+	AttributeMovementSpeed int32 = 20
+	AttributeScale         int32 = 22
+)
+
+var attributeNames = [...]string{
+	"armor", "armor_toughness", "attack_damage", "attack_knockback", "attack_speed",
+	"block_break_speed", "block_interaction_range", "burning_time", "camera_distance", "explosion_knockback_resistance",
+	"entity_interaction_range", "fall_damage_multiplier", "flying_speed", "follow_range", "gravity",
+	"jump_strength", "knockback_resistance", "luck", "max_absorption", "max_health",
+	"movement_speed", "safe_fall_distance", "scale", "spawn_reinforcements", "step_height",
+	"submerged_mining_speed", "sweeping_damage_ratio", "tempt_range", "water_movement_efficiency", "waypoint_transmit_range",
+	"waypoint_receive_range",
+}
+
+// AttributeName returns the registry name of an attribute ID, or "" if unknown.
+func AttributeName(id int32) string {
+	if id < 0 || int(id) >= len(attributeNames) {
+		return ""
+	}
+	return attributeNames[id]
+}
+
+// Attribute modifier operations.
+const (
+	AttributeAddValue           int8 = 0
+	AttributeAddMultipliedBase  int8 = 1
+	AttributeAddMultipliedTotal int8 = 2
+)
+
+type AttributeModifier struct {
+	ID        string
+	Amount    float64
+	Operation int8
+}
+
+type Attribute struct {
+	ID        int32
+	Base      float64
+	Modifiers []AttributeModifier
+}
+
+// Value applies the modifiers to the base value the way the server does.
+func (a Attribute) Value() float64 {
+	value := a.Base
+	for _, m := range a.Modifiers {
+		if m.Operation == AttributeAddValue {
+			value += m.Amount
+		}
+	}
+	total := value
+	for _, m := range a.Modifiers {
+		if m.Operation == AttributeAddMultipliedBase {
+			total += value * m.Amount
+		}
+	}
+	for _, m := range a.Modifiers {
+		if m.Operation == AttributeAddMultipliedTotal {
+			total *= 1 + m.Amount
+		}
+	}
+	return total
+}
+
+// EntityAttributes is the Update Attributes packet.
+type EntityAttributes struct {
+	EntityID   int32
+	Attributes []Attribute
+}
+
+func ParseEntityAttributes(r io.Reader) (*EntityAttributes, error) {
+	entityID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	count, err := readCollectionLength(r)
+	if err != nil {
+		return nil, err
+	}
+	p := &EntityAttributes{EntityID: entityID, Attributes: make([]Attribute, 0, count)}
+	for i := 0; i < count; i++ {
+		var a Attribute
+		if a.ID, err = ReadVarint(r); err != nil {
+			return nil, err
+		}
+		if a.Base, err = ReadDouble(r); err != nil {
+			return nil, err
+		}
+		modifierCount, err := readCollectionLength(r)
+		if err != nil {
+			return nil, err
+		}
+		for j := 0; j < modifierCount; j++ {
+			var m AttributeModifier
+			if m.ID, err = ReadString(r); err != nil {
+				return nil, err
+			}
+			if m.Amount, err = ReadDouble(r); err != nil {
+				return nil, err
+			}
+			op, err := ReadByte(r)
+			if err != nil {
+				return nil, err
+			}
+			m.Operation = int8(op)
+			a.Modifiers = append(a.Modifiers, m)
+		}
+		p.Attributes = append(p.Attributes, a)
+	}
+	return p, nil
+}
+
+func CreateEntityAttributesPacket(entityID int32, attributes []Attribute) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, entityID)
+	_ = WriteVarint(buf, int32(len(attributes)))
+	for _, a := range attributes {
+		_ = WriteVarint(buf, a.ID)
+		_ = WriteDouble(buf, a.Base)
+		_ = WriteVarint(buf, int32(len(a.Modifiers)))
+		for _, m := range a.Modifiers {
+			_ = WriteString(buf, m.ID)
+			_ = WriteDouble(buf, m.Amount)
+			_ = WriteByte(buf, byte(m.Operation))
+		}
+	}
+	return &Packet{ID: S2CEntityUpdateAttributes, Payload: buf.Bytes()}
+}
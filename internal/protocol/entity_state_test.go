@@ -0,0 +1,121 @@
+package protocol
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestLpVec3RoundTrip(t *testing.T) {
+	for _, v := range []Vec3{
+		{},
+		{X: 0.1, Y: -0.0784, Z: 0},
+		{X: -2.5, Y: 1, Z: 0.75},
+		{X: 12, Y: -40.25, Z: 3},
+	} {
+		var buf bytes.Buffer
+		if err := WriteLpVec3(&buf, v); err != nil {
+			t.Fatalf("WriteLpVec3(%v) error = %v", v, err)
+		}
+		got, err := ReadLpVec3(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatalf("ReadLpVec3(%v) error = %v", v, err)
+		}
+		scale := math.Max(1, math.Ceil(math.Max(math.Abs(v.X), math.Max(math.Abs(v.Y), math.Abs(v.Z)))))
+		tolerance := scale / 16000
+		if math.Abs(got.X-v.X) > tolerance || math.Abs(got.Y-v.Y) > tolerance || math.Abs(got.Z-v.Z) > tolerance {
+			t.Fatalf("LpVec3 round trip %v -> %v", v, got)
+		}
+		if v == (Vec3{}) && buf.Len() != 1 {
+			t.Fatalf("zero vector encoded in %d bytes, want 1", buf.Len())
+		}
+	}
+}
+
+func TestParseSpawnEntityReadsVelocityAndAngles(t *testing.T) {
+	var buf bytes.Buffer
+	_ = WriteVarint(&buf, 7)
+	_ = WriteUUID(&buf, GenerateOfflineUUID("zombie"))
+	_ = WriteVarint(&buf, 150)
+	for _, v := range []float64{1, 64, -3} {
+		_ = WriteDouble(&buf, v)
+	}
+	_ = WriteLpVec3(&buf, Vec3{Y: -0.5})
+	_ = WriteByte(&buf, 0)
+	_ = WriteByte(&buf, 64) // yaw 90 degrees
+	_ = WriteByte(&buf, 0xc0)
+	_ = WriteVarint(&buf, 42)
+
+	spawn, err := ParseSpawnEntity(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ParseSpawnEntity() error = %v", err)
+	}
+	if spawn.EntityID != 7 || spawn.Type != 150 || spawn.Data != 42 {
+		t.Fatalf("ParseSpawnEntity() = %+v", spawn)
+	}
+	if math.Abs(spawn.Velocity.Y+0.5) > 1e-3 || AngleToDegrees(spawn.Yaw) != 90 || AngleToDegrees(spawn.HeadYaw) != -90 {
+		t.Fatalf("velocity=%v yaw=%v head=%v, want y=-0.5 yaw 90 head -90", spawn.Velocity, AngleToDegrees(spawn.Yaw), AngleToDegrees(spawn.HeadYaw))
+	}
+}
+
+func TestEntityEquipmentRoundTrip(t *testing.T) {
+	equipment := []EquipmentEntry{
+		{Slot: EquipmentMainHand, Item: Slot{ItemID: 900, Count: 1}},
+		{Slot: EquipmentHead, Item: Slot{}},
+		{Slot: EquipmentChest, Item: Slot{ItemID: 901, Count: 1}},
+	}
+	packet := CreateEntityEquipmentPacket(12, equipment)
+	got, err := ParseEntityEquipment(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ParseEntityEquipment() error = %v", err)
+	}
+	if got.EntityID != 12 || len(got.Equipment) != 3 {
+		t.Fatalf("ParseEntityEquipment() = %+v", got)
+	}
+	for i, entry := range got.Equipment {
+		if entry.Slot != equipment[i].Slot || entry.Item.ItemID != equipment[i].Item.ItemID || entry.Item.IsEmpty() != equipment[i].Item.IsEmpty() {
+			t.Fatalf("equipment[%d] = %+v, want %+v", i, entry, equipment[i])
+		}
+	}
+}
+
+func TestEntityAttributesRoundTripAndValue(t *testing.T) {
+	attributes := []Attribute{
+		{ID: AttributeMaxHealth, Base: 20, Modifiers: []AttributeModifier{
+			{ID: "minecraft:health_boost", Amount: 4, Operation: AttributeAddValue},
+			{ID: "test:half", Amount: 0.5, Operation: AttributeAddMultipliedBase},
+			{ID: "test:double", Amount: 1, Operation: AttributeAddMultipliedTotal},
+		}},
+		{ID: AttributeMovementSpeed, Base: 0.23},
+	}
+	packet := CreateEntityAttributesPacket(3, attributes)
+	got, err := ParseEntityAttributes(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ParseEntityAttributes() error = %v", err)
+	}
+	if got.EntityID != 3 || len(got.Attributes) != 2 || len(got.Attributes[0].Modifiers) != 3 {
+		t.Fatalf("ParseEntityAttributes() = %+v", got)
+	}
+	// (20+4) + 24*0.5 = 36, doubled = 72.
+	if v := got.Attributes[0].Value(); v != 72 {
+		t.Fatalf("max_health Value() = %v, want 72", v)
+	}
+	if AttributeName(got.Attributes[1].ID) != "movement_speed" || got.Attributes[1].Value() != 0.23 {
+		t.Fatalf("speed attribute = %+v", got.Attributes[1])
+	}
+}
+
+func TestParseEntityVelocityAndHeadRotation(t *testing.T) {
+	var buf bytes.Buffer
+	_ = WriteVarint(&buf, 9)
+	_ = WriteLpVec3(&buf, Vec3{X: 0.25})
+	velocity, err := ParseEntityVelocity(bytes.NewReader(buf.Bytes()))
+	if err != nil || velocity.EntityID != 9 || math.Abs(velocity.Velocity.X-0.25) > 1e-3 {
+		t.Fatalf("ParseEntityVelocity() = %+v, %v", velocity, err)
+	}
+
+	head, err := ParseEntityHeadRotation(bytes.NewReader([]byte{9, 0x80}))
+	if err != nil || head.EntityID != 9 || AngleToDegrees(head.HeadYaw) != -180 {
+		t.Fatalf("ParseEntityHeadRotation() = %+v, %v", head, err)
+	}
+}
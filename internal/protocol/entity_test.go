@@ -149,7 +149,7 @@ func TestParseEntityMetadataItemSlot_UnknownTypeGraceful(t *testing.T) {
 	var payload bytes.Buffer
 
 	_ = WriteVarint(&payload, 5)
-	writeMetadataEntry(&payload, 1, 16, func(buf *bytes.Buffer) {})
+	writeMetadataEntry(&payload, 1, 99, func(buf *bytes.Buffer) {})
 
 	entityID, itemID, found, err := ParseEntityMetadataItemSlot(bytes.NewReader(payload.Bytes()))
 	if err != nil {
@@ -0,0 +1,142 @@
+package protocol
+
+import (
+	"fmt"
+	"io"
+)
+
+// PlayerInventoryWindowID is the window ID the server uses for the player's own inventory.
+const PlayerInventoryWindowID int32 = 0
+
+// Player inventory window (window 0) slot layout.
+const (
+	PlayerSlotCraftResult  = 0
+	PlayerSlotCraftStart   = 1 // 1-4: 2x2 crafting grid
+	PlayerSlotArmorStart   = 5 // 5-8: head, chest, legs, feet
+	PlayerSlotMainStart    = 9 // 9-35: main inventory
+	PlayerSlotHotbarStart  = 36
+	PlayerSlotOffhand      = 45
+	PlayerInventorySlotLen = 46
+)
+
+type WindowItems struct {
+	WindowID    int32
+	StateID     int32
+	Items       []Slot
+	CarriedItem Slot
+}
+
+func ParseWindowItems(r io.Reader) (*WindowItems, error) {
+	windowID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	stateID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	count, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if count < 0 || count > 256 {
+		return nil, fmt.Errorf("%w: window item count %d", ErrInvalidPacket, count)
+	}
+
+	items := make([]Slot, 0, count)
+	for i := int32(0); i < count; i++ {
+		slot, err := ReadSlot(r)
+		if err != nil {
+			return nil, fmt.Errorf("window item %d: %w", i, err)
+		}
+		items = append(items, slot)
+	}
+
+	carried, err := ReadSlot(r)
+	if err != nil {
+		return nil, fmt.Errorf("carried item: %w", err)
+	}
+
+	return &WindowItems{
+		WindowID:    windowID,
+		StateID:     stateID,
+		Items:       items,
+		CarriedItem: carried,
+	}, nil
+}
+
+type SetSlot struct {
+	WindowID int32
+	StateID  int32
+	Slot     int16
+	Item     Slot
+}
+
+func ParseSetSlot(r io.Reader) (*SetSlot, error) {
+	windowID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	stateID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	slotIndex, err := ReadInt16(r)
+	if err != nil {
+		return nil, err
+	}
+	item, err := ReadSlot(r)
+	if err != nil {
+		return nil, err
+	}
+	return &SetSlot{
+		WindowID: windowID,
+		StateID:  stateID,
+		Slot:     slotIndex,
+		Item:     item,
+	}, nil
+}
+
+type HeldItemSlot struct {
+	Slot int32
+}
+
+func ParseHeldItemSlot(r io.Reader) (*HeldItemSlot, error) {
+	slot, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	return &HeldItemSlot{Slot: slot}, nil
+}
+
+// SetPlayerInventory updates a slot addressed by the player inventory index
+// (0-8 hotbar, 9-35 main, 36-39 armor feet→head, 40 offhand), independent of window ID.
+type SetPlayerInventory struct {
+	SlotID   int32
+	Contents Slot
+}
+
+func ParseSetPlayerInventory(r io.Reader) (*SetPlayerInventory, error) {
+	slotID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	contents, err := ReadSlot(r)
+	if err != nil {
+		return nil, err
+	}
+	return &SetPlayerInventory{SlotID: slotID, Contents: contents}, nil
+}
+
+// SetCursorItem replaces the item held on the cursor while a window is open.
+type SetCursorItem struct {
+	Contents Slot
+}
+
+func ParseSetCursorItem(r io.Reader) (*SetCursorItem, error) {
+	contents, err := ReadSlot(r)
+	if err != nil {
+		return nil, err
+	}
+	return &SetCursorItem{Contents: contents}, nil
+}
@@ -0,0 +1,100 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func writeTestSlot(buf *bytes.Buffer, itemID, count int32) {
+	_ = WriteVarint(buf, count)
+	if count == 0 {
+		return
+	}
+	_ = WriteVarint(buf, itemID)
+	_ = WriteVarint(buf, 0)
+	_ = WriteVarint(buf, 0)
+}
+
+func TestParseWindowItems(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 0)
+	_ = WriteVarint(buf, 17)
+	_ = WriteVarint(buf, 3)
+	writeTestSlot(buf, 0, 0)
+	writeTestSlot(buf, 1, 64)
+	writeTestSlot(buf, 840, 1)
+	writeTestSlot(buf, 5, 2)
+
+	parsed, err := ParseWindowItems(buf)
+	if err != nil {
+		t.Fatalf("ParseWindowItems failed: %v", err)
+	}
+	if parsed.WindowID != 0 || parsed.StateID != 17 {
+		t.Fatalf("header mismatch: %+v", parsed)
+	}
+	if len(parsed.Items) != 3 {
+		t.Fatalf("items len = %d, want 3", len(parsed.Items))
+	}
+	if !parsed.Items[0].IsEmpty() || parsed.Items[1].ItemID != 1 || parsed.Items[1].Count != 64 {
+		t.Fatalf("items mismatch: %+v", parsed.Items)
+	}
+	if parsed.CarriedItem.ItemID != 5 || parsed.CarriedItem.Count != 2 {
+		t.Fatalf("carried item mismatch: %+v", parsed.CarriedItem)
+	}
+}
+
+func TestParseSetSlot(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 0)
+	_ = WriteVarint(buf, 4)
+	_ = WriteInt16(buf, 36)
+	writeTestSlot(buf, 840, 1)
+
+	parsed, err := ParseSetSlot(buf)
+	if err != nil {
+		t.Fatalf("ParseSetSlot failed: %v", err)
+	}
+	if parsed.WindowID != 0 || parsed.StateID != 4 || parsed.Slot != 36 || parsed.Item.ItemID != 840 {
+		t.Fatalf("SetSlot mismatch: %+v", parsed)
+	}
+}
+
+func TestParseHeldItemSlot(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 5)
+
+	parsed, err := ParseHeldItemSlot(buf)
+	if err != nil {
+		t.Fatalf("ParseHeldItemSlot failed: %v", err)
+	}
+	if parsed.Slot != 5 {
+		t.Fatalf("slot = %d, want 5", parsed.Slot)
+	}
+}
+
+func TestParseSetPlayerInventory(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 40)
+	writeTestSlot(buf, 1154, 1)
+
+	parsed, err := ParseSetPlayerInventory(buf)
+	if err != nil {
+		t.Fatalf("ParseSetPlayerInventory failed: %v", err)
+	}
+	if parsed.SlotID != 40 || parsed.Contents.ItemID != 1154 || parsed.Contents.Count != 1 {
+		t.Fatalf("SetPlayerInventory mismatch: %+v", parsed)
+	}
+}
+
+func TestParseSetCursorItem(t *testing.T) {
+	buf := new(bytes.Buffer)
+	writeTestSlot(buf, 1, 32)
+
+	parsed, err := ParseSetCursorItem(buf)
+	if err != nil {
+		t.Fatalf("ParseSetCursorItem failed: %v", err)
+	}
+	if parsed.Contents.ItemID != 1 || parsed.Contents.Count != 32 {
+		t.Fatalf("SetCursorItem mismatch: %+v", parsed)
+	}
+}
@@ -0,0 +1,169 @@
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// LightSectionBytes is the size of one section's light array: 4096 nibbles.
+const LightSectionBytes = 2048
+
+// LightData is the light part of map_chunk and update_light. Bit i of a mask
+// is light section i; light sections start one section below the world and
+// end one above it. SkyLight and BlockLight hold one array per set bit of
+// their mask, in bit order. A bit in an empty mask means the section is all
+// dark; a section in neither mask is unchanged.
+type LightData struct {
+	SkyLightMask        []int64
+	BlockLightMask      []int64
+	EmptySkyLightMask   []int64
+	EmptyBlockLightMask []int64
+	SkyLight            [][]byte
+	BlockLight          [][]byte
+}
+
+// UpdateLight is the S2C update_light packet payload.
+type UpdateLight struct {
+	ChunkX int32
+	ChunkZ int32
+	Light  LightData
+}
+
+// BitSetIndices lists the set bits of a protocol BitSet in ascending order.
+func BitSetIndices(mask []int64) []int {
+	var out []int
+	for word, v := range mask {
+		u := uint64(v)
+		for u != 0 {
+			bit := bits.TrailingZeros64(u)
+			out = append(out, word*64+bit)
+			u &= u - 1
+		}
+	}
+	return out
+}
+
+// NewBitSet builds a protocol BitSet with the given bits set.
+func NewBitSet(indices ...int) []int64 {
+	var mask []int64
+	for _, i := range indices {
+		for len(mask) <= i/64 {
+			mask = append(mask, 0)
+		}
+		mask[i/64] |= int64(uint64(1) << (i % 64))
+	}
+	return mask
+}
+
+func ParseLightData(r io.Reader) (*LightData, error) {
+	var l LightData
+	masks := []*[]int64{&l.SkyLightMask, &l.BlockLightMask, &l.EmptySkyLightMask, &l.EmptyBlockLightMask}
+	for _, mask := range masks {
+		m, err := readInt64Array(r)
+		if err != nil {
+			return nil, err
+		}
+		*mask = m
+	}
+	var err error
+	if l.SkyLight, err = readLightArrays(r, len(BitSetIndices(l.SkyLightMask))); err != nil {
+		return nil, fmt.Errorf("sky light: %w", err)
+	}
+	if l.BlockLight, err = readLightArrays(r, len(BitSetIndices(l.BlockLightMask))); err != nil {
+		return nil, fmt.Errorf("block light: %w", err)
+	}
+	return &l, nil
+}
+
+func ParseUpdateLight(r io.Reader) (*UpdateLight, error) {
+	chunkX, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	chunkZ, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	light, err := ParseLightData(r)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateLight{ChunkX: chunkX, ChunkZ: chunkZ, Light: *light}, nil
+}
+
+func CreateUpdateLightPacket(chunkX, chunkZ int32, light LightData) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, chunkX)
+	_ = WriteVarint(buf, chunkZ)
+	_ = WriteLightData(buf, light)
+	return &Packet{
+		ID:      S2CUpdateLight,
+		Payload: buf.Bytes(),
+	}
+}
+
+// WriteLightData encodes light the way map_chunk and update_light carry it.
+func WriteLightData(w io.Writer, l LightData) error {
+	for _, mask := range [][]int64{l.SkyLightMask, l.BlockLightMask, l.EmptySkyLightMask, l.EmptyBlockLightMask} {
+		if err := WriteVarint(w, int32(len(mask))); err != nil {
+			return err
+		}
+		for _, v := range mask {
+			if err := WriteInt64(w, v); err != nil {
+				return err
+			}
+		}
+	}
+	for _, arrays := range [][][]byte{l.SkyLight, l.BlockLight} {
+		if err := WriteVarint(w, int32(len(arrays))); err != nil {
+			return err
+		}
+		for _, data := range arrays {
+			if err := WriteByteArray(w, data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readInt64Array(r io.Reader) ([]int64, error) {
+	count, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if count < 0 || count > 64 {
+		return nil, fmt.Errorf("invalid int64 array length: %d", count)
+	}
+	out := make([]int64, count)
+	for i := range out {
+		if out[i], err = ReadInt64(r); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func readLightArrays(r io.Reader, want int) ([][]byte, error) {
+	count, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if int(count) != want {
+		return nil, fmt.Errorf("%d light arrays for %d mask bits", count, want)
+	}
+	out := make([][]byte, count)
+	for i := range out {
+		data, err := readVarIntByteArray(r)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != LightSectionBytes {
+			return nil, fmt.Errorf("light array %d is %d bytes, want %d", i, len(data), LightSectionBytes)
+		}
+		out[i] = data
+	}
+	return out, nil
+}
@@ -0,0 +1,96 @@
+package protocol
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func filledLight(v byte) []byte {
+	return bytes.Repeat([]byte{v}, LightSectionBytes)
+}
+
+func TestBitSetIndices(t *testing.T) {
+	mask := NewBitSet(0, 3, 25, 64, 70)
+	if len(mask) != 2 {
+		t.Fatalf("NewBitSet 长度 = %d, 期望 2", len(mask))
+	}
+	got := BitSetIndices(mask)
+	want := []int{0, 3, 25, 64, 70}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("BitSetIndices = %v, 期望 %v", got, want)
+	}
+	if got := BitSetIndices(NewBitSet(63)); !reflect.DeepEqual(got, []int{63}) {
+		t.Fatalf("最高位 BitSetIndices = %v, 期望 [63]", got)
+	}
+}
+
+func TestUpdateLightRoundTrip(t *testing.T) {
+	light := LightData{
+		SkyLightMask:        NewBitSet(1, 25),
+		BlockLightMask:      NewBitSet(5),
+		EmptySkyLightMask:   NewBitSet(0),
+		EmptyBlockLightMask: NewBitSet(2, 3),
+		SkyLight:            [][]byte{filledLight(0xff), filledLight(0x77)},
+		BlockLight:          [][]byte{filledLight(0x0e)},
+	}
+	packet := CreateUpdateLightPacket(-3, 7, light)
+	if packet.ID != S2CUpdateLight {
+		t.Fatalf("包 ID = 0x%02x, 期望 0x%02x", packet.ID, S2CUpdateLight)
+	}
+
+	got, err := ParseUpdateLight(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ParseUpdateLight 失败: %v", err)
+	}
+	if got.ChunkX != -3 || got.ChunkZ != 7 {
+		t.Fatalf("区块坐标 = (%d,%d), 期望 (-3,7)", got.ChunkX, got.ChunkZ)
+	}
+	if !reflect.DeepEqual(got.Light, light) {
+		t.Fatalf("光照数据往返不一致: %+v", got.Light)
+	}
+}
+
+func TestParseLightDataRejectsMismatchedArrays(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteLightData(buf, LightData{SkyLightMask: NewBitSet(1, 2), SkyLight: [][]byte{filledLight(1)}})
+	if _, err := ParseLightData(bytes.NewReader(buf.Bytes())); err == nil {
+		t.Fatal("掩码位数与数组数不一致时应返回错误")
+	}
+
+	buf.Reset()
+	_ = WriteLightData(buf, LightData{BlockLightMask: NewBitSet(0), BlockLight: [][]byte{{1, 2, 3}}})
+	if _, err := ParseLightData(bytes.NewReader(buf.Bytes())); err == nil {
+		t.Fatal("光照数组长度错误时应返回错误")
+	}
+}
+
+func TestParseLevelChunkWithLightKeepsLight(t *testing.T) {
+	chunkData := new(bytes.Buffer)
+	for section := 0; section < ChunkSectionCount; section++ {
+		_ = writeInt16(chunkData, 0)
+		_ = WriteByte(chunkData, 0)
+		_ = WriteVarint(chunkData, 0)
+		_ = WriteVarint(chunkData, 0)
+		_ = WriteByte(chunkData, 0)
+		_ = WriteVarint(chunkData, 0)
+		_ = WriteVarint(chunkData, 0)
+	}
+	payload := new(bytes.Buffer)
+	_ = WriteInt32(payload, 1)
+	_ = WriteInt32(payload, 2)
+	_ = WriteVarint(payload, 0)
+	_ = WriteVarint(payload, int32(chunkData.Len()))
+	_, _ = payload.Write(chunkData.Bytes())
+	_ = WriteVarint(payload, 0)
+	light := LightData{SkyLightMask: NewBitSet(25), SkyLight: [][]byte{filledLight(0xff)}}
+	_ = WriteLightData(payload, light)
+
+	got, err := ParseLevelChunkWithLight(bytes.NewReader(payload.Bytes()))
+	if err != nil {
+		t.Fatalf("ParseLevelChunkWithLight 失败: %v", err)
+	}
+	if !reflect.DeepEqual(BitSetIndices(got.Light.SkyLightMask), []int{25}) || len(got.Light.SkyLight) != 1 {
+		t.Fatalf("区块光照 = %+v, 期望第 25 段天空光", got.Light)
+	}
+}
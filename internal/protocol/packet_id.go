@@ -1,82 +1,132 @@
 package protocol
 
+//go:generate go run ./protogen -schema ../../1.21.11/protocol.json -ids packet_id_gen.go -packets packets/packets_gen.go
+
+// The names below are the ones the bot uses. Their IDs come from
+// packet_id_gen.go, so a protocol bump only needs a new protocol.json and
+// go generate.
 const (
 	CurrentProtocolVersion = 774
+	NextStateStatus        = 1
 	NextStateLogin         = 2
 
 	// Handshaking (C→S)
-	C2SHandshake = 0x00
+	C2SHandshake = HandshakingToServerSetProtocol
+
+	// Status (C→S)
+	C2SStatusRequest = StatusToServerPingStart
+	C2SStatusPing    = StatusToServerPing
+
+	// Status (S→C)
+	S2CStatusResponse = StatusToClientServerInfo
+	S2CStatusPong     = StatusToClientPing
 
 	// Login (C→S)
-	C2SLoginStart        = 0x00
-	C2SLoginAcknowledged = 0x03
+	C2SLoginStart         = LoginToServerLoginStart
+	C2SEncryptionResponse = LoginToServerEncryptionBegin
+	C2SLoginAcknowledged  = LoginToServerLoginAcknowledged
 
 	// Login (S→C)
-	S2CLoginSuccess   = 0x02
-	S2CSetCompression = 0x03
+	S2CLoginDisconnect   = LoginToClientDisconnect
+	S2CEncryptionRequest = LoginToClientEncryptionBegin
+	S2CLoginSuccess      = LoginToClientSuccess
+	S2CSetCompression    = LoginToClientCompress
 
 	// Configuration (S→C)
-	S2CFinishConfiguration = 0x03
-	S2CSelectKnown         = 0x0E
-	S2CConfigKeepAlive     = 0x04
+	S2CConfigDisconnect    = ConfigurationToClientDisconnect
+	S2CFinishConfiguration = ConfigurationToClientFinishConfiguration
+	S2CSelectKnown         = ConfigurationToClientSelectKnownPacks
+	S2CConfigKeepAlive     = ConfigurationToClientKeepAlive
+	S2CRegistryData        = ConfigurationToClientRegistryData
+	S2CConfigUpdateTags    = ConfigurationToClientTags
 
 	// Configuration (C→S)
-	C2SConfigClientInformation = 0x00
-	C2SCustomPayload           = 0x02
-	C2SSelectKnown             = 0x07
-	C2SFinishConfiguration     = 0x03
-	C2SConfigKeepAlive         = 0x04
+	C2SConfigClientInformation = ConfigurationToServerSettings
+	C2SCustomPayload           = ConfigurationToServerCustomPayload
+	C2SSelectKnown             = ConfigurationToServerSelectKnownPacks
+	C2SFinishConfiguration     = ConfigurationToServerFinishConfiguration
+	C2SConfigKeepAlive         = ConfigurationToServerKeepAlive
 
 	// Play (S→C)
-	S2CSpawnEntity              = 0x01
-	S2CAcknowledgePlayerDigging = 0x04
-	S2CTileEntityData           = 0x06
-	S2CBlockAction              = 0x07
-	S2CBlockChange              = 0x08
-	S2CChunkBatchFinished       = 0x0b
-	S2CChunkBatchStart          = 0x0c
-	S2CSyncEntityPosition       = 0x23
-	S2CUnloadChunk              = 0x25
-	S2CPlayKeepAlive            = 0x2b
-	S2CLevelChunkWithLight      = 0x2c
-	S2CLogin                    = 0x30 // Play state login packet
-	S2CRelEntityMove            = 0x33
-	S2CEntityMoveLook           = 0x34
-	S2CPlayerChatMessage        = 0x3f
-	S2CPlayerRemove             = 0x43
-	S2CPlayerInfo               = 0x44
-	S2CPlayerPosition           = 0x46
-	S2CEntityDestroy            = 0x4b
-	S2CRespawn                  = 0x50
-	S2CMultiBlockChange         = 0x52
-	S2CUpdateViewPosition       = 0x5c
-	S2CEntityMetadata           = 0x61
-	S2CExperience               = 0x65
-	S2CUpdateHealth             = 0x66
-	S2CHeldItemSlot             = 0x67
-	S2CUpdateTime               = 0x6f
-	S2CSystemChatMessage        = 0x77
-	S2CEntityTeleport           = 0x7b
+	S2CSpawnEntity              = PlayToClientSpawnEntity
+	S2CAcknowledgePlayerDigging = PlayToClientAcknowledgePlayerDigging
+	S2CTileEntityData           = PlayToClientTileEntityData
+	S2CBlockAction              = PlayToClientBlockAction
+	S2CBlockChange              = PlayToClientBlockChange
+	S2CChunkBatchFinished       = PlayToClientChunkBatchFinished
+	S2CChunkBatchStart          = PlayToClientChunkBatchStart
+	S2CCloseWindow              = PlayToClientCloseWindow
+	S2CWindowItems              = PlayToClientWindowItems
+	S2CSetSlot                  = PlayToClientSetSlot
+	S2CDisguisedChat            = PlayToClientProfilelessChat
+	S2CKickDisconnect           = PlayToClientKickDisconnect
+	S2CSyncEntityPosition       = PlayToClientSyncEntityPosition
+	S2CUnloadChunk              = PlayToClientUnloadChunk
+	S2CUpdateLight              = PlayToClientUpdateLight
+	S2CPlayKeepAlive            = PlayToClientKeepAlive
+	S2CLevelChunkWithLight      = PlayToClientMapChunk
+	S2CLogin                    = PlayToClientLogin // Play state login packet
+	S2CRelEntityMove            = PlayToClientRelEntityMove
+	S2CEntityMoveLook           = PlayToClientEntityMoveLook
+	S2COpenWindow               = PlayToClientOpenWindow
+	S2CPlayerChatMessage        = PlayToClientPlayerChat
+	S2CPlayerRemove             = PlayToClientPlayerRemove
+	S2CPlayerInfo               = PlayToClientPlayerInfo
+	S2CPlayerPosition           = PlayToClientPosition
+	S2CEntityDestroy            = PlayToClientEntityDestroy
+	S2CRespawn                  = PlayToClientRespawn
+	S2CMultiBlockChange         = PlayToClientMultiBlockChange
+	S2CUpdateViewPosition       = PlayToClientUpdateViewPosition
+	S2CSetCursorItem            = PlayToClientSetCursorItem
+	S2CEntityMetadata           = PlayToClientEntityMetadata
+	S2CExperience               = PlayToClientExperience
+	S2CUpdateHealth             = PlayToClientUpdateHealth
+	S2CHeldItemSlot             = PlayToClientHeldItemSlot
+	S2CSetPlayerInventory       = PlayToClientSetPlayerInventory
+	S2CUpdateTime               = PlayToClientUpdateTime
+	S2CSystemChatMessage        = PlayToClientSystemChat
+	S2CEntityTeleport           = PlayToClientEntityTeleport
+	S2CEntityVelocity           = PlayToClientEntityVelocity
+	S2CEntityEquipment          = PlayToClientEntityEquipment
+	S2CEntityHeadRotation       = PlayToClientEntityHeadRotation
+	S2CEntityUpdateAttributes   = PlayToClientEntityUpdateAttributes
+	S2CEntityEffect             = PlayToClientEntityEffect
+	S2CRemoveEntityEffect       = PlayToClientRemoveEntityEffect
+	S2CAbilities                = PlayToClientAbilities
+	S2CGameEvent                = PlayToClientGameStateChange
+	S2CDeathCombatEvent         = PlayToClientDeathCombatEvent
+	S2CDamageEvent              = PlayToClientDamageEvent
+	S2CHurtAnimation            = PlayToClientHurtAnimation
+	S2CInitializeWorldBorder    = PlayToClientInitializeWorldBorder
+	S2CWorldBorderCenter        = PlayToClientWorldBorderCenter
+	S2CWorldBorderLerpSize      = PlayToClientWorldBorderLerpSize
+	S2CWorldBorderSize          = PlayToClientWorldBorderSize
+	S2CSoundEffect              = PlayToClientSoundEffect
+	S2CEntitySoundEffect        = PlayToClientEntitySoundEffect
+	S2CExplosion                = PlayToClientExplosion
+	S2CUpdateTags               = PlayToClientTags
 
 	// Play (C→S)
-	C2STeleportConfirm       = 0x00
-	C2SChatCommand           = 0x06
-	C2SChatCommandSigned     = 0x07
-	C2SChatMessage           = 0x08
-	C2SChunkBatchReceived    = 0x0a
-	C2SClientCommand         = 0x0b
-	C2SPlayClientInformation = 0x0d
-	C2SUseEntity             = 0x19
-	C2SPlayKeepAlive         = 0x1b
-	C2SPlayerPosition        = 0x1d
-	C2SPlayerPositionLook    = 0x1e
-	C2SPlayerRotation        = 0x1f
-	C2SBlockDig              = 0x28
-	C2SEntityAction          = 0x29
-	C2SPlayerInput           = 0x2a
-	C2SPlayerLoaded          = 0x2b
-	C2SHeldItemSlot          = 0x34
-	C2SArmAnimation          = 0x3c
-	C2SBlockPlace            = 0x3f
-	C2SUseItem               = 0x40
+	C2STeleportConfirm       = PlayToServerTeleportConfirm
+	C2SChatCommand           = PlayToServerChatCommand
+	C2SChatCommandSigned     = PlayToServerChatCommandSigned
+	C2SChatMessage           = PlayToServerChatMessage
+	C2SChunkBatchReceived    = PlayToServerChunkBatchReceived
+	C2SClientCommand         = PlayToServerClientCommand
+	C2SPlayClientInformation = PlayToServerSettings
+	C2SWindowClick           = PlayToServerWindowClick
+	C2SCloseWindow           = PlayToServerCloseWindow
+	C2SUseEntity             = PlayToServerUseEntity
+	C2SPlayKeepAlive         = PlayToServerKeepAlive
+	C2SPlayerPosition        = PlayToServerPosition
+	C2SPlayerPositionLook    = PlayToServerPositionLook
+	C2SPlayerRotation        = PlayToServerLook
+	C2SBlockDig              = PlayToServerBlockDig
+	C2SEntityAction          = PlayToServerEntityAction
+	C2SPlayerInput           = PlayToServerPlayerInput
+	C2SPlayerLoaded          = PlayToServerPlayerLoaded
+	C2SHeldItemSlot          = PlayToServerHeldItemSlot
+	C2SArmAnimation          = PlayToServerArmAnimation
+	C2SBlockPlace            = PlayToServerBlockPlace
+	C2SUseItem               = PlayToServerUseItem
 )
@@ -0,0 +1,530 @@
+// Code generated by protogen from 1.21.11/protocol.json. DO NOT EDIT.
+
+package protocol
+
+const (
+	// handshaking, toServer
+	HandshakingToServerSetProtocol          = 0x00
+	HandshakingToServerLegacyServerListPing = 0xfe
+
+	// status, toClient
+	StatusToClientServerInfo = 0x00
+	StatusToClientPing       = 0x01
+
+	// status, toServer
+	StatusToServerPingStart = 0x00
+	StatusToServerPing      = 0x01
+
+	// login, toClient
+	LoginToClientDisconnect         = 0x00
+	LoginToClientEncryptionBegin    = 0x01
+	LoginToClientSuccess            = 0x02
+	LoginToClientCompress           = 0x03
+	LoginToClientLoginPluginRequest = 0x04
+	LoginToClientCookieRequest      = 0x05
+
+	// login, toServer
+	LoginToServerLoginStart          = 0x00
+	LoginToServerEncryptionBegin     = 0x01
+	LoginToServerLoginPluginResponse = 0x02
+	LoginToServerLoginAcknowledged   = 0x03
+	LoginToServerCookieResponse      = 0x04
+
+	// configuration, toClient
+	ConfigurationToClientCookieRequest       = 0x00
+	ConfigurationToClientCustomPayload       = 0x01
+	ConfigurationToClientDisconnect          = 0x02
+	ConfigurationToClientFinishConfiguration = 0x03
+	ConfigurationToClientKeepAlive           = 0x04
+	ConfigurationToClientPing                = 0x05
+	ConfigurationToClientResetChat           = 0x06
+	ConfigurationToClientRegistryData        = 0x07
+	ConfigurationToClientRemoveResourcePack  = 0x08
+	ConfigurationToClientAddResourcePack     = 0x09
+	ConfigurationToClientStoreCookie         = 0x0a
+	ConfigurationToClientTransfer            = 0x0b
+	ConfigurationToClientFeatureFlags        = 0x0c
+	ConfigurationToClientTags                = 0x0d
+	ConfigurationToClientSelectKnownPacks    = 0x0e
+	ConfigurationToClientCustomReportDetails = 0x0f
+	ConfigurationToClientServerLinks         = 0x10
+	ConfigurationToClientClearDialog         = 0x11
+	ConfigurationToClientShowDialog          = 0x12
+	ConfigurationToClientCodeOfConduct       = 0x13
+
+	// configuration, toServer
+	ConfigurationToServerSettings            = 0x00
+	ConfigurationToServerCookieResponse      = 0x01
+	ConfigurationToServerCustomPayload       = 0x02
+	ConfigurationToServerFinishConfiguration = 0x03
+	ConfigurationToServerKeepAlive           = 0x04
+	ConfigurationToServerPong                = 0x05
+	ConfigurationToServerResourcePackReceive = 0x06
+	ConfigurationToServerSelectKnownPacks    = 0x07
+	ConfigurationToServerCustomClickAction   = 0x08
+	ConfigurationToServerAcceptCodeOfConduct = 0x09
+
+	// play, toClient
+	PlayToClientBundleDelimiter            = 0x00
+	PlayToClientSpawnEntity                = 0x01
+	PlayToClientAnimation                  = 0x02
+	PlayToClientStatistics                 = 0x03
+	PlayToClientAcknowledgePlayerDigging   = 0x04
+	PlayToClientBlockBreakAnimation        = 0x05
+	PlayToClientTileEntityData             = 0x06
+	PlayToClientBlockAction                = 0x07
+	PlayToClientBlockChange                = 0x08
+	PlayToClientBossBar                    = 0x09
+	PlayToClientDifficulty                 = 0x0a
+	PlayToClientChunkBatchFinished         = 0x0b
+	PlayToClientChunkBatchStart            = 0x0c
+	PlayToClientChunkBiomes                = 0x0d
+	PlayToClientClearTitles                = 0x0e
+	PlayToClientTabComplete                = 0x0f
+	PlayToClientDeclareCommands            = 0x10
+	PlayToClientCloseWindow                = 0x11
+	PlayToClientWindowItems                = 0x12
+	PlayToClientCraftProgressBar           = 0x13
+	PlayToClientSetSlot                    = 0x14
+	PlayToClientCookieRequest              = 0x15
+	PlayToClientSetCooldown                = 0x16
+	PlayToClientChatSuggestions            = 0x17
+	PlayToClientCustomPayload              = 0x18
+	PlayToClientDamageEvent                = 0x19
+	PlayToClientDebugBlockValue            = 0x1a
+	PlayToClientDebugChunkValue            = 0x1b
+	PlayToClientDebugEntityValue           = 0x1c
+	PlayToClientDebugEvent                 = 0x1d
+	PlayToClientDebugSample                = 0x1e
+	PlayToClientHideMessage                = 0x1f
+	PlayToClientKickDisconnect             = 0x20
+	PlayToClientProfilelessChat            = 0x21
+	PlayToClientEntityStatus               = 0x22
+	PlayToClientSyncEntityPosition         = 0x23
+	PlayToClientExplosion                  = 0x24
+	PlayToClientUnloadChunk                = 0x25
+	PlayToClientGameStateChange            = 0x26
+	PlayToClientGameTestHighlightPos       = 0x27
+	PlayToClientOpenHorseWindow            = 0x28
+	PlayToClientHurtAnimation              = 0x29
+	PlayToClientInitializeWorldBorder      = 0x2a
+	PlayToClientKeepAlive                  = 0x2b
+	PlayToClientMapChunk                   = 0x2c
+	PlayToClientWorldEvent                 = 0x2d
+	PlayToClientWorldParticles             = 0x2e
+	PlayToClientUpdateLight                = 0x2f
+	PlayToClientLogin                      = 0x30
+	PlayToClientMap                        = 0x31
+	PlayToClientTradeList                  = 0x32
+	PlayToClientRelEntityMove              = 0x33
+	PlayToClientEntityMoveLook             = 0x34
+	PlayToClientMoveMinecart               = 0x35
+	PlayToClientEntityLook                 = 0x36
+	PlayToClientVehicleMove                = 0x37
+	PlayToClientOpenBook                   = 0x38
+	PlayToClientOpenWindow                 = 0x39
+	PlayToClientOpenSignEntity             = 0x3a
+	PlayToClientPing                       = 0x3b
+	PlayToClientPingResponse               = 0x3c
+	PlayToClientCraftRecipeResponse        = 0x3d
+	PlayToClientAbilities                  = 0x3e
+	PlayToClientPlayerChat                 = 0x3f
+	PlayToClientEndCombatEvent             = 0x40
+	PlayToClientEnterCombatEvent           = 0x41
+	PlayToClientDeathCombatEvent           = 0x42
+	PlayToClientPlayerRemove               = 0x43
+	PlayToClientPlayerInfo                 = 0x44
+	PlayToClientFacePlayer                 = 0x45
+	PlayToClientPosition                   = 0x46
+	PlayToClientPlayerRotation             = 0x47
+	PlayToClientRecipeBookAdd              = 0x48
+	PlayToClientRecipeBookRemove           = 0x49
+	PlayToClientRecipeBookSettings         = 0x4a
+	PlayToClientEntityDestroy              = 0x4b
+	PlayToClientRemoveEntityEffect         = 0x4c
+	PlayToClientResetScore                 = 0x4d
+	PlayToClientRemoveResourcePack         = 0x4e
+	PlayToClientAddResourcePack            = 0x4f
+	PlayToClientRespawn                    = 0x50
+	PlayToClientEntityHeadRotation         = 0x51
+	PlayToClientMultiBlockChange           = 0x52
+	PlayToClientSelectAdvancementTab       = 0x53
+	PlayToClientServerData                 = 0x54
+	PlayToClientActionBar                  = 0x55
+	PlayToClientWorldBorderCenter          = 0x56
+	PlayToClientWorldBorderLerpSize        = 0x57
+	PlayToClientWorldBorderSize            = 0x58
+	PlayToClientWorldBorderWarningDelay    = 0x59
+	PlayToClientWorldBorderWarningReach    = 0x5a
+	PlayToClientCamera                     = 0x5b
+	PlayToClientUpdateViewPosition         = 0x5c
+	PlayToClientUpdateViewDistance         = 0x5d
+	PlayToClientSetCursorItem              = 0x5e
+	PlayToClientSpawnPosition              = 0x5f
+	PlayToClientScoreboardDisplayObjective = 0x60
+	PlayToClientEntityMetadata             = 0x61
+	PlayToClientAttachEntity               = 0x62
+	PlayToClientEntityVelocity             = 0x63
+	PlayToClientEntityEquipment            = 0x64
+	PlayToClientExperience                 = 0x65
+	PlayToClientUpdateHealth               = 0x66
+	PlayToClientHeldItemSlot               = 0x67
+	PlayToClientScoreboardObjective        = 0x68
+	PlayToClientSetPassengers              = 0x69
+	PlayToClientSetPlayerInventory         = 0x6a
+	PlayToClientTeams                      = 0x6b
+	PlayToClientScoreboardScore            = 0x6c
+	PlayToClientSimulationDistance         = 0x6d
+	PlayToClientSetTitleSubtitle           = 0x6e
+	PlayToClientUpdateTime                 = 0x6f
+	PlayToClientSetTitleText               = 0x70
+	PlayToClientSetTitleTime               = 0x71
+	PlayToClientEntitySoundEffect          = 0x72
+	PlayToClientSoundEffect                = 0x73
+	PlayToClientStartConfiguration         = 0x74
+	PlayToClientStopSound                  = 0x75
+	PlayToClientStoreCookie                = 0x76
+	PlayToClientSystemChat                 = 0x77
+	PlayToClientPlayerlistHeader           = 0x78
+	PlayToClientNBTQueryResponse           = 0x79
+	PlayToClientCollect                    = 0x7a
+	PlayToClientEntityTeleport             = 0x7b
+	PlayToClientTestInstanceBlockStatus    = 0x7c
+	PlayToClientSetTickingState            = 0x7d
+	PlayToClientStepTick                   = 0x7e
+	PlayToClientTransfer                   = 0x7f
+	PlayToClientAdvancements               = 0x80
+	PlayToClientEntityUpdateAttributes     = 0x81
+	PlayToClientEntityEffect               = 0x82
+	PlayToClientDeclareRecipes             = 0x83
+	PlayToClientTags                       = 0x84
+	PlayToClientSetProjectilePower         = 0x85
+	PlayToClientCustomReportDetails        = 0x86
+	PlayToClientServerLinks                = 0x87
+	PlayToClientTrackedWaypoint            = 0x88
+	PlayToClientClearDialog                = 0x89
+	PlayToClientShowDialog                 = 0x8a
+
+	// play, toServer
+	PlayToServerTeleportConfirm            = 0x00
+	PlayToServerQueryBlockNBT              = 0x01
+	PlayToServerSelectBundleItem           = 0x02
+	PlayToServerSetDifficulty              = 0x03
+	PlayToServerChangeGamemode             = 0x04
+	PlayToServerMessageAcknowledgement     = 0x05
+	PlayToServerChatCommand                = 0x06
+	PlayToServerChatCommandSigned          = 0x07
+	PlayToServerChatMessage                = 0x08
+	PlayToServerChatSessionUpdate          = 0x09
+	PlayToServerChunkBatchReceived         = 0x0a
+	PlayToServerClientCommand              = 0x0b
+	PlayToServerTickEnd                    = 0x0c
+	PlayToServerSettings                   = 0x0d
+	PlayToServerTabComplete                = 0x0e
+	PlayToServerConfigurationAcknowledged  = 0x0f
+	PlayToServerEnchantItem                = 0x10
+	PlayToServerWindowClick                = 0x11
+	PlayToServerCloseWindow                = 0x12
+	PlayToServerSetSlotState               = 0x13
+	PlayToServerCookieResponse             = 0x14
+	PlayToServerCustomPayload              = 0x15
+	PlayToServerDebugSubscriptionRequest   = 0x16
+	PlayToServerEditBook                   = 0x17
+	PlayToServerQueryEntityNBT             = 0x18
+	PlayToServerUseEntity                  = 0x19
+	PlayToServerGenerateStructure          = 0x1a
+	PlayToServerKeepAlive                  = 0x1b
+	PlayToServerLockDifficulty             = 0x1c
+	PlayToServerPosition                   = 0x1d
+	PlayToServerPositionLook               = 0x1e
+	PlayToServerLook                       = 0x1f
+	PlayToServerFlying                     = 0x20
+	PlayToServerVehicleMove                = 0x21
+	PlayToServerSteerBoat                  = 0x22
+	PlayToServerPickItemFromBlock          = 0x23
+	PlayToServerPickItemFromEntity         = 0x24
+	PlayToServerPingRequest                = 0x25
+	PlayToServerCraftRecipeRequest         = 0x26
+	PlayToServerAbilities                  = 0x27
+	PlayToServerBlockDig                   = 0x28
+	PlayToServerEntityAction               = 0x29
+	PlayToServerPlayerInput                = 0x2a
+	PlayToServerPlayerLoaded               = 0x2b
+	PlayToServerPong                       = 0x2c
+	PlayToServerRecipeBook                 = 0x2d
+	PlayToServerDisplayedRecipe            = 0x2e
+	PlayToServerNameItem                   = 0x2f
+	PlayToServerResourcePackReceive        = 0x30
+	PlayToServerAdvancementTab             = 0x31
+	PlayToServerSelectTrade                = 0x32
+	PlayToServerSetBeaconEffect            = 0x33
+	PlayToServerHeldItemSlot               = 0x34
+	PlayToServerUpdateCommandBlock         = 0x35
+	PlayToServerUpdateCommandBlockMinecart = 0x36
+	PlayToServerSetCreativeSlot            = 0x37
+	PlayToServerUpdateJigsawBlock          = 0x38
+	PlayToServerUpdateStructureBlock       = 0x39
+	PlayToServerSetTestBlock               = 0x3a
+	PlayToServerUpdateSign                 = 0x3b
+	PlayToServerArmAnimation               = 0x3c
+	PlayToServerSpectate                   = 0x3d
+	PlayToServerTestInstanceBlockAction    = 0x3e
+	PlayToServerBlockPlace                 = 0x3f
+	PlayToServerUseItem                    = 0x40
+	PlayToServerCustomClickAction          = 0x41
+)
+
+var packetNames = map[packetKey]string{
+	{Handshaking, ToServer, HandshakingToServerSetProtocol}:             "set_protocol",
+	{Handshaking, ToServer, HandshakingToServerLegacyServerListPing}:    "legacy_server_list_ping",
+	{Status, ToClient, StatusToClientServerInfo}:                        "server_info",
+	{Status, ToClient, StatusToClientPing}:                              "ping",
+	{Status, ToServer, StatusToServerPingStart}:                         "ping_start",
+	{Status, ToServer, StatusToServerPing}:                              "ping",
+	{Login, ToClient, LoginToClientDisconnect}:                          "disconnect",
+	{Login, ToClient, LoginToClientEncryptionBegin}:                     "encryption_begin",
+	{Login, ToClient, LoginToClientSuccess}:                             "success",
+	{Login, ToClient, LoginToClientCompress}:                            "compress",
+	{Login, ToClient, LoginToClientLoginPluginRequest}:                  "login_plugin_request",
+	{Login, ToClient, LoginToClientCookieRequest}:                       "cookie_request",
+	{Login, ToServer, LoginToServerLoginStart}:                          "login_start",
+	{Login, ToServer, LoginToServerEncryptionBegin}:                     "encryption_begin",
+	{Login, ToServer, LoginToServerLoginPluginResponse}:                 "login_plugin_response",
+	{Login, ToServer, LoginToServerLoginAcknowledged}:                   "login_acknowledged",
+	{Login, ToServer, LoginToServerCookieResponse}:                      "cookie_response",
+	{Configuration, ToClient, ConfigurationToClientCookieRequest}:       "cookie_request",
+	{Configuration, ToClient, ConfigurationToClientCustomPayload}:       "custom_payload",
+	{Configuration, ToClient, ConfigurationToClientDisconnect}:          "disconnect",
+	{Configuration, ToClient, ConfigurationToClientFinishConfiguration}: "finish_configuration",
+	{Configuration, ToClient, ConfigurationToClientKeepAlive}:           "keep_alive",
+	{Configuration, ToClient, ConfigurationToClientPing}:                "ping",
+	{Configuration, ToClient, ConfigurationToClientResetChat}:           "reset_chat",
+	{Configuration, ToClient, ConfigurationToClientRegistryData}:        "registry_data",
+	{Configuration, ToClient, ConfigurationToClientRemoveResourcePack}:  "remove_resource_pack",
+	{Configuration, ToClient, ConfigurationToClientAddResourcePack}:     "add_resource_pack",
+	{Configuration, ToClient, ConfigurationToClientStoreCookie}:         "store_cookie",
+	{Configuration, ToClient, ConfigurationToClientTransfer}:            "transfer",
+	{Configuration, ToClient, ConfigurationToClientFeatureFlags}:        "feature_flags",
+	{Configuration, ToClient, ConfigurationToClientTags}:                "tags",
+	{Configuration, ToClient, ConfigurationToClientSelectKnownPacks}:    "select_known_packs",
+	{Configuration, ToClient, ConfigurationToClientCustomReportDetails}: "custom_report_details",
+	{Configuration, ToClient, ConfigurationToClientServerLinks}:         "server_links",
+	{Configuration, ToClient, ConfigurationToClientClearDialog}:         "clear_dialog",
+	{Configuration, ToClient, ConfigurationToClientShowDialog}:          "show_dialog",
+	{Configuration, ToClient, ConfigurationToClientCodeOfConduct}:       "code_of_conduct",
+	{Configuration, ToServer, ConfigurationToServerSettings}:            "settings",
+	{Configuration, ToServer, ConfigurationToServerCookieResponse}:      "cookie_response",
+	{Configuration, ToServer, ConfigurationToServerCustomPayload}:       "custom_payload",
+	{Configuration, ToServer, ConfigurationToServerFinishConfiguration}: "finish_configuration",
+	{Configuration, ToServer, ConfigurationToServerKeepAlive}:           "keep_alive",
+	{Configuration, ToServer, ConfigurationToServerPong}:                "pong",
+	{Configuration, ToServer, ConfigurationToServerResourcePackReceive}: "resource_pack_receive",
+	{Configuration, ToServer, ConfigurationToServerSelectKnownPacks}:    "select_known_packs",
+	{Configuration, ToServer, ConfigurationToServerCustomClickAction}:   "custom_click_action",
+	{Configuration, ToServer, ConfigurationToServerAcceptCodeOfConduct}: "accept_code_of_conduct",
+	{Play, ToClient, PlayToClientBundleDelimiter}:                       "bundle_delimiter",
+	{Play, ToClient, PlayToClientSpawnEntity}:                           "spawn_entity",
+	{Play, ToClient, PlayToClientAnimation}:                             "animation",
+	{Play, ToClient, PlayToClientStatistics}:                            "statistics",
+	{Play, ToClient, PlayToClientAcknowledgePlayerDigging}:              "acknowledge_player_digging",
+	{Play, ToClient, PlayToClientBlockBreakAnimation}:                   "block_break_animation",
+	{Play, ToClient, PlayToClientTileEntityData}:                        "tile_entity_data",
+	{Play, ToClient, PlayToClientBlockAction}:                           "block_action",
+	{Play, ToClient, PlayToClientBlockChange}:                           "block_change",
+	{Play, ToClient, PlayToClientBossBar}:                               "boss_bar",
+	{Play, ToClient, PlayToClientDifficulty}:                            "difficulty",
+	{Play, ToClient, PlayToClientChunkBatchFinished}:                    "chunk_batch_finished",
+	{Play, ToClient, PlayToClientChunkBatchStart}:                       "chunk_batch_start",
+	{Play, ToClient, PlayToClientChunkBiomes}:                           "chunk_biomes",
+	{Play, ToClient, PlayToClientClearTitles}:                           "clear_titles",
+	{Play, ToClient, PlayToClientTabComplete}:                           "tab_complete",
+	{Play, ToClient, PlayToClientDeclareCommands}:                       "declare_commands",
+	{Play, ToClient, PlayToClientCloseWindow}:                           "close_window",
+	{Play, ToClient, PlayToClientWindowItems}:                           "window_items",
+	{Play, ToClient, PlayToClientCraftProgressBar}:                      "craft_progress_bar",
+	{Play, ToClient, PlayToClientSetSlot}:                               "set_slot",
+	{Play, ToClient, PlayToClientCookieRequest}:                         "cookie_request",
+	{Play, ToClient, PlayToClientSetCooldown}:                           "set_cooldown",
+	{Play, ToClient, PlayToClientChatSuggestions}:                       "chat_suggestions",
+	{Play, ToClient, PlayToClientCustomPayload}:                         "custom_payload",
+	{Play, ToClient, PlayToClientDamageEvent}:                           "damage_event",
+	{Play, ToClient, PlayToClientDebugBlockValue}:                       "debug_block_value",
+	{Play, ToClient, PlayToClientDebugChunkValue}:                       "debug_chunk_value",
+	{Play, ToClient, PlayToClientDebugEntityValue}:                      "debug_entity_value",
+	{Play, ToClient, PlayToClientDebugEvent}:                            "debug_event",
+	{Play, ToClient, PlayToClientDebugSample}:                           "debug_sample",
+	{Play, ToClient, PlayToClientHideMessage}:                           "hide_message",
+	{Play, ToClient, PlayToClientKickDisconnect}:                        "kick_disconnect",
+	{Play, ToClient, PlayToClientProfilelessChat}:                       "profileless_chat",
+	{Play, ToClient, PlayToClientEntityStatus}:                          "entity_status",
+	{Play, ToClient, PlayToClientSyncEntityPosition}:                    "sync_entity_position",
+	{Play, ToClient, PlayToClientExplosion}:                             "explosion",
+	{Play, ToClient, PlayToClientUnloadChunk}:                           "unload_chunk",
+	{Play, ToClient, PlayToClientGameStateChange}:                       "game_state_change",
+	{Play, ToClient, PlayToClientGameTestHighlightPos}:                  "game_test_highlight_pos",
+	{Play, ToClient, PlayToClientOpenHorseWindow}:                       "open_horse_window",
+	{Play, ToClient, PlayToClientHurtAnimation}:                         "hurt_animation",
+	{Play, ToClient, PlayToClientInitializeWorldBorder}:                 "initialize_world_border",
+	{Play, ToClient, PlayToClientKeepAlive}:                             "keep_alive",
+	{Play, ToClient, PlayToClientMapChunk}:                              "map_chunk",
+	{Play, ToClient, PlayToClientWorldEvent}:                            "world_event",
+	{Play, ToClient, PlayToClientWorldParticles}:                        "world_particles",
+	{Play, ToClient, PlayToClientUpdateLight}:                           "update_light",
+	{Play, ToClient, PlayToClientLogin}:                                 "login",
+	{Play, ToClient, PlayToClientMap}:                                   "map",
+	{Play, ToClient, PlayToClientTradeList}:                             "trade_list",
+	{Play, ToClient, PlayToClientRelEntityMove}:                         "rel_entity_move",
+	{Play, ToClient, PlayToClientEntityMoveLook}:                        "entity_move_look",
+	{Play, ToClient, PlayToClientMoveMinecart}:                          "move_minecart",
+	{Play, ToClient, PlayToClientEntityLook}:                            "entity_look",
+	{Play, ToClient, PlayToClientVehicleMove}:                           "vehicle_move",
+	{Play, ToClient, PlayToClientOpenBook}:                              "open_book",
+	{Play, ToClient, PlayToClientOpenWindow}:                            "open_window",
+	{Play, ToClient, PlayToClientOpenSignEntity}:                        "open_sign_entity",
+	{Play, ToClient, PlayToClientPing}:                                  "ping",
+	{Play, ToClient, PlayToClientPingResponse}:                          "ping_response",
+	{Play, ToClient, PlayToClientCraftRecipeResponse}:                   "craft_recipe_response",
+	{Play, ToClient, PlayToClientAbilities}:                             "abilities",
+	{Play, ToClient, PlayToClientPlayerChat}:                            "player_chat",
+	{Play, ToClient, PlayToClientEndCombatEvent}:                        "end_combat_event",
+	{Play, ToClient, PlayToClientEnterCombatEvent}:                      "enter_combat_event",
+	{Play, ToClient, PlayToClientDeathCombatEvent}:                      "death_combat_event",
+	{Play, ToClient, PlayToClientPlayerRemove}:                          "player_remove",
+	{Play, ToClient, PlayToClientPlayerInfo}:                            "player_info",
+	{Play, ToClient, PlayToClientFacePlayer}:                            "face_player",
+	{Play, ToClient, PlayToClientPosition}:                              "position",
+	{Play, ToClient, PlayToClientPlayerRotation}:                        "player_rotation",
+	{Play, ToClient, PlayToClientRecipeBookAdd}:                         "recipe_book_add",
+	{Play, ToClient, PlayToClientRecipeBookRemove}:                      "recipe_book_remove",
+	{Play, ToClient, PlayToClientRecipeBookSettings}:                    "recipe_book_settings",
+	{Play, ToClient, PlayToClientEntityDestroy}:                         "entity_destroy",
+	{Play, ToClient, PlayToClientRemoveEntityEffect}:                    "remove_entity_effect",
+	{Play, ToClient, PlayToClientResetScore}:                            "reset_score",
+	{Play, ToClient, PlayToClientRemoveResourcePack}:                    "remove_resource_pack",
+	{Play, ToClient, PlayToClientAddResourcePack}:                       "add_resource_pack",
+	{Play, ToClient, PlayToClientRespawn}:                               "respawn",
+	{Play, ToClient, PlayToClientEntityHeadRotation}:                    "entity_head_rotation",
+	{Play, ToClient, PlayToClientMultiBlockChange}:                      "multi_block_change",
+	{Play, ToClient, PlayToClientSelectAdvancementTab}:                  "select_advancement_tab",
+	{Play, ToClient, PlayToClientServerData}:                            "server_data",
+	{Play, ToClient, PlayToClientActionBar}:                             "action_bar",
+	{Play, ToClient, PlayToClientWorldBorderCenter}:                     "world_border_center",
+	{Play, ToClient, PlayToClientWorldBorderLerpSize}:                   "world_border_lerp_size",
+	{Play, ToClient, PlayToClientWorldBorderSize}:                       "world_border_size",
+	{Play, ToClient, PlayToClientWorldBorderWarningDelay}:               "world_border_warning_delay",
+	{Play, ToClient, PlayToClientWorldBorderWarningReach}:               "world_border_warning_reach",
+	{Play, ToClient, PlayToClientCamera}:                                "camera",
+	{Play, ToClient, PlayToClientUpdateViewPosition}:                    "update_view_position",
+	{Play, ToClient, PlayToClientUpdateViewDistance}:                    "update_view_distance",
+	{Play, ToClient, PlayToClientSetCursorItem}:                         "set_cursor_item",
+	{Play, ToClient, PlayToClientSpawnPosition}:                         "spawn_position",
+	{Play, ToClient, PlayToClientScoreboardDisplayObjective}:            "scoreboard_display_objective",
+	{Play, ToClient, PlayToClientEntityMetadata}:                        "entity_metadata",
+	{Play, ToClient, PlayToClientAttachEntity}:                          "attach_entity",
+	{Play, ToClient, PlayToClientEntityVelocity}:                        "entity_velocity",
+	{Play, ToClient, PlayToClientEntityEquipment}:                       "entity_equipment",
+	{Play, ToClient, PlayToClientExperience}:                            "experience",
+	{Play, ToClient, PlayToClientUpdateHealth}:                          "update_health",
+	{Play, ToClient, PlayToClientHeldItemSlot}:                          "held_item_slot",
+	{Play, ToClient, PlayToClientScoreboardObjective}:                   "scoreboard_objective",
+	{Play, ToClient, PlayToClientSetPassengers}:                         "set_passengers",
+	{Play, ToClient, PlayToClientSetPlayerInventory}:                    "set_player_inventory",
+	{Play, ToClient, PlayToClientTeams}:                                 "teams",
+	{Play, ToClient, PlayToClientScoreboardScore}:                       "scoreboard_score",
+	{Play, ToClient, PlayToClientSimulationDistance}:                    "simulation_distance",
+	{Play, ToClient, PlayToClientSetTitleSubtitle}:                      "set_title_subtitle",
+	{Play, ToClient, PlayToClientUpdateTime}:                            "update_time",
+	{Play, ToClient, PlayToClientSetTitleText}:                          "set_title_text",
+	{Play, ToClient, PlayToClientSetTitleTime}:                          "set_title_time",
+	{Play, ToClient, PlayToClientEntitySoundEffect}:                     "entity_sound_effect",
+	{Play, ToClient, PlayToClientSoundEffect}:                           "sound_effect",
+	{Play, ToClient, PlayToClientStartConfiguration}:                    "start_configuration",
+	{Play, ToClient, PlayToClientStopSound}:                             "stop_sound",
+	{Play, ToClient, PlayToClientStoreCookie}:                           "store_cookie",
+	{Play, ToClient, PlayToClientSystemChat}:                            "system_chat",
+	{Play, ToClient, PlayToClientPlayerlistHeader}:                      "playerlist_header",
+	{Play, ToClient, PlayToClientNBTQueryResponse}:                      "nbt_query_response",
+	{Play, ToClient, PlayToClientCollect}:                               "collect",
+	{Play, ToClient, PlayToClientEntityTeleport}:                        "entity_teleport",
+	{Play, ToClient, PlayToClientTestInstanceBlockStatus}:               "test_instance_block_status",
+	{Play, ToClient, PlayToClientSetTickingState}:                       "set_ticking_state",
+	{Play, ToClient, PlayToClientStepTick}:                              "step_tick",
+	{Play, ToClient, PlayToClientTransfer}:                              "transfer",
+	{Play, ToClient, PlayToClientAdvancements}:                          "advancements",
+	{Play, ToClient, PlayToClientEntityUpdateAttributes}:                "entity_update_attributes",
+	{Play, ToClient, PlayToClientEntityEffect}:                          "entity_effect",
+	{Play, ToClient, PlayToClientDeclareRecipes}:                        "declare_recipes",
+	{Play, ToClient, PlayToClientTags}:                                  "tags",
+	{Play, ToClient, PlayToClientSetProjectilePower}:                    "set_projectile_power",
+	{Play, ToClient, PlayToClientCustomReportDetails}:                   "custom_report_details",
+	{Play, ToClient, PlayToClientServerLinks}:                           "server_links",
+	{Play, ToClient, PlayToClientTrackedWaypoint}:                       "tracked_waypoint",
+	{Play, ToClient, PlayToClientClearDialog}:                           "clear_dialog",
+	{Play, ToClient, PlayToClientShowDialog}:                            "show_dialog",
+	{Play, ToServer, PlayToServerTeleportConfirm}:                       "teleport_confirm",
+	{Play, ToServer, PlayToServerQueryBlockNBT}:                         "query_block_nbt",
+	{Play, ToServer, PlayToServerSelectBundleItem}:                      "select_bundle_item",
+	{Play, ToServer, PlayToServerSetDifficulty}:                         "set_difficulty",
+	{Play, ToServer, PlayToServerChangeGamemode}:                        "change_gamemode",
+	{Play, ToServer, PlayToServerMessageAcknowledgement}:                "message_acknowledgement",
+	{Play, ToServer, PlayToServerChatCommand}:                           "chat_command",
+	{Play, ToServer, PlayToServerChatCommandSigned}:                     "chat_command_signed",
+	{Play, ToServer, PlayToServerChatMessage}:                           "chat_message",
+	{Play, ToServer, PlayToServerChatSessionUpdate}:                     "chat_session_update",
+	{Play, ToServer, PlayToServerChunkBatchReceived}:                    "chunk_batch_received",
+	{Play, ToServer, PlayToServerClientCommand}:                         "client_command",
+	{Play, ToServer, PlayToServerTickEnd}:                               "tick_end",
+	{Play, ToServer, PlayToServerSettings}:                              "settings",
+	{Play, ToServer, PlayToServerTabComplete}:                           "tab_complete",
+	{Play, ToServer, PlayToServerConfigurationAcknowledged}:             "configuration_acknowledged",
+	{Play, ToServer, PlayToServerEnchantItem}:                           "enchant_item",
+	{Play, ToServer, PlayToServerWindowClick}:                           "window_click",
+	{Play, ToServer, PlayToServerCloseWindow}:                           "close_window",
+	{Play, ToServer, PlayToServerSetSlotState}:                          "set_slot_state",
+	{Play, ToServer, PlayToServerCookieResponse}:                        "cookie_response",
+	{Play, ToServer, PlayToServerCustomPayload}:                         "custom_payload",
+	{Play, ToServer, PlayToServerDebugSubscriptionRequest}:              "debug_subscription_request",
+	{Play, ToServer, PlayToServerEditBook}:                              "edit_book",
+	{Play, ToServer, PlayToServerQueryEntityNBT}:                        "query_entity_nbt",
+	{Play, ToServer, PlayToServerUseEntity}:                             "use_entity",
+	{Play, ToServer, PlayToServerGenerateStructure}:                     "generate_structure",
+	{Play, ToServer, PlayToServerKeepAlive}:                             "keep_alive",
+	{Play, ToServer, PlayToServerLockDifficulty}:                        "lock_difficulty",
+	{Play, ToServer, PlayToServerPosition}:                              "position",
+	{Play, ToServer, PlayToServerPositionLook}:                          "position_look",
+	{Play, ToServer, PlayToServerLook}:                                  "look",
+	{Play, ToServer, PlayToServerFlying}:                                "flying",
+	{Play, ToServer, PlayToServerVehicleMove}:                           "vehicle_move",
+	{Play, ToServer, PlayToServerSteerBoat}:                             "steer_boat",
+	{Play, ToServer, PlayToServerPickItemFromBlock}:                     "pick_item_from_block",
+	{Play, ToServer, PlayToServerPickItemFromEntity}:                    "pick_item_from_entity",
+	{Play, ToServer, PlayToServerPingRequest}:                           "ping_request",
+	{Play, ToServer, PlayToServerCraftRecipeRequest}:                    "craft_recipe_request",
+	{Play, ToServer, PlayToServerAbilities}:                             "abilities",
+	{Play, ToServer, PlayToServerBlockDig}:                              "block_dig",
+	{Play, ToServer, PlayToServerEntityAction}:                          "entity_action",
+	{Play, ToServer, PlayToServerPlayerInput}:                           "player_input",
+	{Play, ToServer, PlayToServerPlayerLoaded}:                          "player_loaded",
+	{Play, ToServer, PlayToServerPong}:                                  "pong",
+	{Play, ToServer, PlayToServerRecipeBook}:                            "recipe_book",
+	{Play, ToServer, PlayToServerDisplayedRecipe}:                       "displayed_recipe",
+	{Play, ToServer, PlayToServerNameItem}:                              "name_item",
+	{Play, ToServer, PlayToServerResourcePackReceive}:                   "resource_pack_receive",
+	{Play, ToServer, PlayToServerAdvancementTab}:                        "advancement_tab",
+	{Play, ToServer, PlayToServerSelectTrade}:                           "select_trade",
+	{Play, ToServer, PlayToServerSetBeaconEffect}:                       "set_beacon_effect",
+	{Play, ToServer, PlayToServerHeldItemSlot}:                          "held_item_slot",
+	{Play, ToServer, PlayToServerUpdateCommandBlock}:                    "update_command_block",
+	{Play, ToServer, PlayToServerUpdateCommandBlockMinecart}:            "update_command_block_minecart",
+	{Play, ToServer, PlayToServerSetCreativeSlot}:                       "set_creative_slot",
+	{Play, ToServer, PlayToServerUpdateJigsawBlock}:                     "update_jigsaw_block",
+	{Play, ToServer, PlayToServerUpdateStructureBlock}:                  "update_structure_block",
+	{Play, ToServer, PlayToServerSetTestBlock}:                          "set_test_block",
+	{Play, ToServer, PlayToServerUpdateSign}:                            "update_sign",
+	{Play, ToServer, PlayToServerArmAnimation}:                          "arm_animation",
+	{Play, ToServer, PlayToServerSpectate}:                              "spectate",
+	{Play, ToServer, PlayToServerTestInstanceBlockAction}:               "test_instance_block_action",
+	{Play, ToServer, PlayToServerBlockPlace}:                            "block_place",
+	{Play, ToServer, PlayToServerUseItem}:                               "use_item",
+	{Play, ToServer, PlayToServerCustomClickAction}:                     "custom_click_action",
+}
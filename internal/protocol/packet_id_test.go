@@ -20,6 +20,18 @@ type ProtocolMapping struct {
 			} `json:"types"`
 		} `json:"toServer"`
 	} `json:"handshaking"`
+	Status struct {
+		ToClient struct {
+			Types struct {
+				Packet []any `json:"packet"`
+			} `json:"types"`
+		} `json:"toClient"`
+		ToServer struct {
+			Types struct {
+				Packet []any `json:"packet"`
+			} `json:"types"`
+		} `json:"toServer"`
+	} `json:"status"`
 	Login struct {
 		ToClient struct {
 			Types struct {
@@ -117,8 +129,22 @@ func TestPacketIDsConsistency(t *testing.T) {
 		checkID(t, m, "set_protocol", C2SHandshake)
 	})
 
+	t.Run("Status ToClient", func(t *testing.T) {
+		m := getMappings(t, protocol.Status.ToClient.Types.Packet)
+		checkID(t, m, "server_info", S2CStatusResponse)
+		checkID(t, m, "ping", S2CStatusPong)
+	})
+
+	t.Run("Status ToServer", func(t *testing.T) {
+		m := getMappings(t, protocol.Status.ToServer.Types.Packet)
+		checkID(t, m, "ping_start", C2SStatusRequest)
+		checkID(t, m, "ping", C2SStatusPing)
+	})
+
 	t.Run("Login ToClient", func(t *testing.T) {
 		m := getMappings(t, protocol.Login.ToClient.Types.Packet)
+		checkID(t, m, "disconnect", S2CLoginDisconnect)
+		checkID(t, m, "encryption_begin", S2CEncryptionRequest)
 		checkID(t, m, "success", S2CLoginSuccess)
 		checkID(t, m, "compress", S2CSetCompression)
 	})
@@ -126,11 +152,13 @@ func TestPacketIDsConsistency(t *testing.T) {
 	t.Run("Login ToServer", func(t *testing.T) {
 		m := getMappings(t, protocol.Login.ToServer.Types.Packet)
 		checkID(t, m, "login_start", C2SLoginStart)
+		checkID(t, m, "encryption_begin", C2SEncryptionResponse)
 		checkID(t, m, "login_acknowledged", C2SLoginAcknowledged)
 	})
 
 	t.Run("Configuration ToClient", func(t *testing.T) {
 		m := getMappings(t, protocol.Configuration.ToClient.Types.Packet)
+		checkID(t, m, "disconnect", S2CConfigDisconnect)
 		checkID(t, m, "finish_configuration", S2CFinishConfiguration)
 		checkID(t, m, "keep_alive", S2CConfigKeepAlive)
 		checkID(t, m, "select_known_packs", S2CSelectKnown)
@@ -165,6 +193,14 @@ func TestPacketIDsConsistency(t *testing.T) {
 		checkID(t, m, "update_view_position", S2CUpdateViewPosition)
 		checkID(t, m, "entity_metadata", S2CEntityMetadata)
 		checkID(t, m, "held_item_slot", S2CHeldItemSlot)
+		checkID(t, m, "window_items", S2CWindowItems)
+		checkID(t, m, "set_slot", S2CSetSlot)
+		checkID(t, m, "set_player_inventory", S2CSetPlayerInventory)
+		checkID(t, m, "open_window", S2COpenWindow)
+		checkID(t, m, "close_window", S2CCloseWindow)
+		checkID(t, m, "set_cursor_item", S2CSetCursorItem)
+		checkID(t, m, "profileless_chat", S2CDisguisedChat)
+		checkID(t, m, "kick_disconnect", S2CKickDisconnect)
 	})
 
 	t.Run("Play ToServer", func(t *testing.T) {
@@ -187,6 +223,8 @@ func TestPacketIDsConsistency(t *testing.T) {
 		checkID(t, m, "arm_animation", C2SArmAnimation)
 		checkID(t, m, "block_place", C2SBlockPlace)
 		checkID(t, m, "use_item", C2SUseItem)
+		checkID(t, m, "window_click", C2SWindowClick)
+		checkID(t, m, "close_window", C2SCloseWindow)
 	})
 }
 
@@ -0,0 +1,42 @@
+package protocol
+
+// Direction is the side a packet travels towards, as named in protocol.json.
+type Direction int
+
+const (
+	ToClient Direction = iota
+	ToServer
+)
+
+func (d Direction) String() string {
+	switch d {
+	case ToClient:
+		return "toClient"
+	case ToServer:
+		return "toServer"
+	default:
+		return "unknown"
+	}
+}
+
+type packetKey struct {
+	state State
+	dir   Direction
+	id    int32
+}
+
+// PacketName returns the protocol.json name of a packet, e.g. "keep_alive",
+// or "" when the ID is not defined for that state and direction.
+func PacketName(state State, dir Direction, id int32) string {
+	return packetNames[packetKey{state, dir, id}]
+}
+
+// LookupPacketID is the inverse of PacketName.
+func LookupPacketID(state State, dir Direction, name string) (int32, bool) {
+	for key, n := range packetNames {
+		if key.state == state && key.dir == dir && n == name {
+			return key.id, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,38 @@
+package protocol
+
+import "testing"
+
+func TestPacketName(t *testing.T) {
+	tests := []struct {
+		state State
+		dir   Direction
+		id    int32
+		want  string
+	}{
+		{Handshaking, ToServer, C2SHandshake, "set_protocol"},
+		{Login, ToClient, S2CEncryptionRequest, "encryption_begin"},
+		{Login, ToServer, C2SEncryptionResponse, "encryption_begin"},
+		{Configuration, ToClient, S2CSelectKnown, "select_known_packs"},
+		{Play, ToClient, S2CPlayKeepAlive, "keep_alive"},
+		{Play, ToServer, C2SPlayKeepAlive, "keep_alive"},
+		{Play, ToClient, 0x7fff, ""},
+	}
+	for _, tt := range tests {
+		if got := PacketName(tt.state, tt.dir, tt.id); got != tt.want {
+			t.Errorf("PacketName(%s, %s, 0x%02x) = %q, 期望 %q", tt.state, tt.dir, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestLookupPacketID(t *testing.T) {
+	id, ok := LookupPacketID(Play, ToClient, "map_chunk")
+	if !ok || id != S2CLevelChunkWithLight {
+		t.Errorf("LookupPacketID(map_chunk) = 0x%02x, %v, 期望 0x%02x", id, ok, S2CLevelChunkWithLight)
+	}
+	if _, ok := LookupPacketID(Play, ToServer, "map_chunk"); ok {
+		t.Error("map_chunk 不应存在于 toServer 方向")
+	}
+	if ToClient.String() != "toClient" || ToServer.String() != "toServer" {
+		t.Errorf("Direction.String() = %q/%q", ToClient, ToServer)
+	}
+}
@@ -0,0 +1,166 @@
+package packets
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+// Building blocks referenced by the generated code for types package
+// protocol has no reader or writer for.
+
+func readI8(r io.Reader) (int8, error) {
+	b, err := protocol.ReadByte(r)
+	return int8(b), err
+}
+
+func writeI8(w io.Writer, v int8) error {
+	return protocol.WriteByte(w, byte(v))
+}
+
+func readU32(r io.Reader) (uint32, error) {
+	var buf [4]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(buf[:]), nil
+}
+
+func writeU32(w io.Writer, v uint32) error {
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func readU64(r io.Reader) (uint64, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+func writeU64(w io.Writer, v uint64) error {
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func readRest(r io.Reader) ([]byte, error) {
+	return io.ReadAll(io.LimitReader(r, protocol.MaxPacketSize))
+}
+
+func writeRaw(w io.Writer, data []byte) error {
+	_, err := w.Write(data)
+	return err
+}
+
+// readRawNBT keeps an anonymous NBT tag as its encoded bytes, since package
+// protocol can read NBT but not write it back.
+func readRawNBT(r io.Reader) ([]byte, error) {
+	var raw bytes.Buffer
+	if _, err := protocol.ReadAnonymousNBT(io.TeeReader(r, &raw)); err != nil {
+		return nil, err
+	}
+	return raw.Bytes(), nil
+}
+
+// writeRawNBT writes a TAG_End for empty data so a zero value still encodes.
+func writeRawNBT(w io.Writer, data []byte) error {
+	if len(data) == 0 {
+		return protocol.WriteByte(w, protocol.TagEnd)
+	}
+	return writeRaw(w, data)
+}
+
+func readOption[T any](read func(io.Reader) (T, error)) func(io.Reader) (*T, error) {
+	return func(r io.Reader) (*T, error) {
+		present, err := protocol.ReadBool(r)
+		if err != nil || !present {
+			return nil, err
+		}
+		v, err := read(r)
+		if err != nil {
+			return nil, err
+		}
+		return &v, nil
+	}
+}
+
+func writeOption[T any](write func(io.Writer, T) error) func(io.Writer, *T) error {
+	return func(w io.Writer, v *T) error {
+		if err := protocol.WriteBool(w, v != nil); err != nil || v == nil {
+			return err
+		}
+		return write(w, *v)
+	}
+}
+
+func readArray[T any](read func(io.Reader) (T, error)) func(io.Reader) ([]T, error) {
+	return func(r io.Reader) ([]T, error) {
+		n, err := protocol.ReadVarint(r)
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 || n > protocol.MaxPacketSize {
+			return nil, fmt.Errorf("%w: array length %d", protocol.ErrInvalidPacket, n)
+		}
+		return readFixedArray(int(n), read)(r)
+	}
+}
+
+func writeArray[T any](write func(io.Writer, T) error) func(io.Writer, []T) error {
+	return func(w io.Writer, v []T) error {
+		if err := protocol.WriteVarint(w, int32(len(v))); err != nil {
+			return err
+		}
+		return writeFixedArray(len(v), write)(w, v)
+	}
+}
+
+func readFixedArray[T any](n int, read func(io.Reader) (T, error)) func(io.Reader) ([]T, error) {
+	return func(r io.Reader) ([]T, error) {
+		// Grow as elements arrive so a bogus length cannot force a huge allocation.
+		var out []T
+		for i := 0; i < n; i++ {
+			v, err := read(r)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+		}
+		return out, nil
+	}
+}
+
+func writeFixedArray[T any](n int, write func(io.Writer, T) error) func(io.Writer, []T) error {
+	return func(w io.Writer, v []T) error {
+		if len(v) != n {
+			return fmt.Errorf("%w: array has %d elements, want %d", protocol.ErrInvalidPacket, len(v), n)
+		}
+		for _, e := range v {
+			if err := write(w, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func readFixedBytes(n int) func(io.Reader) ([]byte, error) {
+	return func(r io.Reader) ([]byte, error) {
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}
+}
+
+func writeFixedBytes(n int) func(io.Writer, []byte) error {
+	return func(w io.Writer, v []byte) error {
+		if len(v) != n {
+			return fmt.Errorf("%w: buffer has %d bytes, want %d", protocol.ErrInvalidPacket, len(v), n)
+		}
+		return writeRaw(w, v)
+	}
+}
@@ -0,0 +1,61 @@
+// Package packets holds typed codecs for every packet in protocol.json,
+// generated by protogen. The hand-written parsers in package protocol remain
+// the ones the bot uses; these cover the rest of the protocol and are what
+// the hand-written code is checked against.
+package packets
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+// Packet is implemented by every generated packet struct.
+type Packet interface {
+	PacketID() int32
+	Decode(r io.Reader) error
+	Encode(w io.Writer) error
+}
+
+type key struct {
+	state protocol.State
+	dir   protocol.Direction
+	id    int32
+}
+
+// New returns an empty packet for the given state, direction and ID.
+func New(state protocol.State, dir protocol.Direction, id int32) (Packet, bool) {
+	ctor, ok := constructors[key{state, dir, id}]
+	if !ok {
+		return nil, false
+	}
+	return ctor(), true
+}
+
+// Decode parses a raw packet into its generated struct. Trailing bytes are an
+// error, since they mean the schema and the payload disagree.
+func Decode(state protocol.State, dir protocol.Direction, packet *protocol.Packet) (Packet, error) {
+	p, ok := New(state, dir, packet.ID)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown %s %s packet 0x%02x", protocol.ErrInvalidPacket, state, dir, packet.ID)
+	}
+	r := bytes.NewReader(packet.Payload)
+	if err := p.Decode(r); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", protocol.PacketName(state, dir, packet.ID), err)
+	}
+	if r.Len() > 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes after %s", protocol.ErrInvalidPacket, r.Len(), protocol.PacketName(state, dir, packet.ID))
+	}
+	return p, nil
+}
+
+// Encode serializes p into a raw packet ready for protocol.WritePacket.
+func Encode(p Packet) (*protocol.Packet, error) {
+	buf := new(bytes.Buffer)
+	if err := p.Encode(buf); err != nil {
+		return nil, err
+	}
+	return &protocol.Packet{ID: p.PacketID(), Payload: buf.Bytes()}, nil
+}
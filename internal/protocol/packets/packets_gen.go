@@ -0,0 +1,9213 @@
+// Code generated by protogen from 1.21.11/protocol.json. DO NOT EDIT.
+
+package packets
+
+import (
+	"io"
+
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+var constructors = map[key]func() Packet{
+	{protocol.Handshaking, protocol.ToServer, protocol.HandshakingToServerSetProtocol}:             func() Packet { return new(HandshakingToServerSetProtocol) },
+	{protocol.Handshaking, protocol.ToServer, protocol.HandshakingToServerLegacyServerListPing}:    func() Packet { return new(HandshakingToServerLegacyServerListPing) },
+	{protocol.Status, protocol.ToClient, protocol.StatusToClientServerInfo}:                        func() Packet { return new(StatusToClientServerInfo) },
+	{protocol.Status, protocol.ToClient, protocol.StatusToClientPing}:                              func() Packet { return new(StatusToClientPing) },
+	{protocol.Status, protocol.ToServer, protocol.StatusToServerPingStart}:                         func() Packet { return new(StatusToServerPingStart) },
+	{protocol.Status, protocol.ToServer, protocol.StatusToServerPing}:                              func() Packet { return new(StatusToServerPing) },
+	{protocol.Login, protocol.ToClient, protocol.LoginToClientDisconnect}:                          func() Packet { return new(LoginToClientDisconnect) },
+	{protocol.Login, protocol.ToClient, protocol.LoginToClientEncryptionBegin}:                     func() Packet { return new(LoginToClientEncryptionBegin) },
+	{protocol.Login, protocol.ToClient, protocol.LoginToClientSuccess}:                             func() Packet { return new(LoginToClientSuccess) },
+	{protocol.Login, protocol.ToClient, protocol.LoginToClientCompress}:                            func() Packet { return new(LoginToClientCompress) },
+	{protocol.Login, protocol.ToClient, protocol.LoginToClientLoginPluginRequest}:                  func() Packet { return new(LoginToClientLoginPluginRequest) },
+	{protocol.Login, protocol.ToClient, protocol.LoginToClientCookieRequest}:                       func() Packet { return new(LoginToClientCookieRequest) },
+	{protocol.Login, protocol.ToServer, protocol.LoginToServerLoginStart}:                          func() Packet { return new(LoginToServerLoginStart) },
+	{protocol.Login, protocol.ToServer, protocol.LoginToServerEncryptionBegin}:                     func() Packet { return new(LoginToServerEncryptionBegin) },
+	{protocol.Login, protocol.ToServer, protocol.LoginToServerLoginPluginResponse}:                 func() Packet { return new(LoginToServerLoginPluginResponse) },
+	{protocol.Login, protocol.ToServer, protocol.LoginToServerLoginAcknowledged}:                   func() Packet { return new(LoginToServerLoginAcknowledged) },
+	{protocol.Login, protocol.ToServer, protocol.LoginToServerCookieResponse}:                      func() Packet { return new(LoginToServerCookieResponse) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientCookieRequest}:       func() Packet { return new(ConfigurationToClientCookieRequest) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientCustomPayload}:       func() Packet { return new(ConfigurationToClientCustomPayload) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientDisconnect}:          func() Packet { return new(ConfigurationToClientDisconnect) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientFinishConfiguration}: func() Packet { return new(ConfigurationToClientFinishConfiguration) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientKeepAlive}:           func() Packet { return new(ConfigurationToClientKeepAlive) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientPing}:                func() Packet { return new(ConfigurationToClientPing) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientResetChat}:           func() Packet { return new(ConfigurationToClientResetChat) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientRegistryData}:        func() Packet { return new(ConfigurationToClientRegistryData) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientRemoveResourcePack}:  func() Packet { return new(ConfigurationToClientRemoveResourcePack) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientAddResourcePack}:     func() Packet { return new(ConfigurationToClientAddResourcePack) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientStoreCookie}:         func() Packet { return new(ConfigurationToClientStoreCookie) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientTransfer}:            func() Packet { return new(ConfigurationToClientTransfer) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientFeatureFlags}:        func() Packet { return new(ConfigurationToClientFeatureFlags) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientTags}:                func() Packet { return new(ConfigurationToClientTags) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientSelectKnownPacks}:    func() Packet { return new(ConfigurationToClientSelectKnownPacks) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientCustomReportDetails}: func() Packet { return new(ConfigurationToClientCustomReportDetails) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientServerLinks}:         func() Packet { return new(ConfigurationToClientServerLinks) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientClearDialog}:         func() Packet { return new(ConfigurationToClientClearDialog) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientShowDialog}:          func() Packet { return new(ConfigurationToClientShowDialog) },
+	{protocol.Configuration, protocol.ToClient, protocol.ConfigurationToClientCodeOfConduct}:       func() Packet { return new(ConfigurationToClientCodeOfConduct) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerSettings}:            func() Packet { return new(ConfigurationToServerSettings) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerCookieResponse}:      func() Packet { return new(ConfigurationToServerCookieResponse) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerCustomPayload}:       func() Packet { return new(ConfigurationToServerCustomPayload) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerFinishConfiguration}: func() Packet { return new(ConfigurationToServerFinishConfiguration) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerKeepAlive}:           func() Packet { return new(ConfigurationToServerKeepAlive) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerPong}:                func() Packet { return new(ConfigurationToServerPong) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerResourcePackReceive}: func() Packet { return new(ConfigurationToServerResourcePackReceive) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerSelectKnownPacks}:    func() Packet { return new(ConfigurationToServerSelectKnownPacks) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerCustomClickAction}:   func() Packet { return new(ConfigurationToServerCustomClickAction) },
+	{protocol.Configuration, protocol.ToServer, protocol.ConfigurationToServerAcceptCodeOfConduct}: func() Packet { return new(ConfigurationToServerAcceptCodeOfConduct) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientBundleDelimiter}:                       func() Packet { return new(PlayToClientBundleDelimiter) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSpawnEntity}:                           func() Packet { return new(PlayToClientSpawnEntity) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientAnimation}:                             func() Packet { return new(PlayToClientAnimation) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientStatistics}:                            func() Packet { return new(PlayToClientStatistics) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientAcknowledgePlayerDigging}:              func() Packet { return new(PlayToClientAcknowledgePlayerDigging) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientBlockBreakAnimation}:                   func() Packet { return new(PlayToClientBlockBreakAnimation) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientTileEntityData}:                        func() Packet { return new(PlayToClientTileEntityData) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientBlockAction}:                           func() Packet { return new(PlayToClientBlockAction) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientBlockChange}:                           func() Packet { return new(PlayToClientBlockChange) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientBossBar}:                               func() Packet { return new(PlayToClientBossBar) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDifficulty}:                            func() Packet { return new(PlayToClientDifficulty) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientChunkBatchFinished}:                    func() Packet { return new(PlayToClientChunkBatchFinished) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientChunkBatchStart}:                       func() Packet { return new(PlayToClientChunkBatchStart) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientChunkBiomes}:                           func() Packet { return new(PlayToClientChunkBiomes) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientClearTitles}:                           func() Packet { return new(PlayToClientClearTitles) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientTabComplete}:                           func() Packet { return new(PlayToClientTabComplete) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDeclareCommands}:                       func() Packet { return new(PlayToClientDeclareCommands) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientCloseWindow}:                           func() Packet { return new(PlayToClientCloseWindow) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientWindowItems}:                           func() Packet { return new(PlayToClientWindowItems) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientCraftProgressBar}:                      func() Packet { return new(PlayToClientCraftProgressBar) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetSlot}:                               func() Packet { return new(PlayToClientSetSlot) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientCookieRequest}:                         func() Packet { return new(PlayToClientCookieRequest) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetCooldown}:                           func() Packet { return new(PlayToClientSetCooldown) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientChatSuggestions}:                       func() Packet { return new(PlayToClientChatSuggestions) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientCustomPayload}:                         func() Packet { return new(PlayToClientCustomPayload) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDamageEvent}:                           func() Packet { return new(PlayToClientDamageEvent) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDebugBlockValue}:                       func() Packet { return new(PlayToClientDebugBlockValue) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDebugChunkValue}:                       func() Packet { return new(PlayToClientDebugChunkValue) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDebugEntityValue}:                      func() Packet { return new(PlayToClientDebugEntityValue) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDebugEvent}:                            func() Packet { return new(PlayToClientDebugEvent) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDebugSample}:                           func() Packet { return new(PlayToClientDebugSample) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientHideMessage}:                           func() Packet { return new(PlayToClientHideMessage) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientKickDisconnect}:                        func() Packet { return new(PlayToClientKickDisconnect) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientProfilelessChat}:                       func() Packet { return new(PlayToClientProfilelessChat) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityStatus}:                          func() Packet { return new(PlayToClientEntityStatus) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSyncEntityPosition}:                    func() Packet { return new(PlayToClientSyncEntityPosition) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientExplosion}:                             func() Packet { return new(PlayToClientExplosion) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientUnloadChunk}:                           func() Packet { return new(PlayToClientUnloadChunk) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientGameStateChange}:                       func() Packet { return new(PlayToClientGameStateChange) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientGameTestHighlightPos}:                  func() Packet { return new(PlayToClientGameTestHighlightPos) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientOpenHorseWindow}:                       func() Packet { return new(PlayToClientOpenHorseWindow) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientHurtAnimation}:                         func() Packet { return new(PlayToClientHurtAnimation) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientInitializeWorldBorder}:                 func() Packet { return new(PlayToClientInitializeWorldBorder) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientKeepAlive}:                             func() Packet { return new(PlayToClientKeepAlive) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientMapChunk}:                              func() Packet { return new(PlayToClientMapChunk) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientWorldEvent}:                            func() Packet { return new(PlayToClientWorldEvent) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientWorldParticles}:                        func() Packet { return new(PlayToClientWorldParticles) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientUpdateLight}:                           func() Packet { return new(PlayToClientUpdateLight) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientLogin}:                                 func() Packet { return new(PlayToClientLogin) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientMap}:                                   func() Packet { return new(PlayToClientMap) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientTradeList}:                             func() Packet { return new(PlayToClientTradeList) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientRelEntityMove}:                         func() Packet { return new(PlayToClientRelEntityMove) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityMoveLook}:                        func() Packet { return new(PlayToClientEntityMoveLook) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientMoveMinecart}:                          func() Packet { return new(PlayToClientMoveMinecart) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityLook}:                            func() Packet { return new(PlayToClientEntityLook) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientVehicleMove}:                           func() Packet { return new(PlayToClientVehicleMove) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientOpenBook}:                              func() Packet { return new(PlayToClientOpenBook) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientOpenWindow}:                            func() Packet { return new(PlayToClientOpenWindow) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientOpenSignEntity}:                        func() Packet { return new(PlayToClientOpenSignEntity) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientPing}:                                  func() Packet { return new(PlayToClientPing) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientPingResponse}:                          func() Packet { return new(PlayToClientPingResponse) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientCraftRecipeResponse}:                   func() Packet { return new(PlayToClientCraftRecipeResponse) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientAbilities}:                             func() Packet { return new(PlayToClientAbilities) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientPlayerChat}:                            func() Packet { return new(PlayToClientPlayerChat) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEndCombatEvent}:                        func() Packet { return new(PlayToClientEndCombatEvent) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEnterCombatEvent}:                      func() Packet { return new(PlayToClientEnterCombatEvent) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDeathCombatEvent}:                      func() Packet { return new(PlayToClientDeathCombatEvent) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientPlayerRemove}:                          func() Packet { return new(PlayToClientPlayerRemove) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientPlayerInfo}:                            func() Packet { return new(PlayToClientPlayerInfo) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientFacePlayer}:                            func() Packet { return new(PlayToClientFacePlayer) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientPosition}:                              func() Packet { return new(PlayToClientPosition) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientPlayerRotation}:                        func() Packet { return new(PlayToClientPlayerRotation) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientRecipeBookAdd}:                         func() Packet { return new(PlayToClientRecipeBookAdd) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientRecipeBookRemove}:                      func() Packet { return new(PlayToClientRecipeBookRemove) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientRecipeBookSettings}:                    func() Packet { return new(PlayToClientRecipeBookSettings) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityDestroy}:                         func() Packet { return new(PlayToClientEntityDestroy) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientRemoveEntityEffect}:                    func() Packet { return new(PlayToClientRemoveEntityEffect) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientResetScore}:                            func() Packet { return new(PlayToClientResetScore) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientRemoveResourcePack}:                    func() Packet { return new(PlayToClientRemoveResourcePack) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientAddResourcePack}:                       func() Packet { return new(PlayToClientAddResourcePack) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientRespawn}:                               func() Packet { return new(PlayToClientRespawn) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityHeadRotation}:                    func() Packet { return new(PlayToClientEntityHeadRotation) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientMultiBlockChange}:                      func() Packet { return new(PlayToClientMultiBlockChange) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSelectAdvancementTab}:                  func() Packet { return new(PlayToClientSelectAdvancementTab) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientServerData}:                            func() Packet { return new(PlayToClientServerData) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientActionBar}:                             func() Packet { return new(PlayToClientActionBar) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientWorldBorderCenter}:                     func() Packet { return new(PlayToClientWorldBorderCenter) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientWorldBorderLerpSize}:                   func() Packet { return new(PlayToClientWorldBorderLerpSize) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientWorldBorderSize}:                       func() Packet { return new(PlayToClientWorldBorderSize) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientWorldBorderWarningDelay}:               func() Packet { return new(PlayToClientWorldBorderWarningDelay) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientWorldBorderWarningReach}:               func() Packet { return new(PlayToClientWorldBorderWarningReach) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientCamera}:                                func() Packet { return new(PlayToClientCamera) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientUpdateViewPosition}:                    func() Packet { return new(PlayToClientUpdateViewPosition) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientUpdateViewDistance}:                    func() Packet { return new(PlayToClientUpdateViewDistance) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetCursorItem}:                         func() Packet { return new(PlayToClientSetCursorItem) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSpawnPosition}:                         func() Packet { return new(PlayToClientSpawnPosition) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientScoreboardDisplayObjective}:            func() Packet { return new(PlayToClientScoreboardDisplayObjective) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityMetadata}:                        func() Packet { return new(PlayToClientEntityMetadata) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientAttachEntity}:                          func() Packet { return new(PlayToClientAttachEntity) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityVelocity}:                        func() Packet { return new(PlayToClientEntityVelocity) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityEquipment}:                       func() Packet { return new(PlayToClientEntityEquipment) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientExperience}:                            func() Packet { return new(PlayToClientExperience) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientUpdateHealth}:                          func() Packet { return new(PlayToClientUpdateHealth) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientHeldItemSlot}:                          func() Packet { return new(PlayToClientHeldItemSlot) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientScoreboardObjective}:                   func() Packet { return new(PlayToClientScoreboardObjective) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetPassengers}:                         func() Packet { return new(PlayToClientSetPassengers) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetPlayerInventory}:                    func() Packet { return new(PlayToClientSetPlayerInventory) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientTeams}:                                 func() Packet { return new(PlayToClientTeams) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientScoreboardScore}:                       func() Packet { return new(PlayToClientScoreboardScore) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSimulationDistance}:                    func() Packet { return new(PlayToClientSimulationDistance) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetTitleSubtitle}:                      func() Packet { return new(PlayToClientSetTitleSubtitle) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientUpdateTime}:                            func() Packet { return new(PlayToClientUpdateTime) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetTitleText}:                          func() Packet { return new(PlayToClientSetTitleText) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetTitleTime}:                          func() Packet { return new(PlayToClientSetTitleTime) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntitySoundEffect}:                     func() Packet { return new(PlayToClientEntitySoundEffect) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSoundEffect}:                           func() Packet { return new(PlayToClientSoundEffect) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientStartConfiguration}:                    func() Packet { return new(PlayToClientStartConfiguration) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientStopSound}:                             func() Packet { return new(PlayToClientStopSound) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientStoreCookie}:                           func() Packet { return new(PlayToClientStoreCookie) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSystemChat}:                            func() Packet { return new(PlayToClientSystemChat) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientPlayerlistHeader}:                      func() Packet { return new(PlayToClientPlayerlistHeader) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientNBTQueryResponse}:                      func() Packet { return new(PlayToClientNBTQueryResponse) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientCollect}:                               func() Packet { return new(PlayToClientCollect) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityTeleport}:                        func() Packet { return new(PlayToClientEntityTeleport) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientTestInstanceBlockStatus}:               func() Packet { return new(PlayToClientTestInstanceBlockStatus) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetTickingState}:                       func() Packet { return new(PlayToClientSetTickingState) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientStepTick}:                              func() Packet { return new(PlayToClientStepTick) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientTransfer}:                              func() Packet { return new(PlayToClientTransfer) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientAdvancements}:                          func() Packet { return new(PlayToClientAdvancements) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityUpdateAttributes}:                func() Packet { return new(PlayToClientEntityUpdateAttributes) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientEntityEffect}:                          func() Packet { return new(PlayToClientEntityEffect) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientDeclareRecipes}:                        func() Packet { return new(PlayToClientDeclareRecipes) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientTags}:                                  func() Packet { return new(PlayToClientTags) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientSetProjectilePower}:                    func() Packet { return new(PlayToClientSetProjectilePower) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientCustomReportDetails}:                   func() Packet { return new(PlayToClientCustomReportDetails) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientServerLinks}:                           func() Packet { return new(PlayToClientServerLinks) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientTrackedWaypoint}:                       func() Packet { return new(PlayToClientTrackedWaypoint) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientClearDialog}:                           func() Packet { return new(PlayToClientClearDialog) },
+	{protocol.Play, protocol.ToClient, protocol.PlayToClientShowDialog}:                            func() Packet { return new(PlayToClientShowDialog) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerTeleportConfirm}:                       func() Packet { return new(PlayToServerTeleportConfirm) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerQueryBlockNBT}:                         func() Packet { return new(PlayToServerQueryBlockNBT) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSelectBundleItem}:                      func() Packet { return new(PlayToServerSelectBundleItem) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSetDifficulty}:                         func() Packet { return new(PlayToServerSetDifficulty) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerChangeGamemode}:                        func() Packet { return new(PlayToServerChangeGamemode) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerMessageAcknowledgement}:                func() Packet { return new(PlayToServerMessageAcknowledgement) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerChatCommand}:                           func() Packet { return new(PlayToServerChatCommand) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerChatCommandSigned}:                     func() Packet { return new(PlayToServerChatCommandSigned) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerChatMessage}:                           func() Packet { return new(PlayToServerChatMessage) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerChatSessionUpdate}:                     func() Packet { return new(PlayToServerChatSessionUpdate) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerChunkBatchReceived}:                    func() Packet { return new(PlayToServerChunkBatchReceived) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerClientCommand}:                         func() Packet { return new(PlayToServerClientCommand) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerTickEnd}:                               func() Packet { return new(PlayToServerTickEnd) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSettings}:                              func() Packet { return new(PlayToServerSettings) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerTabComplete}:                           func() Packet { return new(PlayToServerTabComplete) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerConfigurationAcknowledged}:             func() Packet { return new(PlayToServerConfigurationAcknowledged) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerEnchantItem}:                           func() Packet { return new(PlayToServerEnchantItem) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerWindowClick}:                           func() Packet { return new(PlayToServerWindowClick) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerCloseWindow}:                           func() Packet { return new(PlayToServerCloseWindow) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSetSlotState}:                          func() Packet { return new(PlayToServerSetSlotState) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerCookieResponse}:                        func() Packet { return new(PlayToServerCookieResponse) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerCustomPayload}:                         func() Packet { return new(PlayToServerCustomPayload) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerDebugSubscriptionRequest}:              func() Packet { return new(PlayToServerDebugSubscriptionRequest) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerEditBook}:                              func() Packet { return new(PlayToServerEditBook) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerQueryEntityNBT}:                        func() Packet { return new(PlayToServerQueryEntityNBT) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerUseEntity}:                             func() Packet { return new(PlayToServerUseEntity) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerGenerateStructure}:                     func() Packet { return new(PlayToServerGenerateStructure) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerKeepAlive}:                             func() Packet { return new(PlayToServerKeepAlive) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerLockDifficulty}:                        func() Packet { return new(PlayToServerLockDifficulty) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerPosition}:                              func() Packet { return new(PlayToServerPosition) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerPositionLook}:                          func() Packet { return new(PlayToServerPositionLook) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerLook}:                                  func() Packet { return new(PlayToServerLook) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerFlying}:                                func() Packet { return new(PlayToServerFlying) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerVehicleMove}:                           func() Packet { return new(PlayToServerVehicleMove) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSteerBoat}:                             func() Packet { return new(PlayToServerSteerBoat) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerPickItemFromBlock}:                     func() Packet { return new(PlayToServerPickItemFromBlock) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerPickItemFromEntity}:                    func() Packet { return new(PlayToServerPickItemFromEntity) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerPingRequest}:                           func() Packet { return new(PlayToServerPingRequest) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerCraftRecipeRequest}:                    func() Packet { return new(PlayToServerCraftRecipeRequest) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerAbilities}:                             func() Packet { return new(PlayToServerAbilities) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerBlockDig}:                              func() Packet { return new(PlayToServerBlockDig) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerEntityAction}:                          func() Packet { return new(PlayToServerEntityAction) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerPlayerInput}:                           func() Packet { return new(PlayToServerPlayerInput) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerPlayerLoaded}:                          func() Packet { return new(PlayToServerPlayerLoaded) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerPong}:                                  func() Packet { return new(PlayToServerPong) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerRecipeBook}:                            func() Packet { return new(PlayToServerRecipeBook) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerDisplayedRecipe}:                       func() Packet { return new(PlayToServerDisplayedRecipe) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerNameItem}:                              func() Packet { return new(PlayToServerNameItem) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerResourcePackReceive}:                   func() Packet { return new(PlayToServerResourcePackReceive) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerAdvancementTab}:                        func() Packet { return new(PlayToServerAdvancementTab) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSelectTrade}:                           func() Packet { return new(PlayToServerSelectTrade) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSetBeaconEffect}:                       func() Packet { return new(PlayToServerSetBeaconEffect) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerHeldItemSlot}:                          func() Packet { return new(PlayToServerHeldItemSlot) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerUpdateCommandBlock}:                    func() Packet { return new(PlayToServerUpdateCommandBlock) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerUpdateCommandBlockMinecart}:            func() Packet { return new(PlayToServerUpdateCommandBlockMinecart) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSetCreativeSlot}:                       func() Packet { return new(PlayToServerSetCreativeSlot) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerUpdateJigsawBlock}:                     func() Packet { return new(PlayToServerUpdateJigsawBlock) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerUpdateStructureBlock}:                  func() Packet { return new(PlayToServerUpdateStructureBlock) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSetTestBlock}:                          func() Packet { return new(PlayToServerSetTestBlock) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerUpdateSign}:                            func() Packet { return new(PlayToServerUpdateSign) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerArmAnimation}:                          func() Packet { return new(PlayToServerArmAnimation) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerSpectate}:                              func() Packet { return new(PlayToServerSpectate) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerTestInstanceBlockAction}:               func() Packet { return new(PlayToServerTestInstanceBlockAction) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerBlockPlace}:                            func() Packet { return new(PlayToServerBlockPlace) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerUseItem}:                               func() Packet { return new(PlayToServerUseItem) },
+	{protocol.Play, protocol.ToServer, protocol.PlayToServerCustomClickAction}:                     func() Packet { return new(PlayToServerCustomClickAction) },
+}
+
+// HandshakingToServerSetProtocol is handshaking toServer packet set_protocol (0x00).
+type HandshakingToServerSetProtocol struct {
+	ProtocolVersion int32
+	ServerHost      string
+	ServerPort      uint16
+	NextState       int32
+}
+
+func (*HandshakingToServerSetProtocol) PacketID() int32 {
+	return protocol.HandshakingToServerSetProtocol
+}
+
+func (p *HandshakingToServerSetProtocol) Decode(r io.Reader) (err error) {
+	if p.ProtocolVersion, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ServerHost, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.ServerPort, err = protocol.ReadUnsignedShort(r); err != nil {
+		return err
+	}
+	if p.NextState, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *HandshakingToServerSetProtocol) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.ProtocolVersion); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.ServerHost); err != nil {
+		return err
+	}
+	if err := protocol.WriteUnsignedShort(w, p.ServerPort); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.NextState); err != nil {
+		return err
+	}
+	return nil
+}
+
+// HandshakingToServerLegacyServerListPing is handshaking toServer packet legacy_server_list_ping (0xfe).
+type HandshakingToServerLegacyServerListPing struct {
+	Payload uint8
+}
+
+func (*HandshakingToServerLegacyServerListPing) PacketID() int32 {
+	return protocol.HandshakingToServerLegacyServerListPing
+}
+
+func (p *HandshakingToServerLegacyServerListPing) Decode(r io.Reader) (err error) {
+	if p.Payload, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *HandshakingToServerLegacyServerListPing) Encode(w io.Writer) error {
+	if err := protocol.WriteByte(w, p.Payload); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StatusToClientServerInfo is status toClient packet server_info (0x00).
+type StatusToClientServerInfo struct {
+	Response string
+}
+
+func (*StatusToClientServerInfo) PacketID() int32 { return protocol.StatusToClientServerInfo }
+
+func (p *StatusToClientServerInfo) Decode(r io.Reader) (err error) {
+	if p.Response, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *StatusToClientServerInfo) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Response); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StatusToClientPing is status toClient packet ping (0x01).
+type StatusToClientPing struct {
+	Time int64
+}
+
+func (*StatusToClientPing) PacketID() int32 { return protocol.StatusToClientPing }
+
+func (p *StatusToClientPing) Decode(r io.Reader) (err error) {
+	if p.Time, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *StatusToClientPing) Encode(w io.Writer) error {
+	if err := protocol.WriteInt64(w, p.Time); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StatusToServerPingStart is status toServer packet ping_start (0x00).
+type StatusToServerPingStart struct {
+}
+
+func (*StatusToServerPingStart) PacketID() int32 { return protocol.StatusToServerPingStart }
+
+func (_ *StatusToServerPingStart) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *StatusToServerPingStart) Encode(w io.Writer) error {
+	return nil
+}
+
+// StatusToServerPing is status toServer packet ping (0x01).
+type StatusToServerPing struct {
+	Time int64
+}
+
+func (*StatusToServerPing) PacketID() int32 { return protocol.StatusToServerPing }
+
+func (p *StatusToServerPing) Decode(r io.Reader) (err error) {
+	if p.Time, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *StatusToServerPing) Encode(w io.Writer) error {
+	if err := protocol.WriteInt64(w, p.Time); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToClientDisconnect is login toClient packet disconnect (0x00).
+type LoginToClientDisconnect struct {
+	Reason string
+}
+
+func (*LoginToClientDisconnect) PacketID() int32 { return protocol.LoginToClientDisconnect }
+
+func (p *LoginToClientDisconnect) Decode(r io.Reader) (err error) {
+	if p.Reason, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToClientDisconnect) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Reason); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToClientEncryptionBegin is login toClient packet encryption_begin (0x01).
+type LoginToClientEncryptionBegin struct {
+	ServerID           string
+	PublicKey          []byte
+	VerifyToken        []byte
+	ShouldAuthenticate bool
+}
+
+func (*LoginToClientEncryptionBegin) PacketID() int32 { return protocol.LoginToClientEncryptionBegin }
+
+func (p *LoginToClientEncryptionBegin) Decode(r io.Reader) (err error) {
+	if p.ServerID, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.PublicKey, err = protocol.ReadByteArray(r); err != nil {
+		return err
+	}
+	if p.VerifyToken, err = protocol.ReadByteArray(r); err != nil {
+		return err
+	}
+	if p.ShouldAuthenticate, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToClientEncryptionBegin) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.ServerID); err != nil {
+		return err
+	}
+	if err := protocol.WriteByteArray(w, p.PublicKey); err != nil {
+		return err
+	}
+	if err := protocol.WriteByteArray(w, p.VerifyToken); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.ShouldAuthenticate); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToClientSuccess is login toClient packet success (0x02).
+type LoginToClientSuccess struct {
+	UUID       protocol.UUID
+	Username   string
+	Properties []LoginToClientSuccessProperties
+}
+
+func (*LoginToClientSuccess) PacketID() int32 { return protocol.LoginToClientSuccess }
+
+func (p *LoginToClientSuccess) Decode(r io.Reader) (err error) {
+	if p.UUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	if p.Username, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Properties, err = readArray(readLoginToClientSuccessProperties)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToClientSuccess) Encode(w io.Writer) error {
+	if err := protocol.WriteUUID(w, p.UUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Username); err != nil {
+		return err
+	}
+	if err := writeArray(writeLoginToClientSuccessProperties)(w, p.Properties); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToClientCompress is login toClient packet compress (0x03).
+type LoginToClientCompress struct {
+	Threshold int32
+}
+
+func (*LoginToClientCompress) PacketID() int32 { return protocol.LoginToClientCompress }
+
+func (p *LoginToClientCompress) Decode(r io.Reader) (err error) {
+	if p.Threshold, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToClientCompress) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Threshold); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToClientLoginPluginRequest is login toClient packet login_plugin_request (0x04).
+type LoginToClientLoginPluginRequest struct {
+	MessageID int32
+	Channel   string
+	Data      []byte
+}
+
+func (*LoginToClientLoginPluginRequest) PacketID() int32 {
+	return protocol.LoginToClientLoginPluginRequest
+}
+
+func (p *LoginToClientLoginPluginRequest) Decode(r io.Reader) (err error) {
+	if p.MessageID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Channel, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Data, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToClientLoginPluginRequest) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.MessageID); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Channel); err != nil {
+		return err
+	}
+	if err := writeRaw(w, p.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToClientCookieRequest is login toClient packet cookie_request (0x05).
+type LoginToClientCookieRequest struct {
+	Cookie string
+}
+
+func (*LoginToClientCookieRequest) PacketID() int32 { return protocol.LoginToClientCookieRequest }
+
+func (p *LoginToClientCookieRequest) Decode(r io.Reader) (err error) {
+	if p.Cookie, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToClientCookieRequest) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Cookie); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToServerLoginStart is login toServer packet login_start (0x00).
+type LoginToServerLoginStart struct {
+	Username   string
+	PlayerUUID protocol.UUID
+}
+
+func (*LoginToServerLoginStart) PacketID() int32 { return protocol.LoginToServerLoginStart }
+
+func (p *LoginToServerLoginStart) Decode(r io.Reader) (err error) {
+	if p.Username, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.PlayerUUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToServerLoginStart) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Username); err != nil {
+		return err
+	}
+	if err := protocol.WriteUUID(w, p.PlayerUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToServerEncryptionBegin is login toServer packet encryption_begin (0x01).
+type LoginToServerEncryptionBegin struct {
+	SharedSecret []byte
+	VerifyToken  []byte
+}
+
+func (*LoginToServerEncryptionBegin) PacketID() int32 { return protocol.LoginToServerEncryptionBegin }
+
+func (p *LoginToServerEncryptionBegin) Decode(r io.Reader) (err error) {
+	if p.SharedSecret, err = protocol.ReadByteArray(r); err != nil {
+		return err
+	}
+	if p.VerifyToken, err = protocol.ReadByteArray(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToServerEncryptionBegin) Encode(w io.Writer) error {
+	if err := protocol.WriteByteArray(w, p.SharedSecret); err != nil {
+		return err
+	}
+	if err := protocol.WriteByteArray(w, p.VerifyToken); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToServerLoginPluginResponse is login toServer packet login_plugin_response (0x02).
+type LoginToServerLoginPluginResponse struct {
+	MessageID int32
+	Data      *[]byte
+}
+
+func (*LoginToServerLoginPluginResponse) PacketID() int32 {
+	return protocol.LoginToServerLoginPluginResponse
+}
+
+func (p *LoginToServerLoginPluginResponse) Decode(r io.Reader) (err error) {
+	if p.MessageID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Data, err = readOption(readRest)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToServerLoginPluginResponse) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.MessageID); err != nil {
+		return err
+	}
+	if err := writeOption(writeRaw)(w, p.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoginToServerLoginAcknowledged is login toServer packet login_acknowledged (0x03).
+type LoginToServerLoginAcknowledged struct {
+}
+
+func (*LoginToServerLoginAcknowledged) PacketID() int32 {
+	return protocol.LoginToServerLoginAcknowledged
+}
+
+func (_ *LoginToServerLoginAcknowledged) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *LoginToServerLoginAcknowledged) Encode(w io.Writer) error {
+	return nil
+}
+
+// LoginToServerCookieResponse is login toServer packet cookie_response (0x04).
+type LoginToServerCookieResponse struct {
+	Key   string
+	Value *[]byte
+}
+
+func (*LoginToServerCookieResponse) PacketID() int32 { return protocol.LoginToServerCookieResponse }
+
+func (p *LoginToServerCookieResponse) Decode(r io.Reader) (err error) {
+	if p.Key, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Value, err = readOption(protocol.ReadByteArray)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *LoginToServerCookieResponse) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Key); err != nil {
+		return err
+	}
+	if err := writeOption(protocol.WriteByteArray)(w, p.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientCookieRequest is configuration toClient packet cookie_request (0x00).
+type ConfigurationToClientCookieRequest struct {
+	Cookie string
+}
+
+func (*ConfigurationToClientCookieRequest) PacketID() int32 {
+	return protocol.ConfigurationToClientCookieRequest
+}
+
+func (p *ConfigurationToClientCookieRequest) Decode(r io.Reader) (err error) {
+	if p.Cookie, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientCookieRequest) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Cookie); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientCustomPayload is configuration toClient packet custom_payload (0x01).
+type ConfigurationToClientCustomPayload struct {
+	Channel string
+	Data    []byte
+}
+
+func (*ConfigurationToClientCustomPayload) PacketID() int32 {
+	return protocol.ConfigurationToClientCustomPayload
+}
+
+func (p *ConfigurationToClientCustomPayload) Decode(r io.Reader) (err error) {
+	if p.Channel, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Data, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientCustomPayload) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Channel); err != nil {
+		return err
+	}
+	if err := writeRaw(w, p.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientDisconnect is configuration toClient packet disconnect (0x02).
+type ConfigurationToClientDisconnect struct {
+	Reason []byte
+}
+
+func (*ConfigurationToClientDisconnect) PacketID() int32 {
+	return protocol.ConfigurationToClientDisconnect
+}
+
+func (p *ConfigurationToClientDisconnect) Decode(r io.Reader) (err error) {
+	if p.Reason, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientDisconnect) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Reason); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientFinishConfiguration is configuration toClient packet finish_configuration (0x03).
+type ConfigurationToClientFinishConfiguration struct {
+}
+
+func (*ConfigurationToClientFinishConfiguration) PacketID() int32 {
+	return protocol.ConfigurationToClientFinishConfiguration
+}
+
+func (_ *ConfigurationToClientFinishConfiguration) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *ConfigurationToClientFinishConfiguration) Encode(w io.Writer) error {
+	return nil
+}
+
+// ConfigurationToClientKeepAlive is configuration toClient packet keep_alive (0x04).
+type ConfigurationToClientKeepAlive struct {
+	KeepAliveID int64
+}
+
+func (*ConfigurationToClientKeepAlive) PacketID() int32 {
+	return protocol.ConfigurationToClientKeepAlive
+}
+
+func (p *ConfigurationToClientKeepAlive) Decode(r io.Reader) (err error) {
+	if p.KeepAliveID, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientKeepAlive) Encode(w io.Writer) error {
+	if err := protocol.WriteInt64(w, p.KeepAliveID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientPing is configuration toClient packet ping (0x05).
+type ConfigurationToClientPing struct {
+	ID int32
+}
+
+func (*ConfigurationToClientPing) PacketID() int32 { return protocol.ConfigurationToClientPing }
+
+func (p *ConfigurationToClientPing) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientPing) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientResetChat is configuration toClient packet reset_chat (0x06).
+type ConfigurationToClientResetChat struct {
+}
+
+func (*ConfigurationToClientResetChat) PacketID() int32 {
+	return protocol.ConfigurationToClientResetChat
+}
+
+func (_ *ConfigurationToClientResetChat) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *ConfigurationToClientResetChat) Encode(w io.Writer) error {
+	return nil
+}
+
+// ConfigurationToClientRegistryData is configuration toClient packet registry_data (0x07).
+type ConfigurationToClientRegistryData struct {
+	ID      string
+	Entries []ConfigurationToClientRegistryDataEntries
+}
+
+func (*ConfigurationToClientRegistryData) PacketID() int32 {
+	return protocol.ConfigurationToClientRegistryData
+}
+
+func (p *ConfigurationToClientRegistryData) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Entries, err = readArray(readConfigurationToClientRegistryDataEntries)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientRegistryData) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.ID); err != nil {
+		return err
+	}
+	if err := writeArray(writeConfigurationToClientRegistryDataEntries)(w, p.Entries); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientRemoveResourcePack is configuration toClient packet remove_resource_pack (0x08).
+type ConfigurationToClientRemoveResourcePack struct {
+	UUID *protocol.UUID
+}
+
+func (*ConfigurationToClientRemoveResourcePack) PacketID() int32 {
+	return protocol.ConfigurationToClientRemoveResourcePack
+}
+
+func (p *ConfigurationToClientRemoveResourcePack) Decode(r io.Reader) (err error) {
+	if p.UUID, err = readOption(protocol.ReadUUID)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientRemoveResourcePack) Encode(w io.Writer) error {
+	if err := writeOption(protocol.WriteUUID)(w, p.UUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientAddResourcePack is configuration toClient packet add_resource_pack (0x09).
+type ConfigurationToClientAddResourcePack struct {
+	UUID          protocol.UUID
+	URL           string
+	Hash          string
+	Forced        bool
+	PromptMessage *[]byte
+}
+
+func (*ConfigurationToClientAddResourcePack) PacketID() int32 {
+	return protocol.ConfigurationToClientAddResourcePack
+}
+
+func (p *ConfigurationToClientAddResourcePack) Decode(r io.Reader) (err error) {
+	if p.UUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	if p.URL, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Hash, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Forced, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.PromptMessage, err = readOption(readRawNBT)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientAddResourcePack) Encode(w io.Writer) error {
+	if err := protocol.WriteUUID(w, p.UUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.URL); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Hash); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.Forced); err != nil {
+		return err
+	}
+	if err := writeOption(writeRawNBT)(w, p.PromptMessage); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientStoreCookie is configuration toClient packet store_cookie (0x0a).
+type ConfigurationToClientStoreCookie struct {
+	Key   string
+	Value []byte
+}
+
+func (*ConfigurationToClientStoreCookie) PacketID() int32 {
+	return protocol.ConfigurationToClientStoreCookie
+}
+
+func (p *ConfigurationToClientStoreCookie) Decode(r io.Reader) (err error) {
+	if p.Key, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Value, err = protocol.ReadByteArray(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientStoreCookie) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Key); err != nil {
+		return err
+	}
+	if err := protocol.WriteByteArray(w, p.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientTransfer is configuration toClient packet transfer (0x0b).
+type ConfigurationToClientTransfer struct {
+	Host string
+	Port int32
+}
+
+func (*ConfigurationToClientTransfer) PacketID() int32 { return protocol.ConfigurationToClientTransfer }
+
+func (p *ConfigurationToClientTransfer) Decode(r io.Reader) (err error) {
+	if p.Host, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Port, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientTransfer) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Host); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Port); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientFeatureFlags is configuration toClient packet feature_flags (0x0c).
+type ConfigurationToClientFeatureFlags struct {
+	Features []string
+}
+
+func (*ConfigurationToClientFeatureFlags) PacketID() int32 {
+	return protocol.ConfigurationToClientFeatureFlags
+}
+
+func (p *ConfigurationToClientFeatureFlags) Decode(r io.Reader) (err error) {
+	if p.Features, err = readArray(protocol.ReadString)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientFeatureFlags) Encode(w io.Writer) error {
+	if err := writeArray(protocol.WriteString)(w, p.Features); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientTags is configuration toClient packet tags (0x0d).
+type ConfigurationToClientTags struct {
+	Tags []ConfigurationToClientTagsTags
+}
+
+func (*ConfigurationToClientTags) PacketID() int32 { return protocol.ConfigurationToClientTags }
+
+func (p *ConfigurationToClientTags) Decode(r io.Reader) (err error) {
+	if p.Tags, err = readArray(readConfigurationToClientTagsTags)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientTags) Encode(w io.Writer) error {
+	if err := writeArray(writeConfigurationToClientTagsTags)(w, p.Tags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientSelectKnownPacks is configuration toClient packet select_known_packs (0x0e).
+type ConfigurationToClientSelectKnownPacks struct {
+	Packs []ConfigurationToClientSelectKnownPacksPacks
+}
+
+func (*ConfigurationToClientSelectKnownPacks) PacketID() int32 {
+	return protocol.ConfigurationToClientSelectKnownPacks
+}
+
+func (p *ConfigurationToClientSelectKnownPacks) Decode(r io.Reader) (err error) {
+	if p.Packs, err = readArray(readConfigurationToClientSelectKnownPacksPacks)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientSelectKnownPacks) Encode(w io.Writer) error {
+	if err := writeArray(writeConfigurationToClientSelectKnownPacksPacks)(w, p.Packs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientCustomReportDetails is configuration toClient packet custom_report_details (0x0f).
+type ConfigurationToClientCustomReportDetails struct {
+	Details []ConfigurationToClientCustomReportDetailsDetails
+}
+
+func (*ConfigurationToClientCustomReportDetails) PacketID() int32 {
+	return protocol.ConfigurationToClientCustomReportDetails
+}
+
+func (p *ConfigurationToClientCustomReportDetails) Decode(r io.Reader) (err error) {
+	if p.Details, err = readArray(readConfigurationToClientCustomReportDetailsDetails)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientCustomReportDetails) Encode(w io.Writer) error {
+	if err := writeArray(writeConfigurationToClientCustomReportDetailsDetails)(w, p.Details); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientServerLinks is configuration toClient packet server_links (0x10).
+type ConfigurationToClientServerLinks struct {
+	// Unparsed holds the raw bytes from links on.
+	Unparsed []byte
+}
+
+func (*ConfigurationToClientServerLinks) PacketID() int32 {
+	return protocol.ConfigurationToClientServerLinks
+}
+
+func (p *ConfigurationToClientServerLinks) Decode(r io.Reader) (err error) {
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientServerLinks) Encode(w io.Writer) error {
+	return writeRaw(w, p.Unparsed)
+}
+
+// ConfigurationToClientClearDialog is configuration toClient packet clear_dialog (0x11).
+type ConfigurationToClientClearDialog struct {
+}
+
+func (*ConfigurationToClientClearDialog) PacketID() int32 {
+	return protocol.ConfigurationToClientClearDialog
+}
+
+func (_ *ConfigurationToClientClearDialog) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *ConfigurationToClientClearDialog) Encode(w io.Writer) error {
+	return nil
+}
+
+// ConfigurationToClientShowDialog is configuration toClient packet show_dialog (0x12).
+type ConfigurationToClientShowDialog struct {
+	Dialog []byte
+}
+
+func (*ConfigurationToClientShowDialog) PacketID() int32 {
+	return protocol.ConfigurationToClientShowDialog
+}
+
+func (p *ConfigurationToClientShowDialog) Decode(r io.Reader) (err error) {
+	if p.Dialog, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientShowDialog) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Dialog); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToClientCodeOfConduct is configuration toClient packet code_of_conduct (0x13).
+type ConfigurationToClientCodeOfConduct struct {
+	Contents string
+}
+
+func (*ConfigurationToClientCodeOfConduct) PacketID() int32 {
+	return protocol.ConfigurationToClientCodeOfConduct
+}
+
+func (p *ConfigurationToClientCodeOfConduct) Decode(r io.Reader) (err error) {
+	if p.Contents, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToClientCodeOfConduct) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Contents); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToServerSettings is configuration toServer packet settings (0x00).
+type ConfigurationToServerSettings struct {
+	Locale              string
+	ViewDistance        int8
+	ChatFlags           int32
+	ChatColors          bool
+	SkinParts           uint8
+	MainHand            int32
+	EnableTextFiltering bool
+	EnableServerListing bool
+	ParticleStatus      int32
+}
+
+func (*ConfigurationToServerSettings) PacketID() int32 { return protocol.ConfigurationToServerSettings }
+
+func (p *ConfigurationToServerSettings) Decode(r io.Reader) (err error) {
+	if p.Locale, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.ViewDistance, err = readI8(r); err != nil {
+		return err
+	}
+	if p.ChatFlags, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ChatColors, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.SkinParts, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	if p.MainHand, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.EnableTextFiltering, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.EnableServerListing, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.ParticleStatus, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToServerSettings) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Locale); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.ViewDistance); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.ChatFlags); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.ChatColors); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.SkinParts); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.MainHand); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.EnableTextFiltering); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.EnableServerListing); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.ParticleStatus); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToServerCookieResponse is configuration toServer packet cookie_response (0x01).
+type ConfigurationToServerCookieResponse struct {
+	Key   string
+	Value *[]byte
+}
+
+func (*ConfigurationToServerCookieResponse) PacketID() int32 {
+	return protocol.ConfigurationToServerCookieResponse
+}
+
+func (p *ConfigurationToServerCookieResponse) Decode(r io.Reader) (err error) {
+	if p.Key, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Value, err = readOption(protocol.ReadByteArray)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToServerCookieResponse) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Key); err != nil {
+		return err
+	}
+	if err := writeOption(protocol.WriteByteArray)(w, p.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToServerCustomPayload is configuration toServer packet custom_payload (0x02).
+type ConfigurationToServerCustomPayload struct {
+	Channel string
+	Data    []byte
+}
+
+func (*ConfigurationToServerCustomPayload) PacketID() int32 {
+	return protocol.ConfigurationToServerCustomPayload
+}
+
+func (p *ConfigurationToServerCustomPayload) Decode(r io.Reader) (err error) {
+	if p.Channel, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Data, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToServerCustomPayload) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Channel); err != nil {
+		return err
+	}
+	if err := writeRaw(w, p.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToServerFinishConfiguration is configuration toServer packet finish_configuration (0x03).
+type ConfigurationToServerFinishConfiguration struct {
+}
+
+func (*ConfigurationToServerFinishConfiguration) PacketID() int32 {
+	return protocol.ConfigurationToServerFinishConfiguration
+}
+
+func (_ *ConfigurationToServerFinishConfiguration) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *ConfigurationToServerFinishConfiguration) Encode(w io.Writer) error {
+	return nil
+}
+
+// ConfigurationToServerKeepAlive is configuration toServer packet keep_alive (0x04).
+type ConfigurationToServerKeepAlive struct {
+	KeepAliveID int64
+}
+
+func (*ConfigurationToServerKeepAlive) PacketID() int32 {
+	return protocol.ConfigurationToServerKeepAlive
+}
+
+func (p *ConfigurationToServerKeepAlive) Decode(r io.Reader) (err error) {
+	if p.KeepAliveID, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToServerKeepAlive) Encode(w io.Writer) error {
+	if err := protocol.WriteInt64(w, p.KeepAliveID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToServerPong is configuration toServer packet pong (0x05).
+type ConfigurationToServerPong struct {
+	ID int32
+}
+
+func (*ConfigurationToServerPong) PacketID() int32 { return protocol.ConfigurationToServerPong }
+
+func (p *ConfigurationToServerPong) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToServerPong) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToServerResourcePackReceive is configuration toServer packet resource_pack_receive (0x06).
+type ConfigurationToServerResourcePackReceive struct {
+	UUID   protocol.UUID
+	Result int32
+}
+
+func (*ConfigurationToServerResourcePackReceive) PacketID() int32 {
+	return protocol.ConfigurationToServerResourcePackReceive
+}
+
+func (p *ConfigurationToServerResourcePackReceive) Decode(r io.Reader) (err error) {
+	if p.UUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	if p.Result, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToServerResourcePackReceive) Encode(w io.Writer) error {
+	if err := protocol.WriteUUID(w, p.UUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Result); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToServerSelectKnownPacks is configuration toServer packet select_known_packs (0x07).
+type ConfigurationToServerSelectKnownPacks struct {
+	Packs []ConfigurationToServerSelectKnownPacksPacks
+}
+
+func (*ConfigurationToServerSelectKnownPacks) PacketID() int32 {
+	return protocol.ConfigurationToServerSelectKnownPacks
+}
+
+func (p *ConfigurationToServerSelectKnownPacks) Decode(r io.Reader) (err error) {
+	if p.Packs, err = readArray(readConfigurationToServerSelectKnownPacksPacks)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToServerSelectKnownPacks) Encode(w io.Writer) error {
+	if err := writeArray(writeConfigurationToServerSelectKnownPacksPacks)(w, p.Packs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToServerCustomClickAction is configuration toServer packet custom_click_action (0x08).
+type ConfigurationToServerCustomClickAction struct {
+	ID  string
+	NBT *[]byte
+}
+
+func (*ConfigurationToServerCustomClickAction) PacketID() int32 {
+	return protocol.ConfigurationToServerCustomClickAction
+}
+
+func (p *ConfigurationToServerCustomClickAction) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.NBT, err = readOption(readRawNBT)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfigurationToServerCustomClickAction) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.ID); err != nil {
+		return err
+	}
+	if err := writeOption(writeRawNBT)(w, p.NBT); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfigurationToServerAcceptCodeOfConduct is configuration toServer packet accept_code_of_conduct (0x09).
+type ConfigurationToServerAcceptCodeOfConduct struct {
+}
+
+func (*ConfigurationToServerAcceptCodeOfConduct) PacketID() int32 {
+	return protocol.ConfigurationToServerAcceptCodeOfConduct
+}
+
+func (_ *ConfigurationToServerAcceptCodeOfConduct) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *ConfigurationToServerAcceptCodeOfConduct) Encode(w io.Writer) error {
+	return nil
+}
+
+// PlayToClientBundleDelimiter is play toClient packet bundle_delimiter (0x00).
+type PlayToClientBundleDelimiter struct {
+}
+
+func (*PlayToClientBundleDelimiter) PacketID() int32 { return protocol.PlayToClientBundleDelimiter }
+
+func (_ *PlayToClientBundleDelimiter) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *PlayToClientBundleDelimiter) Encode(w io.Writer) error {
+	return nil
+}
+
+// PlayToClientSpawnEntity is play toClient packet spawn_entity (0x01).
+type PlayToClientSpawnEntity struct {
+	EntityID   int32
+	ObjectUUID protocol.UUID
+	Type       int32
+	X          float64
+	Y          float64
+	Z          float64
+	// Unparsed holds the raw bytes from velocity on.
+	Unparsed []byte
+}
+
+func (*PlayToClientSpawnEntity) PacketID() int32 { return protocol.PlayToClientSpawnEntity }
+
+func (p *PlayToClientSpawnEntity) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ObjectUUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	if p.Type, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSpawnEntity) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteUUID(w, p.ObjectUUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Type); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientAnimation is play toClient packet animation (0x02).
+type PlayToClientAnimation struct {
+	EntityID  int32
+	Animation uint8
+}
+
+func (*PlayToClientAnimation) PacketID() int32 { return protocol.PlayToClientAnimation }
+
+func (p *PlayToClientAnimation) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Animation, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientAnimation) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Animation); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientStatistics is play toClient packet statistics (0x03).
+type PlayToClientStatistics struct {
+	Entries []PlayToClientStatisticsEntries
+}
+
+func (*PlayToClientStatistics) PacketID() int32 { return protocol.PlayToClientStatistics }
+
+func (p *PlayToClientStatistics) Decode(r io.Reader) (err error) {
+	if p.Entries, err = readArray(readPlayToClientStatisticsEntries)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientStatistics) Encode(w io.Writer) error {
+	if err := writeArray(writePlayToClientStatisticsEntries)(w, p.Entries); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientAcknowledgePlayerDigging is play toClient packet acknowledge_player_digging (0x04).
+type PlayToClientAcknowledgePlayerDigging struct {
+	SequenceID int32
+}
+
+func (*PlayToClientAcknowledgePlayerDigging) PacketID() int32 {
+	return protocol.PlayToClientAcknowledgePlayerDigging
+}
+
+func (p *PlayToClientAcknowledgePlayerDigging) Decode(r io.Reader) (err error) {
+	if p.SequenceID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientAcknowledgePlayerDigging) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.SequenceID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientBlockBreakAnimation is play toClient packet block_break_animation (0x05).
+type PlayToClientBlockBreakAnimation struct {
+	EntityID     int32
+	Location     protocol.BlockPos
+	DestroyStage int8
+}
+
+func (*PlayToClientBlockBreakAnimation) PacketID() int32 {
+	return protocol.PlayToClientBlockBreakAnimation
+}
+
+func (p *PlayToClientBlockBreakAnimation) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.DestroyStage, err = readI8(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientBlockBreakAnimation) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.DestroyStage); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientTileEntityData is play toClient packet tile_entity_data (0x06).
+type PlayToClientTileEntityData struct {
+	Location protocol.BlockPos
+	Action   int32
+	NBTData  []byte
+}
+
+func (*PlayToClientTileEntityData) PacketID() int32 { return protocol.PlayToClientTileEntityData }
+
+func (p *PlayToClientTileEntityData) Decode(r io.Reader) (err error) {
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Action, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.NBTData, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientTileEntityData) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Action); err != nil {
+		return err
+	}
+	if err := writeRawNBT(w, p.NBTData); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientBlockAction is play toClient packet block_action (0x07).
+type PlayToClientBlockAction struct {
+	Location protocol.BlockPos
+	Byte1    uint8
+	Byte2    uint8
+	BlockID  int32
+}
+
+func (*PlayToClientBlockAction) PacketID() int32 { return protocol.PlayToClientBlockAction }
+
+func (p *PlayToClientBlockAction) Decode(r io.Reader) (err error) {
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Byte1, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	if p.Byte2, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	if p.BlockID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientBlockAction) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Byte1); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Byte2); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.BlockID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientBlockChange is play toClient packet block_change (0x08).
+type PlayToClientBlockChange struct {
+	Location protocol.BlockPos
+	Type     int32
+}
+
+func (*PlayToClientBlockChange) PacketID() int32 { return protocol.PlayToClientBlockChange }
+
+func (p *PlayToClientBlockChange) Decode(r io.Reader) (err error) {
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Type, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientBlockChange) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Type); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientBossBar is play toClient packet boss_bar (0x09).
+type PlayToClientBossBar struct {
+	EntityUUID protocol.UUID
+	Action     int32
+	// Unparsed holds the raw bytes from title on.
+	Unparsed []byte
+}
+
+func (*PlayToClientBossBar) PacketID() int32 { return protocol.PlayToClientBossBar }
+
+func (p *PlayToClientBossBar) Decode(r io.Reader) (err error) {
+	if p.EntityUUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	if p.Action, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientBossBar) Encode(w io.Writer) error {
+	if err := protocol.WriteUUID(w, p.EntityUUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Action); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientDifficulty is play toClient packet difficulty (0x0a).
+type PlayToClientDifficulty struct {
+	Difficulty       int32
+	DifficultyLocked bool
+}
+
+func (*PlayToClientDifficulty) PacketID() int32 { return protocol.PlayToClientDifficulty }
+
+func (p *PlayToClientDifficulty) Decode(r io.Reader) (err error) {
+	if p.Difficulty, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.DifficultyLocked, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDifficulty) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Difficulty); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.DifficultyLocked); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientChunkBatchFinished is play toClient packet chunk_batch_finished (0x0b).
+type PlayToClientChunkBatchFinished struct {
+	BatchSize int32
+}
+
+func (*PlayToClientChunkBatchFinished) PacketID() int32 {
+	return protocol.PlayToClientChunkBatchFinished
+}
+
+func (p *PlayToClientChunkBatchFinished) Decode(r io.Reader) (err error) {
+	if p.BatchSize, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientChunkBatchFinished) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.BatchSize); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientChunkBatchStart is play toClient packet chunk_batch_start (0x0c).
+type PlayToClientChunkBatchStart struct {
+}
+
+func (*PlayToClientChunkBatchStart) PacketID() int32 { return protocol.PlayToClientChunkBatchStart }
+
+func (_ *PlayToClientChunkBatchStart) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *PlayToClientChunkBatchStart) Encode(w io.Writer) error {
+	return nil
+}
+
+// PlayToClientChunkBiomes is play toClient packet chunk_biomes (0x0d).
+type PlayToClientChunkBiomes struct {
+	Biomes []PlayToClientChunkBiomesBiomes
+}
+
+func (*PlayToClientChunkBiomes) PacketID() int32 { return protocol.PlayToClientChunkBiomes }
+
+func (p *PlayToClientChunkBiomes) Decode(r io.Reader) (err error) {
+	if p.Biomes, err = readArray(readPlayToClientChunkBiomesBiomes)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientChunkBiomes) Encode(w io.Writer) error {
+	if err := writeArray(writePlayToClientChunkBiomesBiomes)(w, p.Biomes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientClearTitles is play toClient packet clear_titles (0x0e).
+type PlayToClientClearTitles struct {
+	Reset bool
+}
+
+func (*PlayToClientClearTitles) PacketID() int32 { return protocol.PlayToClientClearTitles }
+
+func (p *PlayToClientClearTitles) Decode(r io.Reader) (err error) {
+	if p.Reset, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientClearTitles) Encode(w io.Writer) error {
+	if err := protocol.WriteBool(w, p.Reset); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientTabComplete is play toClient packet tab_complete (0x0f).
+type PlayToClientTabComplete struct {
+	TransactionID int32
+	Start         int32
+	Length        int32
+	Matches       []PlayToClientTabCompleteMatches
+}
+
+func (*PlayToClientTabComplete) PacketID() int32 { return protocol.PlayToClientTabComplete }
+
+func (p *PlayToClientTabComplete) Decode(r io.Reader) (err error) {
+	if p.TransactionID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Start, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Length, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Matches, err = readArray(readPlayToClientTabCompleteMatches)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientTabComplete) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.TransactionID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Start); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Length); err != nil {
+		return err
+	}
+	if err := writeArray(writePlayToClientTabCompleteMatches)(w, p.Matches); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientDeclareCommands is play toClient packet declare_commands (0x10).
+type PlayToClientDeclareCommands struct {
+	// Unparsed holds the raw bytes from nodes on.
+	Unparsed []byte
+}
+
+func (*PlayToClientDeclareCommands) PacketID() int32 { return protocol.PlayToClientDeclareCommands }
+
+func (p *PlayToClientDeclareCommands) Decode(r io.Reader) (err error) {
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDeclareCommands) Encode(w io.Writer) error {
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientCloseWindow is play toClient packet close_window (0x11).
+type PlayToClientCloseWindow struct {
+	WindowID int32
+}
+
+func (*PlayToClientCloseWindow) PacketID() int32 { return protocol.PlayToClientCloseWindow }
+
+func (p *PlayToClientCloseWindow) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientCloseWindow) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientWindowItems is play toClient packet window_items (0x12).
+type PlayToClientWindowItems struct {
+	WindowID    int32
+	StateID     int32
+	Items       []protocol.Slot
+	CarriedItem protocol.Slot
+}
+
+func (*PlayToClientWindowItems) PacketID() int32 { return protocol.PlayToClientWindowItems }
+
+func (p *PlayToClientWindowItems) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.StateID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Items, err = readArray(protocol.ReadSlot)(r); err != nil {
+		return err
+	}
+	if p.CarriedItem, err = protocol.ReadSlot(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientWindowItems) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.StateID); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteSlot)(w, p.Items); err != nil {
+		return err
+	}
+	if err := protocol.WriteSlot(w, p.CarriedItem); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientCraftProgressBar is play toClient packet craft_progress_bar (0x13).
+type PlayToClientCraftProgressBar struct {
+	WindowID int32
+	Property int16
+	Value    int16
+}
+
+func (*PlayToClientCraftProgressBar) PacketID() int32 { return protocol.PlayToClientCraftProgressBar }
+
+func (p *PlayToClientCraftProgressBar) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Property, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.Value, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientCraftProgressBar) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.Property); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSetSlot is play toClient packet set_slot (0x14).
+type PlayToClientSetSlot struct {
+	WindowID int32
+	StateID  int32
+	Slot     int16
+	Item     protocol.Slot
+}
+
+func (*PlayToClientSetSlot) PacketID() int32 { return protocol.PlayToClientSetSlot }
+
+func (p *PlayToClientSetSlot) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.StateID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Slot, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.Item, err = protocol.ReadSlot(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetSlot) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.StateID); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.Slot); err != nil {
+		return err
+	}
+	if err := protocol.WriteSlot(w, p.Item); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientCookieRequest is play toClient packet cookie_request (0x15).
+type PlayToClientCookieRequest struct {
+	Cookie string
+}
+
+func (*PlayToClientCookieRequest) PacketID() int32 { return protocol.PlayToClientCookieRequest }
+
+func (p *PlayToClientCookieRequest) Decode(r io.Reader) (err error) {
+	if p.Cookie, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientCookieRequest) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Cookie); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSetCooldown is play toClient packet set_cooldown (0x16).
+type PlayToClientSetCooldown struct {
+	CooldownGroup string
+	CooldownTicks int32
+}
+
+func (*PlayToClientSetCooldown) PacketID() int32 { return protocol.PlayToClientSetCooldown }
+
+func (p *PlayToClientSetCooldown) Decode(r io.Reader) (err error) {
+	if p.CooldownGroup, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.CooldownTicks, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetCooldown) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.CooldownGroup); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.CooldownTicks); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientChatSuggestions is play toClient packet chat_suggestions (0x17).
+type PlayToClientChatSuggestions struct {
+	Action  int32
+	Entries []string
+}
+
+func (*PlayToClientChatSuggestions) PacketID() int32 { return protocol.PlayToClientChatSuggestions }
+
+func (p *PlayToClientChatSuggestions) Decode(r io.Reader) (err error) {
+	if p.Action, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Entries, err = readArray(protocol.ReadString)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientChatSuggestions) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Action); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteString)(w, p.Entries); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientCustomPayload is play toClient packet custom_payload (0x18).
+type PlayToClientCustomPayload struct {
+	Channel string
+	Data    []byte
+}
+
+func (*PlayToClientCustomPayload) PacketID() int32 { return protocol.PlayToClientCustomPayload }
+
+func (p *PlayToClientCustomPayload) Decode(r io.Reader) (err error) {
+	if p.Channel, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Data, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientCustomPayload) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Channel); err != nil {
+		return err
+	}
+	if err := writeRaw(w, p.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientDamageEvent is play toClient packet damage_event (0x19).
+type PlayToClientDamageEvent struct {
+	EntityID       int32
+	SourceTypeID   int32
+	SourceCauseID  int32
+	SourceDirectID int32
+	SourcePosition *Vec3f64
+}
+
+func (*PlayToClientDamageEvent) PacketID() int32 { return protocol.PlayToClientDamageEvent }
+
+func (p *PlayToClientDamageEvent) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.SourceTypeID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.SourceCauseID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.SourceDirectID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.SourcePosition, err = readOption(readVec3f64)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDamageEvent) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.SourceTypeID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.SourceCauseID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.SourceDirectID); err != nil {
+		return err
+	}
+	if err := writeOption(writeVec3f64)(w, p.SourcePosition); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientDebugBlockValue is play toClient packet debug_block_value (0x1a).
+type PlayToClientDebugBlockValue struct {
+	BlockPos protocol.BlockPos
+	// Unparsed holds the raw bytes from update on.
+	Unparsed []byte
+}
+
+func (*PlayToClientDebugBlockValue) PacketID() int32 { return protocol.PlayToClientDebugBlockValue }
+
+func (p *PlayToClientDebugBlockValue) Decode(r io.Reader) (err error) {
+	if p.BlockPos, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDebugBlockValue) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.BlockPos); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientDebugChunkValue is play toClient packet debug_chunk_value (0x1b).
+type PlayToClientDebugChunkValue struct {
+	ChunkPos PackedChunkPos
+	// Unparsed holds the raw bytes from update on.
+	Unparsed []byte
+}
+
+func (*PlayToClientDebugChunkValue) PacketID() int32 { return protocol.PlayToClientDebugChunkValue }
+
+func (p *PlayToClientDebugChunkValue) Decode(r io.Reader) (err error) {
+	if p.ChunkPos, err = readPackedChunkPos(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDebugChunkValue) Encode(w io.Writer) error {
+	if err := writePackedChunkPos(w, p.ChunkPos); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientDebugEntityValue is play toClient packet debug_entity_value (0x1c).
+type PlayToClientDebugEntityValue struct {
+	EntityID int32
+	// Unparsed holds the raw bytes from update on.
+	Unparsed []byte
+}
+
+func (*PlayToClientDebugEntityValue) PacketID() int32 { return protocol.PlayToClientDebugEntityValue }
+
+func (p *PlayToClientDebugEntityValue) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDebugEntityValue) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientDebugEvent is play toClient packet debug_event (0x1d).
+type PlayToClientDebugEvent struct {
+	// Unparsed holds the raw bytes from event on.
+	Unparsed []byte
+}
+
+func (*PlayToClientDebugEvent) PacketID() int32 { return protocol.PlayToClientDebugEvent }
+
+func (p *PlayToClientDebugEvent) Decode(r io.Reader) (err error) {
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDebugEvent) Encode(w io.Writer) error {
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientDebugSample is play toClient packet debug_sample (0x1e).
+type PlayToClientDebugSample struct {
+	Sample []int64
+	Type   int32
+}
+
+func (*PlayToClientDebugSample) PacketID() int32 { return protocol.PlayToClientDebugSample }
+
+func (p *PlayToClientDebugSample) Decode(r io.Reader) (err error) {
+	if p.Sample, err = readArray(protocol.ReadInt64)(r); err != nil {
+		return err
+	}
+	if p.Type, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDebugSample) Encode(w io.Writer) error {
+	if err := writeArray(protocol.WriteInt64)(w, p.Sample); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Type); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientHideMessage is play toClient packet hide_message (0x1f).
+type PlayToClientHideMessage struct {
+	ID int32
+	// Unparsed holds the raw bytes from signature on.
+	Unparsed []byte
+}
+
+func (*PlayToClientHideMessage) PacketID() int32 { return protocol.PlayToClientHideMessage }
+
+func (p *PlayToClientHideMessage) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientHideMessage) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.ID); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientKickDisconnect is play toClient packet kick_disconnect (0x20).
+type PlayToClientKickDisconnect struct {
+	Reason []byte
+}
+
+func (*PlayToClientKickDisconnect) PacketID() int32 { return protocol.PlayToClientKickDisconnect }
+
+func (p *PlayToClientKickDisconnect) Decode(r io.Reader) (err error) {
+	if p.Reason, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientKickDisconnect) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Reason); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientProfilelessChat is play toClient packet profileless_chat (0x21).
+type PlayToClientProfilelessChat struct {
+	Message []byte
+	// Unparsed holds the raw bytes from type on.
+	Unparsed []byte
+}
+
+func (*PlayToClientProfilelessChat) PacketID() int32 { return protocol.PlayToClientProfilelessChat }
+
+func (p *PlayToClientProfilelessChat) Decode(r io.Reader) (err error) {
+	if p.Message, err = readRawNBT(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientProfilelessChat) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Message); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientEntityStatus is play toClient packet entity_status (0x22).
+type PlayToClientEntityStatus struct {
+	EntityID     int32
+	EntityStatus int8
+}
+
+func (*PlayToClientEntityStatus) PacketID() int32 { return protocol.PlayToClientEntityStatus }
+
+func (p *PlayToClientEntityStatus) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.EntityStatus, err = readI8(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityStatus) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.EntityStatus); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSyncEntityPosition is play toClient packet sync_entity_position (0x23).
+type PlayToClientSyncEntityPosition struct {
+	EntityID int32
+	X        float64
+	Y        float64
+	Z        float64
+	Dx       float64
+	Dy       float64
+	Dz       float64
+	Yaw      float32
+	Pitch    float32
+	OnGround bool
+}
+
+func (*PlayToClientSyncEntityPosition) PacketID() int32 {
+	return protocol.PlayToClientSyncEntityPosition
+}
+
+func (p *PlayToClientSyncEntityPosition) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Dx, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Dy, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Dz, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.OnGround, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSyncEntityPosition) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Dx); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Dy); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Dz); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Pitch); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.OnGround); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientExplosion is play toClient packet explosion (0x24).
+type PlayToClientExplosion struct {
+	Center          Vec3f64
+	Radius          float32
+	BlockCount      int32
+	PlayerKnockback *Vec3f64
+	// Unparsed holds the raw bytes from explosionParticle on.
+	Unparsed []byte
+}
+
+func (*PlayToClientExplosion) PacketID() int32 { return protocol.PlayToClientExplosion }
+
+func (p *PlayToClientExplosion) Decode(r io.Reader) (err error) {
+	if p.Center, err = readVec3f64(r); err != nil {
+		return err
+	}
+	if p.Radius, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.BlockCount, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.PlayerKnockback, err = readOption(readVec3f64)(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientExplosion) Encode(w io.Writer) error {
+	if err := writeVec3f64(w, p.Center); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Radius); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, p.BlockCount); err != nil {
+		return err
+	}
+	if err := writeOption(writeVec3f64)(w, p.PlayerKnockback); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientUnloadChunk is play toClient packet unload_chunk (0x25).
+type PlayToClientUnloadChunk struct {
+	ChunkZ int32
+	ChunkX int32
+}
+
+func (*PlayToClientUnloadChunk) PacketID() int32 { return protocol.PlayToClientUnloadChunk }
+
+func (p *PlayToClientUnloadChunk) Decode(r io.Reader) (err error) {
+	if p.ChunkZ, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.ChunkX, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientUnloadChunk) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.ChunkZ); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, p.ChunkX); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientGameStateChange is play toClient packet game_state_change (0x26).
+type PlayToClientGameStateChange struct {
+	Reason   uint8
+	GameMode float32
+}
+
+func (*PlayToClientGameStateChange) PacketID() int32 { return protocol.PlayToClientGameStateChange }
+
+func (p *PlayToClientGameStateChange) Decode(r io.Reader) (err error) {
+	if p.Reason, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	if p.GameMode, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientGameStateChange) Encode(w io.Writer) error {
+	if err := protocol.WriteByte(w, p.Reason); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.GameMode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientGameTestHighlightPos is play toClient packet game_test_highlight_pos (0x27).
+type PlayToClientGameTestHighlightPos struct {
+	AbsolutePos protocol.BlockPos
+	RelativePos protocol.BlockPos
+}
+
+func (*PlayToClientGameTestHighlightPos) PacketID() int32 {
+	return protocol.PlayToClientGameTestHighlightPos
+}
+
+func (p *PlayToClientGameTestHighlightPos) Decode(r io.Reader) (err error) {
+	if p.AbsolutePos, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.RelativePos, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientGameTestHighlightPos) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.AbsolutePos); err != nil {
+		return err
+	}
+	if err := protocol.WriteBlockPos(w, p.RelativePos); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientOpenHorseWindow is play toClient packet open_horse_window (0x28).
+type PlayToClientOpenHorseWindow struct {
+	WindowID int32
+	NbSlots  int32
+	EntityID int32
+}
+
+func (*PlayToClientOpenHorseWindow) PacketID() int32 { return protocol.PlayToClientOpenHorseWindow }
+
+func (p *PlayToClientOpenHorseWindow) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.NbSlots, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.EntityID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientOpenHorseWindow) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.NbSlots); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, p.EntityID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientHurtAnimation is play toClient packet hurt_animation (0x29).
+type PlayToClientHurtAnimation struct {
+	EntityID int32
+	Yaw      float32
+}
+
+func (*PlayToClientHurtAnimation) PacketID() int32 { return protocol.PlayToClientHurtAnimation }
+
+func (p *PlayToClientHurtAnimation) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientHurtAnimation) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Yaw); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientInitializeWorldBorder is play toClient packet initialize_world_border (0x2a).
+type PlayToClientInitializeWorldBorder struct {
+	X                      float64
+	Z                      float64
+	OldDiameter            float64
+	NewDiameter            float64
+	Speed                  int32
+	PortalTeleportBoundary int32
+	WarningBlocks          int32
+	WarningTime            int32
+}
+
+func (*PlayToClientInitializeWorldBorder) PacketID() int32 {
+	return protocol.PlayToClientInitializeWorldBorder
+}
+
+func (p *PlayToClientInitializeWorldBorder) Decode(r io.Reader) (err error) {
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.OldDiameter, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.NewDiameter, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Speed, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.PortalTeleportBoundary, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.WarningBlocks, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.WarningTime, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientInitializeWorldBorder) Encode(w io.Writer) error {
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.OldDiameter); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.NewDiameter); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Speed); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.PortalTeleportBoundary); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.WarningBlocks); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.WarningTime); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientKeepAlive is play toClient packet keep_alive (0x2b).
+type PlayToClientKeepAlive struct {
+	KeepAliveID int64
+}
+
+func (*PlayToClientKeepAlive) PacketID() int32 { return protocol.PlayToClientKeepAlive }
+
+func (p *PlayToClientKeepAlive) Decode(r io.Reader) (err error) {
+	if p.KeepAliveID, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientKeepAlive) Encode(w io.Writer) error {
+	if err := protocol.WriteInt64(w, p.KeepAliveID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientMapChunk is play toClient packet map_chunk (0x2c).
+type PlayToClientMapChunk struct {
+	X          int32
+	Z          int32
+	Heightmaps []PlayToClientMapChunkHeightmaps
+	ChunkData  []byte
+	// Unparsed holds the raw bytes from blockEntities on.
+	Unparsed []byte
+}
+
+func (*PlayToClientMapChunk) PacketID() int32 { return protocol.PlayToClientMapChunk }
+
+func (p *PlayToClientMapChunk) Decode(r io.Reader) (err error) {
+	if p.X, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.Heightmaps, err = readArray(readPlayToClientMapChunkHeightmaps)(r); err != nil {
+		return err
+	}
+	if p.ChunkData, err = protocol.ReadByteArray(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientMapChunk) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, p.Z); err != nil {
+		return err
+	}
+	if err := writeArray(writePlayToClientMapChunkHeightmaps)(w, p.Heightmaps); err != nil {
+		return err
+	}
+	if err := protocol.WriteByteArray(w, p.ChunkData); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientWorldEvent is play toClient packet world_event (0x2d).
+type PlayToClientWorldEvent struct {
+	EffectID int32
+	Location protocol.BlockPos
+	Data     int32
+	Global   bool
+}
+
+func (*PlayToClientWorldEvent) PacketID() int32 { return protocol.PlayToClientWorldEvent }
+
+func (p *PlayToClientWorldEvent) Decode(r io.Reader) (err error) {
+	if p.EffectID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Data, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.Global, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientWorldEvent) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.EffectID); err != nil {
+		return err
+	}
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, p.Data); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.Global); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientWorldParticles is play toClient packet world_particles (0x2e).
+type PlayToClientWorldParticles struct {
+	LongDistance   bool
+	AlwaysShow     bool
+	X              float64
+	Y              float64
+	Z              float64
+	OffsetX        float32
+	OffsetY        float32
+	OffsetZ        float32
+	VelocityOffset float32
+	Amount         int32
+	// Unparsed holds the raw bytes from particle on.
+	Unparsed []byte
+}
+
+func (*PlayToClientWorldParticles) PacketID() int32 { return protocol.PlayToClientWorldParticles }
+
+func (p *PlayToClientWorldParticles) Decode(r io.Reader) (err error) {
+	if p.LongDistance, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.AlwaysShow, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.OffsetX, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.OffsetY, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.OffsetZ, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.VelocityOffset, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Amount, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientWorldParticles) Encode(w io.Writer) error {
+	if err := protocol.WriteBool(w, p.LongDistance); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.AlwaysShow); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.OffsetX); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.OffsetY); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.OffsetZ); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.VelocityOffset); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, p.Amount); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientUpdateLight is play toClient packet update_light (0x2f).
+type PlayToClientUpdateLight struct {
+	ChunkX              int32
+	ChunkZ              int32
+	SkyLightMask        []int64
+	BlockLightMask      []int64
+	EmptySkyLightMask   []int64
+	EmptyBlockLightMask []int64
+	SkyLight            [][]uint8
+	BlockLight          [][]uint8
+}
+
+func (*PlayToClientUpdateLight) PacketID() int32 { return protocol.PlayToClientUpdateLight }
+
+func (p *PlayToClientUpdateLight) Decode(r io.Reader) (err error) {
+	if p.ChunkX, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ChunkZ, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.SkyLightMask, err = readArray(protocol.ReadInt64)(r); err != nil {
+		return err
+	}
+	if p.BlockLightMask, err = readArray(protocol.ReadInt64)(r); err != nil {
+		return err
+	}
+	if p.EmptySkyLightMask, err = readArray(protocol.ReadInt64)(r); err != nil {
+		return err
+	}
+	if p.EmptyBlockLightMask, err = readArray(protocol.ReadInt64)(r); err != nil {
+		return err
+	}
+	if p.SkyLight, err = readArray(readArray(protocol.ReadByte))(r); err != nil {
+		return err
+	}
+	if p.BlockLight, err = readArray(readArray(protocol.ReadByte))(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientUpdateLight) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.ChunkX); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.ChunkZ); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteInt64)(w, p.SkyLightMask); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteInt64)(w, p.BlockLightMask); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteInt64)(w, p.EmptySkyLightMask); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteInt64)(w, p.EmptyBlockLightMask); err != nil {
+		return err
+	}
+	if err := writeArray(writeArray(protocol.WriteByte))(w, p.SkyLight); err != nil {
+		return err
+	}
+	if err := writeArray(writeArray(protocol.WriteByte))(w, p.BlockLight); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientLogin is play toClient packet login (0x30).
+type PlayToClientLogin struct {
+	EntityID            int32
+	IsHardcore          bool
+	WorldNames          []string
+	MaxPlayers          int32
+	ViewDistance        int32
+	SimulationDistance  int32
+	ReducedDebugInfo    bool
+	EnableRespawnScreen bool
+	DoLimitedCrafting   bool
+	WorldState          SpawnInfo
+	EnforcesSecureChat  bool
+}
+
+func (*PlayToClientLogin) PacketID() int32 { return protocol.PlayToClientLogin }
+
+func (p *PlayToClientLogin) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.IsHardcore, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.WorldNames, err = readArray(protocol.ReadString)(r); err != nil {
+		return err
+	}
+	if p.MaxPlayers, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ViewDistance, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.SimulationDistance, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ReducedDebugInfo, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.EnableRespawnScreen, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.DoLimitedCrafting, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.WorldState, err = readSpawnInfo(r); err != nil {
+		return err
+	}
+	if p.EnforcesSecureChat, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientLogin) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.IsHardcore); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteString)(w, p.WorldNames); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.MaxPlayers); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.ViewDistance); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.SimulationDistance); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.ReducedDebugInfo); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.EnableRespawnScreen); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.DoLimitedCrafting); err != nil {
+		return err
+	}
+	if err := writeSpawnInfo(w, p.WorldState); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.EnforcesSecureChat); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientMap is play toClient packet map (0x31).
+type PlayToClientMap struct {
+	ItemDamage int32
+	Scale      int8
+	Locked     bool
+	Icons      *[]PlayToClientMapIcons
+	Columns    uint8
+	// Unparsed holds the raw bytes from rows on.
+	Unparsed []byte
+}
+
+func (*PlayToClientMap) PacketID() int32 { return protocol.PlayToClientMap }
+
+func (p *PlayToClientMap) Decode(r io.Reader) (err error) {
+	if p.ItemDamage, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Scale, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Locked, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.Icons, err = readOption(readArray(readPlayToClientMapIcons))(r); err != nil {
+		return err
+	}
+	if p.Columns, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientMap) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.ItemDamage); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Scale); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.Locked); err != nil {
+		return err
+	}
+	if err := writeOption(writeArray(writePlayToClientMapIcons))(w, p.Icons); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Columns); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientTradeList is play toClient packet trade_list (0x32).
+type PlayToClientTradeList struct {
+	WindowID int32
+	// Unparsed holds the raw bytes from trades on.
+	Unparsed []byte
+}
+
+func (*PlayToClientTradeList) PacketID() int32 { return protocol.PlayToClientTradeList }
+
+func (p *PlayToClientTradeList) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientTradeList) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientRelEntityMove is play toClient packet rel_entity_move (0x33).
+type PlayToClientRelEntityMove struct {
+	EntityID int32
+	DX       int16
+	DY       int16
+	DZ       int16
+	OnGround bool
+}
+
+func (*PlayToClientRelEntityMove) PacketID() int32 { return protocol.PlayToClientRelEntityMove }
+
+func (p *PlayToClientRelEntityMove) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.DX, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.DY, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.DZ, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.OnGround, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientRelEntityMove) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.DX); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.DY); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.DZ); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.OnGround); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEntityMoveLook is play toClient packet entity_move_look (0x34).
+type PlayToClientEntityMoveLook struct {
+	EntityID int32
+	DX       int16
+	DY       int16
+	DZ       int16
+	Yaw      int8
+	Pitch    int8
+	OnGround bool
+}
+
+func (*PlayToClientEntityMoveLook) PacketID() int32 { return protocol.PlayToClientEntityMoveLook }
+
+func (p *PlayToClientEntityMoveLook) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.DX, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.DY, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.DZ, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = readI8(r); err != nil {
+		return err
+	}
+	if p.OnGround, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityMoveLook) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.DX); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.DY); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.DZ); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Pitch); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.OnGround); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientMoveMinecart is play toClient packet move_minecart (0x35).
+type PlayToClientMoveMinecart struct {
+	EntityID int32
+	Steps    []PlayToClientMoveMinecartSteps
+}
+
+func (*PlayToClientMoveMinecart) PacketID() int32 { return protocol.PlayToClientMoveMinecart }
+
+func (p *PlayToClientMoveMinecart) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Steps, err = readArray(readPlayToClientMoveMinecartSteps)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientMoveMinecart) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := writeArray(writePlayToClientMoveMinecartSteps)(w, p.Steps); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEntityLook is play toClient packet entity_look (0x36).
+type PlayToClientEntityLook struct {
+	EntityID int32
+	Yaw      int8
+	Pitch    int8
+	OnGround bool
+}
+
+func (*PlayToClientEntityLook) PacketID() int32 { return protocol.PlayToClientEntityLook }
+
+func (p *PlayToClientEntityLook) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = readI8(r); err != nil {
+		return err
+	}
+	if p.OnGround, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityLook) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Pitch); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.OnGround); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientVehicleMove is play toClient packet vehicle_move (0x37).
+type PlayToClientVehicleMove struct {
+	X     float64
+	Y     float64
+	Z     float64
+	Yaw   float32
+	Pitch float32
+}
+
+func (*PlayToClientVehicleMove) PacketID() int32 { return protocol.PlayToClientVehicleMove }
+
+func (p *PlayToClientVehicleMove) Decode(r io.Reader) (err error) {
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientVehicleMove) Encode(w io.Writer) error {
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Pitch); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientOpenBook is play toClient packet open_book (0x38).
+type PlayToClientOpenBook struct {
+	Hand int32
+}
+
+func (*PlayToClientOpenBook) PacketID() int32 { return protocol.PlayToClientOpenBook }
+
+func (p *PlayToClientOpenBook) Decode(r io.Reader) (err error) {
+	if p.Hand, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientOpenBook) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Hand); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientOpenWindow is play toClient packet open_window (0x39).
+type PlayToClientOpenWindow struct {
+	WindowID      int32
+	InventoryType int32
+	WindowTitle   []byte
+}
+
+func (*PlayToClientOpenWindow) PacketID() int32 { return protocol.PlayToClientOpenWindow }
+
+func (p *PlayToClientOpenWindow) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.InventoryType, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.WindowTitle, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientOpenWindow) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.InventoryType); err != nil {
+		return err
+	}
+	if err := writeRawNBT(w, p.WindowTitle); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientOpenSignEntity is play toClient packet open_sign_entity (0x3a).
+type PlayToClientOpenSignEntity struct {
+	Location    protocol.BlockPos
+	IsFrontText bool
+}
+
+func (*PlayToClientOpenSignEntity) PacketID() int32 { return protocol.PlayToClientOpenSignEntity }
+
+func (p *PlayToClientOpenSignEntity) Decode(r io.Reader) (err error) {
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.IsFrontText, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientOpenSignEntity) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.IsFrontText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientPing is play toClient packet ping (0x3b).
+type PlayToClientPing struct {
+	ID int32
+}
+
+func (*PlayToClientPing) PacketID() int32 { return protocol.PlayToClientPing }
+
+func (p *PlayToClientPing) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientPing) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientPingResponse is play toClient packet ping_response (0x3c).
+type PlayToClientPingResponse struct {
+	ID int64
+}
+
+func (*PlayToClientPingResponse) PacketID() int32 { return protocol.PlayToClientPingResponse }
+
+func (p *PlayToClientPingResponse) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientPingResponse) Encode(w io.Writer) error {
+	if err := protocol.WriteInt64(w, p.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientCraftRecipeResponse is play toClient packet craft_recipe_response (0x3d).
+type PlayToClientCraftRecipeResponse struct {
+	WindowID int32
+	// Unparsed holds the raw bytes from recipeDisplay on.
+	Unparsed []byte
+}
+
+func (*PlayToClientCraftRecipeResponse) PacketID() int32 {
+	return protocol.PlayToClientCraftRecipeResponse
+}
+
+func (p *PlayToClientCraftRecipeResponse) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientCraftRecipeResponse) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientAbilities is play toClient packet abilities (0x3e).
+type PlayToClientAbilities struct {
+	Flags        int8
+	FlyingSpeed  float32
+	WalkingSpeed float32
+}
+
+func (*PlayToClientAbilities) PacketID() int32 { return protocol.PlayToClientAbilities }
+
+func (p *PlayToClientAbilities) Decode(r io.Reader) (err error) {
+	if p.Flags, err = readI8(r); err != nil {
+		return err
+	}
+	if p.FlyingSpeed, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.WalkingSpeed, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientAbilities) Encode(w io.Writer) error {
+	if err := writeI8(w, p.Flags); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.FlyingSpeed); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.WalkingSpeed); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientPlayerChat is play toClient packet player_chat (0x3f).
+type PlayToClientPlayerChat struct {
+	GlobalIndex  int32
+	SenderUUID   protocol.UUID
+	Index        int32
+	Signature    *[]byte
+	PlainMessage string
+	Timestamp    int64
+	Salt         int64
+	// Unparsed holds the raw bytes from previousMessages on.
+	Unparsed []byte
+}
+
+func (*PlayToClientPlayerChat) PacketID() int32 { return protocol.PlayToClientPlayerChat }
+
+func (p *PlayToClientPlayerChat) Decode(r io.Reader) (err error) {
+	if p.GlobalIndex, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.SenderUUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	if p.Index, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Signature, err = readOption(readFixedBytes(256))(r); err != nil {
+		return err
+	}
+	if p.PlainMessage, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Timestamp, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	if p.Salt, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientPlayerChat) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.GlobalIndex); err != nil {
+		return err
+	}
+	if err := protocol.WriteUUID(w, p.SenderUUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Index); err != nil {
+		return err
+	}
+	if err := writeOption(writeFixedBytes(256))(w, p.Signature); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.PlainMessage); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt64(w, p.Timestamp); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt64(w, p.Salt); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientEndCombatEvent is play toClient packet end_combat_event (0x40).
+type PlayToClientEndCombatEvent struct {
+	Duration int32
+}
+
+func (*PlayToClientEndCombatEvent) PacketID() int32 { return protocol.PlayToClientEndCombatEvent }
+
+func (p *PlayToClientEndCombatEvent) Decode(r io.Reader) (err error) {
+	if p.Duration, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEndCombatEvent) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Duration); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEnterCombatEvent is play toClient packet enter_combat_event (0x41).
+type PlayToClientEnterCombatEvent struct {
+}
+
+func (*PlayToClientEnterCombatEvent) PacketID() int32 { return protocol.PlayToClientEnterCombatEvent }
+
+func (_ *PlayToClientEnterCombatEvent) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *PlayToClientEnterCombatEvent) Encode(w io.Writer) error {
+	return nil
+}
+
+// PlayToClientDeathCombatEvent is play toClient packet death_combat_event (0x42).
+type PlayToClientDeathCombatEvent struct {
+	PlayerID int32
+	Message  []byte
+}
+
+func (*PlayToClientDeathCombatEvent) PacketID() int32 { return protocol.PlayToClientDeathCombatEvent }
+
+func (p *PlayToClientDeathCombatEvent) Decode(r io.Reader) (err error) {
+	if p.PlayerID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Message, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDeathCombatEvent) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.PlayerID); err != nil {
+		return err
+	}
+	if err := writeRawNBT(w, p.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientPlayerRemove is play toClient packet player_remove (0x43).
+type PlayToClientPlayerRemove struct {
+	Players []protocol.UUID
+}
+
+func (*PlayToClientPlayerRemove) PacketID() int32 { return protocol.PlayToClientPlayerRemove }
+
+func (p *PlayToClientPlayerRemove) Decode(r io.Reader) (err error) {
+	if p.Players, err = readArray(protocol.ReadUUID)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientPlayerRemove) Encode(w io.Writer) error {
+	if err := writeArray(protocol.WriteUUID)(w, p.Players); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientPlayerInfo is play toClient packet player_info (0x44).
+type PlayToClientPlayerInfo struct {
+	Action uint8
+	// Unparsed holds the raw bytes from data on.
+	Unparsed []byte
+}
+
+func (*PlayToClientPlayerInfo) PacketID() int32 { return protocol.PlayToClientPlayerInfo }
+
+func (p *PlayToClientPlayerInfo) Decode(r io.Reader) (err error) {
+	if p.Action, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientPlayerInfo) Encode(w io.Writer) error {
+	if err := protocol.WriteByte(w, p.Action); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientFacePlayer is play toClient packet face_player (0x45).
+type PlayToClientFacePlayer struct {
+	FeetEyes int32
+	X        float64
+	Y        float64
+	Z        float64
+	IsEntity bool
+	// Unparsed holds the raw bytes from entityId on.
+	Unparsed []byte
+}
+
+func (*PlayToClientFacePlayer) PacketID() int32 { return protocol.PlayToClientFacePlayer }
+
+func (p *PlayToClientFacePlayer) Decode(r io.Reader) (err error) {
+	if p.FeetEyes, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.IsEntity, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientFacePlayer) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.FeetEyes); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.IsEntity); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientPosition is play toClient packet position (0x46).
+type PlayToClientPosition struct {
+	TeleportID int32
+	X          float64
+	Y          float64
+	Z          float64
+	Dx         float64
+	Dy         float64
+	Dz         float64
+	Yaw        float32
+	Pitch      float32
+	Flags      uint32
+}
+
+func (*PlayToClientPosition) PacketID() int32 { return protocol.PlayToClientPosition }
+
+func (p *PlayToClientPosition) Decode(r io.Reader) (err error) {
+	if p.TeleportID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Dx, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Dy, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Dz, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Flags, err = readU32(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientPosition) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.TeleportID); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Dx); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Dy); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Dz); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Pitch); err != nil {
+		return err
+	}
+	if err := writeU32(w, p.Flags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientPlayerRotation is play toClient packet player_rotation (0x47).
+type PlayToClientPlayerRotation struct {
+	Yaw           float32
+	RelativeYaw   bool
+	Pitch         float32
+	RelativePitch bool
+}
+
+func (*PlayToClientPlayerRotation) PacketID() int32 { return protocol.PlayToClientPlayerRotation }
+
+func (p *PlayToClientPlayerRotation) Decode(r io.Reader) (err error) {
+	if p.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.RelativeYaw, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.RelativePitch, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientPlayerRotation) Encode(w io.Writer) error {
+	if err := protocol.WriteFloat(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.RelativeYaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Pitch); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.RelativePitch); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientRecipeBookAdd is play toClient packet recipe_book_add (0x48).
+type PlayToClientRecipeBookAdd struct {
+	// Unparsed holds the raw bytes from entries on.
+	Unparsed []byte
+}
+
+func (*PlayToClientRecipeBookAdd) PacketID() int32 { return protocol.PlayToClientRecipeBookAdd }
+
+func (p *PlayToClientRecipeBookAdd) Decode(r io.Reader) (err error) {
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientRecipeBookAdd) Encode(w io.Writer) error {
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientRecipeBookRemove is play toClient packet recipe_book_remove (0x49).
+type PlayToClientRecipeBookRemove struct {
+	RecipeIDs []int32
+}
+
+func (*PlayToClientRecipeBookRemove) PacketID() int32 { return protocol.PlayToClientRecipeBookRemove }
+
+func (p *PlayToClientRecipeBookRemove) Decode(r io.Reader) (err error) {
+	if p.RecipeIDs, err = readArray(protocol.ReadVarint)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientRecipeBookRemove) Encode(w io.Writer) error {
+	if err := writeArray(protocol.WriteVarint)(w, p.RecipeIDs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientRecipeBookSettings is play toClient packet recipe_book_settings (0x4a).
+type PlayToClientRecipeBookSettings struct {
+	Crafting RecipeBookSetting
+	Furnace  RecipeBookSetting
+	Blast    RecipeBookSetting
+	Smoker   RecipeBookSetting
+}
+
+func (*PlayToClientRecipeBookSettings) PacketID() int32 {
+	return protocol.PlayToClientRecipeBookSettings
+}
+
+func (p *PlayToClientRecipeBookSettings) Decode(r io.Reader) (err error) {
+	if p.Crafting, err = readRecipeBookSetting(r); err != nil {
+		return err
+	}
+	if p.Furnace, err = readRecipeBookSetting(r); err != nil {
+		return err
+	}
+	if p.Blast, err = readRecipeBookSetting(r); err != nil {
+		return err
+	}
+	if p.Smoker, err = readRecipeBookSetting(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientRecipeBookSettings) Encode(w io.Writer) error {
+	if err := writeRecipeBookSetting(w, p.Crafting); err != nil {
+		return err
+	}
+	if err := writeRecipeBookSetting(w, p.Furnace); err != nil {
+		return err
+	}
+	if err := writeRecipeBookSetting(w, p.Blast); err != nil {
+		return err
+	}
+	if err := writeRecipeBookSetting(w, p.Smoker); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEntityDestroy is play toClient packet entity_destroy (0x4b).
+type PlayToClientEntityDestroy struct {
+	EntityIDs []int32
+}
+
+func (*PlayToClientEntityDestroy) PacketID() int32 { return protocol.PlayToClientEntityDestroy }
+
+func (p *PlayToClientEntityDestroy) Decode(r io.Reader) (err error) {
+	if p.EntityIDs, err = readArray(protocol.ReadVarint)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityDestroy) Encode(w io.Writer) error {
+	if err := writeArray(protocol.WriteVarint)(w, p.EntityIDs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientRemoveEntityEffect is play toClient packet remove_entity_effect (0x4c).
+type PlayToClientRemoveEntityEffect struct {
+	EntityID int32
+	EffectID int32
+}
+
+func (*PlayToClientRemoveEntityEffect) PacketID() int32 {
+	return protocol.PlayToClientRemoveEntityEffect
+}
+
+func (p *PlayToClientRemoveEntityEffect) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.EffectID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientRemoveEntityEffect) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.EffectID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientResetScore is play toClient packet reset_score (0x4d).
+type PlayToClientResetScore struct {
+	EntityName    string
+	ObjectiveName *string
+}
+
+func (*PlayToClientResetScore) PacketID() int32 { return protocol.PlayToClientResetScore }
+
+func (p *PlayToClientResetScore) Decode(r io.Reader) (err error) {
+	if p.EntityName, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.ObjectiveName, err = readOption(protocol.ReadString)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientResetScore) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.EntityName); err != nil {
+		return err
+	}
+	if err := writeOption(protocol.WriteString)(w, p.ObjectiveName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientRemoveResourcePack is play toClient packet remove_resource_pack (0x4e).
+type PlayToClientRemoveResourcePack struct {
+	UUID *protocol.UUID
+}
+
+func (*PlayToClientRemoveResourcePack) PacketID() int32 {
+	return protocol.PlayToClientRemoveResourcePack
+}
+
+func (p *PlayToClientRemoveResourcePack) Decode(r io.Reader) (err error) {
+	if p.UUID, err = readOption(protocol.ReadUUID)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientRemoveResourcePack) Encode(w io.Writer) error {
+	if err := writeOption(protocol.WriteUUID)(w, p.UUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientAddResourcePack is play toClient packet add_resource_pack (0x4f).
+type PlayToClientAddResourcePack struct {
+	UUID          protocol.UUID
+	URL           string
+	Hash          string
+	Forced        bool
+	PromptMessage *[]byte
+}
+
+func (*PlayToClientAddResourcePack) PacketID() int32 { return protocol.PlayToClientAddResourcePack }
+
+func (p *PlayToClientAddResourcePack) Decode(r io.Reader) (err error) {
+	if p.UUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	if p.URL, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Hash, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Forced, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.PromptMessage, err = readOption(readRawNBT)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientAddResourcePack) Encode(w io.Writer) error {
+	if err := protocol.WriteUUID(w, p.UUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.URL); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Hash); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.Forced); err != nil {
+		return err
+	}
+	if err := writeOption(writeRawNBT)(w, p.PromptMessage); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientRespawn is play toClient packet respawn (0x50).
+type PlayToClientRespawn struct {
+	WorldState   SpawnInfo
+	CopyMetadata uint8
+}
+
+func (*PlayToClientRespawn) PacketID() int32 { return protocol.PlayToClientRespawn }
+
+func (p *PlayToClientRespawn) Decode(r io.Reader) (err error) {
+	if p.WorldState, err = readSpawnInfo(r); err != nil {
+		return err
+	}
+	if p.CopyMetadata, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientRespawn) Encode(w io.Writer) error {
+	if err := writeSpawnInfo(w, p.WorldState); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.CopyMetadata); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEntityHeadRotation is play toClient packet entity_head_rotation (0x51).
+type PlayToClientEntityHeadRotation struct {
+	EntityID int32
+	HeadYaw  int8
+}
+
+func (*PlayToClientEntityHeadRotation) PacketID() int32 {
+	return protocol.PlayToClientEntityHeadRotation
+}
+
+func (p *PlayToClientEntityHeadRotation) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.HeadYaw, err = readI8(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityHeadRotation) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.HeadYaw); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientMultiBlockChange is play toClient packet multi_block_change (0x52).
+type PlayToClientMultiBlockChange struct {
+	ChunkCoordinates uint64
+	Records          []int32
+}
+
+func (*PlayToClientMultiBlockChange) PacketID() int32 { return protocol.PlayToClientMultiBlockChange }
+
+func (p *PlayToClientMultiBlockChange) Decode(r io.Reader) (err error) {
+	if p.ChunkCoordinates, err = readU64(r); err != nil {
+		return err
+	}
+	if p.Records, err = readArray(protocol.ReadVarint)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientMultiBlockChange) Encode(w io.Writer) error {
+	if err := writeU64(w, p.ChunkCoordinates); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteVarint)(w, p.Records); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSelectAdvancementTab is play toClient packet select_advancement_tab (0x53).
+type PlayToClientSelectAdvancementTab struct {
+	ID *string
+}
+
+func (*PlayToClientSelectAdvancementTab) PacketID() int32 {
+	return protocol.PlayToClientSelectAdvancementTab
+}
+
+func (p *PlayToClientSelectAdvancementTab) Decode(r io.Reader) (err error) {
+	if p.ID, err = readOption(protocol.ReadString)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSelectAdvancementTab) Encode(w io.Writer) error {
+	if err := writeOption(protocol.WriteString)(w, p.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientServerData is play toClient packet server_data (0x54).
+type PlayToClientServerData struct {
+	Motd      []byte
+	IconBytes *[]byte
+}
+
+func (*PlayToClientServerData) PacketID() int32 { return protocol.PlayToClientServerData }
+
+func (p *PlayToClientServerData) Decode(r io.Reader) (err error) {
+	if p.Motd, err = readRawNBT(r); err != nil {
+		return err
+	}
+	if p.IconBytes, err = readOption(protocol.ReadByteArray)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientServerData) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Motd); err != nil {
+		return err
+	}
+	if err := writeOption(protocol.WriteByteArray)(w, p.IconBytes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientActionBar is play toClient packet action_bar (0x55).
+type PlayToClientActionBar struct {
+	Text []byte
+}
+
+func (*PlayToClientActionBar) PacketID() int32 { return protocol.PlayToClientActionBar }
+
+func (p *PlayToClientActionBar) Decode(r io.Reader) (err error) {
+	if p.Text, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientActionBar) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Text); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientWorldBorderCenter is play toClient packet world_border_center (0x56).
+type PlayToClientWorldBorderCenter struct {
+	X float64
+	Z float64
+}
+
+func (*PlayToClientWorldBorderCenter) PacketID() int32 { return protocol.PlayToClientWorldBorderCenter }
+
+func (p *PlayToClientWorldBorderCenter) Decode(r io.Reader) (err error) {
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientWorldBorderCenter) Encode(w io.Writer) error {
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientWorldBorderLerpSize is play toClient packet world_border_lerp_size (0x57).
+type PlayToClientWorldBorderLerpSize struct {
+	OldDiameter float64
+	NewDiameter float64
+	Speed       int32
+}
+
+func (*PlayToClientWorldBorderLerpSize) PacketID() int32 {
+	return protocol.PlayToClientWorldBorderLerpSize
+}
+
+func (p *PlayToClientWorldBorderLerpSize) Decode(r io.Reader) (err error) {
+	if p.OldDiameter, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.NewDiameter, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Speed, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientWorldBorderLerpSize) Encode(w io.Writer) error {
+	if err := protocol.WriteDouble(w, p.OldDiameter); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.NewDiameter); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Speed); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientWorldBorderSize is play toClient packet world_border_size (0x58).
+type PlayToClientWorldBorderSize struct {
+	Diameter float64
+}
+
+func (*PlayToClientWorldBorderSize) PacketID() int32 { return protocol.PlayToClientWorldBorderSize }
+
+func (p *PlayToClientWorldBorderSize) Decode(r io.Reader) (err error) {
+	if p.Diameter, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientWorldBorderSize) Encode(w io.Writer) error {
+	if err := protocol.WriteDouble(w, p.Diameter); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientWorldBorderWarningDelay is play toClient packet world_border_warning_delay (0x59).
+type PlayToClientWorldBorderWarningDelay struct {
+	WarningTime int32
+}
+
+func (*PlayToClientWorldBorderWarningDelay) PacketID() int32 {
+	return protocol.PlayToClientWorldBorderWarningDelay
+}
+
+func (p *PlayToClientWorldBorderWarningDelay) Decode(r io.Reader) (err error) {
+	if p.WarningTime, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientWorldBorderWarningDelay) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WarningTime); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientWorldBorderWarningReach is play toClient packet world_border_warning_reach (0x5a).
+type PlayToClientWorldBorderWarningReach struct {
+	WarningBlocks int32
+}
+
+func (*PlayToClientWorldBorderWarningReach) PacketID() int32 {
+	return protocol.PlayToClientWorldBorderWarningReach
+}
+
+func (p *PlayToClientWorldBorderWarningReach) Decode(r io.Reader) (err error) {
+	if p.WarningBlocks, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientWorldBorderWarningReach) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WarningBlocks); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientCamera is play toClient packet camera (0x5b).
+type PlayToClientCamera struct {
+	CameraID int32
+}
+
+func (*PlayToClientCamera) PacketID() int32 { return protocol.PlayToClientCamera }
+
+func (p *PlayToClientCamera) Decode(r io.Reader) (err error) {
+	if p.CameraID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientCamera) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.CameraID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientUpdateViewPosition is play toClient packet update_view_position (0x5c).
+type PlayToClientUpdateViewPosition struct {
+	ChunkX int32
+	ChunkZ int32
+}
+
+func (*PlayToClientUpdateViewPosition) PacketID() int32 {
+	return protocol.PlayToClientUpdateViewPosition
+}
+
+func (p *PlayToClientUpdateViewPosition) Decode(r io.Reader) (err error) {
+	if p.ChunkX, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ChunkZ, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientUpdateViewPosition) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.ChunkX); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.ChunkZ); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientUpdateViewDistance is play toClient packet update_view_distance (0x5d).
+type PlayToClientUpdateViewDistance struct {
+	ViewDistance int32
+}
+
+func (*PlayToClientUpdateViewDistance) PacketID() int32 {
+	return protocol.PlayToClientUpdateViewDistance
+}
+
+func (p *PlayToClientUpdateViewDistance) Decode(r io.Reader) (err error) {
+	if p.ViewDistance, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientUpdateViewDistance) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.ViewDistance); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSetCursorItem is play toClient packet set_cursor_item (0x5e).
+type PlayToClientSetCursorItem struct {
+	Contents protocol.Slot
+}
+
+func (*PlayToClientSetCursorItem) PacketID() int32 { return protocol.PlayToClientSetCursorItem }
+
+func (p *PlayToClientSetCursorItem) Decode(r io.Reader) (err error) {
+	if p.Contents, err = protocol.ReadSlot(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetCursorItem) Encode(w io.Writer) error {
+	if err := protocol.WriteSlot(w, p.Contents); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSpawnPosition is play toClient packet spawn_position (0x5f).
+type PlayToClientSpawnPosition struct {
+	GlobalPos GlobalPos
+	Yaw       float32
+	Pitch     float32
+}
+
+func (*PlayToClientSpawnPosition) PacketID() int32 { return protocol.PlayToClientSpawnPosition }
+
+func (p *PlayToClientSpawnPosition) Decode(r io.Reader) (err error) {
+	if p.GlobalPos, err = readGlobalPos(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSpawnPosition) Encode(w io.Writer) error {
+	if err := writeGlobalPos(w, p.GlobalPos); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Pitch); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientScoreboardDisplayObjective is play toClient packet scoreboard_display_objective (0x60).
+type PlayToClientScoreboardDisplayObjective struct {
+	Position int32
+	Name     string
+}
+
+func (*PlayToClientScoreboardDisplayObjective) PacketID() int32 {
+	return protocol.PlayToClientScoreboardDisplayObjective
+}
+
+func (p *PlayToClientScoreboardDisplayObjective) Decode(r io.Reader) (err error) {
+	if p.Position, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Name, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientScoreboardDisplayObjective) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Position); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Name); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEntityMetadata is play toClient packet entity_metadata (0x61).
+type PlayToClientEntityMetadata struct {
+	EntityID int32
+	// Unparsed holds the raw bytes from metadata on.
+	Unparsed []byte
+}
+
+func (*PlayToClientEntityMetadata) PacketID() int32 { return protocol.PlayToClientEntityMetadata }
+
+func (p *PlayToClientEntityMetadata) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityMetadata) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientAttachEntity is play toClient packet attach_entity (0x62).
+type PlayToClientAttachEntity struct {
+	EntityID  int32
+	VehicleID int32
+}
+
+func (*PlayToClientAttachEntity) PacketID() int32 { return protocol.PlayToClientAttachEntity }
+
+func (p *PlayToClientAttachEntity) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.VehicleID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientAttachEntity) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, p.VehicleID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEntityVelocity is play toClient packet entity_velocity (0x63).
+type PlayToClientEntityVelocity struct {
+	EntityID int32
+	// Unparsed holds the raw bytes from velocity on.
+	Unparsed []byte
+}
+
+func (*PlayToClientEntityVelocity) PacketID() int32 { return protocol.PlayToClientEntityVelocity }
+
+func (p *PlayToClientEntityVelocity) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityVelocity) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientEntityEquipment is play toClient packet entity_equipment (0x64).
+type PlayToClientEntityEquipment struct {
+	EntityID int32
+	// Unparsed holds the raw bytes from equipments on.
+	Unparsed []byte
+}
+
+func (*PlayToClientEntityEquipment) PacketID() int32 { return protocol.PlayToClientEntityEquipment }
+
+func (p *PlayToClientEntityEquipment) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityEquipment) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientExperience is play toClient packet experience (0x65).
+type PlayToClientExperience struct {
+	ExperienceBar   float32
+	Level           int32
+	TotalExperience int32
+}
+
+func (*PlayToClientExperience) PacketID() int32 { return protocol.PlayToClientExperience }
+
+func (p *PlayToClientExperience) Decode(r io.Reader) (err error) {
+	if p.ExperienceBar, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Level, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.TotalExperience, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientExperience) Encode(w io.Writer) error {
+	if err := protocol.WriteFloat(w, p.ExperienceBar); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Level); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.TotalExperience); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientUpdateHealth is play toClient packet update_health (0x66).
+type PlayToClientUpdateHealth struct {
+	Health         float32
+	Food           int32
+	FoodSaturation float32
+}
+
+func (*PlayToClientUpdateHealth) PacketID() int32 { return protocol.PlayToClientUpdateHealth }
+
+func (p *PlayToClientUpdateHealth) Decode(r io.Reader) (err error) {
+	if p.Health, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Food, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.FoodSaturation, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientUpdateHealth) Encode(w io.Writer) error {
+	if err := protocol.WriteFloat(w, p.Health); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Food); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.FoodSaturation); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientHeldItemSlot is play toClient packet held_item_slot (0x67).
+type PlayToClientHeldItemSlot struct {
+	Slot int32
+}
+
+func (*PlayToClientHeldItemSlot) PacketID() int32 { return protocol.PlayToClientHeldItemSlot }
+
+func (p *PlayToClientHeldItemSlot) Decode(r io.Reader) (err error) {
+	if p.Slot, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientHeldItemSlot) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Slot); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientScoreboardObjective is play toClient packet scoreboard_objective (0x68).
+type PlayToClientScoreboardObjective struct {
+	Name   string
+	Action int8
+	// Unparsed holds the raw bytes from displayText on.
+	Unparsed []byte
+}
+
+func (*PlayToClientScoreboardObjective) PacketID() int32 {
+	return protocol.PlayToClientScoreboardObjective
+}
+
+func (p *PlayToClientScoreboardObjective) Decode(r io.Reader) (err error) {
+	if p.Name, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Action, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientScoreboardObjective) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Name); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Action); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientSetPassengers is play toClient packet set_passengers (0x69).
+type PlayToClientSetPassengers struct {
+	EntityID   int32
+	Passengers []int32
+}
+
+func (*PlayToClientSetPassengers) PacketID() int32 { return protocol.PlayToClientSetPassengers }
+
+func (p *PlayToClientSetPassengers) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Passengers, err = readArray(protocol.ReadVarint)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetPassengers) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteVarint)(w, p.Passengers); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSetPlayerInventory is play toClient packet set_player_inventory (0x6a).
+type PlayToClientSetPlayerInventory struct {
+	SlotID   int32
+	Contents protocol.Slot
+}
+
+func (*PlayToClientSetPlayerInventory) PacketID() int32 {
+	return protocol.PlayToClientSetPlayerInventory
+}
+
+func (p *PlayToClientSetPlayerInventory) Decode(r io.Reader) (err error) {
+	if p.SlotID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Contents, err = protocol.ReadSlot(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetPlayerInventory) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.SlotID); err != nil {
+		return err
+	}
+	if err := protocol.WriteSlot(w, p.Contents); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientTeams is play toClient packet teams (0x6b).
+type PlayToClientTeams struct {
+	Team string
+	Mode int8
+	// Unparsed holds the raw bytes from an anonymous field on.
+	Unparsed []byte
+}
+
+func (*PlayToClientTeams) PacketID() int32 { return protocol.PlayToClientTeams }
+
+func (p *PlayToClientTeams) Decode(r io.Reader) (err error) {
+	if p.Team, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Mode, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientTeams) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Team); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Mode); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientScoreboardScore is play toClient packet scoreboard_score (0x6c).
+type PlayToClientScoreboardScore struct {
+	ItemName     string
+	ScoreName    string
+	Value        int32
+	DisplayName  *[]byte
+	NumberFormat *int32
+	// Unparsed holds the raw bytes from styling on.
+	Unparsed []byte
+}
+
+func (*PlayToClientScoreboardScore) PacketID() int32 { return protocol.PlayToClientScoreboardScore }
+
+func (p *PlayToClientScoreboardScore) Decode(r io.Reader) (err error) {
+	if p.ItemName, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.ScoreName, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Value, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.DisplayName, err = readOption(readRawNBT)(r); err != nil {
+		return err
+	}
+	if p.NumberFormat, err = readOption(protocol.ReadVarint)(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientScoreboardScore) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.ItemName); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.ScoreName); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Value); err != nil {
+		return err
+	}
+	if err := writeOption(writeRawNBT)(w, p.DisplayName); err != nil {
+		return err
+	}
+	if err := writeOption(protocol.WriteVarint)(w, p.NumberFormat); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientSimulationDistance is play toClient packet simulation_distance (0x6d).
+type PlayToClientSimulationDistance struct {
+	Distance int32
+}
+
+func (*PlayToClientSimulationDistance) PacketID() int32 {
+	return protocol.PlayToClientSimulationDistance
+}
+
+func (p *PlayToClientSimulationDistance) Decode(r io.Reader) (err error) {
+	if p.Distance, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSimulationDistance) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Distance); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSetTitleSubtitle is play toClient packet set_title_subtitle (0x6e).
+type PlayToClientSetTitleSubtitle struct {
+	Text []byte
+}
+
+func (*PlayToClientSetTitleSubtitle) PacketID() int32 { return protocol.PlayToClientSetTitleSubtitle }
+
+func (p *PlayToClientSetTitleSubtitle) Decode(r io.Reader) (err error) {
+	if p.Text, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetTitleSubtitle) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Text); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientUpdateTime is play toClient packet update_time (0x6f).
+type PlayToClientUpdateTime struct {
+	Age         int64
+	Time        int64
+	TickDayTime bool
+}
+
+func (*PlayToClientUpdateTime) PacketID() int32 { return protocol.PlayToClientUpdateTime }
+
+func (p *PlayToClientUpdateTime) Decode(r io.Reader) (err error) {
+	if p.Age, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	if p.Time, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	if p.TickDayTime, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientUpdateTime) Encode(w io.Writer) error {
+	if err := protocol.WriteInt64(w, p.Age); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt64(w, p.Time); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.TickDayTime); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSetTitleText is play toClient packet set_title_text (0x70).
+type PlayToClientSetTitleText struct {
+	Text []byte
+}
+
+func (*PlayToClientSetTitleText) PacketID() int32 { return protocol.PlayToClientSetTitleText }
+
+func (p *PlayToClientSetTitleText) Decode(r io.Reader) (err error) {
+	if p.Text, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetTitleText) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Text); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSetTitleTime is play toClient packet set_title_time (0x71).
+type PlayToClientSetTitleTime struct {
+	FadeIn  int32
+	Stay    int32
+	FadeOut int32
+}
+
+func (*PlayToClientSetTitleTime) PacketID() int32 { return protocol.PlayToClientSetTitleTime }
+
+func (p *PlayToClientSetTitleTime) Decode(r io.Reader) (err error) {
+	if p.FadeIn, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.Stay, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	if p.FadeOut, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetTitleTime) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.FadeIn); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, p.Stay); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, p.FadeOut); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEntitySoundEffect is play toClient packet entity_sound_effect (0x72).
+type PlayToClientEntitySoundEffect struct {
+	// Unparsed holds the raw bytes from sound on.
+	Unparsed []byte
+}
+
+func (*PlayToClientEntitySoundEffect) PacketID() int32 { return protocol.PlayToClientEntitySoundEffect }
+
+func (p *PlayToClientEntitySoundEffect) Decode(r io.Reader) (err error) {
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntitySoundEffect) Encode(w io.Writer) error {
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientSoundEffect is play toClient packet sound_effect (0x73).
+type PlayToClientSoundEffect struct {
+	// Unparsed holds the raw bytes from sound on.
+	Unparsed []byte
+}
+
+func (*PlayToClientSoundEffect) PacketID() int32 { return protocol.PlayToClientSoundEffect }
+
+func (p *PlayToClientSoundEffect) Decode(r io.Reader) (err error) {
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSoundEffect) Encode(w io.Writer) error {
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientStartConfiguration is play toClient packet start_configuration (0x74).
+type PlayToClientStartConfiguration struct {
+}
+
+func (*PlayToClientStartConfiguration) PacketID() int32 {
+	return protocol.PlayToClientStartConfiguration
+}
+
+func (_ *PlayToClientStartConfiguration) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *PlayToClientStartConfiguration) Encode(w io.Writer) error {
+	return nil
+}
+
+// PlayToClientStopSound is play toClient packet stop_sound (0x75).
+type PlayToClientStopSound struct {
+	Flags int8
+	// Unparsed holds the raw bytes from source on.
+	Unparsed []byte
+}
+
+func (*PlayToClientStopSound) PacketID() int32 { return protocol.PlayToClientStopSound }
+
+func (p *PlayToClientStopSound) Decode(r io.Reader) (err error) {
+	if p.Flags, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientStopSound) Encode(w io.Writer) error {
+	if err := writeI8(w, p.Flags); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientStoreCookie is play toClient packet store_cookie (0x76).
+type PlayToClientStoreCookie struct {
+	Key   string
+	Value []byte
+}
+
+func (*PlayToClientStoreCookie) PacketID() int32 { return protocol.PlayToClientStoreCookie }
+
+func (p *PlayToClientStoreCookie) Decode(r io.Reader) (err error) {
+	if p.Key, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Value, err = protocol.ReadByteArray(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientStoreCookie) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Key); err != nil {
+		return err
+	}
+	if err := protocol.WriteByteArray(w, p.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSystemChat is play toClient packet system_chat (0x77).
+type PlayToClientSystemChat struct {
+	Content     []byte
+	IsActionBar bool
+}
+
+func (*PlayToClientSystemChat) PacketID() int32 { return protocol.PlayToClientSystemChat }
+
+func (p *PlayToClientSystemChat) Decode(r io.Reader) (err error) {
+	if p.Content, err = readRawNBT(r); err != nil {
+		return err
+	}
+	if p.IsActionBar, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSystemChat) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Content); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.IsActionBar); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientPlayerlistHeader is play toClient packet playerlist_header (0x78).
+type PlayToClientPlayerlistHeader struct {
+	Header []byte
+	Footer []byte
+}
+
+func (*PlayToClientPlayerlistHeader) PacketID() int32 { return protocol.PlayToClientPlayerlistHeader }
+
+func (p *PlayToClientPlayerlistHeader) Decode(r io.Reader) (err error) {
+	if p.Header, err = readRawNBT(r); err != nil {
+		return err
+	}
+	if p.Footer, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientPlayerlistHeader) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Header); err != nil {
+		return err
+	}
+	if err := writeRawNBT(w, p.Footer); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientNBTQueryResponse is play toClient packet nbt_query_response (0x79).
+type PlayToClientNBTQueryResponse struct {
+	TransactionID int32
+	NBT           []byte
+}
+
+func (*PlayToClientNBTQueryResponse) PacketID() int32 { return protocol.PlayToClientNBTQueryResponse }
+
+func (p *PlayToClientNBTQueryResponse) Decode(r io.Reader) (err error) {
+	if p.TransactionID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.NBT, err = readRawNBT(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientNBTQueryResponse) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.TransactionID); err != nil {
+		return err
+	}
+	if err := writeRawNBT(w, p.NBT); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientCollect is play toClient packet collect (0x7a).
+type PlayToClientCollect struct {
+	CollectedEntityID int32
+	CollectorEntityID int32
+	PickupItemCount   int32
+}
+
+func (*PlayToClientCollect) PacketID() int32 { return protocol.PlayToClientCollect }
+
+func (p *PlayToClientCollect) Decode(r io.Reader) (err error) {
+	if p.CollectedEntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.CollectorEntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.PickupItemCount, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientCollect) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.CollectedEntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.CollectorEntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.PickupItemCount); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEntityTeleport is play toClient packet entity_teleport (0x7b).
+type PlayToClientEntityTeleport struct {
+	EntityID int32
+	X        float64
+	Y        float64
+	Z        float64
+	Yaw      int8
+	Pitch    int8
+	OnGround bool
+}
+
+func (*PlayToClientEntityTeleport) PacketID() int32 { return protocol.PlayToClientEntityTeleport }
+
+func (p *PlayToClientEntityTeleport) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = readI8(r); err != nil {
+		return err
+	}
+	if p.OnGround, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityTeleport) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Pitch); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.OnGround); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientTestInstanceBlockStatus is play toClient packet test_instance_block_status (0x7c).
+type PlayToClientTestInstanceBlockStatus struct {
+	Status []byte
+	Size   *Vec3i
+}
+
+func (*PlayToClientTestInstanceBlockStatus) PacketID() int32 {
+	return protocol.PlayToClientTestInstanceBlockStatus
+}
+
+func (p *PlayToClientTestInstanceBlockStatus) Decode(r io.Reader) (err error) {
+	if p.Status, err = readRawNBT(r); err != nil {
+		return err
+	}
+	if p.Size, err = readOption(readVec3i)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientTestInstanceBlockStatus) Encode(w io.Writer) error {
+	if err := writeRawNBT(w, p.Status); err != nil {
+		return err
+	}
+	if err := writeOption(writeVec3i)(w, p.Size); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSetTickingState is play toClient packet set_ticking_state (0x7d).
+type PlayToClientSetTickingState struct {
+	TickRate float32
+	IsFrozen bool
+}
+
+func (*PlayToClientSetTickingState) PacketID() int32 { return protocol.PlayToClientSetTickingState }
+
+func (p *PlayToClientSetTickingState) Decode(r io.Reader) (err error) {
+	if p.TickRate, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.IsFrozen, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetTickingState) Encode(w io.Writer) error {
+	if err := protocol.WriteFloat(w, p.TickRate); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.IsFrozen); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientStepTick is play toClient packet step_tick (0x7e).
+type PlayToClientStepTick struct {
+	TickSteps int32
+}
+
+func (*PlayToClientStepTick) PacketID() int32 { return protocol.PlayToClientStepTick }
+
+func (p *PlayToClientStepTick) Decode(r io.Reader) (err error) {
+	if p.TickSteps, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientStepTick) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.TickSteps); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientTransfer is play toClient packet transfer (0x7f).
+type PlayToClientTransfer struct {
+	Host string
+	Port int32
+}
+
+func (*PlayToClientTransfer) PacketID() int32 { return protocol.PlayToClientTransfer }
+
+func (p *PlayToClientTransfer) Decode(r io.Reader) (err error) {
+	if p.Host, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Port, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientTransfer) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Host); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Port); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientAdvancements is play toClient packet advancements (0x80).
+type PlayToClientAdvancements struct {
+	Reset bool
+	// Unparsed holds the raw bytes from advancementMapping on.
+	Unparsed []byte
+}
+
+func (*PlayToClientAdvancements) PacketID() int32 { return protocol.PlayToClientAdvancements }
+
+func (p *PlayToClientAdvancements) Decode(r io.Reader) (err error) {
+	if p.Reset, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientAdvancements) Encode(w io.Writer) error {
+	if err := protocol.WriteBool(w, p.Reset); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientEntityUpdateAttributes is play toClient packet entity_update_attributes (0x81).
+type PlayToClientEntityUpdateAttributes struct {
+	EntityID   int32
+	Properties []PlayToClientEntityUpdateAttributesProperties
+}
+
+func (*PlayToClientEntityUpdateAttributes) PacketID() int32 {
+	return protocol.PlayToClientEntityUpdateAttributes
+}
+
+func (p *PlayToClientEntityUpdateAttributes) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Properties, err = readArray(readPlayToClientEntityUpdateAttributesProperties)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityUpdateAttributes) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := writeArray(writePlayToClientEntityUpdateAttributesProperties)(w, p.Properties); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientEntityEffect is play toClient packet entity_effect (0x82).
+type PlayToClientEntityEffect struct {
+	EntityID  int32
+	EffectID  int32
+	Amplifier int32
+	Duration  int32
+	Flags     uint8
+}
+
+func (*PlayToClientEntityEffect) PacketID() int32 { return protocol.PlayToClientEntityEffect }
+
+func (p *PlayToClientEntityEffect) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.EffectID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Amplifier, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Duration, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Flags, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientEntityEffect) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.EffectID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Amplifier); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Duration); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Flags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientDeclareRecipes is play toClient packet declare_recipes (0x83).
+type PlayToClientDeclareRecipes struct {
+	Recipes []PlayToClientDeclareRecipesRecipes
+	// Unparsed holds the raw bytes from stoneCutterRecipes on.
+	Unparsed []byte
+}
+
+func (*PlayToClientDeclareRecipes) PacketID() int32 { return protocol.PlayToClientDeclareRecipes }
+
+func (p *PlayToClientDeclareRecipes) Decode(r io.Reader) (err error) {
+	if p.Recipes, err = readArray(readPlayToClientDeclareRecipesRecipes)(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientDeclareRecipes) Encode(w io.Writer) error {
+	if err := writeArray(writePlayToClientDeclareRecipesRecipes)(w, p.Recipes); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientTags is play toClient packet tags (0x84).
+type PlayToClientTags struct {
+	Tags []PlayToClientTagsTags
+}
+
+func (*PlayToClientTags) PacketID() int32 { return protocol.PlayToClientTags }
+
+func (p *PlayToClientTags) Decode(r io.Reader) (err error) {
+	if p.Tags, err = readArray(readPlayToClientTagsTags)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientTags) Encode(w io.Writer) error {
+	if err := writeArray(writePlayToClientTagsTags)(w, p.Tags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientSetProjectilePower is play toClient packet set_projectile_power (0x85).
+type PlayToClientSetProjectilePower struct {
+	ID                int32
+	AccelerationPower float64
+}
+
+func (*PlayToClientSetProjectilePower) PacketID() int32 {
+	return protocol.PlayToClientSetProjectilePower
+}
+
+func (p *PlayToClientSetProjectilePower) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.AccelerationPower, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientSetProjectilePower) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.ID); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.AccelerationPower); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientCustomReportDetails is play toClient packet custom_report_details (0x86).
+type PlayToClientCustomReportDetails struct {
+	Details []PlayToClientCustomReportDetailsDetails
+}
+
+func (*PlayToClientCustomReportDetails) PacketID() int32 {
+	return protocol.PlayToClientCustomReportDetails
+}
+
+func (p *PlayToClientCustomReportDetails) Decode(r io.Reader) (err error) {
+	if p.Details, err = readArray(readPlayToClientCustomReportDetailsDetails)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientCustomReportDetails) Encode(w io.Writer) error {
+	if err := writeArray(writePlayToClientCustomReportDetailsDetails)(w, p.Details); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToClientServerLinks is play toClient packet server_links (0x87).
+type PlayToClientServerLinks struct {
+	// Unparsed holds the raw bytes from links on.
+	Unparsed []byte
+}
+
+func (*PlayToClientServerLinks) PacketID() int32 { return protocol.PlayToClientServerLinks }
+
+func (p *PlayToClientServerLinks) Decode(r io.Reader) (err error) {
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientServerLinks) Encode(w io.Writer) error {
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientTrackedWaypoint is play toClient packet tracked_waypoint (0x88).
+type PlayToClientTrackedWaypoint struct {
+	Operation int32
+	// Unparsed holds the raw bytes from waypoint on.
+	Unparsed []byte
+}
+
+func (*PlayToClientTrackedWaypoint) PacketID() int32 { return protocol.PlayToClientTrackedWaypoint }
+
+func (p *PlayToClientTrackedWaypoint) Decode(r io.Reader) (err error) {
+	if p.Operation, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientTrackedWaypoint) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Operation); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToClientClearDialog is play toClient packet clear_dialog (0x89).
+type PlayToClientClearDialog struct {
+}
+
+func (*PlayToClientClearDialog) PacketID() int32 { return protocol.PlayToClientClearDialog }
+
+func (_ *PlayToClientClearDialog) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *PlayToClientClearDialog) Encode(w io.Writer) error {
+	return nil
+}
+
+// PlayToClientShowDialog is play toClient packet show_dialog (0x8a).
+type PlayToClientShowDialog struct {
+	// Unparsed holds the raw bytes from dialog on.
+	Unparsed []byte
+}
+
+func (*PlayToClientShowDialog) PacketID() int32 { return protocol.PlayToClientShowDialog }
+
+func (p *PlayToClientShowDialog) Decode(r io.Reader) (err error) {
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToClientShowDialog) Encode(w io.Writer) error {
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToServerTeleportConfirm is play toServer packet teleport_confirm (0x00).
+type PlayToServerTeleportConfirm struct {
+	TeleportID int32
+}
+
+func (*PlayToServerTeleportConfirm) PacketID() int32 { return protocol.PlayToServerTeleportConfirm }
+
+func (p *PlayToServerTeleportConfirm) Decode(r io.Reader) (err error) {
+	if p.TeleportID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerTeleportConfirm) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.TeleportID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerQueryBlockNBT is play toServer packet query_block_nbt (0x01).
+type PlayToServerQueryBlockNBT struct {
+	TransactionID int32
+	Location      protocol.BlockPos
+}
+
+func (*PlayToServerQueryBlockNBT) PacketID() int32 { return protocol.PlayToServerQueryBlockNBT }
+
+func (p *PlayToServerQueryBlockNBT) Decode(r io.Reader) (err error) {
+	if p.TransactionID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerQueryBlockNBT) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.TransactionID); err != nil {
+		return err
+	}
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerSelectBundleItem is play toServer packet select_bundle_item (0x02).
+type PlayToServerSelectBundleItem struct {
+	SlotID            int32
+	SelectedItemIndex int32
+}
+
+func (*PlayToServerSelectBundleItem) PacketID() int32 { return protocol.PlayToServerSelectBundleItem }
+
+func (p *PlayToServerSelectBundleItem) Decode(r io.Reader) (err error) {
+	if p.SlotID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.SelectedItemIndex, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSelectBundleItem) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.SlotID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.SelectedItemIndex); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerSetDifficulty is play toServer packet set_difficulty (0x03).
+type PlayToServerSetDifficulty struct {
+	NewDifficulty int32
+}
+
+func (*PlayToServerSetDifficulty) PacketID() int32 { return protocol.PlayToServerSetDifficulty }
+
+func (p *PlayToServerSetDifficulty) Decode(r io.Reader) (err error) {
+	if p.NewDifficulty, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSetDifficulty) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.NewDifficulty); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerChangeGamemode is play toServer packet change_gamemode (0x04).
+type PlayToServerChangeGamemode struct {
+	Mode int32
+}
+
+func (*PlayToServerChangeGamemode) PacketID() int32 { return protocol.PlayToServerChangeGamemode }
+
+func (p *PlayToServerChangeGamemode) Decode(r io.Reader) (err error) {
+	if p.Mode, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerChangeGamemode) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Mode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerMessageAcknowledgement is play toServer packet message_acknowledgement (0x05).
+type PlayToServerMessageAcknowledgement struct {
+	Count int32
+}
+
+func (*PlayToServerMessageAcknowledgement) PacketID() int32 {
+	return protocol.PlayToServerMessageAcknowledgement
+}
+
+func (p *PlayToServerMessageAcknowledgement) Decode(r io.Reader) (err error) {
+	if p.Count, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerMessageAcknowledgement) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Count); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerChatCommand is play toServer packet chat_command (0x06).
+type PlayToServerChatCommand struct {
+	Command string
+}
+
+func (*PlayToServerChatCommand) PacketID() int32 { return protocol.PlayToServerChatCommand }
+
+func (p *PlayToServerChatCommand) Decode(r io.Reader) (err error) {
+	if p.Command, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerChatCommand) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Command); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerChatCommandSigned is play toServer packet chat_command_signed (0x07).
+type PlayToServerChatCommandSigned struct {
+	Command            string
+	Timestamp          int64
+	Salt               int64
+	ArgumentSignatures []PlayToServerChatCommandSignedArgumentSignatures
+	MessageCount       int32
+	Acknowledged       []byte
+	Checksum           int8
+}
+
+func (*PlayToServerChatCommandSigned) PacketID() int32 { return protocol.PlayToServerChatCommandSigned }
+
+func (p *PlayToServerChatCommandSigned) Decode(r io.Reader) (err error) {
+	if p.Command, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Timestamp, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	if p.Salt, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	if p.ArgumentSignatures, err = readArray(readPlayToServerChatCommandSignedArgumentSignatures)(r); err != nil {
+		return err
+	}
+	if p.MessageCount, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Acknowledged, err = readFixedBytes(3)(r); err != nil {
+		return err
+	}
+	if p.Checksum, err = readI8(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerChatCommandSigned) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Command); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt64(w, p.Timestamp); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt64(w, p.Salt); err != nil {
+		return err
+	}
+	if err := writeArray(writePlayToServerChatCommandSignedArgumentSignatures)(w, p.ArgumentSignatures); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.MessageCount); err != nil {
+		return err
+	}
+	if err := writeFixedBytes(3)(w, p.Acknowledged); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Checksum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerChatMessage is play toServer packet chat_message (0x08).
+type PlayToServerChatMessage struct {
+	Message      string
+	Timestamp    int64
+	Salt         int64
+	Signature    *[]byte
+	Offset       int32
+	Acknowledged []byte
+	Checksum     uint8
+}
+
+func (*PlayToServerChatMessage) PacketID() int32 { return protocol.PlayToServerChatMessage }
+
+func (p *PlayToServerChatMessage) Decode(r io.Reader) (err error) {
+	if p.Message, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Timestamp, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	if p.Salt, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	if p.Signature, err = readOption(readFixedBytes(256))(r); err != nil {
+		return err
+	}
+	if p.Offset, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Acknowledged, err = readFixedBytes(3)(r); err != nil {
+		return err
+	}
+	if p.Checksum, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerChatMessage) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Message); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt64(w, p.Timestamp); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt64(w, p.Salt); err != nil {
+		return err
+	}
+	if err := writeOption(writeFixedBytes(256))(w, p.Signature); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Offset); err != nil {
+		return err
+	}
+	if err := writeFixedBytes(3)(w, p.Acknowledged); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Checksum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerChatSessionUpdate is play toServer packet chat_session_update (0x09).
+type PlayToServerChatSessionUpdate struct {
+	SessionUUID protocol.UUID
+	ExpireTime  int64
+	PublicKey   []byte
+	Signature   []byte
+}
+
+func (*PlayToServerChatSessionUpdate) PacketID() int32 { return protocol.PlayToServerChatSessionUpdate }
+
+func (p *PlayToServerChatSessionUpdate) Decode(r io.Reader) (err error) {
+	if p.SessionUUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	if p.ExpireTime, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	if p.PublicKey, err = protocol.ReadByteArray(r); err != nil {
+		return err
+	}
+	if p.Signature, err = protocol.ReadByteArray(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerChatSessionUpdate) Encode(w io.Writer) error {
+	if err := protocol.WriteUUID(w, p.SessionUUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt64(w, p.ExpireTime); err != nil {
+		return err
+	}
+	if err := protocol.WriteByteArray(w, p.PublicKey); err != nil {
+		return err
+	}
+	if err := protocol.WriteByteArray(w, p.Signature); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerChunkBatchReceived is play toServer packet chunk_batch_received (0x0a).
+type PlayToServerChunkBatchReceived struct {
+	ChunksPerTick float32
+}
+
+func (*PlayToServerChunkBatchReceived) PacketID() int32 {
+	return protocol.PlayToServerChunkBatchReceived
+}
+
+func (p *PlayToServerChunkBatchReceived) Decode(r io.Reader) (err error) {
+	if p.ChunksPerTick, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerChunkBatchReceived) Encode(w io.Writer) error {
+	if err := protocol.WriteFloat(w, p.ChunksPerTick); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerClientCommand is play toServer packet client_command (0x0b).
+type PlayToServerClientCommand struct {
+	ActionID int32
+}
+
+func (*PlayToServerClientCommand) PacketID() int32 { return protocol.PlayToServerClientCommand }
+
+func (p *PlayToServerClientCommand) Decode(r io.Reader) (err error) {
+	if p.ActionID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerClientCommand) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.ActionID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerTickEnd is play toServer packet tick_end (0x0c).
+type PlayToServerTickEnd struct {
+}
+
+func (*PlayToServerTickEnd) PacketID() int32 { return protocol.PlayToServerTickEnd }
+
+func (_ *PlayToServerTickEnd) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *PlayToServerTickEnd) Encode(w io.Writer) error {
+	return nil
+}
+
+// PlayToServerSettings is play toServer packet settings (0x0d).
+type PlayToServerSettings struct {
+	Locale              string
+	ViewDistance        int8
+	ChatFlags           int32
+	ChatColors          bool
+	SkinParts           uint8
+	MainHand            int32
+	EnableTextFiltering bool
+	EnableServerListing bool
+	ParticleStatus      int32
+}
+
+func (*PlayToServerSettings) PacketID() int32 { return protocol.PlayToServerSettings }
+
+func (p *PlayToServerSettings) Decode(r io.Reader) (err error) {
+	if p.Locale, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.ViewDistance, err = readI8(r); err != nil {
+		return err
+	}
+	if p.ChatFlags, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ChatColors, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.SkinParts, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	if p.MainHand, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.EnableTextFiltering, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.EnableServerListing, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.ParticleStatus, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSettings) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Locale); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.ViewDistance); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.ChatFlags); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.ChatColors); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.SkinParts); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.MainHand); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.EnableTextFiltering); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.EnableServerListing); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.ParticleStatus); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerTabComplete is play toServer packet tab_complete (0x0e).
+type PlayToServerTabComplete struct {
+	TransactionID int32
+	Text          string
+}
+
+func (*PlayToServerTabComplete) PacketID() int32 { return protocol.PlayToServerTabComplete }
+
+func (p *PlayToServerTabComplete) Decode(r io.Reader) (err error) {
+	if p.TransactionID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Text, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerTabComplete) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.TransactionID); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Text); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerConfigurationAcknowledged is play toServer packet configuration_acknowledged (0x0f).
+type PlayToServerConfigurationAcknowledged struct {
+}
+
+func (*PlayToServerConfigurationAcknowledged) PacketID() int32 {
+	return protocol.PlayToServerConfigurationAcknowledged
+}
+
+func (_ *PlayToServerConfigurationAcknowledged) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *PlayToServerConfigurationAcknowledged) Encode(w io.Writer) error {
+	return nil
+}
+
+// PlayToServerEnchantItem is play toServer packet enchant_item (0x10).
+type PlayToServerEnchantItem struct {
+	WindowID    int32
+	Enchantment int8
+}
+
+func (*PlayToServerEnchantItem) PacketID() int32 { return protocol.PlayToServerEnchantItem }
+
+func (p *PlayToServerEnchantItem) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Enchantment, err = readI8(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerEnchantItem) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Enchantment); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerWindowClick is play toServer packet window_click (0x11).
+type PlayToServerWindowClick struct {
+	WindowID     int32
+	StateID      int32
+	Slot         int16
+	MouseButton  int8
+	Mode         int32
+	ChangedSlots []PlayToServerWindowClickChangedSlots
+	CursorItem   *HashedSlot
+}
+
+func (*PlayToServerWindowClick) PacketID() int32 { return protocol.PlayToServerWindowClick }
+
+func (p *PlayToServerWindowClick) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.StateID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Slot, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.MouseButton, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Mode, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ChangedSlots, err = readArray(readPlayToServerWindowClickChangedSlots)(r); err != nil {
+		return err
+	}
+	if p.CursorItem, err = readOption(readHashedSlot)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerWindowClick) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.StateID); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt16(w, p.Slot); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.MouseButton); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Mode); err != nil {
+		return err
+	}
+	if err := writeArray(writePlayToServerWindowClickChangedSlots)(w, p.ChangedSlots); err != nil {
+		return err
+	}
+	if err := writeOption(writeHashedSlot)(w, p.CursorItem); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerCloseWindow is play toServer packet close_window (0x12).
+type PlayToServerCloseWindow struct {
+	WindowID int32
+}
+
+func (*PlayToServerCloseWindow) PacketID() int32 { return protocol.PlayToServerCloseWindow }
+
+func (p *PlayToServerCloseWindow) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerCloseWindow) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerSetSlotState is play toServer packet set_slot_state (0x13).
+type PlayToServerSetSlotState struct {
+	SlotID   int32
+	WindowID int32
+	State    bool
+}
+
+func (*PlayToServerSetSlotState) PacketID() int32 { return protocol.PlayToServerSetSlotState }
+
+func (p *PlayToServerSetSlotState) Decode(r io.Reader) (err error) {
+	if p.SlotID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.State, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSetSlotState) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.SlotID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.State); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerCookieResponse is play toServer packet cookie_response (0x14).
+type PlayToServerCookieResponse struct {
+	Key   string
+	Value *[]byte
+}
+
+func (*PlayToServerCookieResponse) PacketID() int32 { return protocol.PlayToServerCookieResponse }
+
+func (p *PlayToServerCookieResponse) Decode(r io.Reader) (err error) {
+	if p.Key, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Value, err = readOption(protocol.ReadByteArray)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerCookieResponse) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Key); err != nil {
+		return err
+	}
+	if err := writeOption(protocol.WriteByteArray)(w, p.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerCustomPayload is play toServer packet custom_payload (0x15).
+type PlayToServerCustomPayload struct {
+	Channel string
+	Data    []byte
+}
+
+func (*PlayToServerCustomPayload) PacketID() int32 { return protocol.PlayToServerCustomPayload }
+
+func (p *PlayToServerCustomPayload) Decode(r io.Reader) (err error) {
+	if p.Channel, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Data, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerCustomPayload) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Channel); err != nil {
+		return err
+	}
+	if err := writeRaw(w, p.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerDebugSubscriptionRequest is play toServer packet debug_subscription_request (0x16).
+type PlayToServerDebugSubscriptionRequest struct {
+	Subscriptions []int32
+}
+
+func (*PlayToServerDebugSubscriptionRequest) PacketID() int32 {
+	return protocol.PlayToServerDebugSubscriptionRequest
+}
+
+func (p *PlayToServerDebugSubscriptionRequest) Decode(r io.Reader) (err error) {
+	if p.Subscriptions, err = readArray(protocol.ReadVarint)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerDebugSubscriptionRequest) Encode(w io.Writer) error {
+	if err := writeArray(protocol.WriteVarint)(w, p.Subscriptions); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerEditBook is play toServer packet edit_book (0x17).
+type PlayToServerEditBook struct {
+	Hand  int32
+	Pages []string
+	Title *string
+}
+
+func (*PlayToServerEditBook) PacketID() int32 { return protocol.PlayToServerEditBook }
+
+func (p *PlayToServerEditBook) Decode(r io.Reader) (err error) {
+	if p.Hand, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Pages, err = readArray(protocol.ReadString)(r); err != nil {
+		return err
+	}
+	if p.Title, err = readOption(protocol.ReadString)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerEditBook) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Hand); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteString)(w, p.Pages); err != nil {
+		return err
+	}
+	if err := writeOption(protocol.WriteString)(w, p.Title); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerQueryEntityNBT is play toServer packet query_entity_nbt (0x18).
+type PlayToServerQueryEntityNBT struct {
+	TransactionID int32
+	EntityID      int32
+}
+
+func (*PlayToServerQueryEntityNBT) PacketID() int32 { return protocol.PlayToServerQueryEntityNBT }
+
+func (p *PlayToServerQueryEntityNBT) Decode(r io.Reader) (err error) {
+	if p.TransactionID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerQueryEntityNBT) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.TransactionID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerUseEntity is play toServer packet use_entity (0x19).
+type PlayToServerUseEntity struct {
+	Target int32
+	Mouse  int32
+	// Unparsed holds the raw bytes from x on.
+	Unparsed []byte
+}
+
+func (*PlayToServerUseEntity) PacketID() int32 { return protocol.PlayToServerUseEntity }
+
+func (p *PlayToServerUseEntity) Decode(r io.Reader) (err error) {
+	if p.Target, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Mouse, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerUseEntity) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Target); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Mouse); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToServerGenerateStructure is play toServer packet generate_structure (0x1a).
+type PlayToServerGenerateStructure struct {
+	Location    protocol.BlockPos
+	Levels      int32
+	KeepJigsaws bool
+}
+
+func (*PlayToServerGenerateStructure) PacketID() int32 { return protocol.PlayToServerGenerateStructure }
+
+func (p *PlayToServerGenerateStructure) Decode(r io.Reader) (err error) {
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Levels, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.KeepJigsaws, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerGenerateStructure) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Levels); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.KeepJigsaws); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerKeepAlive is play toServer packet keep_alive (0x1b).
+type PlayToServerKeepAlive struct {
+	KeepAliveID int64
+}
+
+func (*PlayToServerKeepAlive) PacketID() int32 { return protocol.PlayToServerKeepAlive }
+
+func (p *PlayToServerKeepAlive) Decode(r io.Reader) (err error) {
+	if p.KeepAliveID, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerKeepAlive) Encode(w io.Writer) error {
+	if err := protocol.WriteInt64(w, p.KeepAliveID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerLockDifficulty is play toServer packet lock_difficulty (0x1c).
+type PlayToServerLockDifficulty struct {
+	Locked bool
+}
+
+func (*PlayToServerLockDifficulty) PacketID() int32 { return protocol.PlayToServerLockDifficulty }
+
+func (p *PlayToServerLockDifficulty) Decode(r io.Reader) (err error) {
+	if p.Locked, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerLockDifficulty) Encode(w io.Writer) error {
+	if err := protocol.WriteBool(w, p.Locked); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerPosition is play toServer packet position (0x1d).
+type PlayToServerPosition struct {
+	X     float64
+	Y     float64
+	Z     float64
+	Flags uint8
+}
+
+func (*PlayToServerPosition) PacketID() int32 { return protocol.PlayToServerPosition }
+
+func (p *PlayToServerPosition) Decode(r io.Reader) (err error) {
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Flags, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerPosition) Encode(w io.Writer) error {
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Flags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerPositionLook is play toServer packet position_look (0x1e).
+type PlayToServerPositionLook struct {
+	X     float64
+	Y     float64
+	Z     float64
+	Yaw   float32
+	Pitch float32
+	Flags uint8
+}
+
+func (*PlayToServerPositionLook) PacketID() int32 { return protocol.PlayToServerPositionLook }
+
+func (p *PlayToServerPositionLook) Decode(r io.Reader) (err error) {
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Flags, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerPositionLook) Encode(w io.Writer) error {
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Pitch); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Flags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerLook is play toServer packet look (0x1f).
+type PlayToServerLook struct {
+	Yaw   float32
+	Pitch float32
+	Flags uint8
+}
+
+func (*PlayToServerLook) PacketID() int32 { return protocol.PlayToServerLook }
+
+func (p *PlayToServerLook) Decode(r io.Reader) (err error) {
+	if p.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Flags, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerLook) Encode(w io.Writer) error {
+	if err := protocol.WriteFloat(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Pitch); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Flags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerFlying is play toServer packet flying (0x20).
+type PlayToServerFlying struct {
+	Flags uint8
+}
+
+func (*PlayToServerFlying) PacketID() int32 { return protocol.PlayToServerFlying }
+
+func (p *PlayToServerFlying) Decode(r io.Reader) (err error) {
+	if p.Flags, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerFlying) Encode(w io.Writer) error {
+	if err := protocol.WriteByte(w, p.Flags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerVehicleMove is play toServer packet vehicle_move (0x21).
+type PlayToServerVehicleMove struct {
+	X        float64
+	Y        float64
+	Z        float64
+	Yaw      float32
+	Pitch    float32
+	OnGround bool
+}
+
+func (*PlayToServerVehicleMove) PacketID() int32 { return protocol.PlayToServerVehicleMove }
+
+func (p *PlayToServerVehicleMove) Decode(r io.Reader) (err error) {
+	if p.X, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Y, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Z, err = protocol.ReadDouble(r); err != nil {
+		return err
+	}
+	if p.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Pitch, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.OnGround, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerVehicleMove) Encode(w io.Writer) error {
+	if err := protocol.WriteDouble(w, p.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, p.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Yaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Pitch); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.OnGround); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerSteerBoat is play toServer packet steer_boat (0x22).
+type PlayToServerSteerBoat struct {
+	LeftPaddle  bool
+	RightPaddle bool
+}
+
+func (*PlayToServerSteerBoat) PacketID() int32 { return protocol.PlayToServerSteerBoat }
+
+func (p *PlayToServerSteerBoat) Decode(r io.Reader) (err error) {
+	if p.LeftPaddle, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.RightPaddle, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSteerBoat) Encode(w io.Writer) error {
+	if err := protocol.WriteBool(w, p.LeftPaddle); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.RightPaddle); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerPickItemFromBlock is play toServer packet pick_item_from_block (0x23).
+type PlayToServerPickItemFromBlock struct {
+	Position    protocol.BlockPos
+	IncludeData bool
+}
+
+func (*PlayToServerPickItemFromBlock) PacketID() int32 { return protocol.PlayToServerPickItemFromBlock }
+
+func (p *PlayToServerPickItemFromBlock) Decode(r io.Reader) (err error) {
+	if p.Position, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.IncludeData, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerPickItemFromBlock) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Position); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.IncludeData); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerPickItemFromEntity is play toServer packet pick_item_from_entity (0x24).
+type PlayToServerPickItemFromEntity struct {
+	EntityID    int32
+	IncludeData bool
+}
+
+func (*PlayToServerPickItemFromEntity) PacketID() int32 {
+	return protocol.PlayToServerPickItemFromEntity
+}
+
+func (p *PlayToServerPickItemFromEntity) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.IncludeData, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerPickItemFromEntity) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.IncludeData); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerPingRequest is play toServer packet ping_request (0x25).
+type PlayToServerPingRequest struct {
+	ID int64
+}
+
+func (*PlayToServerPingRequest) PacketID() int32 { return protocol.PlayToServerPingRequest }
+
+func (p *PlayToServerPingRequest) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadInt64(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerPingRequest) Encode(w io.Writer) error {
+	if err := protocol.WriteInt64(w, p.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerCraftRecipeRequest is play toServer packet craft_recipe_request (0x26).
+type PlayToServerCraftRecipeRequest struct {
+	WindowID int32
+	RecipeID int32
+	MakeAll  bool
+}
+
+func (*PlayToServerCraftRecipeRequest) PacketID() int32 {
+	return protocol.PlayToServerCraftRecipeRequest
+}
+
+func (p *PlayToServerCraftRecipeRequest) Decode(r io.Reader) (err error) {
+	if p.WindowID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.RecipeID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.MakeAll, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerCraftRecipeRequest) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.WindowID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.RecipeID); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.MakeAll); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerAbilities is play toServer packet abilities (0x27).
+type PlayToServerAbilities struct {
+	Flags int8
+}
+
+func (*PlayToServerAbilities) PacketID() int32 { return protocol.PlayToServerAbilities }
+
+func (p *PlayToServerAbilities) Decode(r io.Reader) (err error) {
+	if p.Flags, err = readI8(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerAbilities) Encode(w io.Writer) error {
+	if err := writeI8(w, p.Flags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerBlockDig is play toServer packet block_dig (0x28).
+type PlayToServerBlockDig struct {
+	Status   int32
+	Location protocol.BlockPos
+	Face     int8
+	Sequence int32
+}
+
+func (*PlayToServerBlockDig) PacketID() int32 { return protocol.PlayToServerBlockDig }
+
+func (p *PlayToServerBlockDig) Decode(r io.Reader) (err error) {
+	if p.Status, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Face, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Sequence, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerBlockDig) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Status); err != nil {
+		return err
+	}
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.Face); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Sequence); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerEntityAction is play toServer packet entity_action (0x29).
+type PlayToServerEntityAction struct {
+	EntityID  int32
+	ActionID  int32
+	JumpBoost int32
+}
+
+func (*PlayToServerEntityAction) PacketID() int32 { return protocol.PlayToServerEntityAction }
+
+func (p *PlayToServerEntityAction) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.ActionID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.JumpBoost, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerEntityAction) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.ActionID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.JumpBoost); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerPlayerInput is play toServer packet player_input (0x2a).
+type PlayToServerPlayerInput struct {
+	Inputs uint8
+}
+
+func (*PlayToServerPlayerInput) PacketID() int32 { return protocol.PlayToServerPlayerInput }
+
+func (p *PlayToServerPlayerInput) Decode(r io.Reader) (err error) {
+	if p.Inputs, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerPlayerInput) Encode(w io.Writer) error {
+	if err := protocol.WriteByte(w, p.Inputs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerPlayerLoaded is play toServer packet player_loaded (0x2b).
+type PlayToServerPlayerLoaded struct {
+}
+
+func (*PlayToServerPlayerLoaded) PacketID() int32 { return protocol.PlayToServerPlayerLoaded }
+
+func (_ *PlayToServerPlayerLoaded) Decode(r io.Reader) (err error) {
+	return nil
+}
+
+func (_ *PlayToServerPlayerLoaded) Encode(w io.Writer) error {
+	return nil
+}
+
+// PlayToServerPong is play toServer packet pong (0x2c).
+type PlayToServerPong struct {
+	ID int32
+}
+
+func (*PlayToServerPong) PacketID() int32 { return protocol.PlayToServerPong }
+
+func (p *PlayToServerPong) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadInt32(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerPong) Encode(w io.Writer) error {
+	if err := protocol.WriteInt32(w, p.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerRecipeBook is play toServer packet recipe_book (0x2d).
+type PlayToServerRecipeBook struct {
+	BookID       int32
+	BookOpen     bool
+	FilterActive bool
+}
+
+func (*PlayToServerRecipeBook) PacketID() int32 { return protocol.PlayToServerRecipeBook }
+
+func (p *PlayToServerRecipeBook) Decode(r io.Reader) (err error) {
+	if p.BookID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.BookOpen, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.FilterActive, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerRecipeBook) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.BookID); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.BookOpen); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.FilterActive); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerDisplayedRecipe is play toServer packet displayed_recipe (0x2e).
+type PlayToServerDisplayedRecipe struct {
+	RecipeID int32
+}
+
+func (*PlayToServerDisplayedRecipe) PacketID() int32 { return protocol.PlayToServerDisplayedRecipe }
+
+func (p *PlayToServerDisplayedRecipe) Decode(r io.Reader) (err error) {
+	if p.RecipeID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerDisplayedRecipe) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.RecipeID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerNameItem is play toServer packet name_item (0x2f).
+type PlayToServerNameItem struct {
+	Name string
+}
+
+func (*PlayToServerNameItem) PacketID() int32 { return protocol.PlayToServerNameItem }
+
+func (p *PlayToServerNameItem) Decode(r io.Reader) (err error) {
+	if p.Name, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerNameItem) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.Name); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerResourcePackReceive is play toServer packet resource_pack_receive (0x30).
+type PlayToServerResourcePackReceive struct {
+	UUID   protocol.UUID
+	Result int32
+}
+
+func (*PlayToServerResourcePackReceive) PacketID() int32 {
+	return protocol.PlayToServerResourcePackReceive
+}
+
+func (p *PlayToServerResourcePackReceive) Decode(r io.Reader) (err error) {
+	if p.UUID, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	if p.Result, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerResourcePackReceive) Encode(w io.Writer) error {
+	if err := protocol.WriteUUID(w, p.UUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Result); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerAdvancementTab is play toServer packet advancement_tab (0x31).
+type PlayToServerAdvancementTab struct {
+	Action int32
+	// Unparsed holds the raw bytes from tabId on.
+	Unparsed []byte
+}
+
+func (*PlayToServerAdvancementTab) PacketID() int32 { return protocol.PlayToServerAdvancementTab }
+
+func (p *PlayToServerAdvancementTab) Decode(r io.Reader) (err error) {
+	if p.Action, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerAdvancementTab) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Action); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToServerSelectTrade is play toServer packet select_trade (0x32).
+type PlayToServerSelectTrade struct {
+	Slot int32
+}
+
+func (*PlayToServerSelectTrade) PacketID() int32 { return protocol.PlayToServerSelectTrade }
+
+func (p *PlayToServerSelectTrade) Decode(r io.Reader) (err error) {
+	if p.Slot, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSelectTrade) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Slot); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerSetBeaconEffect is play toServer packet set_beacon_effect (0x33).
+type PlayToServerSetBeaconEffect struct {
+	PrimaryEffect   *int32
+	SecondaryEffect *int32
+}
+
+func (*PlayToServerSetBeaconEffect) PacketID() int32 { return protocol.PlayToServerSetBeaconEffect }
+
+func (p *PlayToServerSetBeaconEffect) Decode(r io.Reader) (err error) {
+	if p.PrimaryEffect, err = readOption(protocol.ReadVarint)(r); err != nil {
+		return err
+	}
+	if p.SecondaryEffect, err = readOption(protocol.ReadVarint)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSetBeaconEffect) Encode(w io.Writer) error {
+	if err := writeOption(protocol.WriteVarint)(w, p.PrimaryEffect); err != nil {
+		return err
+	}
+	if err := writeOption(protocol.WriteVarint)(w, p.SecondaryEffect); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerHeldItemSlot is play toServer packet held_item_slot (0x34).
+type PlayToServerHeldItemSlot struct {
+	SlotID int16
+}
+
+func (*PlayToServerHeldItemSlot) PacketID() int32 { return protocol.PlayToServerHeldItemSlot }
+
+func (p *PlayToServerHeldItemSlot) Decode(r io.Reader) (err error) {
+	if p.SlotID, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerHeldItemSlot) Encode(w io.Writer) error {
+	if err := protocol.WriteInt16(w, p.SlotID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerUpdateCommandBlock is play toServer packet update_command_block (0x35).
+type PlayToServerUpdateCommandBlock struct {
+	Location protocol.BlockPos
+	Command  string
+	Mode     int32
+	Flags    uint8
+}
+
+func (*PlayToServerUpdateCommandBlock) PacketID() int32 {
+	return protocol.PlayToServerUpdateCommandBlock
+}
+
+func (p *PlayToServerUpdateCommandBlock) Decode(r io.Reader) (err error) {
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Command, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Mode, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Flags, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerUpdateCommandBlock) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Command); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Mode); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Flags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerUpdateCommandBlockMinecart is play toServer packet update_command_block_minecart (0x36).
+type PlayToServerUpdateCommandBlockMinecart struct {
+	EntityID    int32
+	Command     string
+	TrackOutput bool
+}
+
+func (*PlayToServerUpdateCommandBlockMinecart) PacketID() int32 {
+	return protocol.PlayToServerUpdateCommandBlockMinecart
+}
+
+func (p *PlayToServerUpdateCommandBlockMinecart) Decode(r io.Reader) (err error) {
+	if p.EntityID, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Command, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.TrackOutput, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerUpdateCommandBlockMinecart) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.EntityID); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Command); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.TrackOutput); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerSetCreativeSlot is play toServer packet set_creative_slot (0x37).
+type PlayToServerSetCreativeSlot struct {
+	Slot int16
+	// Unparsed holds the raw bytes from item on.
+	Unparsed []byte
+}
+
+func (*PlayToServerSetCreativeSlot) PacketID() int32 { return protocol.PlayToServerSetCreativeSlot }
+
+func (p *PlayToServerSetCreativeSlot) Decode(r io.Reader) (err error) {
+	if p.Slot, err = protocol.ReadInt16(r); err != nil {
+		return err
+	}
+	if p.Unparsed, err = readRest(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSetCreativeSlot) Encode(w io.Writer) error {
+	if err := protocol.WriteInt16(w, p.Slot); err != nil {
+		return err
+	}
+	return writeRaw(w, p.Unparsed)
+}
+
+// PlayToServerUpdateJigsawBlock is play toServer packet update_jigsaw_block (0x38).
+type PlayToServerUpdateJigsawBlock struct {
+	Location          protocol.BlockPos
+	Name              string
+	Target            string
+	Pool              string
+	FinalState        string
+	JointType         string
+	SelectionPriority int32
+	PlacementPriority int32
+}
+
+func (*PlayToServerUpdateJigsawBlock) PacketID() int32 { return protocol.PlayToServerUpdateJigsawBlock }
+
+func (p *PlayToServerUpdateJigsawBlock) Decode(r io.Reader) (err error) {
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Name, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Target, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Pool, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.FinalState, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.JointType, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.SelectionPriority, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.PlacementPriority, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerUpdateJigsawBlock) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Name); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Target); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Pool); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.FinalState); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.JointType); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.SelectionPriority); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.PlacementPriority); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerUpdateStructureBlock is play toServer packet update_structure_block (0x39).
+type PlayToServerUpdateStructureBlock struct {
+	Location  protocol.BlockPos
+	Action    int32
+	Mode      int32
+	Name      string
+	OffsetX   int8
+	OffsetY   int8
+	OffsetZ   int8
+	SizeX     int8
+	SizeY     int8
+	SizeZ     int8
+	Mirror    int32
+	Rotation  int32
+	Metadata  string
+	Integrity float32
+	Seed      int32
+	Flags     uint8
+}
+
+func (*PlayToServerUpdateStructureBlock) PacketID() int32 {
+	return protocol.PlayToServerUpdateStructureBlock
+}
+
+func (p *PlayToServerUpdateStructureBlock) Decode(r io.Reader) (err error) {
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Action, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Mode, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Name, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.OffsetX, err = readI8(r); err != nil {
+		return err
+	}
+	if p.OffsetY, err = readI8(r); err != nil {
+		return err
+	}
+	if p.OffsetZ, err = readI8(r); err != nil {
+		return err
+	}
+	if p.SizeX, err = readI8(r); err != nil {
+		return err
+	}
+	if p.SizeY, err = readI8(r); err != nil {
+		return err
+	}
+	if p.SizeZ, err = readI8(r); err != nil {
+		return err
+	}
+	if p.Mirror, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Rotation, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Metadata, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Integrity, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.Seed, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Flags, err = protocol.ReadByte(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerUpdateStructureBlock) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Action); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Mode); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Name); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.OffsetX); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.OffsetY); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.OffsetZ); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.SizeX); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.SizeY); err != nil {
+		return err
+	}
+	if err := writeI8(w, p.SizeZ); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Mirror); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Rotation); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Metadata); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.Integrity); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Seed); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, p.Flags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerSetTestBlock is play toServer packet set_test_block (0x3a).
+type PlayToServerSetTestBlock struct {
+	Position protocol.BlockPos
+	Mode     int32
+	Message  string
+}
+
+func (*PlayToServerSetTestBlock) PacketID() int32 { return protocol.PlayToServerSetTestBlock }
+
+func (p *PlayToServerSetTestBlock) Decode(r io.Reader) (err error) {
+	if p.Position, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Mode, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Message, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSetTestBlock) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Position); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Mode); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerUpdateSign is play toServer packet update_sign (0x3b).
+type PlayToServerUpdateSign struct {
+	Location    protocol.BlockPos
+	IsFrontText bool
+	Text1       string
+	Text2       string
+	Text3       string
+	Text4       string
+}
+
+func (*PlayToServerUpdateSign) PacketID() int32 { return protocol.PlayToServerUpdateSign }
+
+func (p *PlayToServerUpdateSign) Decode(r io.Reader) (err error) {
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.IsFrontText, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.Text1, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Text2, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Text3, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.Text4, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerUpdateSign) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.IsFrontText); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Text1); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Text2); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Text3); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, p.Text4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerArmAnimation is play toServer packet arm_animation (0x3c).
+type PlayToServerArmAnimation struct {
+	Hand int32
+}
+
+func (*PlayToServerArmAnimation) PacketID() int32 { return protocol.PlayToServerArmAnimation }
+
+func (p *PlayToServerArmAnimation) Decode(r io.Reader) (err error) {
+	if p.Hand, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerArmAnimation) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Hand); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerSpectate is play toServer packet spectate (0x3d).
+type PlayToServerSpectate struct {
+	Target protocol.UUID
+}
+
+func (*PlayToServerSpectate) PacketID() int32 { return protocol.PlayToServerSpectate }
+
+func (p *PlayToServerSpectate) Decode(r io.Reader) (err error) {
+	if p.Target, err = protocol.ReadUUID(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerSpectate) Encode(w io.Writer) error {
+	if err := protocol.WriteUUID(w, p.Target); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerTestInstanceBlockAction is play toServer packet test_instance_block_action (0x3e).
+type PlayToServerTestInstanceBlockAction struct {
+	Pos    protocol.BlockPos
+	Action int32
+	Data   PlayToServerTestInstanceBlockActionData
+}
+
+func (*PlayToServerTestInstanceBlockAction) PacketID() int32 {
+	return protocol.PlayToServerTestInstanceBlockAction
+}
+
+func (p *PlayToServerTestInstanceBlockAction) Decode(r io.Reader) (err error) {
+	if p.Pos, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Action, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Data, err = readPlayToServerTestInstanceBlockActionData(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerTestInstanceBlockAction) Encode(w io.Writer) error {
+	if err := protocol.WriteBlockPos(w, p.Pos); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Action); err != nil {
+		return err
+	}
+	if err := writePlayToServerTestInstanceBlockActionData(w, p.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerBlockPlace is play toServer packet block_place (0x3f).
+type PlayToServerBlockPlace struct {
+	Hand           int32
+	Location       protocol.BlockPos
+	Direction      int32
+	CursorX        float32
+	CursorY        float32
+	CursorZ        float32
+	InsideBlock    bool
+	WorldBorderHit bool
+	Sequence       int32
+}
+
+func (*PlayToServerBlockPlace) PacketID() int32 { return protocol.PlayToServerBlockPlace }
+
+func (p *PlayToServerBlockPlace) Decode(r io.Reader) (err error) {
+	if p.Hand, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return err
+	}
+	if p.Direction, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.CursorX, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.CursorY, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.CursorZ, err = protocol.ReadFloat(r); err != nil {
+		return err
+	}
+	if p.InsideBlock, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.WorldBorderHit, err = protocol.ReadBool(r); err != nil {
+		return err
+	}
+	if p.Sequence, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerBlockPlace) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Hand); err != nil {
+		return err
+	}
+	if err := protocol.WriteBlockPos(w, p.Location); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Direction); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.CursorX); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.CursorY); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, p.CursorZ); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.InsideBlock); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, p.WorldBorderHit); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Sequence); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerUseItem is play toServer packet use_item (0x40).
+type PlayToServerUseItem struct {
+	Hand     int32
+	Sequence int32
+	Rotation Vec2f
+}
+
+func (*PlayToServerUseItem) PacketID() int32 { return protocol.PlayToServerUseItem }
+
+func (p *PlayToServerUseItem) Decode(r io.Reader) (err error) {
+	if p.Hand, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Sequence, err = protocol.ReadVarint(r); err != nil {
+		return err
+	}
+	if p.Rotation, err = readVec2f(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerUseItem) Encode(w io.Writer) error {
+	if err := protocol.WriteVarint(w, p.Hand); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, p.Sequence); err != nil {
+		return err
+	}
+	if err := writeVec2f(w, p.Rotation); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayToServerCustomClickAction is play toServer packet custom_click_action (0x41).
+type PlayToServerCustomClickAction struct {
+	ID  string
+	NBT *[]byte
+}
+
+func (*PlayToServerCustomClickAction) PacketID() int32 { return protocol.PlayToServerCustomClickAction }
+
+func (p *PlayToServerCustomClickAction) Decode(r io.Reader) (err error) {
+	if p.ID, err = protocol.ReadString(r); err != nil {
+		return err
+	}
+	if p.NBT, err = readOption(readRawNBT)(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PlayToServerCustomClickAction) Encode(w io.Writer) error {
+	if err := protocol.WriteString(w, p.ID); err != nil {
+		return err
+	}
+	if err := writeOption(writeRawNBT)(w, p.NBT); err != nil {
+		return err
+	}
+	return nil
+}
+
+type LoginToClientSuccessProperties struct {
+	Name      string
+	Value     string
+	Signature *string
+}
+
+func readLoginToClientSuccessProperties(r io.Reader) (v LoginToClientSuccessProperties, err error) {
+	if v.Name, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Value, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Signature, err = readOption(protocol.ReadString)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeLoginToClientSuccessProperties(w io.Writer, v LoginToClientSuccessProperties) error {
+	if err := protocol.WriteString(w, v.Name); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, v.Value); err != nil {
+		return err
+	}
+	if err := writeOption(protocol.WriteString)(w, v.Signature); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ConfigurationToClientRegistryDataEntries struct {
+	Key   string
+	Value *[]byte
+}
+
+func readConfigurationToClientRegistryDataEntries(r io.Reader) (v ConfigurationToClientRegistryDataEntries, err error) {
+	if v.Key, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Value, err = readOption(readRawNBT)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeConfigurationToClientRegistryDataEntries(w io.Writer, v ConfigurationToClientRegistryDataEntries) error {
+	if err := protocol.WriteString(w, v.Key); err != nil {
+		return err
+	}
+	if err := writeOption(writeRawNBT)(w, v.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+type Tags struct {
+	TagName string
+	Entries []int32
+}
+
+func readTags(r io.Reader) (v Tags, err error) {
+	if v.TagName, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Entries, err = readArray(protocol.ReadVarint)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeTags(w io.Writer, v Tags) error {
+	if err := protocol.WriteString(w, v.TagName); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteVarint)(w, v.Entries); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ConfigurationToClientTagsTags struct {
+	TagType string
+	Tags    []Tags
+}
+
+func readConfigurationToClientTagsTags(r io.Reader) (v ConfigurationToClientTagsTags, err error) {
+	if v.TagType, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Tags, err = readArray(readTags)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeConfigurationToClientTagsTags(w io.Writer, v ConfigurationToClientTagsTags) error {
+	if err := protocol.WriteString(w, v.TagType); err != nil {
+		return err
+	}
+	if err := writeArray(writeTags)(w, v.Tags); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ConfigurationToClientSelectKnownPacksPacks struct {
+	Namespace string
+	ID        string
+	Version   string
+}
+
+func readConfigurationToClientSelectKnownPacksPacks(r io.Reader) (v ConfigurationToClientSelectKnownPacksPacks, err error) {
+	if v.Namespace, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.ID, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Version, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeConfigurationToClientSelectKnownPacksPacks(w io.Writer, v ConfigurationToClientSelectKnownPacksPacks) error {
+	if err := protocol.WriteString(w, v.Namespace); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, v.ID); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, v.Version); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ConfigurationToClientCustomReportDetailsDetails struct {
+	Key   string
+	Value string
+}
+
+func readConfigurationToClientCustomReportDetailsDetails(r io.Reader) (v ConfigurationToClientCustomReportDetailsDetails, err error) {
+	if v.Key, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Value, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeConfigurationToClientCustomReportDetailsDetails(w io.Writer, v ConfigurationToClientCustomReportDetailsDetails) error {
+	if err := protocol.WriteString(w, v.Key); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, v.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ConfigurationToServerSelectKnownPacksPacks struct {
+	Namespace string
+	ID        string
+	Version   string
+}
+
+func readConfigurationToServerSelectKnownPacksPacks(r io.Reader) (v ConfigurationToServerSelectKnownPacksPacks, err error) {
+	if v.Namespace, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.ID, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Version, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeConfigurationToServerSelectKnownPacksPacks(w io.Writer, v ConfigurationToServerSelectKnownPacksPacks) error {
+	if err := protocol.WriteString(w, v.Namespace); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, v.ID); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, v.Version); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientStatisticsEntries struct {
+	CategoryID  int32
+	StatisticID int32
+	Value       int32
+}
+
+func readPlayToClientStatisticsEntries(r io.Reader) (v PlayToClientStatisticsEntries, err error) {
+	if v.CategoryID, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.StatisticID, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.Value, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientStatisticsEntries(w io.Writer, v PlayToClientStatisticsEntries) error {
+	if err := protocol.WriteVarint(w, v.CategoryID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, v.StatisticID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, v.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PackedChunkPos struct {
+	Z int32
+	X int32
+}
+
+func readPackedChunkPos(r io.Reader) (v PackedChunkPos, err error) {
+	if v.Z, err = protocol.ReadInt32(r); err != nil {
+		return v, err
+	}
+	if v.X, err = protocol.ReadInt32(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePackedChunkPos(w io.Writer, v PackedChunkPos) error {
+	if err := protocol.WriteInt32(w, v.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, v.X); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientChunkBiomesBiomes struct {
+	Position PackedChunkPos
+	Data     []byte
+}
+
+func readPlayToClientChunkBiomesBiomes(r io.Reader) (v PlayToClientChunkBiomesBiomes, err error) {
+	if v.Position, err = readPackedChunkPos(r); err != nil {
+		return v, err
+	}
+	if v.Data, err = protocol.ReadByteArray(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientChunkBiomesBiomes(w io.Writer, v PlayToClientChunkBiomesBiomes) error {
+	if err := writePackedChunkPos(w, v.Position); err != nil {
+		return err
+	}
+	if err := protocol.WriteByteArray(w, v.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientTabCompleteMatches struct {
+	Match   string
+	Tooltip *[]byte
+}
+
+func readPlayToClientTabCompleteMatches(r io.Reader) (v PlayToClientTabCompleteMatches, err error) {
+	if v.Match, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Tooltip, err = readOption(readRawNBT)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientTabCompleteMatches(w io.Writer, v PlayToClientTabCompleteMatches) error {
+	if err := protocol.WriteString(w, v.Match); err != nil {
+		return err
+	}
+	if err := writeOption(writeRawNBT)(w, v.Tooltip); err != nil {
+		return err
+	}
+	return nil
+}
+
+type Vec3f64 struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+func readVec3f64(r io.Reader) (v Vec3f64, err error) {
+	if v.X, err = protocol.ReadDouble(r); err != nil {
+		return v, err
+	}
+	if v.Y, err = protocol.ReadDouble(r); err != nil {
+		return v, err
+	}
+	if v.Z, err = protocol.ReadDouble(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeVec3f64(w io.Writer, v Vec3f64) error {
+	if err := protocol.WriteDouble(w, v.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, v.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, v.Z); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientMapChunkHeightmaps struct {
+	Type int32
+	Data []int64
+}
+
+func readPlayToClientMapChunkHeightmaps(r io.Reader) (v PlayToClientMapChunkHeightmaps, err error) {
+	if v.Type, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.Data, err = readArray(protocol.ReadInt64)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientMapChunkHeightmaps(w io.Writer, v PlayToClientMapChunkHeightmaps) error {
+	if err := protocol.WriteVarint(w, v.Type); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteInt64)(w, v.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GlobalPos struct {
+	DimensionName string
+	Location      protocol.BlockPos
+}
+
+func readGlobalPos(r io.Reader) (v GlobalPos, err error) {
+	if v.DimensionName, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Location, err = protocol.ReadBlockPos(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeGlobalPos(w io.Writer, v GlobalPos) error {
+	if err := protocol.WriteString(w, v.DimensionName); err != nil {
+		return err
+	}
+	if err := protocol.WriteBlockPos(w, v.Location); err != nil {
+		return err
+	}
+	return nil
+}
+
+type SpawnInfo struct {
+	Dimension        int32
+	Name             string
+	HashedSeed       int64
+	Gamemode         int8
+	PreviousGamemode uint8
+	IsDebug          bool
+	IsFlat           bool
+	Death            *GlobalPos
+	PortalCooldown   int32
+	SeaLevel         int32
+}
+
+func readSpawnInfo(r io.Reader) (v SpawnInfo, err error) {
+	if v.Dimension, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.Name, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.HashedSeed, err = protocol.ReadInt64(r); err != nil {
+		return v, err
+	}
+	if v.Gamemode, err = readI8(r); err != nil {
+		return v, err
+	}
+	if v.PreviousGamemode, err = protocol.ReadByte(r); err != nil {
+		return v, err
+	}
+	if v.IsDebug, err = protocol.ReadBool(r); err != nil {
+		return v, err
+	}
+	if v.IsFlat, err = protocol.ReadBool(r); err != nil {
+		return v, err
+	}
+	if v.Death, err = readOption(readGlobalPos)(r); err != nil {
+		return v, err
+	}
+	if v.PortalCooldown, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.SeaLevel, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeSpawnInfo(w io.Writer, v SpawnInfo) error {
+	if err := protocol.WriteVarint(w, v.Dimension); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, v.Name); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt64(w, v.HashedSeed); err != nil {
+		return err
+	}
+	if err := writeI8(w, v.Gamemode); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, v.PreviousGamemode); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, v.IsDebug); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, v.IsFlat); err != nil {
+		return err
+	}
+	if err := writeOption(writeGlobalPos)(w, v.Death); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, v.PortalCooldown); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, v.SeaLevel); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientMapIcons struct {
+	Type        int32
+	X           int8
+	Z           int8
+	Direction   uint8
+	DisplayName *[]byte
+}
+
+func readPlayToClientMapIcons(r io.Reader) (v PlayToClientMapIcons, err error) {
+	if v.Type, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.X, err = readI8(r); err != nil {
+		return v, err
+	}
+	if v.Z, err = readI8(r); err != nil {
+		return v, err
+	}
+	if v.Direction, err = protocol.ReadByte(r); err != nil {
+		return v, err
+	}
+	if v.DisplayName, err = readOption(readRawNBT)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientMapIcons(w io.Writer, v PlayToClientMapIcons) error {
+	if err := protocol.WriteVarint(w, v.Type); err != nil {
+		return err
+	}
+	if err := writeI8(w, v.X); err != nil {
+		return err
+	}
+	if err := writeI8(w, v.Z); err != nil {
+		return err
+	}
+	if err := protocol.WriteByte(w, v.Direction); err != nil {
+		return err
+	}
+	if err := writeOption(writeRawNBT)(w, v.DisplayName); err != nil {
+		return err
+	}
+	return nil
+}
+
+type Vec3f struct {
+	X float32
+	Y float32
+	Z float32
+}
+
+func readVec3f(r io.Reader) (v Vec3f, err error) {
+	if v.X, err = protocol.ReadFloat(r); err != nil {
+		return v, err
+	}
+	if v.Y, err = protocol.ReadFloat(r); err != nil {
+		return v, err
+	}
+	if v.Z, err = protocol.ReadFloat(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeVec3f(w io.Writer, v Vec3f) error {
+	if err := protocol.WriteFloat(w, v.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, v.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, v.Z); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientMoveMinecartSteps struct {
+	Position Vec3f
+	Velocity Vec3f
+	Yaw      float32
+	Pitch    float32
+	Weight   float32
+}
+
+func readPlayToClientMoveMinecartSteps(r io.Reader) (v PlayToClientMoveMinecartSteps, err error) {
+	if v.Position, err = readVec3f(r); err != nil {
+		return v, err
+	}
+	if v.Velocity, err = readVec3f(r); err != nil {
+		return v, err
+	}
+	if v.Yaw, err = protocol.ReadFloat(r); err != nil {
+		return v, err
+	}
+	if v.Pitch, err = protocol.ReadFloat(r); err != nil {
+		return v, err
+	}
+	if v.Weight, err = protocol.ReadFloat(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientMoveMinecartSteps(w io.Writer, v PlayToClientMoveMinecartSteps) error {
+	if err := writeVec3f(w, v.Position); err != nil {
+		return err
+	}
+	if err := writeVec3f(w, v.Velocity); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, v.Yaw); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, v.Pitch); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, v.Weight); err != nil {
+		return err
+	}
+	return nil
+}
+
+type RecipeBookSetting struct {
+	Open      bool
+	Filtering bool
+}
+
+func readRecipeBookSetting(r io.Reader) (v RecipeBookSetting, err error) {
+	if v.Open, err = protocol.ReadBool(r); err != nil {
+		return v, err
+	}
+	if v.Filtering, err = protocol.ReadBool(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeRecipeBookSetting(w io.Writer, v RecipeBookSetting) error {
+	if err := protocol.WriteBool(w, v.Open); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, v.Filtering); err != nil {
+		return err
+	}
+	return nil
+}
+
+type Vec3i struct {
+	X int32
+	Y int32
+	Z int32
+}
+
+func readVec3i(r io.Reader) (v Vec3i, err error) {
+	if v.X, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.Y, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.Z, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeVec3i(w io.Writer, v Vec3i) error {
+	if err := protocol.WriteVarint(w, v.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, v.Y); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, v.Z); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientEntityUpdateAttributesPropertiesModifiers struct {
+	UUID      string
+	Amount    float64
+	Operation int8
+}
+
+func readPlayToClientEntityUpdateAttributesPropertiesModifiers(r io.Reader) (v PlayToClientEntityUpdateAttributesPropertiesModifiers, err error) {
+	if v.UUID, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Amount, err = protocol.ReadDouble(r); err != nil {
+		return v, err
+	}
+	if v.Operation, err = readI8(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientEntityUpdateAttributesPropertiesModifiers(w io.Writer, v PlayToClientEntityUpdateAttributesPropertiesModifiers) error {
+	if err := protocol.WriteString(w, v.UUID); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, v.Amount); err != nil {
+		return err
+	}
+	if err := writeI8(w, v.Operation); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientEntityUpdateAttributesProperties struct {
+	Key       int32
+	Value     float64
+	Modifiers []PlayToClientEntityUpdateAttributesPropertiesModifiers
+}
+
+func readPlayToClientEntityUpdateAttributesProperties(r io.Reader) (v PlayToClientEntityUpdateAttributesProperties, err error) {
+	if v.Key, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.Value, err = protocol.ReadDouble(r); err != nil {
+		return v, err
+	}
+	if v.Modifiers, err = readArray(readPlayToClientEntityUpdateAttributesPropertiesModifiers)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientEntityUpdateAttributesProperties(w io.Writer, v PlayToClientEntityUpdateAttributesProperties) error {
+	if err := protocol.WriteVarint(w, v.Key); err != nil {
+		return err
+	}
+	if err := protocol.WriteDouble(w, v.Value); err != nil {
+		return err
+	}
+	if err := writeArray(writePlayToClientEntityUpdateAttributesPropertiesModifiers)(w, v.Modifiers); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientDeclareRecipesRecipes struct {
+	Name  string
+	Items []int32
+}
+
+func readPlayToClientDeclareRecipesRecipes(r io.Reader) (v PlayToClientDeclareRecipesRecipes, err error) {
+	if v.Name, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Items, err = readArray(protocol.ReadVarint)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientDeclareRecipesRecipes(w io.Writer, v PlayToClientDeclareRecipesRecipes) error {
+	if err := protocol.WriteString(w, v.Name); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteVarint)(w, v.Items); err != nil {
+		return err
+	}
+	return nil
+}
+
+type Tags2 struct {
+	TagName string
+	Entries []int32
+}
+
+func readTags2(r io.Reader) (v Tags2, err error) {
+	if v.TagName, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Entries, err = readArray(protocol.ReadVarint)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeTags2(w io.Writer, v Tags2) error {
+	if err := protocol.WriteString(w, v.TagName); err != nil {
+		return err
+	}
+	if err := writeArray(protocol.WriteVarint)(w, v.Entries); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientTagsTags struct {
+	TagType string
+	Tags    []Tags2
+}
+
+func readPlayToClientTagsTags(r io.Reader) (v PlayToClientTagsTags, err error) {
+	if v.TagType, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Tags, err = readArray(readTags2)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientTagsTags(w io.Writer, v PlayToClientTagsTags) error {
+	if err := protocol.WriteString(w, v.TagType); err != nil {
+		return err
+	}
+	if err := writeArray(writeTags2)(w, v.Tags); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToClientCustomReportDetailsDetails struct {
+	Key   string
+	Value string
+}
+
+func readPlayToClientCustomReportDetailsDetails(r io.Reader) (v PlayToClientCustomReportDetailsDetails, err error) {
+	if v.Key, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Value, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToClientCustomReportDetailsDetails(w io.Writer, v PlayToClientCustomReportDetailsDetails) error {
+	if err := protocol.WriteString(w, v.Key); err != nil {
+		return err
+	}
+	if err := protocol.WriteString(w, v.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToServerChatCommandSignedArgumentSignatures struct {
+	ArgumentName string
+	Signature    []byte
+}
+
+func readPlayToServerChatCommandSignedArgumentSignatures(r io.Reader) (v PlayToServerChatCommandSignedArgumentSignatures, err error) {
+	if v.ArgumentName, err = protocol.ReadString(r); err != nil {
+		return v, err
+	}
+	if v.Signature, err = readFixedBytes(256)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToServerChatCommandSignedArgumentSignatures(w io.Writer, v PlayToServerChatCommandSignedArgumentSignatures) error {
+	if err := protocol.WriteString(w, v.ArgumentName); err != nil {
+		return err
+	}
+	if err := writeFixedBytes(256)(w, v.Signature); err != nil {
+		return err
+	}
+	return nil
+}
+
+type HashedSlotComponents struct {
+	Type int32
+	Hash int32
+}
+
+func readHashedSlotComponents(r io.Reader) (v HashedSlotComponents, err error) {
+	if v.Type, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.Hash, err = protocol.ReadInt32(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeHashedSlotComponents(w io.Writer, v HashedSlotComponents) error {
+	if err := protocol.WriteVarint(w, v.Type); err != nil {
+		return err
+	}
+	if err := protocol.WriteInt32(w, v.Hash); err != nil {
+		return err
+	}
+	return nil
+}
+
+type HashedSlotRemoveComponents struct {
+	Type int32
+}
+
+func readHashedSlotRemoveComponents(r io.Reader) (v HashedSlotRemoveComponents, err error) {
+	if v.Type, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeHashedSlotRemoveComponents(w io.Writer, v HashedSlotRemoveComponents) error {
+	if err := protocol.WriteVarint(w, v.Type); err != nil {
+		return err
+	}
+	return nil
+}
+
+type HashedSlot struct {
+	ItemID           int32
+	ItemCount        int32
+	Components       []HashedSlotComponents
+	RemoveComponents []HashedSlotRemoveComponents
+}
+
+func readHashedSlot(r io.Reader) (v HashedSlot, err error) {
+	if v.ItemID, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.ItemCount, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.Components, err = readArray(readHashedSlotComponents)(r); err != nil {
+		return v, err
+	}
+	if v.RemoveComponents, err = readArray(readHashedSlotRemoveComponents)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeHashedSlot(w io.Writer, v HashedSlot) error {
+	if err := protocol.WriteVarint(w, v.ItemID); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, v.ItemCount); err != nil {
+		return err
+	}
+	if err := writeArray(writeHashedSlotComponents)(w, v.Components); err != nil {
+		return err
+	}
+	if err := writeArray(writeHashedSlotRemoveComponents)(w, v.RemoveComponents); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToServerWindowClickChangedSlots struct {
+	Location int16
+	Item     *HashedSlot
+}
+
+func readPlayToServerWindowClickChangedSlots(r io.Reader) (v PlayToServerWindowClickChangedSlots, err error) {
+	if v.Location, err = protocol.ReadInt16(r); err != nil {
+		return v, err
+	}
+	if v.Item, err = readOption(readHashedSlot)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToServerWindowClickChangedSlots(w io.Writer, v PlayToServerWindowClickChangedSlots) error {
+	if err := protocol.WriteInt16(w, v.Location); err != nil {
+		return err
+	}
+	if err := writeOption(writeHashedSlot)(w, v.Item); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PlayToServerTestInstanceBlockActionData struct {
+	Test           *string
+	Size           Vec3i
+	Rotation       int32
+	IgnoreEntities bool
+	Status         int32
+	ErrorMessage   *[]byte
+}
+
+func readPlayToServerTestInstanceBlockActionData(r io.Reader) (v PlayToServerTestInstanceBlockActionData, err error) {
+	if v.Test, err = readOption(protocol.ReadString)(r); err != nil {
+		return v, err
+	}
+	if v.Size, err = readVec3i(r); err != nil {
+		return v, err
+	}
+	if v.Rotation, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.IgnoreEntities, err = protocol.ReadBool(r); err != nil {
+		return v, err
+	}
+	if v.Status, err = protocol.ReadVarint(r); err != nil {
+		return v, err
+	}
+	if v.ErrorMessage, err = readOption(readRawNBT)(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writePlayToServerTestInstanceBlockActionData(w io.Writer, v PlayToServerTestInstanceBlockActionData) error {
+	if err := writeOption(protocol.WriteString)(w, v.Test); err != nil {
+		return err
+	}
+	if err := writeVec3i(w, v.Size); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, v.Rotation); err != nil {
+		return err
+	}
+	if err := protocol.WriteBool(w, v.IgnoreEntities); err != nil {
+		return err
+	}
+	if err := protocol.WriteVarint(w, v.Status); err != nil {
+		return err
+	}
+	if err := writeOption(writeRawNBT)(w, v.ErrorMessage); err != nil {
+		return err
+	}
+	return nil
+}
+
+type Vec2f struct {
+	X float32
+	Y float32
+}
+
+func readVec2f(r io.Reader) (v Vec2f, err error) {
+	if v.X, err = protocol.ReadFloat(r); err != nil {
+		return v, err
+	}
+	if v.Y, err = protocol.ReadFloat(r); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func writeVec2f(w io.Writer, v Vec2f) error {
+	if err := protocol.WriteFloat(w, v.X); err != nil {
+		return err
+	}
+	if err := protocol.WriteFloat(w, v.Y); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package packets
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/Versifine/locus/internal/protocol"
+)
+
+// The hand-written packets in package protocol are checked against the
+// schema in both directions: builders must decode cleanly with the generated
+// codec, and parsers must consume exactly what the generated codec writes.
+
+func decodeBuilt(t *testing.T, state protocol.State, dir protocol.Direction, packet *protocol.Packet) Packet {
+	t.Helper()
+	p, err := Decode(state, dir, packet)
+	if err != nil {
+		t.Fatalf("手写构造的 %s 与 schema 不符: %v", protocol.PacketName(state, dir, packet.ID), err)
+	}
+	return p
+}
+
+func parseEncoded[T any](t *testing.T, p Packet, parse func(io.Reader) (T, error)) T {
+	t.Helper()
+	packet, err := Encode(p)
+	if err != nil {
+		t.Fatalf("Encode(%T) 失败: %v", p, err)
+	}
+	r := bytes.NewReader(packet.Payload)
+	v, err := parse(r)
+	if err != nil {
+		t.Fatalf("手写解析器无法解析 %T: %v", p, err)
+	}
+	if r.Len() != 0 {
+		t.Fatalf("手写解析器解析 %T 后剩余 %d 字节", p, r.Len())
+	}
+	return v
+}
+
+func TestHandWrittenBuildersMatchSchema(t *testing.T) {
+	uuid := protocol.GenerateOfflineUUID("Steve")
+
+	t.Run("handshake", func(t *testing.T) {
+		p := decodeBuilt(t, protocol.Handshaking, protocol.ToServer,
+			protocol.CreateHandshakePacket(protocol.CurrentProtocolVersion, "example.com", 25565, protocol.NextStateLogin)).(*HandshakingToServerSetProtocol)
+		if p.ProtocolVersion != protocol.CurrentProtocolVersion || p.ServerHost != "example.com" || p.ServerPort != 25565 || p.NextState != protocol.NextStateLogin {
+			t.Errorf("握手包字段 = %+v", p)
+		}
+	})
+
+	t.Run("login_start", func(t *testing.T) {
+		p := decodeBuilt(t, protocol.Login, protocol.ToServer, protocol.CreateLoginStartPacket("Steve", uuid)).(*LoginToServerLoginStart)
+		if p.Username != "Steve" || p.PlayerUUID != uuid {
+			t.Errorf("登录开始包字段 = %+v", p)
+		}
+	})
+
+	t.Run("encryption_response", func(t *testing.T) {
+		p := decodeBuilt(t, protocol.Login, protocol.ToServer,
+			protocol.CreateEncryptionResponsePacket([]byte{1, 2}, []byte{3})).(*LoginToServerEncryptionBegin)
+		if !bytes.Equal(p.SharedSecret, []byte{1, 2}) || !bytes.Equal(p.VerifyToken, []byte{3}) {
+			t.Errorf("加密响应字段 = %+v", p)
+		}
+	})
+
+	t.Run("block_dig", func(t *testing.T) {
+		pos := protocol.BlockPos{X: -12, Y: -60, Z: 300}
+		p := decodeBuilt(t, protocol.Play, protocol.ToServer,
+			protocol.CreateBlockDigPacket(protocol.BlockDigStatusStarted, pos, 1, 9)).(*PlayToServerBlockDig)
+		if p.Location != pos || p.Face != 1 || p.Sequence != 9 {
+			t.Errorf("挖掘包字段 = %+v", p)
+		}
+	})
+
+	t.Run("block_place", func(t *testing.T) {
+		pos := protocol.BlockPos{X: 1, Y: 64, Z: -1}
+		p := decodeBuilt(t, protocol.Play, protocol.ToServer,
+			protocol.CreateBlockPlacePacket(pos, 1, 0, 0.5, 1, 0.5, false, false, 3)).(*PlayToServerBlockPlace)
+		if p.Location != pos || p.Direction != 1 || p.Sequence != 3 {
+			t.Errorf("放置方块包字段 = %+v", p)
+		}
+	})
+
+	t.Run("window_click", func(t *testing.T) {
+		p := decodeBuilt(t, protocol.Play, protocol.ToServer,
+			protocol.CreateWindowClickPacket(1, 5, 36, 0, protocol.WindowClickPickup, nil, nil)).(*PlayToServerWindowClick)
+		if p.WindowID != 1 || p.StateID != 5 || p.Slot != 36 || p.CursorItem != nil {
+			t.Errorf("点击窗口包字段 = %+v", p)
+		}
+	})
+
+	t.Run("position_look", func(t *testing.T) {
+		p := decodeBuilt(t, protocol.Play, protocol.ToServer,
+			protocol.CreatePlayerPositionAndRotationPacket(1.5, 64, -2.5, 90, 10, true)).(*PlayToServerPositionLook)
+		if p.X != 1.5 || p.Y != 64 || p.Z != -2.5 || p.Yaw != 90 || p.Pitch != 10 {
+			t.Errorf("位置朝向包字段 = %+v", p)
+		}
+	})
+
+	builders := []struct {
+		name   string
+		state  protocol.State
+		packet *protocol.Packet
+	}{
+		{"status_request", protocol.Status, protocol.CreateStatusRequestPacket()},
+		{"status_ping", protocol.Status, protocol.CreateStatusPingPacket(42)},
+		{"login_acknowledged", protocol.Login, protocol.CreateLoginAcknowledgedPacket()},
+		{"client_information", protocol.Configuration, protocol.CreateClientInformationPacket("zh_cn", 8, 0, true, 0x7f, 1, false, true, 0, protocol.C2SConfigClientInformation)},
+		{"config_keep_alive", protocol.Configuration, protocol.CreateKeepAlivePacket(7, protocol.C2SConfigKeepAlive)},
+		{"finish_configuration", protocol.Configuration, protocol.CreateFinishConfigurationPacket(protocol.C2SFinishConfiguration)},
+		{"select_known_packs", protocol.Configuration, protocol.CreateSelectKnownPacket([]protocol.KnownPack{{NameSpace: "minecraft", Id: "core", Version: "1.21.11"}}, protocol.C2SSelectKnown)},
+		{"custom_payload", protocol.Configuration, protocol.CreateCustomPayloadPacket("minecraft:brand", []byte("vanilla"))},
+		{"play_keep_alive", protocol.Play, protocol.CreateKeepAlivePacket(7, protocol.C2SPlayKeepAlive)},
+		{"teleport_confirm", protocol.Play, protocol.CreateTeleportConfirmPacket(3)},
+		{"chunk_batch_received", protocol.Play, protocol.CreateChunkBatchReceivedPacket(9.5)},
+		{"client_command", protocol.Play, protocol.CreateClientCommandPacket(0)},
+		{"entity_action", protocol.Play, protocol.CreateEntityActionPacket(1, 0, 0)},
+		{"held_item_slot", protocol.Play, protocol.CreateHeldItemSlotPacket(2)},
+		{"use_item", protocol.Play, protocol.CreateUseItemPacket(0, 4)},
+		{"arm_animation", protocol.Play, protocol.CreateArmAnimationPacket(0)},
+		{"player_input", protocol.Play, protocol.CreatePlayerInputPacket(true, false, false, false, true, false, true)},
+		{"player_loaded", protocol.Play, protocol.CreatePlayerLoadedPacket()},
+		{"chat_message", protocol.Play, protocol.CreateChatMessagePacket("hello")},
+		{"chat_command", protocol.Play, protocol.CreateChatCommandPacket("msg Alex hi")},
+		{"position", protocol.Play, protocol.CreatePlayerPositionPacket(1, 2, 3, false)},
+		{"look", protocol.Play, protocol.CreatePlayerRotationPacket(45, 0, true)},
+		{"close_window", protocol.Play, protocol.CreateCloseWindowPacket(1)},
+	}
+	for _, tt := range builders {
+		t.Run(tt.name, func(t *testing.T) {
+			decodeBuilt(t, tt.state, protocol.ToServer, tt.packet)
+		})
+	}
+}
+
+func TestHandWrittenParsersMatchSchema(t *testing.T) {
+	uuid := protocol.GenerateOfflineUUID("Steve")
+
+	t.Run("login_success", func(t *testing.T) {
+		got := parseEncoded(t, &LoginToClientSuccess{UUID: uuid, Username: "Steve"}, protocol.ParseLoginSuccess)
+		if got.UUID != uuid || got.Username != "Steve" {
+			t.Errorf("登录成功包 = %+v", got)
+		}
+	})
+
+	t.Run("encryption_request", func(t *testing.T) {
+		got := parseEncoded(t, &LoginToClientEncryptionBegin{ServerID: "", PublicKey: []byte{1}, VerifyToken: []byte{2}, ShouldAuthenticate: true}, protocol.ParseEncryptionRequest)
+		if !got.ShouldAuthenticate || !bytes.Equal(got.PublicKey, []byte{1}) {
+			t.Errorf("加密请求包 = %+v", got)
+		}
+	})
+
+	t.Run("block_change", func(t *testing.T) {
+		got := parseEncoded(t, &PlayToClientBlockChange{Location: protocol.BlockPos{X: -5, Y: -64, Z: 7}, Type: 10}, protocol.ParseBlockChange)
+		if got.X != -5 || got.Y != -64 || got.Z != 7 || got.StateID != 10 {
+			t.Errorf("方块更新包 = %+v", got)
+		}
+	})
+
+	t.Run("update_health", func(t *testing.T) {
+		got := parseEncoded(t, &PlayToClientUpdateHealth{Health: 12, Food: 18, FoodSaturation: 2.5}, protocol.ParseUpdateHealth)
+		if got.Health != 12 || got.Food != 18 || got.FoodSaturation != 2.5 {
+			t.Errorf("生命值包 = %+v", got)
+		}
+	})
+
+	t.Run("set_slot", func(t *testing.T) {
+		item := protocol.Slot{ItemID: 1, Count: 3}
+		got := parseEncoded(t, &PlayToClientSetSlot{WindowID: 0, StateID: 4, Slot: 36, Item: item}, protocol.ParseSetSlot)
+		if got.Slot != 36 || got.Item.ItemID != 1 || got.Item.Count != 3 {
+			t.Errorf("设置槽位包 = %+v", got)
+		}
+	})
+
+	t.Run("window_items", func(t *testing.T) {
+		items := []protocol.Slot{{}, {ItemID: 5, Count: 64}}
+		got := parseEncoded(t, &PlayToClientWindowItems{WindowID: 1, StateID: 2, Items: items}, protocol.ParseWindowItems)
+		if len(got.Items) != 2 || got.Items[1].Count != 64 {
+			t.Errorf("窗口物品包 = %+v", got)
+		}
+	})
+
+	t.Run("position", func(t *testing.T) {
+		got := parseEncoded(t, &PlayToClientPosition{TeleportID: 8, X: 1, Y: 70, Z: -3, Yaw: 90, Flags: 0x1}, protocol.ParsePlayerPosition)
+		if got.TeleportID != 8 || got.Y != 70 || got.Yaw != 90 || got.Flags != 0x1 {
+			t.Errorf("玩家位置包 = %+v", got)
+		}
+	})
+
+	t.Run("unload_chunk", func(t *testing.T) {
+		got := parseEncoded(t, &PlayToClientUnloadChunk{ChunkZ: -2, ChunkX: 5}, protocol.ParseUnloadChunk)
+		if got.ChunkX != 5 || got.ChunkZ != -2 {
+			t.Errorf("卸载区块包 = %+v", got)
+		}
+	})
+
+	t.Run("update_time", func(t *testing.T) {
+		got := parseEncoded(t, &PlayToClientUpdateTime{Age: 100, Time: 6000, TickDayTime: true}, protocol.ParseUpdateTime)
+		if got.Age != 100 || got.WorldTime != 6000 || !got.TickDayTime {
+			t.Errorf("时间包 = %+v", got)
+		}
+	})
+
+	t.Run("rel_entity_move", func(t *testing.T) {
+		got := parseEncoded(t, &PlayToClientRelEntityMove{EntityID: 3, DX: 4096, DY: -1, OnGround: true}, protocol.ParseRelEntityMove)
+		if got.EntityID != 3 || got.DX != 4096 || got.DY != -1 || !got.OnGround {
+			t.Errorf("实体相对移动包 = %+v", got)
+		}
+	})
+
+	t.Run("entity_destroy", func(t *testing.T) {
+		got := parseEncoded(t, &PlayToClientEntityDestroy{EntityIDs: []int32{1, 2, 3}}, protocol.ParseEntityDestroy)
+		if len(got.EntityIDs) != 3 || got.EntityIDs[2] != 3 {
+			t.Errorf("实体销毁包 = %+v", got)
+		}
+	})
+
+	t.Run("player_remove", func(t *testing.T) {
+		got := parseEncoded(t, &PlayToClientPlayerRemove{Players: []protocol.UUID{uuid}}, protocol.ParsePlayerRemove)
+		if len(got.Players) != 1 || got.Players[0] != uuid {
+			t.Errorf("移除玩家包 = %+v", got)
+		}
+	})
+
+	t.Run("simple", func(t *testing.T) {
+		parseEncoded(t, &PlayToClientKeepAlive{KeepAliveID: 9}, protocol.ParseKeepAlive)
+		parseEncoded(t, &PlayToClientExperience{ExperienceBar: 0.5, Level: 3, TotalExperience: 40}, protocol.ParseExperience)
+		parseEncoded(t, &PlayToClientAcknowledgePlayerDigging{SequenceID: 4}, protocol.ParseAcknowledgePlayerDigging)
+		parseEncoded(t, &PlayToClientHeldItemSlot{Slot: 2}, protocol.ParseHeldItemSlot)
+		parseEncoded(t, &PlayToClientEntityTeleport{EntityID: 1, X: 1, Y: 2, Z: 3}, protocol.ParseEntityTeleport)
+		parseEncoded(t, &PlayToClientUpdateViewPosition{ChunkX: 1, ChunkZ: -1}, protocol.ParseUpdateViewPosition)
+		parseEncoded(t, &PlayToClientChunkBatchFinished{BatchSize: 12}, protocol.ParseChunkBatchFinished)
+		parseEncoded(t, &PlayToClientCloseWindow{WindowID: 1}, protocol.ParseCloseWindow)
+	})
+}
+
+func TestGeneratedPacketsRoundTrip(t *testing.T) {
+	for k, ctor := range constructors {
+		p := ctor()
+		if p.PacketID() != k.id {
+			t.Errorf("%s %s 0x%02x: PacketID() = 0x%02x", k.state, k.dir, k.id, p.PacketID())
+			continue
+		}
+		var first bytes.Buffer
+		if err := p.Encode(&first); err != nil {
+			// Fixed-size arrays and buffers have no valid zero value.
+			continue
+		}
+		decoded, err := Decode(k.state, k.dir, &protocol.Packet{ID: k.id, Payload: first.Bytes()})
+		if err != nil {
+			t.Errorf("%s: 零值无法解码: %v", protocol.PacketName(k.state, k.dir, k.id), err)
+			continue
+		}
+		var second bytes.Buffer
+		if err := decoded.Encode(&second); err != nil {
+			t.Errorf("%s: 重新编码失败: %v", protocol.PacketName(k.state, k.dir, k.id), err)
+			continue
+		}
+		if !bytes.Equal(first.Bytes(), second.Bytes()) {
+			t.Errorf("%s: 往返编码不一致 %x != %x", protocol.PacketName(k.state, k.dir, k.id), first.Bytes(), second.Bytes())
+		}
+	}
+}
+
+func TestDecodeRejectsUnknownAndTrailing(t *testing.T) {
+	if _, err := Decode(protocol.Play, protocol.ToClient, &protocol.Packet{ID: 0x7fff}); err == nil {
+		t.Error("未知包 ID 应返回错误")
+	}
+	payload := []byte{0, 0, 0, 0, 0, 0, 0, 1, 0xff}
+	if _, err := Decode(protocol.Play, protocol.ToClient, &protocol.Packet{ID: protocol.PlayToClientKeepAlive, Payload: payload}); err == nil {
+		t.Error("多余的尾部字节应返回错误")
+	}
+}
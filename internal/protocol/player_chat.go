@@ -13,7 +13,7 @@ type PlayerChat struct {
 	UnsignedChatContent *NBTNode
 	FilterType          int32
 	FilterTypeMask      []int64
-	Type                int32
+	Type                ChatType
 	NetworkName         *NBTNode
 	NetworkTargetName   *NBTNode
 }
@@ -148,7 +148,7 @@ func ParsePlayerChat(r io.Reader) (*PlayerChat, error) {
 		chat.FilterTypeMask = nil
 	}
 	// Type
-	chatType, err := ReadVarint(r)
+	chatType, err := ReadChatTypeHolder(r)
 	if err != nil {
 		return nil, err
 	}
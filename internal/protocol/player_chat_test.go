@@ -130,7 +130,7 @@ func TestParsePlayerChat_Basic(t *testing.T) {
 		0,             // previousMessagesCount
 		false,         // hasUnsignedContent
 		0,             // filterType (PASS_THROUGH)
-		0,             // chatType
+		1,             // chatType holder: registry index 0 (minecraft:chat) + 1
 		"Steve",       // networkName
 		false,         // hasNetworkTargetName
 		"",            // networkTargetName
@@ -157,6 +157,9 @@ func TestParsePlayerChat_Basic(t *testing.T) {
 	if chat.FilterType != 0 {
 		t.Errorf("FilterType = %d, 期望 0", chat.FilterType)
 	}
+	if chat.Type.RegistryID != ChatTypeChat || chat.Type.IsIncomingWhisper() {
+		t.Errorf("Type = %+v, 期望 minecraft:chat", chat.Type)
+	}
 }
 
 func TestReadPreviousMessages_Empty(t *testing.T) {
@@ -1,6 +1,22 @@
 package protocol
 
-import "io"
+import (
+	"bytes"
+	"io"
+)
+
+// Player Info Update actions. Each player entry only carries the fields of
+// the actions set in PlayerInfo.Actions.
+const (
+	PlayerInfoAddPlayer         uint8 = 0x01
+	PlayerInfoInitializeChat    uint8 = 0x02
+	PlayerInfoUpdateGameMode    uint8 = 0x04
+	PlayerInfoUpdateListed      uint8 = 0x08
+	PlayerInfoUpdateLatency     uint8 = 0x10
+	PlayerInfoUpdateDisplayName uint8 = 0x20
+	PlayerInfoUpdateHat         uint8 = 0x40
+	PlayerInfoUpdateListOrder   uint8 = 0x80
+)
 
 type PlayerInfo struct {
 	Actions     uint8
@@ -8,8 +24,21 @@ type PlayerInfo struct {
 	Players     []Player
 }
 type Player struct {
-	UUID UUID
-	Name string
+	UUID     UUID
+	Name     string
+	GameMode int32
+	Listed   bool
+	// Latency is the round-trip time in milliseconds.
+	Latency int32
+	// DisplayName is the plain text of the tab-list name; empty means the
+	// player's own name is shown.
+	DisplayName string
+	ListOrder   int32
+	ShowHat     bool
+}
+
+func (p *PlayerInfo) Has(action uint8) bool {
+	return p.Actions&action != 0
 }
 
 func ParsePlayerInfo(r io.Reader) (*PlayerInfo, error) {
@@ -29,7 +58,7 @@ func ParsePlayerInfo(r io.Reader) (*PlayerInfo, error) {
 			return nil, err
 		}
 		players[i].UUID = uuid
-		if actions&0x01 != 0 {
+		if actions&PlayerInfoAddPlayer != 0 {
 			name, err := ReadString(r)
 			if err != nil {
 				return nil, err
@@ -60,7 +89,7 @@ func ParsePlayerInfo(r io.Reader) (*PlayerInfo, error) {
 			}
 			players[i].Name = name
 		}
-		if actions&0x02 != 0 {
+		if actions&PlayerInfoInitializeChat != 0 {
 			hasSession, err := ReadBool(r)
 			if err != nil {
 				return nil, err
@@ -96,44 +125,44 @@ func ParsePlayerInfo(r io.Reader) (*PlayerInfo, error) {
 				}
 			}
 		}
-		if actions&0x04 != 0 {
-			_, err := ReadVarint(r)
+		if actions&PlayerInfoUpdateGameMode != 0 {
+			players[i].GameMode, err = ReadVarint(r)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if actions&0x08 != 0 {
-			_, err := ReadVarint(r)
+		if actions&PlayerInfoUpdateListed != 0 {
+			players[i].Listed, err = ReadBool(r)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if actions&0x10 != 0 {
-			_, err := ReadVarint(r)
+		if actions&PlayerInfoUpdateLatency != 0 {
+			players[i].Latency, err = ReadVarint(r)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if actions&0x20 != 0 {
+		if actions&PlayerInfoUpdateDisplayName != 0 {
 			flag, err := ReadBool(r)
 			if err != nil {
 				return nil, err
 			}
 			if flag {
-				_, err := ReadAnonymousNBT(r)
+				players[i].DisplayName, err = readTextComponent(r)
 				if err != nil {
 					return nil, err
 				}
 			}
 		}
-		if actions&0x80 != 0 {
-			_, err := ReadVarint(r)
+		if actions&PlayerInfoUpdateListOrder != 0 {
+			players[i].ListOrder, err = ReadVarint(r)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if actions&0x40 != 0 {
-			_, err := ReadBool(r)
+		if actions&PlayerInfoUpdateHat != 0 {
+			players[i].ShowHat, err = ReadBool(r)
 			if err != nil {
 				return nil, err
 			}
@@ -145,3 +174,43 @@ func ParsePlayerInfo(r io.Reader) (*PlayerInfo, error) {
 		Players:     players,
 	}, nil
 }
+
+// CreatePlayerInfoPacket encodes a Player Info Update without properties or
+// chat sessions.
+func CreatePlayerInfoPacket(actions uint8, players []Player) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteByte(buf, actions)
+	_ = WriteVarint(buf, int32(len(players)))
+	for _, p := range players {
+		_ = WriteUUID(buf, p.UUID)
+		if actions&PlayerInfoAddPlayer != 0 {
+			_ = WriteString(buf, p.Name)
+			_ = WriteVarint(buf, 0)
+		}
+		if actions&PlayerInfoInitializeChat != 0 {
+			_ = WriteBool(buf, false)
+		}
+		if actions&PlayerInfoUpdateGameMode != 0 {
+			_ = WriteVarint(buf, p.GameMode)
+		}
+		if actions&PlayerInfoUpdateListed != 0 {
+			_ = WriteBool(buf, p.Listed)
+		}
+		if actions&PlayerInfoUpdateLatency != 0 {
+			_ = WriteVarint(buf, p.Latency)
+		}
+		if actions&PlayerInfoUpdateDisplayName != 0 {
+			_ = WriteBool(buf, p.DisplayName != "")
+			if p.DisplayName != "" {
+				writeTextComponent(buf, p.DisplayName)
+			}
+		}
+		if actions&PlayerInfoUpdateListOrder != 0 {
+			_ = WriteVarint(buf, p.ListOrder)
+		}
+		if actions&PlayerInfoUpdateHat != 0 {
+			_ = WriteBool(buf, p.ShowHat)
+		}
+	}
+	return &Packet{ID: S2CPlayerInfo, Payload: buf.Bytes()}
+}
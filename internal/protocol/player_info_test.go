@@ -53,3 +53,59 @@ func TestParsePlayerInfo_OtherActions(t *testing.T) {
 		t.Errorf("Expected action 0x04, got 0x%x", parsed.Actions)
 	}
 }
+
+func TestParsePlayerInfo_AllActions(t *testing.T) {
+	uuid := UUID{0x0a}
+	buf := new(bytes.Buffer)
+	_ = WriteByte(buf, 0xFF)
+	_ = WriteVarint(buf, 1)
+	_ = WriteUUID(buf, uuid)
+	_ = WriteString(buf, "Alex")
+	_ = WriteVarint(buf, 1) // one signed property
+	_ = WriteString(buf, "textures")
+	_ = WriteString(buf, "value")
+	_ = WriteBool(buf, true)
+	_ = WriteString(buf, "signature")
+	_ = WriteBool(buf, true) // chat session
+	_ = WriteUUID(buf, UUID{0x0b})
+	_ = WriteInt64(buf, 1234)
+	_ = WriteVarint(buf, 2)
+	buf.Write([]byte{1, 2})
+	_ = WriteVarint(buf, 1)
+	buf.Write([]byte{3})
+	_ = WriteVarint(buf, 1) // creative
+	_ = WriteBool(buf, true)
+	_ = WriteVarint(buf, 87)
+	_ = WriteBool(buf, true)
+	writeTextComponent(buf, "[Admin] Alex")
+	_ = WriteVarint(buf, 5)
+	_ = WriteBool(buf, true)
+
+	parsed, err := ParsePlayerInfo(buf)
+	if err != nil {
+		t.Fatalf("ParsePlayerInfo failed: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("%d bytes left unread", buf.Len())
+	}
+	want := Player{UUID: uuid, Name: "Alex", GameMode: 1, Listed: true, Latency: 87, DisplayName: "[Admin] Alex", ListOrder: 5, ShowHat: true}
+	if parsed.Players[0] != want {
+		t.Fatalf("player = %+v, want %+v", parsed.Players[0], want)
+	}
+	if !parsed.Has(PlayerInfoUpdateLatency) {
+		t.Fatal("Has(PlayerInfoUpdateLatency) = false")
+	}
+}
+
+func TestCreatePlayerInfoPacketRoundTrip(t *testing.T) {
+	players := []Player{{UUID: UUID{0x01}, GameMode: 3, Latency: 20}}
+	actions := PlayerInfoUpdateGameMode | PlayerInfoUpdateLatency | PlayerInfoUpdateDisplayName
+	packet := CreatePlayerInfoPacket(actions, players)
+	parsed, err := ParsePlayerInfo(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ParsePlayerInfo failed: %v", err)
+	}
+	if parsed.Actions != actions || parsed.Players[0] != players[0] {
+		t.Fatalf("parsed = %+v, want %+v", parsed, players[0])
+	}
+}
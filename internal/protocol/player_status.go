@@ -0,0 +1,155 @@
+package protocol
+
+import (
+	"bytes"
+	"io"
+)
+
+// Entity effect flags.
+const (
+	EffectFlagAmbient       uint8 = 0x01
+	EffectFlagShowParticles uint8 = 0x02
+	EffectFlagShowIcon      uint8 = 0x04
+	EffectFlagBlend         uint8 = 0x08
+)
+
+// EntityEffect is the Entity Effect packet. Duration is in ticks, -1 for
+// infinite effects.
+type EntityEffect struct {
+	EntityID  int32
+	EffectID  int32
+	Amplifier int32
+	Duration  int32
+	Flags     uint8
+}
+
+func ParseEntityEffect(r io.Reader) (*EntityEffect, error) {
+	var p EntityEffect
+	var err error
+	if p.EntityID, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	if p.EffectID, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	if p.Amplifier, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	if p.Duration, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	if p.Flags, err = ReadByte(r); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func CreateEntityEffectPacket(e EntityEffect) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, e.EntityID)
+	_ = WriteVarint(buf, e.EffectID)
+	_ = WriteVarint(buf, e.Amplifier)
+	_ = WriteVarint(buf, e.Duration)
+	_ = WriteByte(buf, e.Flags)
+	return &Packet{ID: S2CEntityEffect, Payload: buf.Bytes()}
+}
+
+type RemoveEntityEffect struct {
+	EntityID int32
+	EffectID int32
+}
+
+func ParseRemoveEntityEffect(r io.Reader) (*RemoveEntityEffect, error) {
+	entityID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	effectID, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoveEntityEffect{EntityID: entityID, EffectID: effectID}, nil
+}
+
+// Player ability flags.
+const (
+	AbilityInvulnerable uint8 = 0x01
+	AbilityFlying       uint8 = 0x02
+	AbilityAllowFlying  uint8 = 0x04
+	AbilityCreativeMode uint8 = 0x08
+)
+
+// PlayerAbilities is the clientbound Player Abilities packet.
+type PlayerAbilities struct {
+	Flags        uint8
+	FlyingSpeed  float32
+	WalkingSpeed float32
+}
+
+func ParsePlayerAbilities(r io.Reader) (*PlayerAbilities, error) {
+	flags, err := ReadByte(r)
+	if err != nil {
+		return nil, err
+	}
+	flyingSpeed, err := ReadFloat(r)
+	if err != nil {
+		return nil, err
+	}
+	walkingSpeed, err := ReadFloat(r)
+	if err != nil {
+		return nil, err
+	}
+	return &PlayerAbilities{Flags: flags, FlyingSpeed: flyingSpeed, WalkingSpeed: walkingSpeed}, nil
+}
+
+func CreatePlayerAbilitiesPacket(a PlayerAbilities) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteByte(buf, a.Flags)
+	_ = WriteFloat(buf, a.FlyingSpeed)
+	_ = WriteFloat(buf, a.WalkingSpeed)
+	return &Packet{ID: S2CAbilities, Payload: buf.Bytes()}
+}
+
+// Game event types.
+const (
+	GameEventNoRespawnBlock     uint8 = 0
+	GameEventBeginRaining       uint8 = 1
+	GameEventEndRaining         uint8 = 2
+	GameEventChangeGameMode     uint8 = 3
+	GameEventWinGame            uint8 = 4
+	GameEventDemo               uint8 = 5
+	GameEventArrowHitPlayer     uint8 = 6
+	GameEventRainLevelChange    uint8 = 7
+	GameEventThunderLevelChange uint8 = 8
+	GameEventPufferfishSting    uint8 = 9
+	GameEventGuardianAppearance uint8 = 10
+	GameEventImmediateRespawn   uint8 = 11
+	GameEventLimitedCrafting    uint8 = 12
+	GameEventWaitForChunks      uint8 = 13
+)
+
+// GameEvent is the Game Event packet. Value is the new game mode for
+// GameEventChangeGameMode and the rain or thunder level for the level events.
+type GameEvent struct {
+	Event uint8
+	Value float32
+}
+
+func ParseGameEvent(r io.Reader) (*GameEvent, error) {
+	evt, err := ReadByte(r)
+	if err != nil {
+		return nil, err
+	}
+	value, err := ReadFloat(r)
+	if err != nil {
+		return nil, err
+	}
+	return &GameEvent{Event: evt, Value: value}, nil
+}
+
+func CreateGameEventPacket(evt uint8, value float32) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteByte(buf, evt)
+	_ = WriteFloat(buf, value)
+	return &Packet{ID: S2CGameEvent, Payload: buf.Bytes()}
+}
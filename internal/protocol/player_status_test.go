@@ -0,0 +1,48 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEntityEffectRoundTrip(t *testing.T) {
+	want := EntityEffect{EntityID: 1, EffectID: 7, Amplifier: 1, Duration: -1, Flags: EffectFlagShowIcon | EffectFlagShowParticles}
+	got, err := ParseEntityEffect(bytes.NewReader(CreateEntityEffectPacket(want).Payload))
+	if err != nil {
+		t.Fatalf("ParseEntityEffect failed: %v", err)
+	}
+	if *got != want {
+		t.Fatalf("ParseEntityEffect = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseRemoveEntityEffect(t *testing.T) {
+	got, err := ParseRemoveEntityEffect(bytes.NewReader([]byte{5, 1}))
+	if err != nil {
+		t.Fatalf("ParseRemoveEntityEffect failed: %v", err)
+	}
+	if got.EntityID != 5 || got.EffectID != 1 {
+		t.Fatalf("ParseRemoveEntityEffect = %+v, want entity 5 effect 1", got)
+	}
+}
+
+func TestPlayerAbilitiesRoundTrip(t *testing.T) {
+	want := PlayerAbilities{Flags: AbilityFlying | AbilityAllowFlying | AbilityCreativeMode, FlyingSpeed: 0.05, WalkingSpeed: 0.1}
+	got, err := ParsePlayerAbilities(bytes.NewReader(CreatePlayerAbilitiesPacket(want).Payload))
+	if err != nil {
+		t.Fatalf("ParsePlayerAbilities failed: %v", err)
+	}
+	if *got != want {
+		t.Fatalf("ParsePlayerAbilities = %+v, want %+v", *got, want)
+	}
+}
+
+func TestGameEventRoundTrip(t *testing.T) {
+	got, err := ParseGameEvent(bytes.NewReader(CreateGameEventPacket(GameEventChangeGameMode, 1).Payload))
+	if err != nil {
+		t.Fatalf("ParseGameEvent failed: %v", err)
+	}
+	if got.Event != GameEventChangeGameMode || got.Value != 1 {
+		t.Fatalf("ParseGameEvent = %+v, want change game mode to 1", got)
+	}
+}
@@ -0,0 +1,687 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Output is the generated source for one protocol.json.
+type Output struct {
+	IDs     []byte
+	Packets []byte
+	// Partial lists packets whose tail is kept as raw bytes, with the reason.
+	Partial []string
+}
+
+type stateDef struct {
+	key    string // protocol.json key
+	goName string // protocol.State constant and identifier prefix
+}
+
+var states = []stateDef{
+	{"handshaking", "Handshaking"},
+	{"status", "Status"},
+	{"login", "Login"},
+	{"configuration", "Configuration"},
+	{"play", "Play"},
+}
+
+var directions = []stateDef{
+	{"toClient", "ToClient"},
+	{"toServer", "ToServer"},
+}
+
+type packetDef struct {
+	state   stateDef
+	dir     stateDef
+	id      int
+	name    string // protocol.json packet name, e.g. keep_alive
+	goName  string // e.g. PlayToClientKeepAlive
+	typeKey string // e.g. packet_keep_alive
+	schema  any
+	scope   map[string]any
+}
+
+// goType describes how one schema type maps to Go: the Go type and the
+// expressions for a func(io.Reader) (T, error) and a func(io.Writer, T) error.
+type goType struct {
+	typ   string
+	read  string
+	write string
+}
+
+var primitives = map[string]goType{
+	"varint":          {"int32", "protocol.ReadVarint", "protocol.WriteVarint"},
+	"varlong":         {"int64", "protocol.ReadVarLong", "protocol.WriteVarLong"},
+	"u8":              {"uint8", "protocol.ReadByte", "protocol.WriteByte"},
+	"i8":              {"int8", "readI8", "writeI8"},
+	"u16":             {"uint16", "protocol.ReadUnsignedShort", "protocol.WriteUnsignedShort"},
+	"i16":             {"int16", "protocol.ReadInt16", "protocol.WriteInt16"},
+	"u32":             {"uint32", "readU32", "writeU32"},
+	"i32":             {"int32", "protocol.ReadInt32", "protocol.WriteInt32"},
+	"u64":             {"uint64", "readU64", "writeU64"},
+	"i64":             {"int64", "protocol.ReadInt64", "protocol.WriteInt64"},
+	"bool":            {"bool", "protocol.ReadBool", "protocol.WriteBool"},
+	"f32":             {"float32", "protocol.ReadFloat", "protocol.WriteFloat"},
+	"f64":             {"float64", "protocol.ReadDouble", "protocol.WriteDouble"},
+	"UUID":            {"protocol.UUID", "protocol.ReadUUID", "protocol.WriteUUID"},
+	"restBuffer":      {"[]byte", "readRest", "writeRaw"},
+	"position":        {"protocol.BlockPos", "protocol.ReadBlockPos", "protocol.WriteBlockPos"},
+	"Slot":            {"protocol.Slot", "protocol.ReadSlot", "protocol.WriteSlot"},
+	"anonymousNbt":    {"[]byte", "readRawNBT", "writeRawNBT"},
+	"anonOptionalNbt": {"[]byte", "readRawNBT", "writeRawNBT"},
+}
+
+// bitfieldTypes maps a bitfield's total width to the integer carrying it.
+var bitfieldTypes = map[int]string{8: "u8", 16: "u16", 32: "u32", 64: "u64"}
+
+type generator struct {
+	global map[string]any
+	// decls holds struct types and their codec functions in emit order.
+	decls bytes.Buffer
+	// named caches schema types that became named Go structs, per scope.
+	named   map[string]goType
+	goNames map[string]bool
+}
+
+type unsupportedError struct{ reason string }
+
+func (e *unsupportedError) Error() string { return e.reason }
+
+func unsupported(format string, args ...any) error {
+	return &unsupportedError{fmt.Sprintf(format, args...)}
+}
+
+// Generate renders the ID table and packet codecs. source names the schema
+// in the generated header.
+func Generate(schema []byte, source string) (*Output, error) {
+	var root map[string]any
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+	global, _ := root["types"].(map[string]any)
+	g := &generator{
+		global:  global,
+		named:   make(map[string]goType),
+		goNames: make(map[string]bool),
+	}
+
+	packets, err := collectPackets(root, global)
+	if err != nil {
+		return nil, err
+	}
+
+	header := fmt.Sprintf("// Code generated by protogen from %s. DO NOT EDIT.\n\n", normalizeSource(source))
+	ids, err := g.renderIDs(header, packets)
+	if err != nil {
+		return nil, err
+	}
+	codecs, partial, err := g.renderPackets(header, packets)
+	if err != nil {
+		return nil, err
+	}
+	return &Output{IDs: ids, Packets: codecs, Partial: partial}, nil
+}
+
+// normalizeSource keeps only the version directory and file name so the
+// header does not depend on where go generate ran.
+func normalizeSource(source string) string {
+	parts := strings.Split(strings.ReplaceAll(source, "\\", "/"), "/")
+	if len(parts) >= 2 {
+		return strings.Join(parts[len(parts)-2:], "/")
+	}
+	return source
+}
+
+func collectPackets(root, global map[string]any) ([]packetDef, error) {
+	var packets []packetDef
+	for _, st := range states {
+		stateNode, ok := root[st.key].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("schema has no %q state", st.key)
+		}
+		for _, dir := range directions {
+			dirNode, _ := stateNode[dir.key].(map[string]any)
+			scope, _ := dirNode["types"].(map[string]any)
+			if scope == nil {
+				return nil, fmt.Errorf("schema has no %s.%s.types", st.key, dir.key)
+			}
+			ids, typeKeys, err := packetMappings(scope["packet"])
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", st.key, dir.key, err)
+			}
+			for name, id := range ids {
+				typeKey := typeKeys[name]
+				if typeKey == "" {
+					typeKey = "packet_" + name
+				}
+				// Packets shared between states live in the global types.
+				var schema any = typeKey
+				ok := typeKey == "void"
+				if !ok {
+					schema, ok = scope[typeKey]
+				}
+				if !ok {
+					schema, ok = global[typeKey]
+				}
+				if !ok {
+					return nil, fmt.Errorf("%s.%s: packet %s has no type %s", st.key, dir.key, name, typeKey)
+				}
+				packets = append(packets, packetDef{
+					state:   st,
+					dir:     dir,
+					id:      id,
+					name:    name,
+					goName:  st.goName + dir.goName + camel(name),
+					typeKey: typeKey,
+					schema:  schema,
+					scope:   scope,
+				})
+			}
+		}
+	}
+	sort.SliceStable(packets, func(i, j int) bool {
+		a, b := packets[i], packets[j]
+		if a.state.goName != b.state.goName {
+			return stateIndex(a.state) < stateIndex(b.state)
+		}
+		if a.dir.key != b.dir.key {
+			return a.dir.key < b.dir.key
+		}
+		return a.id < b.id
+	})
+	return packets, nil
+}
+
+func stateIndex(st stateDef) int {
+	for i, s := range states {
+		if s.key == st.key {
+			return i
+		}
+	}
+	return len(states)
+}
+
+// packetMappings reads the packet id mapper and the params switch of a
+// direction's "packet" container.
+func packetMappings(node any) (map[string]int, map[string]string, error) {
+	fields, ok := containerFields(node)
+	if !ok {
+		return nil, nil, fmt.Errorf("packet type is not a container")
+	}
+	ids := make(map[string]int)
+	typeKeys := make(map[string]string)
+	sawMapper := false
+	for _, f := range fields {
+		field, _ := f.(map[string]any)
+		typ, _ := field["type"].([]any)
+		if len(typ) != 2 {
+			continue
+		}
+		args, _ := typ[1].(map[string]any)
+		switch {
+		case field["name"] == "name" && typ[0] == "mapper":
+			sawMapper = true
+			mappings, _ := args["mappings"].(map[string]any)
+			for idStr, name := range mappings {
+				id, err := strconv.ParseInt(idStr, 0, 32)
+				if err != nil {
+					return nil, nil, fmt.Errorf("packet id %q: %w", idStr, err)
+				}
+				ids[name.(string)] = int(id)
+			}
+		case field["name"] == "params" && typ[0] == "switch":
+			cases, _ := args["fields"].(map[string]any)
+			for name, key := range cases {
+				if s, ok := key.(string); ok {
+					typeKeys[name] = s
+				}
+			}
+		}
+	}
+	// An empty mapper is valid: nothing is sent to the client in handshaking.
+	if !sawMapper {
+		return nil, nil, fmt.Errorf("packet type has no id mapper")
+	}
+	return ids, typeKeys, nil
+}
+
+func containerFields(node any) ([]any, bool) {
+	arr, ok := node.([]any)
+	if !ok || len(arr) != 2 || arr[0] != "container" {
+		return nil, false
+	}
+	fields, ok := arr[1].([]any)
+	return fields, ok
+}
+
+func (g *generator) renderIDs(header string, packets []packetDef) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package protocol\n\n")
+	b.WriteString("const (\n")
+	var group string
+	for _, p := range packets {
+		if key := p.state.key + " " + p.dir.key; key != group {
+			if group != "" {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "\t// %s, %s\n", p.state.key, p.dir.key)
+			group = key
+		}
+		fmt.Fprintf(&b, "\t%s = 0x%02x\n", p.goName, p.id)
+	}
+	b.WriteString(")\n\n")
+	b.WriteString("var packetNames = map[packetKey]string{\n")
+	for _, p := range packets {
+		fmt.Fprintf(&b, "\t{%s, %s, %s}: %q,\n", p.state.goName, p.dir.goName, p.goName, p.name)
+	}
+	b.WriteString("}\n")
+	return formatSource(b.Bytes())
+}
+
+func (g *generator) renderPackets(header string, packets []packetDef) ([]byte, []string, error) {
+	var body bytes.Buffer
+	var partial []string
+	for _, p := range packets {
+		reason, err := g.renderPacket(&body, p)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", p.goName, err)
+		}
+		if reason != "" {
+			partial = append(partial, fmt.Sprintf("%s.%s %s: %s", p.state.key, p.dir.key, p.name, reason))
+		}
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package packets\n\n")
+	b.WriteString("import (\n\t\"io\"\n\n\t\"github.com/Versifine/locus/internal/protocol\"\n)\n\n")
+	b.WriteString("var constructors = map[key]func() Packet{\n")
+	for _, p := range packets {
+		fmt.Fprintf(&b, "\t{protocol.%s, protocol.%s, protocol.%s}: func() Packet { return new(%s) },\n",
+			p.state.goName, p.dir.goName, p.goName, p.goName)
+	}
+	b.WriteString("}\n\n")
+	b.Write(body.Bytes())
+	b.Write(g.decls.Bytes())
+	src, err := formatSource(b.Bytes())
+	return src, partial, err
+}
+
+type fieldDef struct {
+	name string
+	typ  goType
+}
+
+// renderPacket emits the packet struct. Fields the generator cannot model,
+// and everything after them, land in Unparsed so Encode round-trips.
+func (g *generator) renderPacket(b *bytes.Buffer, p packetDef) (string, error) {
+	fields, err := g.packetFields(p)
+	if err != nil {
+		return "", err
+	}
+	var defs []fieldDef
+	var partialReason, partialField string
+	used := map[string]bool{"Unparsed": true}
+	for _, f := range fields {
+		field, _ := f.(map[string]any)
+		name, _ := field["name"].(string)
+		if field["anon"] == true || name == "" {
+			partialReason, partialField = "anonymous field", "an anonymous field"
+			break
+		}
+		goName := uniqueName(fieldName(name), used)
+		undo := g.checkpoint()
+		t, err := g.resolve(field["type"], p.goName+goName, p.scope)
+		if err != nil {
+			var u *unsupportedError
+			if !asUnsupported(err, &u) {
+				return "", err
+			}
+			undo()
+			partialReason, partialField = fmt.Sprintf("%s (%s)", name, u.reason), name
+			break
+		}
+		if t.typ == "" {
+			continue // void
+		}
+		defs = append(defs, fieldDef{goName, t})
+	}
+
+	fmt.Fprintf(b, "// %s is %s %s packet %s (0x%02x).\n", p.goName, p.state.key, p.dir.key, p.name, p.id)
+	fmt.Fprintf(b, "type %s struct {\n", p.goName)
+	for _, d := range defs {
+		fmt.Fprintf(b, "\t%s %s\n", d.name, d.typ.typ)
+	}
+	if partialReason != "" {
+		fmt.Fprintf(b, "\t// Unparsed holds the raw bytes from %s on.\n", partialField)
+		b.WriteString("\tUnparsed []byte\n")
+	}
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(b, "func (*%s) PacketID() int32 { return protocol.%s }\n\n", p.goName, p.goName)
+
+	recv := "p"
+	if len(defs) == 0 && partialReason == "" {
+		recv = "_"
+	}
+	fmt.Fprintf(b, "func (%s *%s) Decode(r io.Reader) (err error) {\n", recv, p.goName)
+	for _, d := range defs {
+		fmt.Fprintf(b, "\tif p.%s, err = %s(r); err != nil {\n\t\treturn err\n\t}\n", d.name, d.typ.read)
+	}
+	if partialReason != "" {
+		b.WriteString("\tif p.Unparsed, err = readRest(r); err != nil {\n\t\treturn err\n\t}\n")
+	}
+	b.WriteString("\treturn nil\n}\n\n")
+
+	fmt.Fprintf(b, "func (%s *%s) Encode(w io.Writer) error {\n", recv, p.goName)
+	for _, d := range defs {
+		fmt.Fprintf(b, "\tif err := %s(w, p.%s); err != nil {\n\t\treturn err\n\t}\n", d.typ.write, d.name)
+	}
+	if partialReason != "" {
+		b.WriteString("\treturn writeRaw(w, p.Unparsed)\n}\n\n")
+	} else {
+		b.WriteString("\treturn nil\n}\n\n")
+	}
+	return partialReason, nil
+}
+
+// packetFields returns the top-level fields of a packet. A packet may also
+// name another type directly, or be "void" when it has no body.
+func (g *generator) packetFields(p packetDef) ([]any, error) {
+	schema := p.schema
+	if name, ok := schema.(string); ok {
+		if name == "void" {
+			return nil, nil
+		}
+		def, ok := p.scope[name]
+		if !ok {
+			def = g.global[name]
+		}
+		schema = def
+	}
+	fields, ok := containerFields(schema)
+	if !ok {
+		return nil, fmt.Errorf("packet schema is not a container")
+	}
+	return fields, nil
+}
+
+// checkpoint returns a func that drops every declaration emitted since, so a
+// field that turns out to be unsupported leaves no orphaned helper types.
+func (g *generator) checkpoint() func() {
+	declLen := g.decls.Len()
+	named := make(map[string]goType, len(g.named))
+	for k, v := range g.named {
+		named[k] = v
+	}
+	goNames := make(map[string]bool, len(g.goNames))
+	for k, v := range g.goNames {
+		goNames[k] = v
+	}
+	return func() {
+		g.decls.Truncate(declLen)
+		g.named = named
+		g.goNames = goNames
+	}
+}
+
+func asUnsupported(err error, target **unsupportedError) bool {
+	u, ok := err.(*unsupportedError)
+	if ok {
+		*target = u
+	}
+	return ok
+}
+
+// resolve maps a schema type to Go. hint names any struct it has to create.
+func (g *generator) resolve(node any, hint string, scope map[string]any) (goType, error) {
+	switch t := node.(type) {
+	case string:
+		return g.resolveNamed(t, scope)
+	case []any:
+		if len(t) == 0 {
+			return goType{}, fmt.Errorf("empty type expression")
+		}
+		kind, _ := t[0].(string)
+		var args map[string]any
+		if len(t) > 1 {
+			args, _ = t[1].(map[string]any)
+		}
+		switch kind {
+		case "container":
+			return g.resolveContainer(t, hint, scope)
+		case "option":
+			inner, err := g.resolve(t[1], hint, scope)
+			if err != nil {
+				return goType{}, err
+			}
+			return goType{
+				typ:   "*" + inner.typ,
+				read:  fmt.Sprintf("readOption(%s)", inner.read),
+				write: fmt.Sprintf("writeOption(%s)", inner.write),
+			}, nil
+		case "array":
+			inner, err := g.resolve(args["type"], hint, scope)
+			if err != nil {
+				return goType{}, err
+			}
+			if count, ok := args["count"].(float64); ok {
+				return goType{
+					typ:   "[]" + inner.typ,
+					read:  fmt.Sprintf("readFixedArray(%d, %s)", int(count), inner.read),
+					write: fmt.Sprintf("writeFixedArray(%d, %s)", int(count), inner.write),
+				}, nil
+			}
+			if args["countType"] != "varint" {
+				return goType{}, unsupported("array counted by %v", args["count"])
+			}
+			return goType{
+				typ:   "[]" + inner.typ,
+				read:  fmt.Sprintf("readArray(%s)", inner.read),
+				write: fmt.Sprintf("writeArray(%s)", inner.write),
+			}, nil
+		case "buffer":
+			if count, ok := args["count"].(float64); ok {
+				return goType{
+					typ:   "[]byte",
+					read:  fmt.Sprintf("readFixedBytes(%d)", int(count)),
+					write: fmt.Sprintf("writeFixedBytes(%d)", int(count)),
+				}, nil
+			}
+			if args["countType"] != "varint" {
+				return goType{}, unsupported("buffer counted by %v", args["countType"])
+			}
+			return goType{"[]byte", "protocol.ReadByteArray", "protocol.WriteByteArray"}, nil
+		case "pstring":
+			if args["countType"] != "varint" {
+				return goType{}, unsupported("string counted by %v", args["countType"])
+			}
+			return goType{"string", "protocol.ReadString", "protocol.WriteString"}, nil
+		case "mapper", "bitflags":
+			return g.resolve(args["type"], hint, scope)
+		case "bitfield":
+			fields, _ := t[1].([]any)
+			bits := 0
+			for _, f := range fields {
+				field, _ := f.(map[string]any)
+				size, _ := field["size"].(float64)
+				bits += int(size)
+			}
+			name, ok := bitfieldTypes[bits]
+			if !ok {
+				return goType{}, unsupported("%d-bit bitfield", bits)
+			}
+			return primitives[name], nil
+		default:
+			return goType{}, unsupported("%s", kind)
+		}
+	default:
+		return goType{}, fmt.Errorf("unexpected type expression %T", node)
+	}
+}
+
+func (g *generator) resolveNamed(name string, scope map[string]any) (goType, error) {
+	if name == "void" {
+		return goType{}, nil
+	}
+	if p, ok := primitives[name]; ok {
+		return p, nil
+	}
+	def, inScope := scope[name]
+	if !inScope {
+		def = g.global[name]
+	}
+	if def == nil {
+		return goType{}, unsupported("unknown type %s", name)
+	}
+	if def == "native" {
+		return goType{}, unsupported("native type %s", name)
+	}
+	if _, ok := containerFields(def); !ok {
+		return g.resolve(def, camel(name), scope)
+	}
+
+	cacheKey := name
+	if inScope {
+		cacheKey = fmt.Sprintf("%p/%s", scope, name)
+	}
+	if t, ok := g.named[cacheKey]; ok {
+		return t, nil
+	}
+	t, err := g.resolveContainer(def.([]any), camel(name), scope)
+	if err != nil {
+		return goType{}, err
+	}
+	g.named[cacheKey] = t
+	return t, nil
+}
+
+// resolveContainer emits a struct with read/write functions for a nested
+// container. Unlike packets, a nested container must be fully supported.
+func (g *generator) resolveContainer(node []any, hint string, scope map[string]any) (goType, error) {
+	fields, _ := containerFields(node)
+	var defs []fieldDef
+	used := map[string]bool{}
+	for _, f := range fields {
+		field, _ := f.(map[string]any)
+		name, _ := field["name"].(string)
+		if field["anon"] == true || name == "" {
+			return goType{}, unsupported("anonymous field in %s", hint)
+		}
+		goName := uniqueName(fieldName(name), used)
+		t, err := g.resolve(field["type"], hint+goName, scope)
+		if err != nil {
+			return goType{}, err
+		}
+		if t.typ == "" {
+			continue
+		}
+		defs = append(defs, fieldDef{goName, t})
+	}
+
+	typeName := hint
+	for i := 2; g.goNames[typeName]; i++ {
+		typeName = fmt.Sprintf("%s%d", hint, i)
+	}
+	g.goNames[typeName] = true
+
+	b := &g.decls
+	fmt.Fprintf(b, "type %s struct {\n", typeName)
+	for _, d := range defs {
+		fmt.Fprintf(b, "\t%s %s\n", d.name, d.typ.typ)
+	}
+	b.WriteString("}\n\n")
+
+	readName, writeName := "read"+typeName, "write"+typeName
+	param := "r"
+	if len(defs) == 0 {
+		param = "_"
+	}
+	fmt.Fprintf(b, "func %s(%s io.Reader) (v %s, err error) {\n", readName, param, typeName)
+	for _, d := range defs {
+		fmt.Fprintf(b, "\tif v.%s, err = %s(r); err != nil {\n\t\treturn v, err\n\t}\n", d.name, d.typ.read)
+	}
+	b.WriteString("\treturn v, nil\n}\n\n")
+	wparam, vparam := "w", "v"
+	if len(defs) == 0 {
+		wparam, vparam = "_", "_"
+	}
+	fmt.Fprintf(b, "func %s(%s io.Writer, %s %s) error {\n", writeName, wparam, vparam, typeName)
+	for _, d := range defs {
+		fmt.Fprintf(b, "\tif err := %s(w, v.%s); err != nil {\n\t\treturn err\n\t}\n", d.typ.write, d.name)
+	}
+	b.WriteString("\treturn nil\n}\n\n")
+	return goType{typeName, readName, writeName}, nil
+}
+
+func uniqueName(name string, used map[string]bool) string {
+	out := name
+	for i := 2; used[out]; i++ {
+		out = fmt.Sprintf("%s%d", name, i)
+	}
+	used[out] = true
+	return out
+}
+
+// initialisms are upper-cased whole when they form a word of a name.
+var initialisms = map[string]string{
+	"Id": "ID", "Ids": "IDs", "Uuid": "UUID", "Url": "URL", "Json": "JSON", "Nbt": "NBT", "Ui": "UI",
+}
+
+// camel turns snake_case packet names into Go identifiers.
+func camel(name string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '.' || r == ':' || r == '/' }) {
+		b.WriteString(fieldName(part))
+	}
+	return b.String()
+}
+
+// fieldName turns a camelCase schema field into an exported Go name.
+func fieldName(name string) string {
+	name = strings.TrimLeft(name, "_")
+	if name == "" {
+		return "Field"
+	}
+	if strings.ContainsAny(name, "_") {
+		return camel(name)
+	}
+	var words []string
+	start := 0
+	for i := 1; i < len(name); i++ {
+		if name[i] >= 'A' && name[i] <= 'Z' {
+			words = append(words, name[start:i])
+			start = i
+		}
+	}
+	words = append(words, name[start:])
+
+	var b strings.Builder
+	for _, w := range words {
+		w = strings.ToUpper(w[:1]) + w[1:]
+		if up, ok := initialisms[w]; ok {
+			w = up
+		}
+		b.WriteString(w)
+	}
+	out := b.String()
+	if out[0] >= '0' && out[0] <= '9' {
+		out = "F" + out
+	}
+	return out
+}
+
+func formatSource(src []byte) ([]byte, error) {
+	out, err := format.Source(src)
+	if err != nil {
+		return nil, fmt.Errorf("format generated source: %w", err)
+	}
+	return out, nil
+}
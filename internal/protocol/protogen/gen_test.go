@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	schemaPath := filepath.Join("..", "..", "..", "1.21.11", "protocol.json")
+	schema, err := os.ReadFile(schemaPath)
+	if err != nil {
+		t.Fatalf("读取 protocol.json 失败: %v", err)
+	}
+	out, err := Generate(schema, schemaPath)
+	if err != nil {
+		t.Fatalf("Generate 失败: %v", err)
+	}
+	for path, want := range map[string][]byte{
+		filepath.Join("..", "packet_id_gen.go"):          out.IDs,
+		filepath.Join("..", "packets", "packets_gen.go"): out.Packets,
+	} {
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("读取 %s 失败: %v", path, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s 已过期，请在 internal/protocol 下运行 go generate", path)
+		}
+	}
+}
+
+const miniSchema = `{
+  "types": {
+    "varint": "native", "string": ["pstring", {"countType": "varint"}], "void": "native",
+    "vec2": ["container", [{"name": "x", "type": "f32"}, {"name": "y", "type": "f32"}]]
+  },
+  "handshaking": {"toClient": {"types": {"packet": ["container", [
+      {"name": "name", "type": ["mapper", {"type": "varint", "mappings": {}}]},
+      {"name": "params", "type": ["switch", {"compareTo": "name", "fields": {}}]}]]}},
+    "toServer": {"types": {"packet": ["container", [
+      {"name": "name", "type": ["mapper", {"type": "varint", "mappings": {"0x00": "hello"}}]},
+      {"name": "params", "type": ["switch", {"compareTo": "name", "fields": {"hello": "packet_hello"}}]}]],
+      "packet_hello": ["container", [{"name": "playerId", "type": "varint"}, {"name": "look", "type": "vec2"}]]}}},
+  "status": {"toClient": {"types": {"packet": ["container", [
+      {"name": "name", "type": ["mapper", {"type": "varint", "mappings": {"0x01": "mixed", "0x02": "marker"}}]},
+      {"name": "params", "type": ["switch", {"compareTo": "name", "fields": {"mixed": "packet_mixed", "marker": "void"}}]}]],
+      "packet_mixed": ["container", [
+        {"name": "flag", "type": "bool"},
+        {"name": "extra", "type": ["switch", {"compareTo": "flag", "fields": {"true": "string"}, "default": "void"}]},
+        {"name": "tail", "type": "i32"}]]}},
+    "toServer": {"types": {"packet": ["container", [
+      {"name": "name", "type": ["mapper", {"type": "varint", "mappings": {}}]},
+      {"name": "params", "type": ["switch", {"compareTo": "name", "fields": {}}]}]]}}},
+  "login": {"toClient": {"types": {"packet": ["container", [{"name": "name", "type": ["mapper", {"type": "varint", "mappings": {}}]}]]}},
+    "toServer": {"types": {"packet": ["container", [{"name": "name", "type": ["mapper", {"type": "varint", "mappings": {}}]}]]}}},
+  "configuration": {"toClient": {"types": {"packet": ["container", [{"name": "name", "type": ["mapper", {"type": "varint", "mappings": {}}]}]]}},
+    "toServer": {"types": {"packet": ["container", [{"name": "name", "type": ["mapper", {"type": "varint", "mappings": {}}]}]]}}},
+  "play": {"toClient": {"types": {"packet": ["container", [{"name": "name", "type": ["mapper", {"type": "varint", "mappings": {}}]}]]}},
+    "toServer": {"types": {"packet": ["container", [{"name": "name", "type": ["mapper", {"type": "varint", "mappings": {}}]}]]}}}
+}`
+
+func TestGenerateMiniSchema(t *testing.T) {
+	out, err := Generate([]byte(miniSchema), "test/protocol.json")
+	if err != nil {
+		t.Fatalf("Generate 失败: %v", err)
+	}
+	ids := squash(string(out.IDs))
+	for _, want := range []string{
+		"// Code generated by protogen from test/protocol.json. DO NOT EDIT.",
+		"HandshakingToServerHello = 0x00",
+		"StatusToClientMixed = 0x01",
+		`{Handshaking, ToServer, HandshakingToServerHello}: "hello"`,
+	} {
+		if !strings.Contains(ids, squash(want)) {
+			t.Errorf("ID 表缺少 %q:\n%s", want, ids)
+		}
+	}
+
+	packets := squash(string(out.Packets))
+	for _, want := range []string{
+		"PlayerID int32",
+		"Look     Vec2",
+		"func readVec2(r io.Reader) (v Vec2, err error)",
+		"type StatusToClientMarker struct {\n}",
+		"// Unparsed holds the raw bytes from extra on.",
+	} {
+		if !strings.Contains(packets, squash(want)) {
+			t.Errorf("生成的编解码缺少 %q", want)
+		}
+	}
+	// The switch and everything after it stay raw; tail must not become a field.
+	if strings.Contains(packets, "Tail int32") {
+		t.Error("不支持的字段之后的字段不应生成")
+	}
+	if len(out.Partial) != 1 || !strings.Contains(out.Partial[0], "mixed: extra (switch)") {
+		t.Errorf("Partial = %v, 期望仅 mixed 部分解析", out.Partial)
+	}
+}
+
+// squash collapses whitespace so checks do not depend on gofmt alignment.
+func squash(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func TestFieldName(t *testing.T) {
+	tests := map[string]string{
+		"keepAliveId":   "KeepAliveID",
+		"entityIds":     "EntityIDs",
+		"playerUUID":    "PlayerUUID",
+		"x":             "X",
+		"_unused":       "Unused",
+		"world_surface": "WorldSurface",
+		"2d":            "F2d",
+	}
+	for in, want := range tests {
+		if got := fieldName(in); got != want {
+			t.Errorf("fieldName(%q) = %q, 期望 %q", in, got, want)
+		}
+	}
+	if got := camel("select_known_packs"); got != "SelectKnownPacks" {
+		t.Errorf("camel = %q, 期望 SelectKnownPacks", got)
+	}
+}
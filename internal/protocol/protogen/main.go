@@ -0,0 +1,50 @@
+// Command protogen generates packet ID tables and packet codecs from a
+// minecraft-data protocol.json. It is run through go generate in
+// internal/protocol.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	schema := flag.String("schema", "", "path to protocol.json")
+	idsOut := flag.String("ids", "", "output file for the packet ID table (package protocol)")
+	packetsOut := flag.String("packets", "", "output file for the packet codecs (package packets)")
+	verbose := flag.Bool("v", false, "list packets that are only partly decoded")
+	flag.Parse()
+
+	if *schema == "" || *idsOut == "" || *packetsOut == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*schema, *idsOut, *packetsOut, *verbose); err != nil {
+		fmt.Fprintln(os.Stderr, "protogen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaPath, idsOut, packetsOut string, verbose bool) error {
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return err
+	}
+	out, err := Generate(data, schemaPath)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(idsOut, out.IDs, 0o644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(packetsOut, out.Packets, 0o644); err != nil {
+		return err
+	}
+	if verbose {
+		for _, s := range out.Partial {
+			fmt.Fprintln(os.Stderr, "protogen: partial:", s)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Registry IDs sent in Registry Data during the configuration phase.
+const (
+	RegistryBiome         = "minecraft:worldgen/biome"
+	RegistryDamageType    = "minecraft:damage_type"
+	RegistryDimensionType = "minecraft:dimension_type"
+)
+
+// RegistryEntry is one entry of a synchronized registry. Data is nil when the
+// server relies on a known pack the client already has, which vanilla does
+// for every built-in entry.
+type RegistryEntry struct {
+	Key  string
+	Data *NBTNode
+}
+
+// RegistryData carries one whole registry. An entry's network ID is its
+// index in Entries.
+type RegistryData struct {
+	ID      string
+	Entries []RegistryEntry
+}
+
+// Keys returns the entry names in network ID order.
+func (r RegistryData) Keys() []string {
+	keys := make([]string, len(r.Entries))
+	for i, entry := range r.Entries {
+		keys[i] = entry.Key
+	}
+	return keys
+}
+
+func ParseRegistryData(r io.Reader) (*RegistryData, error) {
+	id, err := ReadString(r)
+	if err != nil {
+		return nil, err
+	}
+	count, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("invalid registry entry count: %d", count)
+	}
+	entries := make([]RegistryEntry, 0, count)
+	for i := int32(0); i < count; i++ {
+		key, err := ReadString(r)
+		if err != nil {
+			return nil, err
+		}
+		hasData, err := ReadBool(r)
+		if err != nil {
+			return nil, err
+		}
+		entry := RegistryEntry{Key: key}
+		if hasData {
+			if entry.Data, err = ReadAnonymousNBT(r); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return &RegistryData{ID: id, Entries: entries}, nil
+}
+
+// CreateRegistryDataPacket writes a registry whose entries carry no data.
+func CreateRegistryDataPacket(id string, keys []string) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteString(buf, id)
+	_ = WriteVarint(buf, int32(len(keys)))
+	for _, key := range keys {
+		_ = WriteString(buf, key)
+		_ = WriteBool(buf, false)
+	}
+	return &Packet{
+		ID:      S2CRegistryData,
+		Payload: buf.Bytes(),
+	}
+}
+
+// DimensionType holds the height fields of a dimension_type registry entry.
+// Blocks exist from MinY up to MinY+Height-1.
+type DimensionType struct {
+	MinY          int32
+	Height        int32
+	LogicalHeight int32
+}
+
+// DimensionType decodes the entry's data as a dimension type. ok is false
+// when the entry has no data or lacks min_y or height.
+func (e RegistryEntry) DimensionType() (DimensionType, bool) {
+	minY, okMin := e.intField("min_y")
+	height, okHeight := e.intField("height")
+	if !okMin || !okHeight {
+		return DimensionType{}, false
+	}
+	logical, ok := e.intField("logical_height")
+	if !ok {
+		logical = height
+	}
+	return DimensionType{MinY: minY, Height: height, LogicalHeight: logical}, true
+}
+
+func (e RegistryEntry) intField(name string) (int32, bool) {
+	if e.Data == nil || e.Data.Type != TagCompound {
+		return 0, false
+	}
+	field := e.Data.Value.(map[string]*NBTNode)[name]
+	if field == nil || field.Type != TagInt {
+		return 0, false
+	}
+	return field.Value.(int32), true
+}
+
+// CreateDimensionTypeRegistryPacket writes a dimension_type registry whose
+// entries carry only the height fields; types[i] belongs to keys[i].
+func CreateDimensionTypeRegistryPacket(keys []string, types []DimensionType) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteString(buf, RegistryDimensionType)
+	_ = WriteVarint(buf, int32(len(keys)))
+	for i, key := range keys {
+		_ = WriteString(buf, key)
+		_ = WriteBool(buf, true)
+		_ = WriteByte(buf, TagCompound)
+		for _, field := range []struct {
+			name  string
+			value int32
+		}{{"min_y", types[i].MinY}, {"height", types[i].Height}, {"logical_height", types[i].LogicalHeight}} {
+			_ = WriteByte(buf, TagInt)
+			_ = WriteUnsignedShort(buf, uint16(len(field.name)))
+			buf.WriteString(field.name)
+			_ = WriteInt32(buf, field.value)
+		}
+		_ = WriteByte(buf, TagEnd)
+	}
+	return &Packet{
+		ID:      S2CRegistryData,
+		Payload: buf.Bytes(),
+	}
+}
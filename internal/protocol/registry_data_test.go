@@ -0,0 +1,79 @@
+package protocol
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestRegistryDataRoundTrip(t *testing.T) {
+	keys := []string{"minecraft:badlands", "minecraft:desert", "custom:glow_forest"}
+	packet := CreateRegistryDataPacket(RegistryBiome, keys)
+	if packet.ID != S2CRegistryData {
+		t.Fatalf("包 ID = 0x%02x, 期望 0x%02x", packet.ID, S2CRegistryData)
+	}
+
+	got, err := ParseRegistryData(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("解析 registry data 失败: %v", err)
+	}
+	if got.ID != RegistryBiome {
+		t.Fatalf("registry ID = %q, 期望 %q", got.ID, RegistryBiome)
+	}
+	if !reflect.DeepEqual(got.Keys(), keys) {
+		t.Fatalf("Keys = %v, 期望 %v", got.Keys(), keys)
+	}
+	for _, entry := range got.Entries {
+		if entry.Data != nil {
+			t.Fatalf("条目 %s 不应带数据", entry.Key)
+		}
+	}
+}
+
+func TestParseRegistryDataWithNBT(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteString(buf, "minecraft:dimension_type")
+	_ = WriteVarint(buf, 1)
+	_ = WriteString(buf, "minecraft:overworld")
+	_ = WriteBool(buf, true)
+	// Anonymous compound {min_y: Int(-64)}.
+	_ = WriteByte(buf, TagCompound)
+	_ = WriteByte(buf, TagInt)
+	_ = WriteUnsignedShort(buf, uint16(len("min_y")))
+	buf.WriteString("min_y")
+	_ = WriteInt32(buf, -64)
+	_ = WriteByte(buf, TagEnd)
+
+	got, err := ParseRegistryData(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("解析带 NBT 的 registry data 失败: %v", err)
+	}
+	if len(got.Entries) != 1 || got.Entries[0].Data == nil {
+		t.Fatalf("条目 = %+v, 期望 1 个带数据的条目", got.Entries)
+	}
+	compound, ok := got.Entries[0].Data.Value.(map[string]*NBTNode)
+	if !ok || compound["min_y"] == nil || compound["min_y"].Value.(int32) != -64 {
+		t.Fatalf("NBT 数据 = %v, 期望 min_y=-64", got.Entries[0].Data)
+	}
+}
+
+func TestRegistryEntryDimensionType(t *testing.T) {
+	keys := []string{"minecraft:overworld", "custom:deep"}
+	types := []DimensionType{{MinY: -64, Height: 384, LogicalHeight: 384}, {MinY: -256, Height: 768, LogicalHeight: 512}}
+	got, err := ParseRegistryData(bytes.NewReader(CreateDimensionTypeRegistryPacket(keys, types).Payload))
+	if err != nil {
+		t.Fatalf("解析 dimension_type 失败: %v", err)
+	}
+	if got.ID != RegistryDimensionType || !reflect.DeepEqual(got.Keys(), keys) {
+		t.Fatalf("registry = %s %v, 期望 %s %v", got.ID, got.Keys(), RegistryDimensionType, keys)
+	}
+	for i, entry := range got.Entries {
+		dt, ok := entry.DimensionType()
+		if !ok || dt != types[i] {
+			t.Fatalf("%s DimensionType = %+v, %v, 期望 %+v", entry.Key, dt, ok, types[i])
+		}
+	}
+	if _, ok := (RegistryEntry{Key: "minecraft:the_end"}).DimensionType(); ok {
+		t.Fatal("没有数据的条目不应解析出维度类型")
+	}
+}
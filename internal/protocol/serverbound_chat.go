@@ -66,7 +66,11 @@ type ChatCommand struct {
 }
 
 func CreateSayChatCommandPacket(msg string) *Packet {
-	command := "say " + msg
+	return CreateChatCommandPacket("say " + msg)
+}
+
+// CreateChatCommandPacket sends an unsigned command; command has no leading slash.
+func CreateChatCommandPacket(command string) *Packet {
 	buf := new(bytes.Buffer)
 	_ = WriteString(buf, command)
 	return &Packet{
@@ -0,0 +1,747 @@
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Slot component type IDs that carry information useful to the agent.
+// The full mapping lives in slotComponentTypeNames.
+const (
+	SlotComponentCustomData          int32 = 0
+	SlotComponentMaxStackSize        int32 = 1
+	SlotComponentMaxDamage           int32 = 2
+	SlotComponentDamage              int32 = 3
+	SlotComponentUnbreakable         int32 = 4
+	SlotComponentCustomName          int32 = 6
+	SlotComponentItemName            int32 = 9
+	SlotComponentLore                int32 = 11
+	SlotComponentEnchantments        int32 = 13
+	SlotComponentRepairCost          int32 = 19
+	SlotComponentStoredEnchantments  int32 = 41
+	SlotComponentPotionContents      int32 = 49
+	SlotComponentContainer           int32 = 73
+	slotComponentTypeCount                 = 104
+	maxSlotNestingDepth                    = 16
+	maxSlotComponentCollectionLength       = 1 << 16
+)
+
+var ErrUnknownSlotComponent = errors.New("unknown slot component type")
+
+var slotComponentTypeNames = [slotComponentTypeCount]string{
+	"custom_data", "max_stack_size", "max_damage", "damage", "unbreakable",
+	"use_effects", "custom_name", "minimum_attack_charge", "damage_type", "item_name",
+	"item_model", "lore", "rarity", "enchantments", "can_place_on",
+	"can_break", "attribute_modifiers", "custom_model_data", "tooltip_display", "repair_cost",
+	"creative_slot_lock", "enchantment_glint_override", "intangible_projectile", "food", "consumable",
+	"use_remainder", "use_cooldown", "damage_resistant", "tool", "weapon",
+	"attack_range", "enchantable", "equippable", "repairable", "glider",
+	"tooltip_style", "death_protection", "blocks_attacks", "piercing_weapon", "kinetic_weapon",
+	"swing_animation", "stored_enchantments", "dyed_color", "map_color", "map_id",
+	"map_decorations", "map_post_processing", "charged_projectiles", "bundle_contents", "potion_contents",
+	"potion_duration_scale", "suspicious_stew_effects", "writable_book_content", "written_book_content", "trim",
+	"debug_stick_state", "entity_data", "bucket_entity_data", "block_entity_data", "instrument",
+	"provides_trim_material", "ominous_bottle_amplifier", "jukebox_playable", "provides_banner_patterns", "recipes",
+	"lodestone_tracker", "firework_explosion", "fireworks", "profile", "note_block_sound",
+	"banner_patterns", "base_color", "pot_decorations", "container", "block_state",
+	"bees", "lock", "container_loot", "break_sound", "villager/variant",
+	"wolf/variant", "wolf/sound_variant", "wolf/collar", "fox/variant", "salmon/size",
+	"parrot/variant", "tropical_fish/pattern", "tropical_fish/base_color", "tropical_fish/pattern_color", "mooshroom/variant",
+	"rabbit/variant", "pig/variant", "cow/variant", "chicken/variant", "zombie_nautilus/variant",
+	"frog/variant", "horse/variant", "painting/variant", "llama/variant", "axolotl/variant",
+	"cat/variant", "cat/collar", "sheep/color", "shulker/color",
+}
+
+// SlotComponentName returns the registry name of a slot component type, or "" if unknown.
+func SlotComponentName(componentType int32) string {
+	if componentType < 0 || int(componentType) >= len(slotComponentTypeNames) {
+		return ""
+	}
+	return slotComponentTypeNames[componentType]
+}
+
+// Slot is a decoded item stack. A zero Count means the slot is empty.
+type Slot struct {
+	ItemID            int32
+	Count             int32
+	Components        []SlotComponent
+	RemovedComponents []int32
+}
+
+// SlotComponent is one added data component of an item stack.
+// Raw always holds the encoded payload so the stack can be re-serialized;
+// Value is only populated for component types the agent cares about:
+//   - int32 for varint counters (damage, max_damage, repair_cost, ...)
+//   - string for text components (custom_name, item_name)
+//   - []string for lore
+//   - []Enchantment for enchantments/stored_enchantments
+//   - []Slot for container/bundle_contents/charged_projectiles
+//   - *int32 potion ID for potion_contents (nil when absent)
+type SlotComponent struct {
+	Type  int32
+	Value any
+	Raw   []byte
+}
+
+type Enchantment struct {
+	ID    int32
+	Level int32
+}
+
+func (s Slot) IsEmpty() bool {
+	return s.Count <= 0
+}
+
+// Component returns the first added component with the given type.
+func (s Slot) Component(componentType int32) (SlotComponent, bool) {
+	for _, c := range s.Components {
+		if c.Type == componentType {
+			return c, true
+		}
+	}
+	return SlotComponent{}, false
+}
+
+// IntComponent returns an int32-valued component such as damage or max_damage.
+func (s Slot) IntComponent(componentType int32) (int32, bool) {
+	c, ok := s.Component(componentType)
+	if !ok {
+		return 0, false
+	}
+	v, ok := c.Value.(int32)
+	return v, ok
+}
+
+// CustomName returns the display name set by an anvil or command, if any.
+func (s Slot) CustomName() string {
+	c, ok := s.Component(SlotComponentCustomName)
+	if !ok {
+		return ""
+	}
+	name, _ := c.Value.(string)
+	return name
+}
+
+func (s Slot) Enchantments() []Enchantment {
+	c, ok := s.Component(SlotComponentEnchantments)
+	if !ok {
+		return nil
+	}
+	enchantments, _ := c.Value.([]Enchantment)
+	return enchantments
+}
+
+func ReadSlot(r io.Reader) (Slot, error) {
+	return readSlot(r, 0)
+}
+
+func readSlot(r io.Reader, depth int) (Slot, error) {
+	if depth > maxSlotNestingDepth {
+		return Slot{}, fmt.Errorf("%w: slot nesting too deep", ErrInvalidPacket)
+	}
+
+	count, err := ReadVarint(r)
+	if err != nil {
+		return Slot{}, err
+	}
+	if count <= 0 {
+		return Slot{}, nil
+	}
+
+	itemID, err := ReadVarint(r)
+	if err != nil {
+		return Slot{}, err
+	}
+	addedCount, err := ReadVarint(r)
+	if err != nil {
+		return Slot{}, err
+	}
+	removedCount, err := ReadVarint(r)
+	if err != nil {
+		return Slot{}, err
+	}
+	if addedCount < 0 || removedCount < 0 ||
+		addedCount > slotComponentTypeCount || removedCount > slotComponentTypeCount {
+		return Slot{}, fmt.Errorf("%w: slot component counts added=%d removed=%d", ErrInvalidPacket, addedCount, removedCount)
+	}
+
+	slot := Slot{ItemID: itemID, Count: count}
+	if addedCount > 0 {
+		slot.Components = make([]SlotComponent, 0, addedCount)
+	}
+	for i := int32(0); i < addedCount; i++ {
+		component, err := readSlotComponent(r, depth)
+		if err != nil {
+			return Slot{}, err
+		}
+		slot.Components = append(slot.Components, component)
+	}
+	if removedCount > 0 {
+		slot.RemovedComponents = make([]int32, 0, removedCount)
+	}
+	for i := int32(0); i < removedCount; i++ {
+		componentType, err := ReadVarint(r)
+		if err != nil {
+			return Slot{}, err
+		}
+		slot.RemovedComponents = append(slot.RemovedComponents, componentType)
+	}
+
+	return slot, nil
+}
+
+// WriteSlot serializes a slot using the Raw payload captured by ReadSlot.
+// Components built by hand must provide Raw themselves.
+func WriteSlot(w io.Writer, slot Slot) error {
+	if slot.IsEmpty() {
+		return WriteVarint(w, 0)
+	}
+	if err := WriteVarint(w, slot.Count); err != nil {
+		return err
+	}
+	if err := WriteVarint(w, slot.ItemID); err != nil {
+		return err
+	}
+	if err := WriteVarint(w, int32(len(slot.Components))); err != nil {
+		return err
+	}
+	if err := WriteVarint(w, int32(len(slot.RemovedComponents))); err != nil {
+		return err
+	}
+	for _, c := range slot.Components {
+		if err := WriteVarint(w, c.Type); err != nil {
+			return err
+		}
+		if _, err := w.Write(c.Raw); err != nil {
+			return err
+		}
+	}
+	for _, componentType := range slot.RemovedComponents {
+		if err := WriteVarint(w, componentType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSlotComponent(r io.Reader, depth int) (SlotComponent, error) {
+	componentType, err := ReadVarint(r)
+	if err != nil {
+		return SlotComponent{}, err
+	}
+
+	raw := new(bytes.Buffer)
+	value, err := readSlotComponentValue(io.TeeReader(r, raw), componentType, depth)
+	if err != nil {
+		return SlotComponent{}, fmt.Errorf("slot component %d (%s): %w", componentType, SlotComponentName(componentType), err)
+	}
+	return SlotComponent{Type: componentType, Value: value, Raw: raw.Bytes()}, nil
+}
+
+// readSlotComponentValue decodes or skips a single component payload.
+// Layouts follow the SlotComponent switch in 1.21.11/protocol.json.
+func readSlotComponentValue(r io.Reader, componentType int32, depth int) (any, error) {
+	switch componentType {
+	case 0, 45, 55, 57, 64, 76, 77: // custom_data, map_decorations, debug_stick_state, bucket_entity_data, recipes, lock, container_loot
+		_, err := ReadAnonymousNBT(r)
+		return nil, err
+	case 1, 2, 3, 12, 19, 31, 44, 46, 61, 71,
+		79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 95, 96, 98, 99, 100, 101, 102, 103:
+		v, err := ReadVarint(r)
+		return v, err
+	case 4, 20, 22, 34: // unbreakable, creative_slot_lock, intangible_projectile, glider
+		return nil, nil
+	case 5: // use_effects
+		return nil, discardBytes(r, 1+1+4)
+	case 6, 9: // custom_name, item_name
+		node, err := ReadAnonymousNBT(r)
+		if err != nil {
+			return nil, err
+		}
+		return FormatTextComponent(node), nil
+	case 7, 50: // minimum_attack_charge, potion_duration_scale
+		return nil, discardBytes(r, 4)
+	case 8: // damage_type
+		return nil, skipEitherHolder(r, skipDamageTypeData)
+	case 10, 27, 35, 63, 69: // item_model, damage_resistant, tooltip_style, provides_banner_patterns, note_block_sound
+		v, err := ReadString(r)
+		return v, err
+	case 11: // lore
+		n, err := readCollectionLength(r)
+		if err != nil {
+			return nil, err
+		}
+		lines := make([]string, 0, n)
+		for i := 0; i < n; i++ {
+			node, err := ReadAnonymousNBT(r)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, FormatTextComponent(node))
+		}
+		return lines, nil
+	case 13, 41: // enchantments, stored_enchantments
+		n, err := readCollectionLength(r)
+		if err != nil {
+			return nil, err
+		}
+		enchantments := make([]Enchantment, 0, n)
+		for i := 0; i < n; i++ {
+			id, err := ReadVarint(r)
+			if err != nil {
+				return nil, err
+			}
+			level, err := ReadVarint(r)
+			if err != nil {
+				return nil, err
+			}
+			enchantments = append(enchantments, Enchantment{ID: id, Level: level})
+		}
+		return enchantments, nil
+	case 14, 15: // can_place_on, can_break
+		return nil, skipArray(r, func(r io.Reader) error { return skipItemBlockPredicate(r, depth) })
+	case 16: // attribute_modifiers
+		return nil, skipArray(r, skipAttributeModifier)
+	case 17: // custom_model_data
+		if err := skipArray(r, skipFixed(4)); err != nil {
+			return nil, err
+		}
+		if err := skipArray(r, skipFixed(1)); err != nil {
+			return nil, err
+		}
+		if err := skipArray(r, skipString); err != nil {
+			return nil, err
+		}
+		return nil, skipArray(r, skipFixed(4))
+	case 18: // tooltip_display
+		if err := discardBytes(r, 1); err != nil {
+			return nil, err
+		}
+		return nil, skipArray(r, skipVarint)
+	case 21: // enchantment_glint_override
+		v, err := ReadBool(r)
+		return v, err
+	case 23: // food
+		return nil, skipSequence(r, skipVarint, skipFixed(4+1))
+	case 24: // consumable
+		return nil, skipSequence(r,
+			skipFixed(4),
+			skipVarint,
+			skipSoundHolder,
+			skipFixed(1),
+			func(r io.Reader) error { return skipArray(r, skipConsumeEffect) },
+		)
+	case 25: // use_remainder
+		slot, err := readSlot(r, depth+1)
+		return []Slot{slot}, err
+	case 26: // use_cooldown
+		return nil, skipSequence(r, skipFixed(4), skipOptional(skipString))
+	case 28: // tool
+		return nil, skipSequence(r,
+			func(r io.Reader) error {
+				return skipArray(r, func(r io.Reader) error {
+					return skipSequence(r, skipIDSet, skipOptional(skipFixed(4)), skipOptional(skipFixed(1)))
+				})
+			},
+			skipFixed(4),
+			skipVarint,
+			skipFixed(1),
+		)
+	case 29: // weapon
+		return nil, skipSequence(r, skipVarint, skipFixed(4))
+	case 30: // attack_range
+		return nil, discardBytes(r, 6*4)
+	case 32: // equippable
+		return nil, skipSequence(r,
+			skipVarint,
+			skipSoundHolder,
+			skipOptional(skipString),
+			skipOptional(skipString),
+			skipOptional(skipIDSet),
+			skipFixed(5),
+			skipSoundHolder,
+		)
+	case 33: // repairable
+		return nil, skipIDSet(r)
+	case 36: // death_protection
+		return nil, skipArray(r, skipConsumeEffect)
+	case 37: // blocks_attacks
+		return nil, skipSequence(r,
+			skipFixed(4+4),
+			func(r io.Reader) error {
+				return skipArray(r, func(r io.Reader) error {
+					return skipSequence(r, skipFixed(4), skipOptional(skipIDSet), skipFixed(4+4))
+				})
+			},
+			skipFixed(3*4),
+			skipOptional(skipString),
+			skipOptional(skipSoundHolder),
+			skipOptional(skipSoundHolder),
+		)
+	case 38: // piercing_weapon
+		return nil, skipSequence(r, skipFixed(2), skipOptional(skipSoundHolder), skipOptional(skipSoundHolder))
+	case 39: // kinetic_weapon
+		condition := skipOptional(func(r io.Reader) error { return skipSequence(r, skipVarint, skipFixed(4+4)) })
+		return nil, skipSequence(r,
+			skipVarint,
+			skipVarint,
+			condition,
+			condition,
+			condition,
+			skipFixed(4+4),
+			skipOptional(skipSoundHolder),
+			skipOptional(skipSoundHolder),
+		)
+	case 40: // swing_animation
+		return nil, skipSequence(r, skipVarint, skipVarint)
+	case 42, 43: // dyed_color, map_color
+		v, err := ReadInt32(r)
+		return v, err
+	case 47, 48, 73: // charged_projectiles, bundle_contents, container
+		n, err := readCollectionLength(r)
+		if err != nil {
+			return nil, err
+		}
+		slots := make([]Slot, 0, n)
+		for i := 0; i < n; i++ {
+			slot, err := readSlot(r, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			slots = append(slots, slot)
+		}
+		return slots, nil
+	case 49: // potion_contents
+		var potionID *int32
+		hasPotion, err := ReadBool(r)
+		if err != nil {
+			return nil, err
+		}
+		if hasPotion {
+			id, err := ReadVarint(r)
+			if err != nil {
+				return nil, err
+			}
+			potionID = &id
+		}
+		err = skipSequence(r,
+			skipOptional(skipFixed(4)),
+			func(r io.Reader) error { return skipArray(r, skipPotionEffect) },
+			skipOptional(skipString),
+		)
+		return potionID, err
+	case 51: // suspicious_stew_effects
+		return nil, skipArray(r, func(r io.Reader) error { return skipSequence(r, skipVarint, skipVarint) })
+	case 52: // writable_book_content
+		return nil, skipArray(r, func(r io.Reader) error { return skipSequence(r, skipString, skipOptional(skipString)) })
+	case 53: // written_book_content
+		return nil, skipSequence(r,
+			skipString,
+			skipOptional(skipString),
+			skipString,
+			skipVarint,
+			func(r io.Reader) error {
+				return skipArray(r, func(r io.Reader) error { return skipSequence(r, skipNBT, skipNBT) })
+			},
+			skipFixed(1),
+		)
+	case 54: // trim
+		return nil, skipSequence(r, skipHolder(skipArmorTrimMaterial), skipHolder(skipArmorTrimPattern))
+	case 56, 58: // entity_data, block_entity_data
+		return nil, skipSequence(r, skipVarint, skipNBT)
+	case 59: // instrument
+		return nil, skipEitherHolder(r, func(r io.Reader) error {
+			return skipSequence(r, skipSoundHolder, skipFixed(4+4), skipNBT)
+		})
+	case 60: // provides_trim_material
+		return nil, skipEitherHolder(r, skipArmorTrimMaterial)
+	case 62: // jukebox_playable
+		return nil, skipEitherHolder(r, func(r io.Reader) error {
+			return skipSequence(r, skipSoundHolder, skipNBT, skipFixed(4), skipVarint)
+		})
+	case 65: // lodestone_tracker
+		return nil, skipSequence(r, skipOptional(func(r io.Reader) error {
+			return skipSequence(r, skipString, skipFixed(8))
+		}), skipFixed(1))
+	case 66: // firework_explosion
+		return nil, skipFireworkExplosion(r)
+	case 67: // fireworks
+		return nil, skipSequence(r, skipVarint, func(r io.Reader) error { return skipArray(r, skipFireworkExplosion) })
+	case 68: // profile
+		return nil, skipResolvableProfile(r)
+	case 70: // banner_patterns
+		return nil, skipArray(r, func(r io.Reader) error {
+			return skipSequence(r, skipHolder(func(r io.Reader) error { return skipSequence(r, skipString, skipString) }), skipVarint)
+		})
+	case 72: // pot_decorations
+		return nil, skipArray(r, skipVarint)
+	case 74: // block_state
+		return nil, skipArray(r, func(r io.Reader) error { return skipSequence(r, skipString, skipString) })
+	case 75: // bees
+		return nil, skipArray(r, func(r io.Reader) error { return skipSequence(r, skipNBT, skipVarint, skipVarint) })
+	case 78: // break_sound
+		return nil, skipSoundHolder(r)
+	case 93, 94: // chicken/variant, zombie_nautilus/variant
+		return nil, skipHolder(skipString)(r)
+	case 97: // painting/variant
+		return nil, skipHolder(func(r io.Reader) error {
+			return skipSequence(r, skipFixed(4+4), skipString, skipOptional(skipNBT), skipOptional(skipNBT))
+		})(r)
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownSlotComponent, componentType)
+	}
+}
+
+type skipFunc func(r io.Reader) error
+
+func skipSequence(r io.Reader, fns ...skipFunc) error {
+	for _, fn := range fns {
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func skipFixed(n int64) skipFunc {
+	return func(r io.Reader) error { return discardBytes(r, n) }
+}
+
+func skipVarint(r io.Reader) error {
+	_, err := ReadVarint(r)
+	return err
+}
+
+func skipString(r io.Reader) error {
+	_, err := ReadString(r)
+	return err
+}
+
+func skipNBT(r io.Reader) error {
+	_, err := ReadAnonymousNBT(r)
+	return err
+}
+
+func skipOptional(fn skipFunc) skipFunc {
+	return func(r io.Reader) error {
+		present, err := ReadBool(r)
+		if err != nil || !present {
+			return err
+		}
+		return fn(r)
+	}
+}
+
+func skipArray(r io.Reader, fn skipFunc) error {
+	n, err := readCollectionLength(r)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readCollectionLength(r io.Reader) (int, error) {
+	n, err := ReadVarint(r)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 || n > maxSlotComponentCollectionLength {
+		return 0, fmt.Errorf("%w: collection length %d", ErrInvalidPacket, n)
+	}
+	return int(n), nil
+}
+
+// skipHolder skips a registryEntryHolder: varint 0 means inline data follows,
+// otherwise the value is registry ID + 1.
+func skipHolder(inline skipFunc) skipFunc {
+	return func(r io.Reader) error {
+		id, err := ReadVarint(r)
+		if err != nil || id != 0 {
+			return err
+		}
+		return inline(r)
+	}
+}
+
+// skipEitherHolder skips the (bool hasHolder, holder | resource key) pattern.
+func skipEitherHolder(r io.Reader, inline skipFunc) error {
+	hasHolder, err := ReadBool(r)
+	if err != nil {
+		return err
+	}
+	if !hasHolder {
+		return skipString(r)
+	}
+	return skipHolder(inline)(r)
+}
+
+// skipIDSet skips a registryEntryHolderSet: varint 0 means a tag name follows,
+// otherwise the value is element count + 1.
+func skipIDSet(r io.Reader) error {
+	n, err := ReadVarint(r)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return skipString(r)
+	}
+	if n < 0 || n-1 > maxSlotComponentCollectionLength {
+		return fmt.Errorf("%w: id set length %d", ErrInvalidPacket, n-1)
+	}
+	for i := int32(0); i < n-1; i++ {
+		if err := skipVarint(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func skipSoundHolder(r io.Reader) error {
+	return skipHolder(func(r io.Reader) error {
+		return skipSequence(r, skipString, skipOptional(skipFixed(4)))
+	})(r)
+}
+
+func skipPotionEffect(r io.Reader) error {
+	if err := skipVarint(r); err != nil {
+		return err
+	}
+	return skipEffectDetail(r)
+}
+
+func skipEffectDetail(r io.Reader) error {
+	return skipSequence(r, skipVarint, skipVarint, skipFixed(3), skipOptional(skipEffectDetail))
+}
+
+func skipConsumeEffect(r io.Reader) error {
+	effectType, err := ReadVarint(r)
+	if err != nil {
+		return err
+	}
+	switch effectType {
+	case 0: // apply_effects
+		return skipSequence(r, func(r io.Reader) error { return skipArray(r, skipPotionEffect) }, skipFixed(4))
+	case 1: // remove_effects
+		return skipIDSet(r)
+	case 2: // clear_all_effects
+		return nil
+	case 3: // teleport_randomly
+		return discardBytes(r, 4)
+	case 4: // play_sound
+		return skipSoundHolder(r)
+	default:
+		return fmt.Errorf("%w: consume effect type %d", ErrInvalidPacket, effectType)
+	}
+}
+
+// skipAttributeModifier skips one attribute_modifiers entry. Since 1.21.6 the
+// display mode is encoded per entry (type 2 = override carries a text component).
+func skipAttributeModifier(r io.Reader) error {
+	if err := skipSequence(r, skipVarint, skipString, skipFixed(8), skipVarint, skipVarint); err != nil {
+		return err
+	}
+	displayType, err := ReadVarint(r)
+	if err != nil {
+		return err
+	}
+	if displayType == 2 {
+		return skipNBT(r)
+	}
+	return nil
+}
+
+func skipItemBlockPredicate(r io.Reader, depth int) error {
+	return skipSequence(r,
+		skipOptional(skipIDSet),
+		skipOptional(func(r io.Reader) error {
+			return skipArray(r, func(r io.Reader) error {
+				if err := skipString(r); err != nil {
+					return err
+				}
+				exact, err := ReadBool(r)
+				if err != nil {
+					return err
+				}
+				if exact {
+					return skipString(r)
+				}
+				return skipSequence(r, skipString, skipString)
+			})
+		}),
+		skipNBT,
+		func(r io.Reader) error {
+			return skipArray(r, func(r io.Reader) error {
+				if depth+1 > maxSlotNestingDepth {
+					return fmt.Errorf("%w: component matcher nesting too deep", ErrInvalidPacket)
+				}
+				_, err := readSlotComponent(r, depth+1)
+				return err
+			})
+		},
+		func(r io.Reader) error { return skipArray(r, skipVarint) },
+	)
+}
+
+func skipDamageTypeData(r io.Reader) error {
+	return skipSequence(r, skipString, skipVarint, skipFixed(4), skipVarint, skipVarint)
+}
+
+func skipArmorTrimMaterial(r io.Reader) error {
+	return skipSequence(r,
+		skipString,
+		func(r io.Reader) error {
+			return skipArray(r, func(r io.Reader) error { return skipSequence(r, skipString, skipString) })
+		},
+		skipNBT,
+	)
+}
+
+func skipArmorTrimPattern(r io.Reader) error {
+	return skipSequence(r, skipString, skipNBT, skipFixed(1))
+}
+
+func skipFireworkExplosion(r io.Reader) error {
+	return skipSequence(r,
+		skipVarint,
+		func(r io.Reader) error { return skipArray(r, skipFixed(4)) },
+		func(r io.Reader) error { return skipArray(r, skipFixed(4)) },
+		skipFixed(2),
+	)
+}
+
+func skipGameProfileProperty(r io.Reader) error {
+	return skipSequence(r, skipString, skipString, skipOptional(skipString))
+}
+
+func skipResolvableProfile(r io.Reader) error {
+	profileType, err := ReadVarint(r)
+	if err != nil {
+		return err
+	}
+	properties := func(r io.Reader) error { return skipArray(r, skipGameProfileProperty) }
+	switch profileType {
+	case 0: // partial
+		err = skipSequence(r, skipOptional(skipString), skipOptional(skipFixed(16)), properties)
+	case 1: // complete
+		err = skipSequence(r, skipFixed(16), skipString, properties)
+	default:
+		return fmt.Errorf("%w: resolvable profile type %d", ErrInvalidPacket, profileType)
+	}
+	if err != nil {
+		return err
+	}
+	// PlayerSkinPatch
+	return skipSequence(r,
+		skipOptional(skipString),
+		skipOptional(skipString),
+		skipOptional(skipString),
+		skipOptional(skipVarint),
+	)
+}
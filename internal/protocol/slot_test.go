@@ -0,0 +1,177 @@
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func writeTestNBTString(buf *bytes.Buffer, s string) {
+	_ = WriteByte(buf, TagString)
+	_ = WriteUnsignedShort(buf, uint16(len(s)))
+	buf.WriteString(s)
+}
+
+func TestReadSlotEmpty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 0)
+
+	slot, err := ReadSlot(buf)
+	if err != nil {
+		t.Fatalf("ReadSlot failed: %v", err)
+	}
+	if !slot.IsEmpty() {
+		t.Fatalf("slot should be empty: %+v", slot)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("unread bytes left: %d", buf.Len())
+	}
+}
+
+func TestReadSlotWithComponents(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 1)   // count
+	_ = WriteVarint(buf, 840) // item id
+	_ = WriteVarint(buf, 5)   // added
+	_ = WriteVarint(buf, 1)   // removed
+
+	_ = WriteVarint(buf, SlotComponentDamage)
+	_ = WriteVarint(buf, 12)
+
+	_ = WriteVarint(buf, SlotComponentCustomName)
+	writeTestNBTString(buf, "Excalibur")
+
+	_ = WriteVarint(buf, SlotComponentEnchantments)
+	_ = WriteVarint(buf, 2)
+	_ = WriteVarint(buf, 9)
+	_ = WriteVarint(buf, 3)
+	_ = WriteVarint(buf, 22)
+	_ = WriteVarint(buf, 1)
+
+	// attribute_modifiers: one entry with override display text
+	_ = WriteVarint(buf, 16)
+	_ = WriteVarint(buf, 1)
+	_ = WriteVarint(buf, 2)
+	_ = WriteString(buf, "minecraft:base_attack_damage")
+	_ = WriteDouble(buf, 7)
+	_ = WriteVarint(buf, 0)
+	_ = WriteVarint(buf, 1)
+	_ = WriteVarint(buf, 2)
+	writeTestNBTString(buf, "+7")
+
+	// tool: one rule on a tag set, default speed, damage per block, creative flag
+	_ = WriteVarint(buf, 28)
+	_ = WriteVarint(buf, 1)
+	_ = WriteVarint(buf, 0)
+	_ = WriteString(buf, "minecraft:mineable/pickaxe")
+	_ = WriteBool(buf, true)
+	_ = WriteFloat(buf, 8)
+	_ = WriteBool(buf, false)
+	_ = WriteFloat(buf, 1)
+	_ = WriteVarint(buf, 1)
+	_ = WriteBool(buf, true)
+
+	_ = WriteVarint(buf, SlotComponentRepairCost) // removed component
+	_ = WriteVarint(buf, 7)                       // trailing sentinel
+
+	slot, err := ReadSlot(buf)
+	if err != nil {
+		t.Fatalf("ReadSlot failed: %v", err)
+	}
+	if slot.ItemID != 840 || slot.Count != 1 {
+		t.Fatalf("slot header mismatch: %+v", slot)
+	}
+	if len(slot.Components) != 5 {
+		t.Fatalf("components len = %d, want 5", len(slot.Components))
+	}
+	if damage, ok := slot.IntComponent(SlotComponentDamage); !ok || damage != 12 {
+		t.Fatalf("damage = %d,%v want 12,true", damage, ok)
+	}
+	if name := slot.CustomName(); name != "Excalibur" {
+		t.Fatalf("custom name = %q", name)
+	}
+	enchantments := slot.Enchantments()
+	if len(enchantments) != 2 || enchantments[0] != (Enchantment{ID: 9, Level: 3}) {
+		t.Fatalf("enchantments mismatch: %+v", enchantments)
+	}
+	if len(slot.RemovedComponents) != 1 || slot.RemovedComponents[0] != SlotComponentRepairCost {
+		t.Fatalf("removed components mismatch: %+v", slot.RemovedComponents)
+	}
+
+	sentinel, err := ReadVarint(buf)
+	if err != nil || sentinel != 7 {
+		t.Fatalf("stream misaligned after slot: sentinel=%d err=%v", sentinel, err)
+	}
+}
+
+func TestReadSlotNestedContainer(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 1)
+	_ = WriteVarint(buf, 700) // shulker box
+	_ = WriteVarint(buf, 1)
+	_ = WriteVarint(buf, 0)
+	_ = WriteVarint(buf, SlotComponentContainer)
+	_ = WriteVarint(buf, 2)
+	_ = WriteVarint(buf, 64)
+	_ = WriteVarint(buf, 1)
+	_ = WriteVarint(buf, 0)
+	_ = WriteVarint(buf, 0)
+	_ = WriteVarint(buf, 0) // empty slot
+
+	slot, err := ReadSlot(buf)
+	if err != nil {
+		t.Fatalf("ReadSlot failed: %v", err)
+	}
+	c, ok := slot.Component(SlotComponentContainer)
+	if !ok {
+		t.Fatal("container component missing")
+	}
+	contents, ok := c.Value.([]Slot)
+	if !ok || len(contents) != 2 {
+		t.Fatalf("container contents mismatch: %#v", c.Value)
+	}
+	if contents[0].ItemID != 1 || contents[0].Count != 64 || !contents[1].IsEmpty() {
+		t.Fatalf("container contents mismatch: %+v", contents)
+	}
+}
+
+func TestReadSlotUnknownComponent(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 1)
+	_ = WriteVarint(buf, 1)
+	_ = WriteVarint(buf, 1)
+	_ = WriteVarint(buf, 0)
+	_ = WriteVarint(buf, 200)
+
+	_, err := ReadSlot(buf)
+	if !errors.Is(err, ErrUnknownSlotComponent) {
+		t.Fatalf("expected ErrUnknownSlotComponent, got %v", err)
+	}
+}
+
+func TestWriteSlotRoundTrip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, 3)
+	_ = WriteVarint(buf, 42)
+	_ = WriteVarint(buf, 2)
+	_ = WriteVarint(buf, 0)
+	_ = WriteVarint(buf, SlotComponentMaxDamage)
+	_ = WriteVarint(buf, 250)
+	_ = WriteVarint(buf, SlotComponentLore)
+	_ = WriteVarint(buf, 1)
+	writeTestNBTString(buf, "line")
+	original := append([]byte(nil), buf.Bytes()...)
+
+	slot, err := ReadSlot(buf)
+	if err != nil {
+		t.Fatalf("ReadSlot failed: %v", err)
+	}
+
+	out := new(bytes.Buffer)
+	if err := WriteSlot(out, slot); err != nil {
+		t.Fatalf("WriteSlot failed: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), original) {
+		t.Fatalf("round trip mismatch:\n got %x\nwant %x", out.Bytes(), original)
+	}
+}
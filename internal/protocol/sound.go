@@ -0,0 +1,226 @@
+package protocol
+
+import (
+	"bytes"
+	"io"
+)
+
+// Sound categories, the sound source mapping of the sound packets.
+var soundCategories = [...]string{
+	"master", "music", "record", "weather", "block", "hostile",
+	"neutral", "player", "ambient", "voice", "ui",
+}
+
+// SoundCategoryName returns the name of a sound category ID, or "" if unknown.
+func SoundCategoryName(id int32) string {
+	if id < 0 || int(id) >= len(soundCategories) {
+		return ""
+	}
+	return soundCategories[id]
+}
+
+// SoundEffect is the Sound Effect packet, a sound played at a position.
+// SoundID is the sound_event holder value, which is the one-based ID used by
+// minecraft-data's sounds.json; it is 0 when the packet carries an inline
+// sound named SoundName instead.
+type SoundEffect struct {
+	SoundID   int32
+	SoundName string
+	Category  int32
+	X         float64
+	Y         float64
+	Z         float64
+	Volume    float32
+	Pitch     float32
+	Seed      int64
+}
+
+func ParseSoundEffect(r io.Reader) (*SoundEffect, error) {
+	var p SoundEffect
+	var err error
+	if p.SoundID, p.SoundName, err = readSoundHolder(r); err != nil {
+		return nil, err
+	}
+	if p.Category, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	// The position is fixed-point with three fraction bits.
+	for _, f := range []*float64{&p.X, &p.Y, &p.Z} {
+		v, err := ReadInt32(r)
+		if err != nil {
+			return nil, err
+		}
+		*f = float64(v) / 8
+	}
+	if p.Volume, p.Pitch, p.Seed, err = readSoundTail(r); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func CreateSoundEffectPacket(p SoundEffect) *Packet {
+	buf := new(bytes.Buffer)
+	writeSoundHolder(buf, p.SoundID, p.SoundName)
+	_ = WriteVarint(buf, p.Category)
+	for _, f := range []float64{p.X, p.Y, p.Z} {
+		_ = WriteInt32(buf, int32(f*8))
+	}
+	writeSoundTail(buf, p.Volume, p.Pitch, p.Seed)
+	return &Packet{ID: S2CSoundEffect, Payload: buf.Bytes()}
+}
+
+// EntitySoundEffect is the Entity Sound Effect packet, a sound that follows
+// an entity. SoundID and SoundName are as in SoundEffect.
+type EntitySoundEffect struct {
+	SoundID   int32
+	SoundName string
+	Category  int32
+	EntityID  int32
+	Volume    float32
+	Pitch     float32
+	Seed      int64
+}
+
+func ParseEntitySoundEffect(r io.Reader) (*EntitySoundEffect, error) {
+	var p EntitySoundEffect
+	var err error
+	if p.SoundID, p.SoundName, err = readSoundHolder(r); err != nil {
+		return nil, err
+	}
+	if p.Category, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	if p.EntityID, err = ReadVarint(r); err != nil {
+		return nil, err
+	}
+	if p.Volume, p.Pitch, p.Seed, err = readSoundTail(r); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func CreateEntitySoundEffectPacket(p EntitySoundEffect) *Packet {
+	buf := new(bytes.Buffer)
+	writeSoundHolder(buf, p.SoundID, p.SoundName)
+	_ = WriteVarint(buf, p.Category)
+	_ = WriteVarint(buf, p.EntityID)
+	writeSoundTail(buf, p.Volume, p.Pitch, p.Seed)
+	return &Packet{ID: S2CEntitySoundEffect, Payload: buf.Bytes()}
+}
+
+// Explosion is the Explosion packet. The server sends the blast's sound in
+// it rather than as a separate Sound Effect; SoundID and SoundName are as in
+// SoundEffect. The trailing block particles are not decoded.
+type Explosion struct {
+	X            float64
+	Y            float64
+	Z            float64
+	Radius       float32
+	BlockCount   int32
+	HasKnockback bool
+	Knockback    Vec3
+	Particle     Particle
+	SoundID      int32
+	SoundName    string
+}
+
+func ParseExplosion(r io.Reader) (*Explosion, error) {
+	var p Explosion
+	var err error
+	for _, f := range []*float64{&p.X, &p.Y, &p.Z} {
+		if *f, err = ReadDouble(r); err != nil {
+			return nil, err
+		}
+	}
+	if p.Radius, err = ReadFloat(r); err != nil {
+		return nil, err
+	}
+	if p.BlockCount, err = ReadInt32(r); err != nil {
+		return nil, err
+	}
+	if p.HasKnockback, err = ReadBool(r); err != nil {
+		return nil, err
+	}
+	if p.HasKnockback {
+		for _, f := range []*float64{&p.Knockback.X, &p.Knockback.Y, &p.Knockback.Z} {
+			if *f, err = ReadDouble(r); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if p.Particle, err = readParticle(r); err != nil {
+		return nil, err
+	}
+	if p.SoundID, p.SoundName, err = readSoundHolder(r); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// CreateExplosionPacket writes an explosion without block particles. The
+// particle must be a type that carries no data.
+func CreateExplosionPacket(p Explosion) *Packet {
+	buf := new(bytes.Buffer)
+	for _, f := range []float64{p.X, p.Y, p.Z} {
+		_ = WriteDouble(buf, f)
+	}
+	_ = WriteFloat(buf, p.Radius)
+	_ = WriteInt32(buf, p.BlockCount)
+	_ = WriteBool(buf, p.HasKnockback)
+	if p.HasKnockback {
+		for _, f := range []float64{p.Knockback.X, p.Knockback.Y, p.Knockback.Z} {
+			_ = WriteDouble(buf, f)
+		}
+	}
+	_ = WriteVarint(buf, p.Particle.Type)
+	writeSoundHolder(buf, p.SoundID, p.SoundName)
+	_ = WriteVarint(buf, 0)
+	return &Packet{ID: S2CExplosion, Payload: buf.Bytes()}
+}
+
+// readSoundHolder reads a sound_event holder: a registry ID plus one, or 0
+// followed by an inline sound name and optional fixed range.
+func readSoundHolder(r io.Reader) (int32, string, error) {
+	id, err := ReadVarint(r)
+	if err != nil || id != 0 {
+		return id, "", err
+	}
+	name, err := ReadString(r)
+	if err != nil {
+		return 0, "", err
+	}
+	if err := skipOptional(skipFixed(4))(r); err != nil {
+		return 0, "", err
+	}
+	return 0, name, nil
+}
+
+func writeSoundHolder(w io.Writer, id int32, name string) {
+	_ = WriteVarint(w, id)
+	if id == 0 {
+		_ = WriteString(w, name)
+		_ = WriteBool(w, false)
+	}
+}
+
+func readSoundTail(r io.Reader) (float32, float32, int64, error) {
+	volume, err := ReadFloat(r)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	pitch, err := ReadFloat(r)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	seed, err := ReadInt64(r)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return volume, pitch, seed, nil
+}
+
+func writeSoundTail(w io.Writer, volume, pitch float32, seed int64) {
+	_ = WriteFloat(w, volume)
+	_ = WriteFloat(w, pitch)
+	_ = WriteInt64(w, seed)
+}
@@ -0,0 +1,63 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSoundEffectRoundTrip(t *testing.T) {
+	want := SoundEffect{SoundID: 321, Category: 5, X: 10.5, Y: -3.125, Z: -7, Volume: 1, Pitch: 0.5, Seed: 42}
+	got, err := ParseSoundEffect(bytes.NewReader(CreateSoundEffectPacket(want).Payload))
+	if err != nil {
+		t.Fatalf("ParseSoundEffect failed: %v", err)
+	}
+	if *got != want {
+		t.Fatalf("ParseSoundEffect = %+v, want %+v", *got, want)
+	}
+	if got := SoundCategoryName(got.Category); got != "hostile" {
+		t.Fatalf("SoundCategoryName(5) = %q, want hostile", got)
+	}
+}
+
+func TestEntitySoundEffectInlineSound(t *testing.T) {
+	var buf bytes.Buffer
+	_ = WriteVarint(&buf, 0)
+	_ = WriteString(&buf, "custom:alarm")
+	_ = WriteBool(&buf, true)
+	_ = WriteFloat(&buf, 32)
+	_ = WriteVarint(&buf, 6)
+	_ = WriteVarint(&buf, 99)
+	_ = WriteFloat(&buf, 2)
+	_ = WriteFloat(&buf, 1)
+	_ = WriteInt64(&buf, -1)
+
+	got, err := ParseEntitySoundEffect(&buf)
+	if err != nil {
+		t.Fatalf("ParseEntitySoundEffect failed: %v", err)
+	}
+	want := EntitySoundEffect{SoundName: "custom:alarm", Category: 6, EntityID: 99, Volume: 2, Pitch: 1, Seed: -1}
+	if *got != want {
+		t.Fatalf("ParseEntitySoundEffect = %+v, want %+v", *got, want)
+	}
+
+	roundTrip, err := ParseEntitySoundEffect(bytes.NewReader(CreateEntitySoundEffectPacket(want).Payload))
+	if err != nil || *roundTrip != want {
+		t.Fatalf("entity sound round trip = %+v, %v", roundTrip, err)
+	}
+}
+
+func TestExplosionRoundTrip(t *testing.T) {
+	want := Explosion{
+		X: 1.5, Y: 64, Z: -20.25, Radius: 4, BlockCount: 12,
+		HasKnockback: true, Knockback: Vec3{X: 0.5, Y: 0.25, Z: -0.5},
+		Particle: Particle{Type: 23},
+		SoundID:  669,
+	}
+	got, err := ParseExplosion(bytes.NewReader(CreateExplosionPacket(want).Payload))
+	if err != nil {
+		t.Fatalf("ParseExplosion failed: %v", err)
+	}
+	if *got != want {
+		t.Fatalf("ParseExplosion = %+v, want %+v", *got, want)
+	}
+}
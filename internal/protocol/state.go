@@ -12,6 +12,23 @@ const (
 	Play
 )
 
+func (s State) String() string {
+	switch s {
+	case Handshaking:
+		return "handshaking"
+	case Status:
+		return "status"
+	case Login:
+		return "login"
+	case Configuration:
+		return "configuration"
+	case Play:
+		return "play"
+	default:
+		return "unknown"
+	}
+}
+
 type ConnState struct {
 	mu        sync.RWMutex
 	state     State
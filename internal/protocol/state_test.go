@@ -71,3 +71,20 @@ func TestConnStateConcurrency(t *testing.T) {
 		t.Errorf("最终状态 %d 不在合法范围内", got)
 	}
 }
+
+// TestStateString 测试状态名称
+func TestStateString(t *testing.T) {
+	tests := map[State]string{
+		Handshaking:   "handshaking",
+		Status:        "status",
+		Login:         "login",
+		Configuration: "configuration",
+		Play:          "play",
+		State(42):     "unknown",
+	}
+	for state, want := range tests {
+		if got := state.String(); got != want {
+			t.Errorf("State(%d).String() = %q, 期望 %q", state, got, want)
+		}
+	}
+}
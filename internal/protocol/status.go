@@ -0,0 +1,72 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// StatusResponse is the Server List Ping JSON sent in the Status state.
+type StatusResponse struct {
+	Version            StatusVersion `json:"version"`
+	Players            StatusPlayers `json:"players"`
+	Description        string        `json:"-"`
+	Favicon            string        `json:"favicon,omitempty"`
+	EnforcesSecureChat bool          `json:"enforcesSecureChat"`
+}
+
+type StatusVersion struct {
+	Name     string `json:"name"`
+	Protocol int32  `json:"protocol"`
+}
+
+type StatusPlayers struct {
+	Max    int            `json:"max"`
+	Online int            `json:"online"`
+	Sample []StatusPlayer `json:"sample,omitempty"`
+}
+
+type StatusPlayer struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+func ParseStatusResponse(r io.Reader) (*StatusResponse, error) {
+	raw, err := ReadString(r)
+	if err != nil {
+		return nil, err
+	}
+	var payload struct {
+		StatusResponse
+		// description is a text component: a bare string or an object.
+		Description any `json:"description"`
+	}
+	if err := json.Unmarshal([]byte(raw), &payload); err != nil {
+		return nil, fmt.Errorf("parse status json: %w", err)
+	}
+	status := payload.StatusResponse
+	status.Description = formatJSONText(payload.Description)
+	return &status, nil
+}
+
+func CreateStatusRequestPacket() *Packet {
+	return &Packet{
+		ID:      C2SStatusRequest,
+		Payload: []byte{},
+	}
+}
+
+func CreateStatusPingPacket(payload int64) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteInt64(buf, payload)
+	return &Packet{
+		ID:      C2SStatusPing,
+		Payload: buf.Bytes(),
+	}
+}
+
+// ParseStatusPong reads the payload the server echoed back.
+func ParseStatusPong(r io.Reader) (int64, error) {
+	return ReadInt64(r)
+}
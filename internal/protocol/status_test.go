@@ -0,0 +1,74 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseStatusResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		description string
+		sample      int
+	}{
+		{
+			name:        "文本组件描述",
+			raw:         `{"version":{"name":"1.21.11","protocol":774},"players":{"max":20,"online":2,"sample":[{"name":"Steve","id":"069a79f4-44e9-4726-a5be-fca90e38aaf5"},{"name":"Alex","id":"ec561538-f3fd-461d-aff5-086b22154bce"}]},"description":{"text":"A ","extra":[{"text":"Minecraft"}," Server"]},"enforcesSecureChat":true}`,
+			description: "A Minecraft Server",
+			sample:      2,
+		},
+		{
+			name:        "字符串描述",
+			raw:         `{"version":{"name":"Paper 1.21.11","protocol":774},"players":{"max":100,"online":0},"description":"Hello"}`,
+			description: "Hello",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteString(&buf, tt.raw); err != nil {
+				t.Fatalf("WriteString() 返回错误: %v", err)
+			}
+			status, err := ParseStatusResponse(&buf)
+			if err != nil {
+				t.Fatalf("ParseStatusResponse() 返回错误: %v", err)
+			}
+			if status.Version.Protocol != 774 {
+				t.Errorf("Version.Protocol = %d, 期望 774", status.Version.Protocol)
+			}
+			if status.Description != tt.description {
+				t.Errorf("Description = %q, 期望 %q", status.Description, tt.description)
+			}
+			if len(status.Players.Sample) != tt.sample {
+				t.Errorf("Players.Sample = %v, 期望 %d 项", status.Players.Sample, tt.sample)
+			}
+		})
+	}
+
+	var bad bytes.Buffer
+	_ = WriteString(&bad, "not json")
+	if _, err := ParseStatusResponse(&bad); err == nil {
+		t.Error("ParseStatusResponse() 非法 JSON 应返回错误")
+	}
+}
+
+func TestStatusPingRoundTrip(t *testing.T) {
+	packet := CreateStatusPingPacket(1234567890123)
+	if packet.ID != C2SStatusPing {
+		t.Errorf("ID = 0x%02X, 期望 0x%02X", packet.ID, C2SStatusPing)
+	}
+	got, err := ParseStatusPong(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ParseStatusPong() 返回错误: %v", err)
+	}
+	if got != 1234567890123 {
+		t.Errorf("payload = %d, 期望 1234567890123", got)
+	}
+
+	req := CreateStatusRequestPacket()
+	if req.ID != C2SStatusRequest || len(req.Payload) != 0 {
+		t.Errorf("status request = %+v", req)
+	}
+}
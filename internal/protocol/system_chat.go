@@ -47,9 +47,34 @@ func FormatTextComponent(node *NBTNode) string {
 	}
 }
 
+// TextTranslation returns the translation key of a translatable component and
+// its arguments formatted with FormatTextComponent.
+func TextTranslation(node *NBTNode) (string, []string, bool) {
+	if node == nil || node.Type != TagCompound {
+		return "", nil, false
+	}
+	c := node.Value.(map[string]*NBTNode)
+	tr, ok := c["translate"]
+	if !ok || tr.Type != TagString {
+		return "", nil, false
+	}
+	var args []string
+	if with, ok := c["with"]; ok && with.Type == TagList {
+		for _, arg := range with.Value.([]*NBTNode) {
+			args = append(args, FormatTextComponent(arg))
+		}
+	}
+	return tr.Value.(string), args, true
+}
+
 func formatCompound(c map[string]*NBTNode) string {
 	var b strings.Builder
 
+	// Entries of a mixed-type list are wrapped as { "": value }.
+	if wrapped, ok := c[""]; ok && len(c) == 1 {
+		return FormatTextComponent(wrapped)
+	}
+
 	// "text" field — literal text
 	if text, ok := c["text"]; ok && text.Type == TagString {
 		b.WriteString(text.Value.(string))
@@ -145,3 +145,66 @@ func TestParseSystemChatErrors(t *testing.T) {
 		})
 	}
 }
+
+func writeTestNBTStringField(buf *bytes.Buffer, name, value string) {
+	buf.WriteByte(TagString)
+	binary.Write(buf, binary.BigEndian, uint16(len(name)))
+	buf.WriteString(name)
+	binary.Write(buf, binary.BigEndian, uint16(len(value)))
+	buf.WriteString(value)
+}
+
+// writeTestTranslate 写入 { "translate": key, "with": [ {"text": arg}... ] }（不含外层类型字节）
+func writeTestTranslate(buf *bytes.Buffer, key string, args ...string) {
+	writeTestNBTStringField(buf, "translate", key)
+	if len(args) > 0 {
+		buf.WriteByte(TagList)
+		binary.Write(buf, binary.BigEndian, uint16(4))
+		buf.WriteString("with")
+		buf.WriteByte(TagCompound)
+		binary.Write(buf, binary.BigEndian, int32(len(args)))
+		for _, arg := range args {
+			writeTestNBTStringField(buf, "text", arg)
+			buf.WriteByte(TagEnd)
+		}
+	}
+	buf.WriteByte(TagEnd)
+}
+
+func TestTextTranslation(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteByte(TagCompound)
+	writeTestTranslate(&buf, "death.attack.mob", "Steve", "Zombie")
+
+	node, err := ReadAnonymousNBT(&buf)
+	if err != nil {
+		t.Fatalf("ReadAnonymousNBT() 返回错误: %v", err)
+	}
+	key, args, ok := TextTranslation(node)
+	if !ok {
+		t.Fatal("TextTranslation() 应识别 translate 组件")
+	}
+	if key != "death.attack.mob" {
+		t.Errorf("key = %q, 期望 death.attack.mob", key)
+	}
+	if len(args) != 2 || args[0] != "Steve" || args[1] != "Zombie" {
+		t.Errorf("args = %v, 期望 [Steve Zombie]", args)
+	}
+	if got := FormatTextComponent(node); got != "death.attack.mob(Steve, Zombie)" {
+		t.Errorf("FormatTextComponent() = %q", got)
+	}
+
+	plain := &NBTNode{Type: TagString, Value: "hello"}
+	if _, _, ok := TextTranslation(plain); ok {
+		t.Error("纯文本不应识别为 translate 组件")
+	}
+}
+
+func TestFormatTextComponentUnwrapsMixedListEntries(t *testing.T) {
+	node := &NBTNode{Type: TagCompound, Value: map[string]*NBTNode{
+		"": {Type: TagString, Value: "Alex"},
+	}}
+	if got := FormatTextComponent(node); got != "Alex" {
+		t.Errorf("FormatTextComponent() = %q, 期望 Alex", got)
+	}
+}
@@ -0,0 +1,82 @@
+package protocol
+
+import (
+	"bytes"
+	"io"
+)
+
+// Tag names a set of entries of one registry. Entries are network IDs of
+// that registry, e.g. block IDs for "minecraft:mineable/pickaxe".
+type Tag struct {
+	Name    string
+	Entries []int32
+}
+
+// RegistryTags holds every tag of one registry, such as "minecraft:block".
+type RegistryTags struct {
+	Registry string
+	Tags     []Tag
+}
+
+// UpdateTags is the Update Tags packet, sent during configuration and again
+// when the server reloads its datapacks. Registries it leaves out keep their
+// tags.
+type UpdateTags struct {
+	Registries []RegistryTags
+}
+
+func ParseUpdateTags(r io.Reader) (*UpdateTags, error) {
+	registryCount, err := readCollectionLength(r)
+	if err != nil {
+		return nil, err
+	}
+	p := &UpdateTags{Registries: make([]RegistryTags, 0, registryCount)}
+	for i := 0; i < registryCount; i++ {
+		registry := RegistryTags{}
+		if registry.Registry, err = ReadString(r); err != nil {
+			return nil, err
+		}
+		tagCount, err := readCollectionLength(r)
+		if err != nil {
+			return nil, err
+		}
+		registry.Tags = make([]Tag, 0, tagCount)
+		for j := 0; j < tagCount; j++ {
+			tag := Tag{}
+			if tag.Name, err = ReadString(r); err != nil {
+				return nil, err
+			}
+			entryCount, err := readCollectionLength(r)
+			if err != nil {
+				return nil, err
+			}
+			tag.Entries = make([]int32, entryCount)
+			for k := range tag.Entries {
+				if tag.Entries[k], err = ReadVarint(r); err != nil {
+					return nil, err
+				}
+			}
+			registry.Tags = append(registry.Tags, tag)
+		}
+		p.Registries = append(p.Registries, registry)
+	}
+	return p, nil
+}
+
+// CreateUpdateTagsPacket writes a configuration-phase Update Tags packet.
+func CreateUpdateTagsPacket(registries []RegistryTags) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteVarint(buf, int32(len(registries)))
+	for _, registry := range registries {
+		_ = WriteString(buf, registry.Registry)
+		_ = WriteVarint(buf, int32(len(registry.Tags)))
+		for _, tag := range registry.Tags {
+			_ = WriteString(buf, tag.Name)
+			_ = WriteVarint(buf, int32(len(tag.Entries)))
+			for _, entry := range tag.Entries {
+				_ = WriteVarint(buf, entry)
+			}
+		}
+	}
+	return &Packet{ID: S2CConfigUpdateTags, Payload: buf.Bytes()}
+}
@@ -0,0 +1,28 @@
+package protocol
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateTagsRoundTrip(t *testing.T) {
+	want := []RegistryTags{
+		{Registry: "minecraft:block", Tags: []Tag{
+			{Name: "minecraft:mineable/pickaxe", Entries: []int32{1, 2, 3}},
+			{Name: "minecraft:logs", Entries: []int32{}},
+		}},
+		{Registry: "minecraft:item", Tags: []Tag{{Name: "minecraft:planks", Entries: []int32{36}}}},
+	}
+	packet := CreateUpdateTagsPacket(want)
+	if packet.ID != S2CConfigUpdateTags {
+		t.Fatalf("packet ID = 0x%02x, want 0x%02x", packet.ID, S2CConfigUpdateTags)
+	}
+	got, err := ParseUpdateTags(bytes.NewReader(packet.Payload))
+	if err != nil {
+		t.Fatalf("ParseUpdateTags failed: %v", err)
+	}
+	if !reflect.DeepEqual(got.Registries, want) {
+		t.Fatalf("ParseUpdateTags = %+v, want %+v", got.Registries, want)
+	}
+}
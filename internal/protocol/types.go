@@ -117,6 +117,30 @@ func WriteString(w io.Writer, s string) error {
 	return err
 }
 
+// ReadByteArray reads a VarInt length-prefixed byte array.
+func ReadByteArray(r io.Reader) ([]byte, error) {
+	length, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 || length > MaxPacketSize {
+		return nil, fmt.Errorf("invalid byte array length %d", length)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func WriteByteArray(w io.Writer, data []byte) error {
+	if err := WriteVarint(w, int32(len(data))); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
 func ReadUnsignedShort(r io.Reader) (uint16, error) {
 	var buf [2]byte
 	_, err := io.ReadFull(r, buf[:])
@@ -191,6 +215,13 @@ func ReadInt16(r io.Reader) (int16, error) {
 	return int16(binary.BigEndian.Uint16(buf[:])), nil
 }
 
+func WriteInt16(w io.Writer, value int16) error {
+	var buf [2]byte
+	binary.BigEndian.PutUint16(buf[:], uint16(value))
+	_, err := w.Write(buf[:])
+	return err
+}
+
 func ReadInt32(r io.Reader) (int32, error) {
 	var buf [4]byte
 	_, err := io.ReadFull(r, buf[:])
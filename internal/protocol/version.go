@@ -0,0 +1,304 @@
+package protocol
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// CurrentVersionName is the Minecraft version the packet constants and
+// hand-written parsers were written against.
+const CurrentVersionName = "1.21.11"
+
+// Version describes one protocol version: its number, the minecraft-data
+// directory holding its data files, and how its packet IDs map onto the
+// canonical IDs in packet_id_gen.go. Packets are matched by protocol.json
+// name, so a packet that only moved between patches keeps working; payload
+// layouts are assumed unchanged for the packets the bot parses.
+type Version struct {
+	Protocol int32
+	Name     string
+	// DataDir holds protocol.json, blocks.json, items.json and friends.
+	DataDir string
+
+	// Both maps are nil for the canonical version, which needs no remapping.
+	toCanonical   map[packetKey]int32
+	fromCanonical map[packetKey]int32
+}
+
+var (
+	defaultVersionOnce sync.Once
+	defaultVersion     *Version
+)
+
+// DefaultVersion is the version the constants in this package describe.
+func DefaultVersion() *Version {
+	defaultVersionOnce.Do(func() {
+		defaultVersion = &Version{
+			Protocol: CurrentProtocolVersion,
+			Name:     CurrentVersionName,
+			DataDir:  filepath.Join(DefaultDataRoot(), CurrentVersionName),
+		}
+	})
+	return defaultVersion
+}
+
+func (v *Version) String() string {
+	if v == nil {
+		return fmt.Sprintf("%s (%d)", CurrentVersionName, CurrentProtocolVersion)
+	}
+	return fmt.Sprintf("%s (%d)", v.Name, v.Protocol)
+}
+
+// IsCanonical reports whether packet IDs pass through unchanged.
+func (v *Version) IsCanonical() bool {
+	return v == nil || v.toCanonical == nil
+}
+
+// ProtocolNumber returns the number sent in the handshake.
+func (v *Version) ProtocolNumber() int32 {
+	if v == nil {
+		return CurrentProtocolVersion
+	}
+	return v.Protocol
+}
+
+// CanonicalID maps a packet ID read off the wire to the constant used in this
+// package. Packets the canonical version does not know map to -1, which no
+// handler matches.
+func (v *Version) CanonicalID(state State, dir Direction, wireID int32) int32 {
+	if v.IsCanonical() {
+		return wireID
+	}
+	id, ok := v.toCanonical[packetKey{state, dir, wireID}]
+	if !ok {
+		return -1
+	}
+	return id
+}
+
+// WireID maps a canonical packet ID to the one this version sends.
+func (v *Version) WireID(state State, dir Direction, canonicalID int32) (int32, error) {
+	if v.IsCanonical() {
+		return canonicalID, nil
+	}
+	id, ok := v.fromCanonical[packetKey{state, dir, canonicalID}]
+	if !ok {
+		return 0, fmt.Errorf("%s packet %q (0x%02x) does not exist in %s",
+			state, PacketName(state, dir, canonicalID), canonicalID, v)
+	}
+	return id, nil
+}
+
+type versionFile struct {
+	Version          int32  `json:"version"`
+	MinecraftVersion string `json:"minecraftVersion"`
+}
+
+// LoadVersion reads version.json and protocol.json from a minecraft-data
+// version directory.
+func LoadVersion(dataDir string) (*Version, error) {
+	data, err := os.ReadFile(filepath.Join(dataDir, "version.json"))
+	if err != nil {
+		return nil, fmt.Errorf("read version.json: %w", err)
+	}
+	var vf versionFile
+	if err := json.Unmarshal(data, &vf); err != nil {
+		return nil, fmt.Errorf("parse version.json: %w", err)
+	}
+	if vf.Version <= 0 || vf.MinecraftVersion == "" {
+		return nil, fmt.Errorf("%s: version.json has no protocol version", dataDir)
+	}
+
+	v := &Version{Protocol: vf.Version, Name: vf.MinecraftVersion, DataDir: dataDir}
+	if v.Protocol == CurrentProtocolVersion {
+		return v, nil
+	}
+
+	data, err = os.ReadFile(filepath.Join(dataDir, "protocol.json"))
+	if err != nil {
+		return nil, fmt.Errorf("read protocol.json: %w", err)
+	}
+	ids, err := parsePacketIDs(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", dataDir, err)
+	}
+	v.toCanonical = make(map[packetKey]int32, len(ids))
+	v.fromCanonical = make(map[packetKey]int32, len(ids))
+	for key, name := range ids {
+		canonical, ok := LookupPacketID(key.state, key.dir, name)
+		if !ok {
+			continue
+		}
+		v.toCanonical[key] = canonical
+		v.fromCanonical[packetKey{key.state, key.dir, canonical}] = key.id
+	}
+	return v, nil
+}
+
+type protocolMapper struct {
+	Types struct {
+		Packet []json.RawMessage `json:"packet"`
+	} `json:"types"`
+}
+
+type protocolField struct {
+	Name string            `json:"name"`
+	Type []json.RawMessage `json:"type"`
+}
+
+// parsePacketIDs extracts the packet name mapper of every state and direction.
+func parsePacketIDs(data []byte) (map[packetKey]string, error) {
+	// The top-level "types" entry is not a state, so states are decoded one by one.
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse protocol.json: %w", err)
+	}
+
+	ids := make(map[packetKey]string)
+	for _, state := range []State{Handshaking, Status, Login, Configuration, Play} {
+		var dirs map[string]protocolMapper
+		if err := json.Unmarshal(raw[state.String()], &dirs); err != nil {
+			return nil, fmt.Errorf("parse %s packets: %w", state, err)
+		}
+		for _, dir := range []Direction{ToClient, ToServer} {
+			packet := dirs[dir.String()].Types.Packet
+			if len(packet) != 2 {
+				continue
+			}
+			var fields []protocolField
+			if err := json.Unmarshal(packet[1], &fields); err != nil {
+				return nil, fmt.Errorf("parse %s %s packet mapper: %w", state, dir, err)
+			}
+			for _, f := range fields {
+				if f.Name != "name" || len(f.Type) != 2 {
+					continue
+				}
+				var args struct {
+					Mappings map[string]string `json:"mappings"`
+				}
+				if err := json.Unmarshal(f.Type[1], &args); err != nil {
+					return nil, fmt.Errorf("parse %s %s packet mapper: %w", state, dir, err)
+				}
+				for idStr, name := range args.Mappings {
+					id, err := strconv.ParseInt(idStr, 0, 32)
+					if err != nil {
+						return nil, fmt.Errorf("packet id %q: %w", idStr, err)
+					}
+					ids[packetKey{state, dir, int32(id)}] = name
+				}
+			}
+		}
+	}
+	return ids, nil
+}
+
+// VersionRegistry indexes the protocol versions Locus has data for.
+type VersionRegistry struct {
+	mu       sync.RWMutex
+	versions map[int32]*Version
+}
+
+func NewVersionRegistry(versions ...*Version) *VersionRegistry {
+	r := &VersionRegistry{versions: make(map[int32]*Version)}
+	for _, v := range versions {
+		r.Register(v)
+	}
+	return r
+}
+
+func (r *VersionRegistry) Register(v *Version) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.versions[v.Protocol] = v
+}
+
+func (r *VersionRegistry) ByProtocol(protocol int32) (*Version, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	v, ok := r.versions[protocol]
+	return v, ok
+}
+
+// Lookup accepts a Minecraft version name ("1.21.11") or a protocol number.
+func (r *VersionRegistry) Lookup(nameOrProtocol string) (*Version, bool) {
+	if n, err := strconv.Atoi(nameOrProtocol); err == nil {
+		return r.ByProtocol(int32(n))
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, v := range r.versions {
+		if v.Name == nameOrProtocol {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// Versions returns the registered versions, newest protocol first.
+func (r *VersionRegistry) Versions() []*Version {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]*Version, 0, len(r.versions))
+	for _, v := range r.versions {
+		out = append(out, v)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Protocol > out[j].Protocol })
+	return out
+}
+
+// DiscoverVersions registers every directory under root that holds a
+// version.json. A directory that fails to load is logged and skipped so one
+// broken version does not hide the others. The default version is always
+// present, even when its data directory lives elsewhere.
+func DiscoverVersions(root string) (*VersionRegistry, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("read data root: %w", err)
+	}
+	r := NewVersionRegistry()
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(root, entry.Name())
+		if _, err := os.Stat(filepath.Join(dir, "version.json")); err != nil {
+			continue
+		}
+		v, err := LoadVersion(dir)
+		if err != nil {
+			slog.Warn("Skipping version data directory", "dir", dir, "error", err)
+			continue
+		}
+		r.Register(v)
+	}
+	if _, ok := r.ByProtocol(CurrentProtocolVersion); !ok {
+		r.Register(DefaultVersion())
+	}
+	return r, nil
+}
+
+// DefaultDataRoot finds the directory that holds the per-version data
+// directories: the working directory, next to the executable, or the source
+// tree when running tests.
+func DefaultDataRoot() string {
+	candidates := []string{"."}
+	if exePath, err := os.Executable(); err == nil {
+		candidates = append(candidates, filepath.Dir(exePath))
+	}
+	if _, file, _, ok := runtime.Caller(0); ok {
+		candidates = append(candidates, filepath.Join(filepath.Dir(file), "..", ".."))
+	}
+	for _, candidate := range candidates {
+		if _, err := os.Stat(filepath.Join(candidate, CurrentVersionName, "version.json")); err == nil {
+			return filepath.Clean(candidate)
+		}
+	}
+	return "."
+}
@@ -0,0 +1,137 @@
+package protocol
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// writeShiftedVersion writes a fake version directory whose play toClient IDs
+// are all shifted by one, as if a packet had been inserted at 0x00.
+func writeShiftedVersion(t *testing.T, root, name string, protocolNumber int) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", CurrentVersionName, "protocol.json"))
+	if err != nil {
+		t.Fatalf("读取 protocol.json 失败: %v", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("解析 protocol.json 失败: %v", err)
+	}
+	packet := schema["play"].(map[string]any)["toClient"].(map[string]any)["types"].(map[string]any)["packet"].([]any)
+	nameField := packet[1].([]any)[0].(map[string]any)
+	mapper := nameField["type"].([]any)[1].(map[string]any)
+	shifted := make(map[string]any)
+	for idStr, packetName := range mapper["mappings"].(map[string]any) {
+		id, err := strconv.ParseInt(idStr, 0, 32)
+		if err != nil {
+			t.Fatalf("解析包 ID %q 失败: %v", idStr, err)
+		}
+		shifted["0x"+strconv.FormatInt(id+1, 16)] = packetName
+	}
+	mapper["mappings"] = shifted
+
+	dir := filepath.Join(root, name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	out, _ := json.Marshal(schema)
+	if err := os.WriteFile(filepath.Join(dir, "protocol.json"), out, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	version, _ := json.Marshal(map[string]any{"version": protocolNumber, "minecraftVersion": name})
+	if err := os.WriteFile(filepath.Join(dir, "version.json"), version, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestLoadVersionRemapsPacketIDs(t *testing.T) {
+	dir := writeShiftedVersion(t, t.TempDir(), "1.21.99", 999)
+	v, err := LoadVersion(dir)
+	if err != nil {
+		t.Fatalf("LoadVersion 失败: %v", err)
+	}
+	if v.Protocol != 999 || v.Name != "1.21.99" || v.IsCanonical() {
+		t.Fatalf("版本 = %+v, 期望非规范的 1.21.99 (999)", v)
+	}
+
+	if got := v.CanonicalID(Play, ToClient, S2CPlayKeepAlive+1); got != S2CPlayKeepAlive {
+		t.Errorf("CanonicalID = 0x%02x, 期望 0x%02x", got, S2CPlayKeepAlive)
+	}
+	if got := v.CanonicalID(Play, ToClient, 0x00); got != -1 {
+		t.Errorf("未知线上 ID 应映射为 -1, 得到 0x%02x", got)
+	}
+	wire, err := v.WireID(Play, ToClient, S2CPlayKeepAlive)
+	if err != nil || wire != S2CPlayKeepAlive+1 {
+		t.Errorf("WireID = 0x%02x, %v, 期望 0x%02x", wire, err, S2CPlayKeepAlive+1)
+	}
+	// Other directions are unchanged in the fake version.
+	if got := v.CanonicalID(Play, ToServer, C2SPlayKeepAlive); got != C2SPlayKeepAlive {
+		t.Errorf("toServer CanonicalID = 0x%02x, 期望不变", got)
+	}
+}
+
+func TestDefaultVersionIsCanonical(t *testing.T) {
+	v := DefaultVersion()
+	if !v.IsCanonical() || v.ProtocolNumber() != CurrentProtocolVersion {
+		t.Fatalf("默认版本 = %s, 期望规范版本 %d", v, CurrentProtocolVersion)
+	}
+	if got := v.CanonicalID(Play, ToClient, 0x55); got != 0x55 {
+		t.Errorf("规范版本应原样返回 ID, 得到 0x%02x", got)
+	}
+	if _, err := os.Stat(filepath.Join(v.DataDir, "blocks.json")); err != nil {
+		t.Errorf("默认数据目录 %s 缺少 blocks.json: %v", v.DataDir, err)
+	}
+	var nilVersion *Version
+	if nilVersion.ProtocolNumber() != CurrentProtocolVersion || !nilVersion.IsCanonical() {
+		t.Error("nil 版本应视为规范版本")
+	}
+}
+
+func TestDiscoverVersions(t *testing.T) {
+	root := t.TempDir()
+	writeShiftedVersion(t, root, "1.21.99", 999)
+	if err := os.MkdirAll(filepath.Join(root, "notes"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	// A broken directory must not hide the versions that do load.
+	broken := filepath.Join(root, "1.21.98")
+	if err := os.MkdirAll(broken, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(broken, "version.json"), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	reg, err := DiscoverVersions(root)
+	if err != nil {
+		t.Fatalf("DiscoverVersions 失败: %v", err)
+	}
+	versions := reg.Versions()
+	if len(versions) != 2 || versions[0].Protocol != 999 || versions[1].Protocol != CurrentProtocolVersion {
+		t.Fatalf("Versions = %v, 期望 [999, %d]", versions, CurrentProtocolVersion)
+	}
+	if v, ok := reg.Lookup("1.21.99"); !ok || v.Protocol != 999 {
+		t.Errorf("按名称查找失败: %v %v", v, ok)
+	}
+	if v, ok := reg.Lookup("774"); !ok || v.Name != CurrentVersionName {
+		t.Errorf("按协议号查找失败: %v %v", v, ok)
+	}
+	if _, ok := reg.Lookup("1.8.9"); ok {
+		t.Error("未注册的版本不应被找到")
+	}
+}
+
+func TestDiscoverVersionsRepoData(t *testing.T) {
+	reg, err := DiscoverVersions(DefaultDataRoot())
+	if err != nil {
+		t.Fatalf("DiscoverVersions 失败: %v", err)
+	}
+	v, ok := reg.ByProtocol(CurrentProtocolVersion)
+	if !ok || !v.IsCanonical() {
+		t.Fatalf("仓库数据应包含规范版本 %d", CurrentProtocolVersion)
+	}
+}
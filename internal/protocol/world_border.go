@@ -0,0 +1,102 @@
+package protocol
+
+import (
+	"bytes"
+	"io"
+)
+
+// InitializeWorldBorder is the Initialize World Border packet. The border is
+// a square of side Diameter around X, Z. While OldDiameter differs from
+// NewDiameter it is resizing, taking Speed ticks.
+type InitializeWorldBorder struct {
+	X                      float64
+	Z                      float64
+	OldDiameter            float64
+	NewDiameter            float64
+	Speed                  int32
+	PortalTeleportBoundary int32
+	WarningBlocks          int32
+	WarningTime            int32
+}
+
+func ParseInitializeWorldBorder(r io.Reader) (*InitializeWorldBorder, error) {
+	var p InitializeWorldBorder
+	var err error
+	for _, f := range []*float64{&p.X, &p.Z, &p.OldDiameter, &p.NewDiameter} {
+		if *f, err = ReadDouble(r); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range []*int32{&p.Speed, &p.PortalTeleportBoundary, &p.WarningBlocks, &p.WarningTime} {
+		if *v, err = ReadVarint(r); err != nil {
+			return nil, err
+		}
+	}
+	return &p, nil
+}
+
+func CreateInitializeWorldBorderPacket(p InitializeWorldBorder) *Packet {
+	buf := new(bytes.Buffer)
+	for _, f := range []float64{p.X, p.Z, p.OldDiameter, p.NewDiameter} {
+		_ = WriteDouble(buf, f)
+	}
+	for _, v := range []int32{p.Speed, p.PortalTeleportBoundary, p.WarningBlocks, p.WarningTime} {
+		_ = WriteVarint(buf, v)
+	}
+	return &Packet{ID: S2CInitializeWorldBorder, Payload: buf.Bytes()}
+}
+
+type WorldBorderCenter struct {
+	X float64
+	Z float64
+}
+
+func ParseWorldBorderCenter(r io.Reader) (*WorldBorderCenter, error) {
+	x, err := ReadDouble(r)
+	if err != nil {
+		return nil, err
+	}
+	z, err := ReadDouble(r)
+	if err != nil {
+		return nil, err
+	}
+	return &WorldBorderCenter{X: x, Z: z}, nil
+}
+
+// WorldBorderLerpSize starts a border resize from OldDiameter to
+// NewDiameter that takes Speed ticks.
+type WorldBorderLerpSize struct {
+	OldDiameter float64
+	NewDiameter float64
+	Speed       int32
+}
+
+func ParseWorldBorderLerpSize(r io.Reader) (*WorldBorderLerpSize, error) {
+	oldDiameter, err := ReadDouble(r)
+	if err != nil {
+		return nil, err
+	}
+	newDiameter, err := ReadDouble(r)
+	if err != nil {
+		return nil, err
+	}
+	speed, err := ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	return &WorldBorderLerpSize{OldDiameter: oldDiameter, NewDiameter: newDiameter, Speed: speed}, nil
+}
+
+func CreateWorldBorderLerpSizePacket(oldDiameter, newDiameter float64, speed int32) *Packet {
+	buf := new(bytes.Buffer)
+	_ = WriteDouble(buf, oldDiameter)
+	_ = WriteDouble(buf, newDiameter)
+	_ = WriteVarint(buf, speed)
+	return &Packet{ID: S2CWorldBorderLerpSize, Payload: buf.Bytes()}
+}
+
+// ParseWorldBorderSize reads the Set Border Size packet, which sets the
+// diameter at once.
+func ParseWorldBorderSize(r io.Reader) (float64, error) {
+	return ReadDouble(r)
+}
@@ -0,0 +1,38 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestInitializeWorldBorderRoundTrip(t *testing.T) {
+	want := InitializeWorldBorder{X: 100.5, Z: -20, OldDiameter: 500, NewDiameter: 200, Speed: 6000, PortalTeleportBoundary: 29999984, WarningBlocks: 5, WarningTime: 15}
+	got, err := ParseInitializeWorldBorder(bytes.NewReader(CreateInitializeWorldBorderPacket(want).Payload))
+	if err != nil {
+		t.Fatalf("ParseInitializeWorldBorder failed: %v", err)
+	}
+	if *got != want {
+		t.Fatalf("ParseInitializeWorldBorder = %+v, want %+v", *got, want)
+	}
+}
+
+func TestWorldBorderUpdates(t *testing.T) {
+	var center bytes.Buffer
+	_ = WriteDouble(&center, 8)
+	_ = WriteDouble(&center, -8)
+	c, err := ParseWorldBorderCenter(&center)
+	if err != nil || c.X != 8 || c.Z != -8 {
+		t.Fatalf("ParseWorldBorderCenter = %+v, %v", c, err)
+	}
+
+	lerp, err := ParseWorldBorderLerpSize(bytes.NewReader(CreateWorldBorderLerpSizePacket(300, 100, 200).Payload))
+	if err != nil || lerp.OldDiameter != 300 || lerp.NewDiameter != 100 || lerp.Speed != 200 {
+		t.Fatalf("ParseWorldBorderLerpSize = %+v, %v", lerp, err)
+	}
+
+	var size bytes.Buffer
+	_ = WriteDouble(&size, 64)
+	if d, err := ParseWorldBorderSize(&size); err != nil || d != 64 {
+		t.Fatalf("ParseWorldBorderSize = %v, %v", d, err)
+	}
+}
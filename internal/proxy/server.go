@@ -148,8 +148,8 @@ func (s *Server) relayPackets(ctx context.Context, src, dst net.Conn, tag string
 			if err := s.handleHandshaking(packet, tag, connState); err != nil {
 				return err
 			}
-		//case protocol.Status:
-		// Handle status state if needed
+		case protocol.Status:
+			s.handleStatus(packet, tag)
 		case protocol.Login:
 			if err := s.handleLogin(packet, tag, connState, &newThreshold); err != nil {
 				return err
@@ -198,16 +198,28 @@ func (s *Server) handleHandshaking(packet *protocol.Packet, tag string, connStat
 			return err
 		}
 		slog.Info("Handshake", "proto", handshake.ProtocolVersion, "addr", handshake.ServerAddress, "port", handshake.ServerPort, "next", handshake.NextState)
-		if handshake.NextState == 1 {
+		if handshake.NextState == protocol.NextStateStatus {
 			connState.Set(protocol.Status)
 		}
-		if handshake.NextState == 2 {
+		if handshake.NextState == protocol.NextStateLogin {
 			connState.Set(protocol.Login)
 		}
 	}
 	return nil
 }
 
+func (s *Server) handleStatus(packet *protocol.Packet, tag string) {
+	if tag == "S->C" && packet.ID == protocol.S2CStatusResponse {
+		packetRdr := bytes.NewReader(packet.Payload)
+		status, err := protocol.ParseStatusResponse(packetRdr)
+		if err != nil {
+			slog.Warn("Failed to parse status response", "error", err)
+			return
+		}
+		slog.Info("Server status", "version", status.Version.Name, "protocol", status.Version.Protocol, "online", status.Players.Online, "max", status.Players.Max)
+	}
+}
+
 func (s *Server) handleLogin(packet *protocol.Packet, tag string, connState *protocol.ConnState, newThreshold *int) error {
 	if tag == "C->S" && packet.ID == protocol.C2SLoginStart {
 		packetRdr := bytes.NewReader(packet.Payload)
@@ -2,10 +2,15 @@ package behaviors
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/Versifine/locus/internal/crafting"
 	"github.com/Versifine/locus/internal/skill"
 	"github.com/Versifine/locus/internal/world"
 )
@@ -63,6 +68,22 @@ func (m *mockBlocks) IsSolid(x, y, z int) bool {
 	return state != 0
 }
 
+func (m *mockBlocks) DescribeBlockState(stateID int32) (world.BlockState, bool) {
+	name, ok := m.GetBlockNameByStateID(stateID)
+	return world.BlockState{ID: stateID, Block: name}, ok
+}
+
+func (m *mockBlocks) LookupBlockState(block string, properties map[string]string) (int32, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for state, name := range m.names {
+		if name == block {
+			return state, true
+		}
+	}
+	return 0, false
+}
+
 func newFlatBlocks(minX, maxX, minZ, maxZ int, groundY int) *mockBlocks {
 	b := newMockBlocks()
 	for x := minX; x <= maxX; x++ {
@@ -86,6 +107,11 @@ type behaviorHarness struct {
 }
 
 func startBehaviorHarness(t *testing.T, fn skill.BehaviorFunc, blocks skill.BlockAccess, initial world.Snapshot) *behaviorHarness {
+	t.Helper()
+	return startBehaviorHarnessWithCrafter(t, fn, blocks, nil, initial)
+}
+
+func startBehaviorHarnessWithCrafter(t *testing.T, fn skill.BehaviorFunc, blocks skill.BlockAccess, crafter skill.Crafter, initial world.Snapshot) *behaviorHarness {
 	t.Helper()
 	ctx, cancel := context.WithCancel(context.Background())
 	h := &behaviorHarness{
@@ -99,10 +125,11 @@ func startBehaviorHarness(t *testing.T, fn skill.BehaviorFunc, blocks skill.Bloc
 	}
 
 	bctx := skill.BehaviorCtx{
-		Ctx:    ctx,
-		Tick:   h.tickCh,
-		Output: h.outCh,
-		Blocks: blocks,
+		Ctx:     ctx,
+		Tick:    h.tickCh,
+		Output:  h.outCh,
+		Blocks:  blocks,
+		Crafter: crafter,
 		SnapshotFn: func() world.Snapshot {
 			h.mu.RLock()
 			defer h.mu.RUnlock()
@@ -629,6 +656,31 @@ func TestMineBreaksSoftOccluderBeforeTarget(t *testing.T) {
 	}
 }
 
+type taggedBlocks struct {
+	*mockBlocks
+	tags map[int32][]string
+}
+
+func (b taggedBlocks) BlockHasTag(stateID int32, tag string) bool {
+	return slices.Contains(b.tags[stateID], tag)
+}
+
+func TestMineSoftBlockUsesTags(t *testing.T) {
+	blocks := newMockBlocks()
+	blocks.SetName(2, "custom:glow_canopy")
+	blocks.SetName(3, "minecraft:oak_leaves")
+	if isMineSoftBlock(blocks, 2) {
+		t.Fatal("unknown block should not be soft without tags")
+	}
+	if !isMineSoftBlock(blocks, 3) {
+		t.Fatal("vanilla leaves should be soft by name")
+	}
+	tagged := taggedBlocks{mockBlocks: blocks, tags: map[int32][]string{2: {"leaves"}}}
+	if !isMineSoftBlock(tagged, 2) || isMineSoftBlock(tagged, 1) {
+		t.Fatal("datapack leaves should be soft through the leaves tag")
+	}
+}
+
 func TestMineDurationExpires(t *testing.T) {
 	blocks := newFlatBlocks(-2, 4, -2, 2, 0)
 	target := skill.BlockPos{X: 1, Y: 1, Z: 0}
@@ -765,3 +817,112 @@ func TestSwitchSlotSingleTick(t *testing.T) {
 		t.Fatalf("switch_slot returned error: %v", err)
 	}
 }
+
+type fakeCrafter struct {
+	mu        sync.Mutex
+	inventory map[int32]int
+	tableAt   *skill.BlockPos
+	crafted   []string
+	closed    bool
+	book      *crafting.Book
+}
+
+func (f *fakeCrafter) InventoryCounts() (map[int32]int, bool) {
+	return f.inventory, true
+}
+
+func (f *fakeCrafter) OpenCraftingTable(_ context.Context, x, y, z int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tableAt = &skill.BlockPos{X: x, Y: y, Z: z}
+	return nil
+}
+
+func (f *fakeCrafter) CloseCraftingTable() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
+
+func (f *fakeCrafter) CraftRecipe(_ context.Context, recipe crafting.Recipe, times int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !recipe.FitsGrid(crafting.InventoryGridSize) && f.tableAt == nil {
+		return errors.New("needs table")
+	}
+	f.crafted = append(f.crafted, fmt.Sprintf("%s*%d", f.book.ItemName(recipe.Result.ID), times))
+	return nil
+}
+
+func newFakeCrafter(t *testing.T, items map[string]int) *fakeCrafter {
+	t.Helper()
+	book, err := crafting.DefaultBook()
+	if err != nil {
+		t.Fatalf("DefaultBook failed: %v", err)
+	}
+	inv := make(map[int32]int, len(items))
+	for name, n := range items {
+		id, ok := book.ItemID(name)
+		if !ok {
+			t.Fatalf("unknown item %s", name)
+		}
+		inv[id] = n
+	}
+	return &fakeCrafter{inventory: inv, book: book}
+}
+
+func TestCraftUsesInventoryGridWithoutTable(t *testing.T) {
+	crafter := newFakeCrafter(t, map[string]int{"oak_log": 1})
+	h := startBehaviorHarnessWithCrafter(t, Craft("crafting_table", 1, 0), newMockBlocks(), crafter, world.Snapshot{})
+
+	if err := h.waitDone(); err != nil {
+		t.Fatalf("craft returned error: %v", err)
+	}
+	if crafter.tableAt != nil {
+		t.Fatalf("crafting table should not be opened for a 2x2 plan")
+	}
+	if got := strings.Join(crafter.crafted, ","); got != "oak_planks*1,crafting_table*1" {
+		t.Fatalf("crafted=%s", got)
+	}
+}
+
+func TestCraftOpensNearbyTable(t *testing.T) {
+	blocks := newFlatBlocks(-4, 4, -4, 4, 0)
+	table := skill.BlockPos{X: 1, Y: 1, Z: 0}
+	blocks.SetName(5, "crafting_table")
+	blocks.SetState(table, 5)
+
+	crafter := newFakeCrafter(t, map[string]int{"oak_log": 2})
+	h := startBehaviorHarnessWithCrafter(t, Craft("wooden_pickaxe", 1, 0), blocks, crafter, world.Snapshot{Position: world.Position{X: 0.5, Y: 1, Z: 0.5}})
+
+	out := h.pullOutput()
+	if out.Yaw == nil || out.Pitch == nil {
+		t.Fatalf("expected look at crafting table before opening it")
+	}
+	h.pushSnapshot(world.Snapshot{Position: world.Position{X: 0.5, Y: 1, Z: 0.5}})
+
+	if err := h.waitDone(); err != nil {
+		t.Fatalf("craft returned error: %v", err)
+	}
+	if crafter.tableAt == nil || *crafter.tableAt != table || !crafter.closed {
+		t.Fatalf("expected table at %v to be opened and closed, got %v closed=%v", table, crafter.tableAt, crafter.closed)
+	}
+	if got := crafter.crafted[len(crafter.crafted)-1]; got != "wooden_pickaxe*1" {
+		t.Fatalf("last craft=%s want wooden_pickaxe*1 (all=%v)", got, crafter.crafted)
+	}
+}
+
+func TestCraftFailsWithoutTableOrMaterials(t *testing.T) {
+	crafter := newFakeCrafter(t, map[string]int{"oak_log": 2})
+	h := startBehaviorHarnessWithCrafter(t, Craft("wooden_pickaxe", 1, 0), newFlatBlocks(-4, 4, -4, 4, 0), crafter, world.Snapshot{Position: world.Position{X: 0.5, Y: 1, Z: 0.5}})
+	if err := h.waitDone(); err == nil || !strings.Contains(err.Error(), "crafting table") {
+		t.Fatalf("expected missing crafting table error, got %v", err)
+	}
+
+	crafter = newFakeCrafter(t, map[string]int{})
+	h = startBehaviorHarnessWithCrafter(t, Craft("wooden_pickaxe", 1, 0), newMockBlocks(), crafter, world.Snapshot{})
+	if err := h.waitDone(); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Fatalf("expected missing materials error, got %v", err)
+	}
+}
@@ -43,6 +43,15 @@ func (m *mapBlockAccess) IsSolid(x, y, z int) bool {
 	return m.solid[skill.BlockPos{X: x, Y: y, Z: z}]
 }
 
+func (m *mapBlockAccess) DescribeBlockState(stateID int32) (world.BlockState, bool) {
+	name, _ := m.GetBlockNameByStateID(stateID)
+	return world.BlockState{ID: stateID, Block: name}, true
+}
+
+func (m *mapBlockAccess) LookupBlockState(block string, properties map[string]string) (int32, bool) {
+	return 0, false
+}
+
 func (s singleBlockAccess) GetBlockState(x, y, z int) (int32, bool) {
 	return s.state, true
 }
@@ -55,6 +64,14 @@ func (s singleBlockAccess) IsSolid(x, y, z int) bool {
 	return s.solid
 }
 
+func (s singleBlockAccess) DescribeBlockState(stateID int32) (world.BlockState, bool) {
+	return world.BlockState{ID: stateID, Block: s.name}, true
+}
+
+func (s singleBlockAccess) LookupBlockState(block string, properties map[string]string) (int32, bool) {
+	return s.state, block == s.name
+}
+
 func TestIsAirAtNonSolidNotAir(t *testing.T) {
 	blocks := singleBlockAccess{state: 2, name: "Water", solid: false}
 	if isAirAt(blocks, skill.BlockPos{X: 0, Y: 1, Z: 0}) {
@@ -0,0 +1,147 @@
+package behaviors
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/Versifine/locus/internal/crafting"
+	"github.com/Versifine/locus/internal/skill"
+)
+
+const (
+	craftTableSearchRadius = 8
+	craftTableReach        = 4.0
+)
+
+// Craft plans count of item against the current inventory and crafts every
+// intermediate in order. Plans that need a 3x3 grid walk to the nearest
+// crafting table first.
+func Craft(item string, count int, durationMs int) skill.BehaviorFunc {
+	return func(bctx skill.BehaviorCtx) error {
+		if bctx.Crafter == nil {
+			return errors.New("craft requires inventory access")
+		}
+		book, err := crafting.DefaultBook()
+		if err != nil {
+			return err
+		}
+		target, ok := book.ItemID(item)
+		if !ok {
+			return fmt.Errorf("unknown item %q", item)
+		}
+		inventory, ok := bctx.Crafter.InventoryCounts()
+		if !ok {
+			return errors.New("inventory not ready")
+		}
+		plan, err := book.Plan(target, count, inventory)
+		if err != nil {
+			return err
+		}
+
+		if plan.NeedsTable {
+			table, err := approachCraftingTable(bctx, durationMs)
+			if err != nil {
+				return err
+			}
+			if bctx.Ctx.Err() != nil {
+				return nil
+			}
+			if err := bctx.Crafter.OpenCraftingTable(bctx.Ctx, table.X, table.Y, table.Z); err != nil {
+				return err
+			}
+			defer func() { _ = bctx.Crafter.CloseCraftingTable() }()
+		}
+
+		for _, step := range plan.Steps {
+			batch := book.MaxBatch(*step.Recipe)
+			for remaining := step.Times; remaining > 0; remaining -= batch {
+				times := min(batch, remaining)
+				if err := bctx.Crafter.CraftRecipe(bctx.Ctx, *step.Recipe, times); err != nil {
+					if bctx.Ctx.Err() != nil {
+						return nil
+					}
+					return fmt.Errorf("craft %s: %w", book.ItemName(step.Recipe.Result.ID), err)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func approachCraftingTable(bctx skill.BehaviorCtx, durationMs int) (skill.BlockPos, error) {
+	if bctx.Blocks == nil {
+		return skill.BlockPos{}, errors.New("craft requires block access")
+	}
+	snap := bctx.Snapshot()
+	table, ok := findNearestBlock(bctx.Blocks, toBlockPos(snap.Position), craftTableSearchRadius, isCraftingTableName)
+	if !ok {
+		return skill.BlockPos{}, errors.New("recipe needs a crafting table but none is nearby")
+	}
+
+	nav := newPathNavigator(32, 1.0)
+	timedOut := durationCheck(durationMs)
+	for !skill.IsNear(snap.Position, blockCenter(table), craftTableReach) {
+		approach, ok := nearestApproach(table, snap.Position, bctx.Blocks)
+		if !ok {
+			return skill.BlockPos{}, errors.New("crafting table approach not found")
+		}
+		move, _, err := nav.Tick(snap, approach, bctx.Blocks, true)
+		if err != nil {
+			return skill.BlockPos{}, err
+		}
+		next, ok := skill.Step(bctx, skill.PartialInput{
+			Forward: move.Forward,
+			Yaw:     move.Yaw,
+			Jump:    move.Jump,
+			Sprint:  move.Sprint,
+		})
+		if !ok {
+			return table, nil
+		}
+		snap = next
+		if timedOut() {
+			return skill.BlockPos{}, errors.New("timed out walking to crafting table")
+		}
+	}
+
+	yaw, pitch := skill.CalcLookAt(snap.Position, blockTopCenter(table))
+	_, _ = skill.Step(bctx, skill.PartialInput{Yaw: float32Ptr(yaw), Pitch: float32Ptr(pitch)})
+	return table, nil
+}
+
+func findNearestBlock(blocks skill.BlockAccess, center skill.BlockPos, radius int, match func(string) bool) (skill.BlockPos, bool) {
+	best := skill.BlockPos{}
+	bestDist := math.MaxInt
+	found := false
+	for dx := -radius; dx <= radius; dx++ {
+		for dy := -radius; dy <= radius; dy++ {
+			for dz := -radius; dz <= radius; dz++ {
+				pos := skill.BlockPos{X: center.X + dx, Y: center.Y + dy, Z: center.Z + dz}
+				stateID, ok := blocks.GetBlockState(pos.X, pos.Y, pos.Z)
+				if !ok || stateID == 0 {
+					continue
+				}
+				name, ok := blocks.GetBlockNameByStateID(stateID)
+				if !ok || !match(name) {
+					continue
+				}
+				if d := dx*dx + dy*dy + dz*dz; d < bestDist {
+					best, bestDist, found = pos, d, true
+				}
+			}
+		}
+	}
+	return best, found
+}
+
+func isCraftingTableName(name string) bool {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	switch normalized {
+	case "crafting_table", "minecraft:crafting_table", "crafting table":
+		return true
+	default:
+		return false
+	}
+}
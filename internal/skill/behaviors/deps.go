@@ -14,5 +14,6 @@ func Deps() skill.BehaviorDeps {
 		PlaceBlock:   PlaceBlock,
 		UseItem:      UseItem,
 		SwitchSlot:   SwitchSlot,
+		Craft:        Craft,
 	}
 }
@@ -102,11 +102,7 @@ func mineBreakTicksForBlock(blocks skill.BlockAccess, pos skill.BlockPos) int {
 	if !ok || stateID == 0 {
 		return mineEstimatedBreakTicks
 	}
-	name, ok := blocks.GetBlockNameByStateID(stateID)
-	if !ok {
-		return mineEstimatedBreakTicks
-	}
-	if isMineSoftBlockName(name) {
+	if isMineSoftBlock(blocks, stateID) {
 		return mineSoftBlockBreakTicks
 	}
 	return mineEstimatedBreakTicks
@@ -120,11 +116,21 @@ func isMineSoftOccluder(blocks skill.BlockAccess, pos skill.BlockPos) bool {
 	if !ok || stateID == 0 {
 		return false
 	}
-	name, ok := blocks.GetBlockNameByStateID(stateID)
-	if !ok {
-		return false
+	return isMineSoftBlock(blocks, stateID)
+}
+
+// mineSoftBlockTags break almost instantly, which also covers leaves and
+// vines added by datapacks.
+var mineSoftBlockTags = []string{"leaves", "cave_vines"}
+
+func isMineSoftBlock(blocks skill.BlockAccess, stateID int32) bool {
+	for _, tag := range mineSoftBlockTags {
+		if skill.BlockHasTag(blocks, stateID, tag) {
+			return true
+		}
 	}
-	return isMineSoftBlockName(name)
+	name, ok := blocks.GetBlockNameByStateID(stateID)
+	return ok && isMineSoftBlockName(name)
 }
 
 func isMineSoftBlockName(name string) bool {
@@ -104,7 +104,9 @@ func (n *pathNavigator) Tick(
 
 	wp := n.path[n.waypointIdx]
 	forward, yaw := skill.CalcWalkToward(snap.Position, blockCenter(wp))
-	needJump := wp.Y > int(math.Floor(snap.Position.Y))
+	feetY := int(math.Floor(snap.Position.Y))
+	// In water, holding jump keeps the head above the surface.
+	needJump := wp.Y > feetY || (wp.Y == feetY && skill.IsSwimmable(toBlockPos(snap.Position), blocks))
 	partial := skill.PartialInput{Forward: boolPtr(forward), Yaw: float32Ptr(yaw), Jump: boolPtr(needJump)}
 	if sprint {
 		partial.Sprint = boolPtr(forward)
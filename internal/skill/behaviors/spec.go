@@ -19,6 +19,7 @@ const (
 	PriorityPlaceBlock = 30
 	PriorityUseItem    = 30
 	PrioritySwitchSlot = 50
+	PriorityCraft      = 30
 )
 
 func IdleSpec(durationMs int) Spec {
@@ -110,3 +111,12 @@ func SwitchSlotSpec(slot int8, durationMs int) Spec {
 		Priority: PrioritySwitchSlot,
 	}
 }
+
+func CraftSpec(item string, count int, durationMs int) Spec {
+	return Spec{
+		Name:     "craft",
+		Fn:       Craft(item, count, durationMs),
+		Channels: []skill.Channel{skill.ChannelLegs, skill.ChannelHead, skill.ChannelHands},
+		Priority: PriorityCraft,
+	}
+}
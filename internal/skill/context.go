@@ -3,6 +3,7 @@ package skill
 import (
 	"context"
 
+	"github.com/Versifine/locus/internal/crafting"
 	"github.com/Versifine/locus/internal/world"
 )
 
@@ -10,6 +11,48 @@ type BlockAccess interface {
 	GetBlockState(x, y, z int) (int32, bool)
 	GetBlockNameByStateID(stateID int32) (string, bool)
 	IsSolid(x, y, z int) bool
+	// DescribeBlockState decodes a state ID into block name and properties.
+	DescribeBlockState(stateID int32) (world.BlockState, bool)
+	// LookupBlockState is the reverse of DescribeBlockState; omitted
+	// properties take the block's default values.
+	LookupBlockState(block string, properties map[string]string) (int32, bool)
+}
+
+// BlockTagger is optionally implemented by a BlockAccess that knows the
+// server's block tags, such as "logs" or "mineable/pickaxe".
+type BlockTagger interface {
+	BlockHasTag(stateID int32, tag string) bool
+}
+
+// BlockHasTag reports whether a state's block is in a block tag. It is false
+// when blocks does not know tags.
+func BlockHasTag(blocks BlockAccess, stateID int32, tag string) bool {
+	tagger, ok := blocks.(BlockTagger)
+	return ok && tagger.BlockHasTag(stateID, tag)
+}
+
+// KnownBlockSource is optionally implemented by a BlockAccess that remembers
+// chunks the server has unloaded.
+type KnownBlockSource interface {
+	KnownBlocks() BlockAccess
+}
+
+// KnownBlocks returns blocks with remembered chunks filled in, or blocks
+// itself when it remembers none. Routes are planned through it.
+func KnownBlocks(blocks BlockAccess) BlockAccess {
+	if source, ok := blocks.(KnownBlockSource); ok {
+		return source.KnownBlocks()
+	}
+	return blocks
+}
+
+// Crafter drives crafting grid clicks. Without an open crafting table the 2x2
+// inventory grid is used.
+type Crafter interface {
+	InventoryCounts() (map[int32]int, bool)
+	OpenCraftingTable(ctx context.Context, x, y, z int) error
+	CloseCraftingTable() error
+	CraftRecipe(ctx context.Context, recipe crafting.Recipe, times int) error
 }
 
 type BehaviorCtx struct {
@@ -20,6 +63,7 @@ type BehaviorCtx struct {
 	SendFunc   func(string) error
 	SnapshotFn func() world.Snapshot
 	Blocks     BlockAccess
+	Crafter    Crafter
 }
 
 func (b BehaviorCtx) Send(message string) error {
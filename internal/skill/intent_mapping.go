@@ -12,6 +12,7 @@ const (
 	PriorityPlaceBlock = 30
 	PriorityUseItem    = 30
 	PrioritySwitchSlot = 50
+	PriorityCraft      = 30
 )
 
 type Intent struct {
@@ -30,6 +31,7 @@ type BehaviorDeps struct {
 	PlaceBlock   func(pos BlockPos, face int, slot *int8, durationMs int) BehaviorFunc
 	UseItem      func(slot *int8, durationMs int) BehaviorFunc
 	SwitchSlot   func(slot int8, durationMs int) BehaviorFunc
+	Craft        func(item string, count int, durationMs int) BehaviorFunc
 }
 
 func MapIntentToBehavior(intent Intent, deps BehaviorDeps) (BehaviorFunc, []Channel, int, error) {
@@ -165,6 +167,21 @@ func MapIntentToBehavior(intent Intent, deps BehaviorDeps) (BehaviorFunc, []Chan
 			return nil, nil, 0, err
 		}
 		return deps.SwitchSlot(int8(slot), durationMs), []Channel{ChannelHands}, PrioritySwitchSlot, nil
+	case "craft":
+		if deps.Craft == nil {
+			return nil, nil, 0, fmt.Errorf("craft behavior factory is nil")
+		}
+		item, ok := intent.Params["item"].(string)
+		if !ok || item == "" {
+			return nil, nil, 0, fmt.Errorf("missing item")
+		}
+		count := 1
+		if v, ok := intent.Params["count"]; ok {
+			if n, ok := asIntFromAny(v); ok && n > 0 {
+				count = n
+			}
+		}
+		return deps.Craft(item, count, durationMs), []Channel{ChannelLegs, ChannelHead, ChannelHands}, PriorityCraft, nil
 	default:
 		return nil, nil, 0, fmt.Errorf("unknown intent action: %s", intent.Action)
 	}
@@ -111,6 +111,39 @@ func TestMapIntentToBehaviorLookAtEntity(t *testing.T) {
 	}
 }
 
+func TestMapIntentToBehaviorCraft(t *testing.T) {
+	called := false
+	deps := BehaviorDeps{
+		Craft: func(item string, count int, durationMs int) BehaviorFunc {
+			called = true
+			if item != "wooden_pickaxe" || count != 2 {
+				t.Fatalf("item=%q count=%d want wooden_pickaxe 2", item, count)
+			}
+			return func(BehaviorCtx) error { return nil }
+		},
+	}
+	_, channels, priority, err := MapIntentToBehavior(Intent{
+		Action: "craft",
+		Params: map[string]any{"item": "wooden_pickaxe", "count": 2},
+	}, deps)
+	if err != nil {
+		t.Fatalf("MapIntentToBehavior error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected craft factory call")
+	}
+	if priority != PriorityCraft {
+		t.Fatalf("priority=%d want %d", priority, PriorityCraft)
+	}
+	if len(channels) != 3 || channels[2] != ChannelHands {
+		t.Fatalf("channels=%v", channels)
+	}
+
+	if _, _, _, err := MapIntentToBehavior(Intent{Action: "craft", Params: map[string]any{}}, deps); err == nil {
+		t.Fatal("expected error for missing item")
+	}
+}
+
 func TestMapIntentToBehaviorUnknownAction(t *testing.T) {
 	_, _, _, err := MapIntentToBehavior(Intent{Action: "unknown", Params: map[string]any{}}, BehaviorDeps{})
 	if err == nil {
@@ -3,6 +3,8 @@ package skill
 import (
 	"container/heap"
 	"math"
+
+	"github.com/Versifine/locus/internal/physics"
 )
 
 type BlockPos struct {
@@ -14,6 +16,14 @@ type BlockPos struct {
 const (
 	defaultMaxPathDist = 64
 	maxDropHeight      = 3
+	// swimCost is added to moves into water so paths prefer dry land but
+	// still cross rivers.
+	swimCost = 20
+	// climbCost is added to each ladder rung.
+	climbCost = 4
+	// trapCost keeps paths out of cobwebs and berry bushes unless there is
+	// no other way.
+	trapCost = 60
 )
 
 type PathResult struct {
@@ -26,10 +36,13 @@ func FindPath(from, to BlockPos, blocks BlockAccess, maxDist int) []BlockPos {
 	return result.Path
 }
 
+// FindPathResult plans through KnownBlocks(blocks), so a route may cross
+// chunks that are no longer loaded but were seen before.
 func FindPathResult(from, to BlockPos, blocks BlockAccess, maxDist int) PathResult {
 	if blocks == nil {
 		return PathResult{}
 	}
+	blocks = KnownBlocks(blocks)
 	if maxDist <= 0 {
 		maxDist = defaultMaxPathDist
 	}
@@ -85,7 +98,7 @@ func FindPathResult(from, to BlockPos, blocks BlockAccess, maxDist int) PathResu
 				continue
 			}
 
-			tentative := gScore[current.Pos] + moveCost(current.Pos, next)
+			tentative := gScore[current.Pos] + moveCost(current.Pos, next, blocks)
 			prev, known := gScore[next]
 			if known && tentative >= prev {
 				continue
@@ -126,17 +139,74 @@ func NormalizeWalkable(pos BlockPos, blocks BlockAccess) (BlockPos, bool) {
 	return BlockPos{}, false
 }
 
+// IsWalkable reports whether a player can stand or swim in pos. Blocks with
+// collision shapes are honoured: a slab or carpet in pos is stood on, and a
+// fence or wall below pos (1.5 tall) cannot be walked onto. Lava is never
+// walkable.
 func IsWalkable(pos BlockPos, blocks BlockAccess) bool {
-	if blocks == nil {
+	if blocks == nil || touchesLava(pos, blocks) {
 		return false
 	}
-	if blocks.IsSolid(pos.X, pos.Y, pos.Z) {
+	if IsSwimmable(pos, blocks) || IsClimbable(pos, blocks) {
+		return true
+	}
+	floor, ok := standingHeight(pos, blocks)
+	if !ok {
 		return false
 	}
-	if blocks.IsSolid(pos.X, pos.Y+1, pos.Z) {
+	return !physics.CollidesWithBlock(physics.PlayerAABB(float64(pos.X)+0.5, floor, float64(pos.Z)+0.5), blocks)
+}
+
+// IsSwimmable reports whether pos is water the player fits in.
+func IsSwimmable(pos BlockPos, blocks BlockAccess) bool {
+	if blocks == nil || physics.BlockFluid(blocks, pos.X, pos.Y, pos.Z).Kind != physics.FluidWater {
 		return false
 	}
-	return blocks.IsSolid(pos.X, pos.Y-1, pos.Z)
+	return !physics.CollidesWithBlock(physics.PlayerAABB(float64(pos.X)+0.5, float64(pos.Y), float64(pos.Z)+0.5), blocks)
+}
+
+// IsClimbable reports whether pos holds a ladder, vine or scaffolding the
+// player fits beside.
+func IsClimbable(pos BlockPos, blocks BlockAccess) bool {
+	if blocks == nil || !physics.BlockMovementAt(blocks, pos.X, pos.Y, pos.Z).Climbable {
+		return false
+	}
+	return !physics.CollidesWithBlock(physics.PlayerAABB(float64(pos.X)+0.5, float64(pos.Y), float64(pos.Z)+0.5), blocks)
+}
+
+func touchesLava(pos BlockPos, blocks BlockAccess) bool {
+	return physics.BlockFluid(blocks, pos.X, pos.Y, pos.Z).Kind == physics.FluidLava ||
+		physics.BlockFluid(blocks, pos.X, pos.Y+1, pos.Z).Kind == physics.FluidLava
+}
+
+// standingHeight is the Y a player standing in pos has: the top of a low
+// block inside pos, otherwise the top of a full-height block below it.
+func standingHeight(pos BlockPos, blocks BlockAccess) (float64, bool) {
+	if blocks == nil {
+		return 0, false
+	}
+	if top, ok := shapeTop(physics.BlockCollisionBoxes(blocks, pos.X, pos.Y, pos.Z)); ok {
+		if top > physics.StepHeight {
+			return 0, false
+		}
+		return float64(pos.Y) + top, true
+	}
+	top, ok := shapeTop(physics.BlockCollisionBoxes(blocks, pos.X, pos.Y-1, pos.Z))
+	if !ok || math.Abs(top-1) > physics.CollisionAxisTolerance {
+		return 0, false
+	}
+	return float64(pos.Y), true
+}
+
+func shapeTop(boxes []physics.AABB) (float64, bool) {
+	if len(boxes) == 0 {
+		return 0, false
+	}
+	top := boxes[0].MaxY
+	for _, box := range boxes[1:] {
+		top = math.Max(top, box.MaxY)
+	}
+	return top, true
 }
 
 func nearestWalkable(target, from BlockPos, blocks BlockAccess) BlockPos {
@@ -171,6 +241,15 @@ func neighbors(pos BlockPos, blocks BlockAccess) []BlockPos {
 	dirs := [][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}}
 	out := make([]BlockPos, 0, 12)
 
+	if IsClimbable(pos, blocks) {
+		for _, dy := range []int{1, -1} {
+			rung := BlockPos{X: pos.X, Y: pos.Y + dy, Z: pos.Z}
+			if IsWalkable(rung, blocks) {
+				out = append(out, rung)
+			}
+		}
+	}
+
 	for _, d := range dirs {
 		nx := pos.X + d[0]
 		nz := pos.Z + d[1]
@@ -205,7 +284,7 @@ func neighbors(pos BlockPos, blocks BlockAccess) []BlockPos {
 
 func canDropTo(x, fromY, z, toY int, blocks BlockAccess) bool {
 	for y := fromY; y >= toY+1; y-- {
-		if blocks.IsSolid(x, y, z) {
+		if len(physics.BlockCollisionBoxes(blocks, x, y, z)) > 0 {
 			return false
 		}
 	}
@@ -243,8 +322,15 @@ func heuristic(a, b BlockPos) int {
 	return dx + dy*2 + dz
 }
 
-func moveCost(a, b BlockPos) int {
+func moveCost(a, b BlockPos, blocks BlockAccess) int {
 	base := 10
+	if IsSwimmable(b, blocks) {
+		base += swimCost
+	}
+	if a.X == b.X && a.Z == b.Z {
+		return base + climbCost
+	}
+	base += surfaceCost(b, blocks)
 	if b.Y > a.Y {
 		return base + 8
 	}
@@ -254,6 +340,24 @@ func moveCost(a, b BlockPos) int {
 	return base
 }
 
+// surfaceCost penalises slow ground such as soul sand and honey in
+// proportion to the time lost, and blocks that trap the player.
+func surfaceCost(pos BlockPos, blocks BlockAccess) int {
+	cost := 0
+	if physics.BlockMovementAt(blocks, pos.X, pos.Y, pos.Z).Stuck != (physics.Vec3{}) ||
+		physics.BlockMovementAt(blocks, pos.X, pos.Y+1, pos.Z).Stuck != (physics.Vec3{}) {
+		cost += trapCost
+	}
+	speed := physics.BlockMovementAt(blocks, pos.X, pos.Y, pos.Z).SpeedFactor
+	if speed == 1 {
+		speed = physics.BlockMovementAt(blocks, pos.X, pos.Y-1, pos.Z).SpeedFactor
+	}
+	if speed > 0 && speed < 1 {
+		cost += int(10 * (1/speed - 1))
+	}
+	return cost
+}
+
 func sqDist(a, b BlockPos) float64 {
 	dx := float64(a.X - b.X)
 	dy := float64(a.Y - b.Y)
@@ -1,6 +1,11 @@
 package skill
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/Versifine/locus/internal/physics"
+	"github.com/Versifine/locus/internal/world"
+)
 
 type gridBlocks struct {
 	solids map[BlockPos]bool
@@ -32,6 +37,83 @@ func (g *gridBlocks) IsSolid(x, y, z int) bool {
 	return g.solids[BlockPos{X: x, Y: y, Z: z}]
 }
 
+func (g *gridBlocks) DescribeBlockState(stateID int32) (world.BlockState, bool) {
+	name, _ := g.GetBlockNameByStateID(stateID)
+	return world.BlockState{ID: stateID, Block: name}, true
+}
+
+func (g *gridBlocks) LookupBlockState(block string, properties map[string]string) (int32, bool) {
+	switch block {
+	case "air":
+		return 0, true
+	case "stone":
+		return 1, true
+	}
+	return 0, false
+}
+
+// shapedBlocks adds collision shapes on top of gridBlocks; cells without an
+// explicit shape are full cubes where solid.
+type shapedBlocks struct {
+	*gridBlocks
+	shapes map[BlockPos][]physics.AABB
+}
+
+func newShapedBlocks() *shapedBlocks {
+	return &shapedBlocks{gridBlocks: newGridBlocks(), shapes: make(map[BlockPos][]physics.AABB)}
+}
+
+func (s *shapedBlocks) setShape(x, y, z int, boxes ...physics.AABB) {
+	s.shapes[BlockPos{X: x, Y: y, Z: z}] = boxes
+	s.setSolid(x, y, z)
+}
+
+func (s *shapedBlocks) CollisionBoxes(x, y, z int) []physics.AABB {
+	if boxes, ok := s.shapes[BlockPos{X: x, Y: y, Z: z}]; ok {
+		return boxes
+	}
+	if s.IsSolid(x, y, z) {
+		return []physics.AABB{{MaxX: 1, MaxY: 1, MaxZ: 1}}
+	}
+	return nil
+}
+
+// fluidBlocks adds water and lava to gridBlocks.
+type fluidBlocks struct {
+	*gridBlocks
+	fluids map[BlockPos]physics.Fluid
+}
+
+func newFluidBlocks() *fluidBlocks {
+	return &fluidBlocks{gridBlocks: newGridBlocks(), fluids: make(map[BlockPos]physics.Fluid)}
+}
+
+func (f *fluidBlocks) FluidAt(x, y, z int) physics.Fluid {
+	return f.fluids[BlockPos{X: x, Y: y, Z: z}]
+}
+
+// movementBlocks adds per-block movement modifiers to gridBlocks.
+type movementBlocks struct {
+	*gridBlocks
+	movement map[BlockPos]physics.BlockMovement
+}
+
+func newMovementBlocks() *movementBlocks {
+	return &movementBlocks{gridBlocks: newGridBlocks(), movement: make(map[BlockPos]physics.BlockMovement)}
+}
+
+func (m *movementBlocks) BlockMovement(x, y, z int) physics.BlockMovement {
+	if mv, ok := m.movement[BlockPos{X: x, Y: y, Z: z}]; ok {
+		return mv
+	}
+	return physics.DefaultBlockMovement
+}
+
+var (
+	bottomSlab = physics.AABB{MaxX: 1, MaxY: 0.5, MaxZ: 1}
+	fencePost  = physics.AABB{MinX: 0.375, MaxX: 0.625, MaxY: 1.5, MinZ: 0.375, MaxZ: 0.625}
+)
+
 func makeFlatGround(g *gridBlocks, minX, maxX, minZ, maxZ int, y int) {
 	for x := minX; x <= maxX; x++ {
 		for z := minZ; z <= maxZ; z++ {
@@ -147,3 +229,201 @@ func TestFindPathReturnsPartialWhenTargetOutsideRadius(t *testing.T) {
 		t.Fatalf("expected partial endpoint near radius edge, got %+v", last)
 	}
 }
+
+// unloadedBlocks hides every column east of loadedMaxX, as if the server had
+// unloaded those chunks.
+type unloadedBlocks struct {
+	*gridBlocks
+	loadedMaxX int
+}
+
+func (u *unloadedBlocks) GetBlockState(x, y, z int) (int32, bool) {
+	if x > u.loadedMaxX {
+		return 0, false
+	}
+	return u.gridBlocks.GetBlockState(x, y, z)
+}
+
+func (u *unloadedBlocks) IsSolid(x, y, z int) bool {
+	return x <= u.loadedMaxX && u.gridBlocks.IsSolid(x, y, z)
+}
+
+// rememberingBlocks still knows the unloaded columns.
+type rememberingBlocks struct {
+	*unloadedBlocks
+}
+
+func (r rememberingBlocks) KnownBlocks() BlockAccess {
+	return r.gridBlocks
+}
+
+func TestFindPathPlansThroughKnownBlocks(t *testing.T) {
+	g := newGridBlocks()
+	makeFlatGround(g, -2, 8, -2, 2, 0)
+	unloaded := &unloadedBlocks{gridBlocks: g, loadedMaxX: 2}
+
+	from := BlockPos{X: 0, Y: 1, Z: 0}
+	to := BlockPos{X: 6, Y: 1, Z: 0}
+	if result := FindPathResult(from, to, unloaded, 64); result.Complete {
+		t.Fatalf("expected no complete path through unloaded chunks, got %+v", result.Path)
+	}
+
+	result := FindPathResult(from, to, rememberingBlocks{unloaded}, 64)
+	if !result.Complete || result.Path[len(result.Path)-1] != to {
+		t.Fatalf("expected complete path through remembered chunks, got %+v", result)
+	}
+}
+
+func TestIsWalkableUsesCollisionShapes(t *testing.T) {
+	g := newShapedBlocks()
+	makeFlatGround(g.gridBlocks, -2, 4, -2, 2, 0)
+	g.setShape(1, 1, 0, bottomSlab)
+	g.setShape(2, 1, 0, fencePost)
+	g.setShape(3, 1, 0, bottomSlab)
+	g.setShape(3, 3, 0, bottomSlab)
+
+	if !IsWalkable(BlockPos{X: 1, Y: 1, Z: 0}, g) {
+		t.Fatal("bottom slab should be stood on")
+	}
+	if IsWalkable(BlockPos{X: 1, Y: 2, Z: 0}, g) {
+		t.Fatal("cell above a slab has no full-height floor")
+	}
+	if IsWalkable(BlockPos{X: 2, Y: 1, Z: 0}, g) || IsWalkable(BlockPos{X: 2, Y: 2, Z: 0}, g) {
+		t.Fatal("fence should be neither passable nor standable")
+	}
+	if IsWalkable(BlockPos{X: 3, Y: 1, Z: 0}, g) {
+		t.Fatal("slab floor with a slab 1.5 above leaves no head room")
+	}
+}
+
+func TestFindPathStepsOverSlabAndAvoidsFence(t *testing.T) {
+	g := newShapedBlocks()
+	makeFlatGround(g.gridBlocks, -2, 6, -2, 2, 0)
+	g.setShape(2, 1, 0, bottomSlab)
+	for z := -2; z <= 1; z++ {
+		g.setShape(4, 1, z, fencePost)
+	}
+
+	path := FindPath(BlockPos{X: 0, Y: 1, Z: 0}, BlockPos{X: 6, Y: 1, Z: 0}, g, 64)
+	if len(path) == 0 || path[len(path)-1] != (BlockPos{X: 6, Y: 1, Z: 0}) {
+		t.Fatalf("expected complete path, got %v", path)
+	}
+	for _, step := range path {
+		if step.X == 4 && step.Z != 2 {
+			t.Fatalf("path crosses the fence line at %+v: %v", step, path)
+		}
+		if step.Y != 1 {
+			t.Fatalf("slab step should not change cell height: %v", path)
+		}
+	}
+}
+
+func TestFindPathSwimsAcrossRiver(t *testing.T) {
+	g := newFluidBlocks()
+	makeFlatGround(g.gridBlocks, -2, 8, -3, 3, 0)
+	for x := 2; x <= 4; x++ {
+		for z := -3; z <= 3; z++ {
+			delete(g.solids, BlockPos{X: x, Y: 0, Z: z})
+			g.setSolid(x, -2, z)
+			g.fluids[BlockPos{X: x, Y: 0, Z: z}] = physics.Fluid{Kind: physics.FluidWater}
+			g.fluids[BlockPos{X: x, Y: -1, Z: z}] = physics.Fluid{Kind: physics.FluidWater}
+		}
+	}
+
+	to := BlockPos{X: 6, Y: 1, Z: 0}
+	path := FindPath(BlockPos{X: 0, Y: 1, Z: 0}, to, g, 64)
+	if len(path) == 0 || path[len(path)-1] != to {
+		t.Fatalf("expected path across the river, got %v", path)
+	}
+	swum := false
+	for _, step := range path {
+		if IsSwimmable(step, g) {
+			swum = true
+		}
+	}
+	if !swum {
+		t.Fatalf("path should swim through the river: %v", path)
+	}
+}
+
+func TestFindPathPrefersBridgeOverSwimmingAndAvoidsLava(t *testing.T) {
+	g := newFluidBlocks()
+	makeFlatGround(g.gridBlocks, -2, 8, -3, 3, 0)
+	for z := -3; z <= 3; z++ {
+		if z == 1 {
+			continue // the bridge
+		}
+		delete(g.solids, BlockPos{X: 3, Y: 0, Z: z})
+		g.setSolid(3, -1, z)
+		kind := physics.FluidWater
+		if z <= -2 {
+			kind = physics.FluidLava
+		}
+		g.fluids[BlockPos{X: 3, Y: 0, Z: z}] = physics.Fluid{Kind: kind}
+	}
+
+	if IsWalkable(BlockPos{X: 3, Y: 0, Z: -3}, g) || IsWalkable(BlockPos{X: 3, Y: 1, Z: -3}, g) {
+		t.Fatal("lava should not be walkable")
+	}
+	path := FindPath(BlockPos{X: 0, Y: 1, Z: 0}, BlockPos{X: 6, Y: 1, Z: 0}, g, 64)
+	if len(path) == 0 {
+		t.Fatal("expected a path")
+	}
+	// Swimming one cell costs more than the detour over the bridge.
+	for _, step := range path {
+		if IsSwimmable(step, g) {
+			t.Fatalf("path should use the bridge, got %v", path)
+		}
+	}
+}
+
+func TestFindPathClimbsLadder(t *testing.T) {
+	g := newMovementBlocks()
+	makeFlatGround(g.gridBlocks, -2, 6, -2, 2, 0)
+	// A five-block cliff from x=2 on, with a ladder on its face at x=1.
+	for x := 2; x <= 6; x++ {
+		for z := -2; z <= 2; z++ {
+			for y := 1; y <= 5; y++ {
+				g.setSolid(x, y, z)
+			}
+		}
+	}
+	ladder := physics.DefaultBlockMovement
+	ladder.Climbable = true
+	for y := 1; y <= 5; y++ {
+		g.movement[BlockPos{X: 1, Y: y, Z: 0}] = ladder
+	}
+
+	to := BlockPos{X: 4, Y: 6, Z: 0}
+	path := FindPath(BlockPos{X: 0, Y: 1, Z: 0}, to, g, 64)
+	if len(path) == 0 || path[len(path)-1] != to {
+		t.Fatalf("expected path up the ladder, got %v", path)
+	}
+	for _, step := range path {
+		if step.X == 1 && step.Y > 1 && step.Z != 0 {
+			t.Fatalf("path leaves the ladder column mid-climb: %v", path)
+		}
+	}
+}
+
+func TestFindPathAvoidsSoulSand(t *testing.T) {
+	g := newMovementBlocks()
+	makeFlatGround(g.gridBlocks, -2, 8, -3, 3, 0)
+	soulSand := physics.DefaultBlockMovement
+	soulSand.SpeedFactor = 0.4
+	for x := 1; x <= 5; x++ {
+		for z := -1; z <= 1; z++ {
+			g.movement[BlockPos{X: x, Y: 0, Z: z}] = soulSand
+		}
+	}
+
+	path := FindPath(BlockPos{X: 0, Y: 1, Z: 0}, BlockPos{X: 6, Y: 1, Z: 0}, g, 64)
+	if len(path) == 0 {
+		t.Fatal("expected a path")
+	}
+	for _, step := range path {
+		if _, slow := g.movement[BlockPos{X: step.X, Y: 0, Z: step.Z}]; slow {
+			t.Fatalf("path walks over soul sand at %+v: %v", step, path)
+		}
+	}
+}
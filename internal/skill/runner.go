@@ -51,6 +51,7 @@ type BehaviorRunner struct {
 	send     func(string) error
 	snapshot func() world.Snapshot
 	blocks   BlockAccess
+	crafter  Crafter
 	endCh    chan BehaviorEnd
 }
 
@@ -65,6 +66,16 @@ func NewBehaviorRunner(send func(string) error, snapshot func() world.Snapshot,
 	}
 }
 
+// SetCrafter makes inventory crafting available to behaviors started afterwards.
+func (r *BehaviorRunner) SetCrafter(crafter Crafter) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.crafter = crafter
+	r.mu.Unlock()
+}
+
 func (r *BehaviorRunner) Start(name string, fn BehaviorFunc, channels []Channel, priority int) bool {
 	ok, _ := r.StartWithRunID(name, fn, channels, priority)
 	return ok
@@ -96,6 +107,7 @@ func (r *BehaviorRunner) StartWithRunID(name string, fn BehaviorFunc, channels [
 		r.preemptLocked(conflictName)
 	}
 
+	crafter := r.crafter
 	ctx, cancel := context.WithCancel(context.Background())
 	h := &behaviorHandle{
 		name:     name,
@@ -121,6 +133,7 @@ func (r *BehaviorRunner) StartWithRunID(name string, fn BehaviorFunc, channels [
 		SendFunc:   r.send,
 		SnapshotFn: r.snapshot,
 		Blocks:     r.blocks,
+		Crafter:    crafter,
 	}
 
 	go func(handle *behaviorHandle) {
@@ -0,0 +1,218 @@
+package world
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// BiomesPerSection is the number of 4x4x4 biome cells in a chunk section.
+const BiomesPerSection = 4 * 4 * 4
+
+// Biome describes one worldgen biome. Fields other than ID and Name are
+// empty for datapack biomes that biomes.json does not know.
+type Biome struct {
+	ID               int32
+	Name             string
+	DisplayName      string
+	Category         string
+	Temperature      float64
+	HasPrecipitation bool
+	Dimension        string
+}
+
+// BiomeRegistry maps network biome IDs to biomes. The IDs come from the
+// server's worldgen/biome registry; biomes.json order is used until the
+// server sends it, which matches vanilla.
+type BiomeRegistry struct {
+	byID   []Biome
+	byName map[string]Biome
+}
+
+type biomeDefinition struct {
+	ID               int32   `json:"id"`
+	Name             string  `json:"name"`
+	DisplayName      string  `json:"displayName"`
+	Category         string  `json:"category"`
+	Temperature      float64 `json:"temperature"`
+	HasPrecipitation bool    `json:"has_precipitation"`
+	Dimension        string  `json:"dimension"`
+}
+
+// LoadBiomes reads minecraft-data's biomes.json.
+func LoadBiomes(path string) (*BiomeRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read biomes.json: %w", err)
+	}
+	var defs []biomeDefinition
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return nil, fmt.Errorf("parse biomes.json: %w", err)
+	}
+	maxID := int32(-1)
+	for _, def := range defs {
+		if def.ID < 0 {
+			return nil, fmt.Errorf("biomes.json: negative id %d", def.ID)
+		}
+		maxID = max(maxID, def.ID)
+	}
+	r := &BiomeRegistry{byID: make([]Biome, maxID+1), byName: make(map[string]Biome, len(defs))}
+	for _, def := range defs {
+		biome := Biome{
+			ID:               def.ID,
+			Name:             def.Name,
+			DisplayName:      def.DisplayName,
+			Category:         def.Category,
+			Temperature:      def.Temperature,
+			HasPrecipitation: def.HasPrecipitation,
+			Dimension:        def.Dimension,
+		}
+		r.byID[def.ID] = biome
+		r.byName[def.Name] = biome
+	}
+	return r, nil
+}
+
+// WithOrder returns a registry whose IDs follow names, the entry keys of the
+// server's worldgen/biome registry. Unknown names keep only their name.
+func (r *BiomeRegistry) WithOrder(names []string) *BiomeRegistry {
+	out := &BiomeRegistry{byID: make([]Biome, len(names)), byName: make(map[string]Biome, len(names))}
+	for i, name := range names {
+		name = trimMinecraftNamespace(name)
+		biome := Biome{Name: name}
+		if r != nil {
+			if known, ok := r.byName[name]; ok {
+				biome = known
+			}
+		}
+		biome.ID = int32(i)
+		out.byID[i] = biome
+		out.byName[name] = biome
+	}
+	return out
+}
+
+// Biome returns the biome with a network ID.
+func (r *BiomeRegistry) Biome(id int32) (Biome, bool) {
+	if r == nil || id < 0 || int(id) >= len(r.byID) || r.byID[id].Name == "" {
+		return Biome{}, false
+	}
+	return r.byID[id], true
+}
+
+// Lookup finds a biome by name, with or without the minecraft: prefix.
+func (r *BiomeRegistry) Lookup(name string) (Biome, bool) {
+	if r == nil {
+		return Biome{}, false
+	}
+	biome, ok := r.byName[trimMinecraftNamespace(name)]
+	return biome, ok
+}
+
+func trimMinecraftNamespace(name string) string {
+	return strings.TrimPrefix(name, "minecraft:")
+}
+
+func biomesPath(blocksJSONPath string) string {
+	return filepath.Join(filepath.Dir(blocksJSONPath), "biomes.json")
+}
+
+// SetBiomeOrder applies the server's worldgen/biome registry keys.
+func (bs *BlockStore) SetBiomeOrder(names []string) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	bs.biomes = bs.biomes.WithOrder(names)
+}
+
+// Biomes returns the active biome registry, or nil when none is loaded.
+func (bs *BlockStore) Biomes() *BiomeRegistry {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+	return bs.biomes
+}
+
+// GetBiome returns the biome at a position. ok is false for unloaded chunks,
+// chunks sent without biomes and IDs missing from the registry.
+func (bs *BlockStore) GetBiome(x, y, z int) (Biome, bool) {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+	sectionIndex, _, ok := bs.blockIndexLocked(x, y, z)
+	if !ok {
+		return Biome{}, false
+	}
+	minY, _ := bs.heightLocked()
+	cell := biomeIndex(floorMod16(x), (y-minY)%ChunkSectionHeight, floorMod16(z))
+	chunk, ok := bs.chunks[ChunkPos{X: int32(floorDiv16(x)), Z: int32(floorDiv16(z))}]
+	if !ok {
+		return Biome{}, false
+	}
+	biomes := chunk.Sections[sectionIndex].Biomes
+	if len(biomes) != BiomesPerSection {
+		return Biome{}, false
+	}
+	return bs.biomes.Biome(biomes[cell])
+}
+
+// FindNearestBiome returns the center of the nearest loaded 4x4x4 biome cell
+// whose biome satisfies match, searching at most maxDist blocks away.
+func (bs *BlockStore) FindNearestBiome(from BlockPos, maxDist int, match func(Biome) bool) (BlockPos, Biome, bool) {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	minY, _ := bs.heightLocked()
+	maxDistSq := maxDist * maxDist
+	bestDistSq := -1
+	var bestPos BlockPos
+	var best Biome
+	matches := make(map[int32]bool)
+	for pos, chunk := range bs.chunks {
+		baseX, baseZ := int(pos.X)*16, int(pos.Z)*16
+		if chunkDistSq(from, baseX, baseZ) > maxDistSq {
+			continue
+		}
+		for sectionIndex, section := range chunk.Sections {
+			if len(section.Biomes) != BiomesPerSection {
+				continue
+			}
+			baseY := minY + sectionIndex*ChunkSectionHeight
+			for cell, id := range section.Biomes {
+				ok, seen := matches[id]
+				if !seen {
+					biome, known := bs.biomes.Biome(id)
+					ok = known && match(biome)
+					matches[id] = ok
+				}
+				if !ok {
+					continue
+				}
+				center := BlockPos{
+					X: baseX + (cell&3)*4 + 2,
+					Y: baseY + (cell>>4)*4 + 2,
+					Z: baseZ + ((cell>>2)&3)*4 + 2,
+				}
+				dx, dy, dz := center.X-from.X, center.Y-from.Y, center.Z-from.Z
+				distSq := dx*dx + dy*dy + dz*dz
+				if distSq > maxDistSq || (bestDistSq >= 0 && distSq >= bestDistSq) {
+					continue
+				}
+				bestDistSq, bestPos = distSq, center
+				best, _ = bs.biomes.Biome(id)
+			}
+		}
+	}
+	return bestPos, best, bestDistSq >= 0
+}
+
+// chunkDistSq is the squared horizontal distance from pos to the nearest
+// point of the chunk starting at baseX, baseZ.
+func chunkDistSq(pos BlockPos, baseX, baseZ int) int {
+	dx := max(baseX-pos.X, 0, pos.X-(baseX+15))
+	dz := max(baseZ-pos.Z, 0, pos.Z-(baseZ+15))
+	return dx*dx + dz*dz
+}
+
+func biomeIndex(localX, localY, localZ int) int {
+	return (localY>>2)<<4 | (localZ>>2)<<2 | localX>>2
+}
@@ -0,0 +1,68 @@
+package world
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadBiomesAndServerOrder(t *testing.T) {
+	biomes, err := LoadBiomes(filepath.Join("..", "..", "1.21.11", "biomes.json"))
+	if err != nil {
+		t.Fatalf("LoadBiomes failed: %v", err)
+	}
+	desert, ok := biomes.Lookup("minecraft:desert")
+	if !ok || desert.Category != "desert" || desert.HasPrecipitation {
+		t.Fatalf("Lookup(desert) = %+v, %v", desert, ok)
+	}
+	if got, ok := biomes.Biome(desert.ID); !ok || got.Name != "desert" {
+		t.Fatalf("Biome(%d) = %+v, %v; want desert", desert.ID, got, ok)
+	}
+
+	ordered := biomes.WithOrder([]string{"minecraft:jungle", "custom:glow_forest"})
+	if got, ok := ordered.Biome(0); !ok || got.Name != "jungle" || got.ID != 0 || got.Category != "jungle" {
+		t.Fatalf("ordered Biome(0) = %+v, %v; want jungle", got, ok)
+	}
+	if got, ok := ordered.Biome(1); !ok || got.Name != "custom:glow_forest" || got.Category != "" {
+		t.Fatalf("ordered Biome(1) = %+v, %v; want bare datapack biome", got, ok)
+	}
+	if _, ok := ordered.Biome(2); ok {
+		t.Fatal("ID past the server registry should be unknown")
+	}
+}
+
+func TestBlockStoreGetBiome(t *testing.T) {
+	bs := &BlockStore{chunks: make(map[ChunkPos]*Chunk)}
+	bs.SetBiomeOrder([]string{"plains", "desert"})
+
+	sections := makeFilledSections(0)
+	// Section 8 covers y=64..79; put desert in the cell x=4..7, y=68..71, z=8..11.
+	sections[8].Biomes = make([]int32, BiomesPerSection)
+	sections[8].Biomes[biomeIndex(5, 5, 9)] = 1
+	if err := bs.StoreChunk(-1, 0, sections); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+
+	if got, ok := bs.GetBiome(-11, 70, 10); !ok || got.Name != "desert" {
+		t.Fatalf("GetBiome(-11,70,10) = %+v, %v; want desert", got, ok)
+	}
+	if got, ok := bs.GetBiome(-11, 66, 10); !ok || got.Name != "plains" {
+		t.Fatalf("GetBiome(-11,66,10) = %+v, %v; want plains", got, ok)
+	}
+	if _, ok := bs.GetBiome(-11, 10, 10); ok {
+		t.Fatal("section without biome data should report no biome")
+	}
+
+	pos, biome, ok := bs.FindNearestBiome(BlockPos{X: 0, Y: 64, Z: 0}, 32, func(b Biome) bool { return b.Name == "desert" })
+	if !ok || biome.Name != "desert" || pos != (BlockPos{X: -10, Y: 70, Z: 10}) {
+		t.Fatalf("FindNearestBiome = %+v %+v %v; want desert at (-10,70,10)", pos, biome, ok)
+	}
+	if _, _, ok := bs.FindNearestBiome(BlockPos{X: 0, Y: 64, Z: 0}, 8, func(b Biome) bool { return b.Name == "desert" }); ok {
+		t.Fatal("desert beyond maxDist should not be found")
+	}
+
+	bad := makeFilledSections(0)
+	bad[0].Biomes = []int32{1, 2}
+	if err := bs.StoreChunk(0, 0, bad); err == nil {
+		t.Fatal("StoreChunk should reject a short biome array")
+	}
+}
@@ -0,0 +1,258 @@
+package world
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BlockState is one decoded block state, e.g. oak_door with facing=north,
+// half=lower, hinge=left, open=false, powered=false.
+type BlockState struct {
+	ID int32
+	// Block is the registry name without namespace, e.g. "oak_door".
+	Block       string
+	DisplayName string
+	Properties  map[string]string
+}
+
+// String formats the state the way the game's debug screen and commands do:
+// oak_door[facing=north,half=lower,...]. Properties are sorted by name.
+func (s BlockState) String() string {
+	if len(s.Properties) == 0 {
+		return s.Block
+	}
+	keys := make([]string, 0, len(s.Properties))
+	for k := range s.Properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + s.Properties[k]
+	}
+	return s.Block + "[" + strings.Join(parts, ",") + "]"
+}
+
+// Property returns a single property value, "" when the block lacks it.
+func (s BlockState) Property(name string) string {
+	return s.Properties[name]
+}
+
+type blockStateProperty struct {
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	NumValues int      `json:"num_values"`
+	Values    []string `json:"values"`
+}
+
+// blockStateDef describes how a block's state IDs are laid out: one ID per
+// combination of property values, the last property varying fastest.
+type blockStateDef struct {
+	// id is the block registry ID that block tags refer to.
+	id           int32
+	name         string
+	displayName  string
+	minStateID   int32
+	maxStateID   int32
+	defaultState int32
+	properties   []blockStateProperty
+}
+
+// BlockStateRegistry maps state IDs to decoded block states and back.
+// Properties are decoded on demand, so the registry holds one entry per
+// block rather than one per state.
+type BlockStateRegistry struct {
+	blocks       []blockStateDef
+	blockByState []int32
+	blockByName  map[string]int
+}
+
+func newBlockStateRegistry(defs []blockDefinition, maxStateID int32) (*BlockStateRegistry, error) {
+	r := &BlockStateRegistry{
+		blocks:       make([]blockStateDef, 0, len(defs)),
+		blockByState: make([]int32, int(maxStateID)+1),
+		blockByName:  make(map[string]int, len(defs)),
+	}
+	for i := range r.blockByState {
+		r.blockByState[i] = -1
+	}
+	for _, def := range defs {
+		props := make([]blockStateProperty, len(def.States))
+		combinations := int32(1)
+		for i, p := range def.States {
+			if p.Type == "bool" && len(p.Values) == 0 {
+				p.Values = []string{"true", "false"}
+			}
+			if len(p.Values) == 0 {
+				return nil, fmt.Errorf("block %s property %s has no values", def.Name, p.Name)
+			}
+			props[i] = p
+			combinations *= int32(len(p.Values))
+		}
+		if def.MaxStateID-def.MinStateID+1 != combinations {
+			return nil, fmt.Errorf("block %s has %d states but %d property combinations",
+				def.Name, def.MaxStateID-def.MinStateID+1, combinations)
+		}
+		defaultState := def.DefaultState
+		if defaultState < def.MinStateID || defaultState > def.MaxStateID {
+			defaultState = def.MinStateID
+		}
+		index := len(r.blocks)
+		r.blocks = append(r.blocks, blockStateDef{
+			id:           def.ID,
+			name:         def.Name,
+			displayName:  def.DisplayName,
+			minStateID:   def.MinStateID,
+			maxStateID:   def.MaxStateID,
+			defaultState: defaultState,
+			properties:   props,
+		})
+		if def.Name != "" {
+			r.blockByName[def.Name] = index
+		}
+		for id := def.MinStateID; id <= def.MaxStateID; id++ {
+			r.blockByState[id] = int32(index)
+		}
+	}
+	return r, nil
+}
+
+// LoadBlockStateRegistry reads a minecraft-data blocks.json.
+func LoadBlockStateRegistry(blocksJSONPath string) (*BlockStateRegistry, error) {
+	defs, maxStateID, err := readBlockDefinitions(blocksJSONPath)
+	if err != nil {
+		return nil, err
+	}
+	return newBlockStateRegistry(defs, maxStateID)
+}
+
+// State decodes a state ID.
+func (r *BlockStateRegistry) State(stateID int32) (BlockState, bool) {
+	if r == nil || stateID < 0 || int(stateID) >= len(r.blockByState) || r.blockByState[stateID] < 0 {
+		return BlockState{}, false
+	}
+	def := &r.blocks[r.blockByState[stateID]]
+	state := BlockState{ID: stateID, Block: def.name, DisplayName: def.displayName}
+	if len(def.properties) == 0 {
+		return state, true
+	}
+	state.Properties = make(map[string]string, len(def.properties))
+	offset := stateID - def.minStateID
+	for i := len(def.properties) - 1; i >= 0; i-- {
+		p := def.properties[i]
+		n := int32(len(p.Values))
+		state.Properties[p.Name] = p.Values[offset%n]
+		offset /= n
+	}
+	return state, true
+}
+
+// BlockID returns the block registry ID of a state.
+func (r *BlockStateRegistry) BlockID(stateID int32) (int32, bool) {
+	if r == nil || stateID < 0 || int(stateID) >= len(r.blockByState) || r.blockByState[stateID] < 0 {
+		return 0, false
+	}
+	return r.blocks[r.blockByState[stateID]].id, true
+}
+
+// StateID finds the state of block with the given properties. Properties
+// left out take their value from the block's default state; unknown
+// properties or values fail the lookup.
+func (r *BlockStateRegistry) StateID(block string, properties map[string]string) (int32, bool) {
+	if r == nil {
+		return 0, false
+	}
+	index, ok := r.blockByName[strings.TrimPrefix(block, "minecraft:")]
+	if !ok {
+		return 0, false
+	}
+	def := &r.blocks[index]
+	for name := range properties {
+		if def.propertyIndex(name) < 0 {
+			return 0, false
+		}
+	}
+
+	defaults, _ := r.State(def.defaultState)
+	offset := int32(0)
+	for _, p := range def.properties {
+		value, ok := properties[p.Name]
+		if !ok {
+			value = defaults.Properties[p.Name]
+		}
+		valueIndex := -1
+		for i, v := range p.Values {
+			if v == value {
+				valueIndex = i
+				break
+			}
+		}
+		if valueIndex < 0 {
+			return 0, false
+		}
+		offset = offset*int32(len(p.Values)) + int32(valueIndex)
+	}
+	return def.minStateID + offset, true
+}
+
+// DefaultStateID is the state a block is placed in without context.
+func (r *BlockStateRegistry) DefaultStateID(block string) (int32, bool) {
+	if r == nil {
+		return 0, false
+	}
+	index, ok := r.blockByName[strings.TrimPrefix(block, "minecraft:")]
+	if !ok {
+		return 0, false
+	}
+	return r.blocks[index].defaultState, true
+}
+
+// Lookup resolves a state string such as "oak_door[open=false,half=lower]".
+func (r *BlockStateRegistry) Lookup(state string) (int32, bool) {
+	block, properties, err := ParseBlockState(state)
+	if err != nil {
+		return 0, false
+	}
+	return r.StateID(block, properties)
+}
+
+func (d *blockStateDef) propertyIndex(name string) int {
+	for i, p := range d.properties {
+		if p.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseBlockState splits "minecraft:oak_door[open=false,half=lower]" into the
+// block name and its properties.
+func ParseBlockState(s string) (string, map[string]string, error) {
+	s = strings.TrimSpace(s)
+	block, rest, hasProps := strings.Cut(s, "[")
+	block = strings.TrimPrefix(block, "minecraft:")
+	if block == "" {
+		return "", nil, fmt.Errorf("block state %q has no block name", s)
+	}
+	if !hasProps {
+		return block, nil, nil
+	}
+	body, ok := strings.CutSuffix(rest, "]")
+	if !ok {
+		return "", nil, fmt.Errorf("block state %q is missing ']'", s)
+	}
+	properties := make(map[string]string)
+	if strings.TrimSpace(body) == "" {
+		return block, properties, nil
+	}
+	for _, pair := range strings.Split(body, ",") {
+		name, value, ok := strings.Cut(pair, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" || value == "" {
+			return "", nil, fmt.Errorf("block state %q has malformed property %q", s, pair)
+		}
+		properties[name] = value
+	}
+	return block, properties, nil
+}
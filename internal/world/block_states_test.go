@@ -0,0 +1,122 @@
+package world
+
+import "testing"
+
+func loadTestBlockStates(t *testing.T) *BlockStateRegistry {
+	t.Helper()
+	registry, err := LoadBlockStateRegistry(defaultBlocksJSONPath())
+	if err != nil {
+		t.Fatalf("LoadBlockStateRegistry failed: %v", err)
+	}
+	return registry
+}
+
+func TestBlockStateRegistryDecodesProperties(t *testing.T) {
+	registry := loadTestBlockStates(t)
+
+	// 5465 is oak_door's default state in 1.21.11.
+	state, ok := registry.State(5465)
+	if !ok {
+		t.Fatal("State(5465) not found")
+	}
+	if state.Block != "oak_door" || state.DisplayName != "Oak Door" {
+		t.Fatalf("State(5465) = %+v, want oak_door", state)
+	}
+	want := map[string]string{"facing": "north", "half": "lower", "hinge": "left", "open": "false", "powered": "false"}
+	for k, v := range want {
+		if state.Property(k) != v {
+			t.Fatalf("property %s = %q, want %q (state %s)", k, state.Property(k), v, state)
+		}
+	}
+	if got := state.String(); got != "oak_door[facing=north,half=lower,hinge=left,open=false,powered=false]" {
+		t.Fatalf("String() = %q", got)
+	}
+
+	stone, ok := registry.State(1)
+	if !ok || stone.Block != "stone" || len(stone.Properties) != 0 || stone.String() != "stone" {
+		t.Fatalf("State(1) = %+v, want plain stone", stone)
+	}
+	if _, ok := registry.State(-1); ok {
+		t.Fatal("negative state ID should not decode")
+	}
+}
+
+func TestBlockStateRegistryReverseLookup(t *testing.T) {
+	registry := loadTestBlockStates(t)
+
+	id, ok := registry.StateID("minecraft:oak_door", map[string]string{"open": "true", "half": "upper"})
+	if !ok {
+		t.Fatal("StateID(oak_door open upper) not found")
+	}
+	state, _ := registry.State(id)
+	if state.Property("open") != "true" || state.Property("half") != "upper" || state.Property("facing") != "north" {
+		t.Fatalf("round trip gave %s", state)
+	}
+
+	if id, ok := registry.Lookup("wheat[age=7]"); !ok || id != 5117 {
+		t.Fatalf("Lookup(wheat[age=7]) = %d, %v, want 5117", id, ok)
+	}
+	if _, ok := registry.StateID("oak_door", map[string]string{"open": "maybe"}); ok {
+		t.Fatal("unknown property value should fail")
+	}
+	if _, ok := registry.StateID("oak_door", map[string]string{"age": "1"}); ok {
+		t.Fatal("property the block lacks should fail")
+	}
+	if _, ok := registry.StateID("not_a_block", nil); ok {
+		t.Fatal("unknown block should fail")
+	}
+	if id, ok := registry.DefaultStateID("oak_door"); !ok || id != 5465 {
+		t.Fatalf("DefaultStateID(oak_door) = %d, %v, want 5465", id, ok)
+	}
+}
+
+func TestBlockStateRegistryRoundTripsEveryState(t *testing.T) {
+	registry := loadTestBlockStates(t)
+	for id := int32(0); int(id) < len(registry.blockByState); id++ {
+		state, ok := registry.State(id)
+		if !ok {
+			continue
+		}
+		got, ok := registry.StateID(state.Block, state.Properties)
+		if !ok || got != id {
+			t.Fatalf("StateID(%s) = %d, %v, want %d", state, got, ok, id)
+		}
+	}
+}
+
+func TestParseBlockState(t *testing.T) {
+	block, props, err := ParseBlockState("minecraft:oak_door[open=false, half=lower]")
+	if err != nil {
+		t.Fatalf("ParseBlockState failed: %v", err)
+	}
+	if block != "oak_door" || props["open"] != "false" || props["half"] != "lower" || len(props) != 2 {
+		t.Fatalf("ParseBlockState = %q %v", block, props)
+	}
+	if block, props, err := ParseBlockState("stone"); err != nil || block != "stone" || props != nil {
+		t.Fatalf("ParseBlockState(stone) = %q %v %v", block, props, err)
+	}
+	for _, bad := range []string{"", "oak_door[open=false", "oak_door[open]", "[open=true]"} {
+		if _, _, err := ParseBlockState(bad); err == nil {
+			t.Fatalf("ParseBlockState(%q) should fail", bad)
+		}
+	}
+}
+
+func TestBlockStoreDescribeBlockState(t *testing.T) {
+	store, err := NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	state, ok := store.DescribeBlockState(5117)
+	if !ok || state.Block != "wheat" || state.Property("age") != "7" {
+		t.Fatalf("DescribeBlockState(5117) = %+v, %v", state, ok)
+	}
+	if id, ok := store.LookupBlockState("wheat", map[string]string{"age": "0"}); !ok || id != 5110 {
+		t.Fatalf("LookupBlockState(wheat age=0) = %d, %v", id, ok)
+	}
+
+	var empty BlockStore
+	if _, ok := empty.DescribeBlockState(1); ok {
+		t.Fatal("store without registry should not describe states")
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 )
 
+// ChunkMinY, ChunkMaxY and ChunkSectionCount are the overworld's height,
+// which a BlockStore has until SetHeight sizes it for another dimension.
 const (
 	ChunkMinY          = -64
 	ChunkMaxY          = 319
@@ -25,6 +27,9 @@ type ChunkPos struct {
 
 type ChunkSection struct {
 	BlockStates []int32
+	// Biomes holds BiomesPerSection network biome IDs, or nothing when the
+	// chunk was sent without biomes.
+	Biomes []int32
 }
 
 type BlockPos struct {
@@ -57,6 +62,10 @@ type Chunk struct {
 	Sections      []ChunkSection
 	BlockEntities map[BlockPos]BlockEntity
 	BlockActions  map[BlockPos]BlockActionRecord
+	// SkyLight and BlockLight hold LightSectionCount nibble arrays each; nil
+	// means unknown and an empty slice means all dark.
+	SkyLight   [][]byte
+	BlockLight [][]byte
 }
 
 type BlockStore struct {
@@ -64,14 +73,34 @@ type BlockStore struct {
 	chunks             map[ChunkPos]*Chunk
 	solidByStateID     []bool
 	blockNameByStateID []string
+	states             *BlockStateRegistry
+	// shapes is nil when blocks.json has no blockCollisionShapes.json next
+	// to it; collision then falls back to full cubes for solid states.
+	shapes *CollisionShapes
+	fluids []Fluid
+	// movement is indexed like states.blocks.
+	movement []BlockMovement
+	// biomes is nil when blocks.json has no biomes.json next to it.
+	biomes *BiomeRegistry
+	// registries holds the server's tags; nil until the bot sets it.
+	registries *Registries
+	// chunkCache receives chunks as they are unloaded; nil keeps nothing.
+	chunkCache *ChunkCache
+	// minY and sectionCount size every chunk; a zero sectionCount means the
+	// overworld height.
+	minY         int
+	sectionCount int
 }
 
 type blockDefinition struct {
-	Name        string `json:"name"`
-	DisplayName string `json:"displayName"`
-	MinStateID  int32  `json:"minStateId"`
-	MaxStateID  int32  `json:"maxStateId"`
-	BoundingBox string `json:"boundingBox"`
+	ID           int32                `json:"id"`
+	Name         string               `json:"name"`
+	DisplayName  string               `json:"displayName"`
+	MinStateID   int32                `json:"minStateId"`
+	MaxStateID   int32                `json:"maxStateId"`
+	DefaultState int32                `json:"defaultState"`
+	States       []blockStateProperty `json:"states"`
+	BoundingBox  string               `json:"boundingBox"`
 }
 
 func NewBlockStore() (*BlockStore, error) {
@@ -79,14 +108,36 @@ func NewBlockStore() (*BlockStore, error) {
 }
 
 func NewBlockStoreFromBlocksJSON(blocksJSONPath string) (*BlockStore, error) {
-	solidByStateID, blockNameByStateID, err := LoadStateMetadataFromBlocksJSON(blocksJSONPath)
+	blocks, maxStateID, err := readBlockDefinitions(blocksJSONPath)
 	if err != nil {
 		return nil, err
 	}
+	solidByStateID, blockNameByStateID := stateMetadata(blocks, maxStateID)
+	states, err := newBlockStateRegistry(blocks, maxStateID)
+	if err != nil {
+		return nil, err
+	}
+	var shapes *CollisionShapes
+	if shapesPath := collisionShapesPath(blocksJSONPath); fileExists(shapesPath) {
+		if shapes, err = LoadCollisionShapes(shapesPath, states); err != nil {
+			return nil, err
+		}
+	}
+	var biomes *BiomeRegistry
+	if path := biomesPath(blocksJSONPath); fileExists(path) {
+		if biomes, err = LoadBiomes(path); err != nil {
+			return nil, err
+		}
+	}
 	return &BlockStore{
 		chunks:             make(map[ChunkPos]*Chunk),
 		solidByStateID:     solidByStateID,
 		blockNameByStateID: blockNameByStateID,
+		states:             states,
+		shapes:             shapes,
+		fluids:             fluidTable(states),
+		movement:           movementTable(states),
+		biomes:             biomes,
 	}, nil
 }
 
@@ -96,27 +147,36 @@ func LoadStateSolidityFromBlocksJSON(blocksJSONPath string) ([]bool, error) {
 }
 
 func LoadStateMetadataFromBlocksJSON(blocksJSONPath string) ([]bool, []string, error) {
+	blocks, maxStateID, err := readBlockDefinitions(blocksJSONPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	solidByStateID, blockNameByStateID := stateMetadata(blocks, maxStateID)
+	return solidByStateID, blockNameByStateID, nil
+}
+
+func readBlockDefinitions(blocksJSONPath string) ([]blockDefinition, int32, error) {
 	if blocksJSONPath == "" {
-		return nil, nil, fmt.Errorf("blocks.json path is empty")
+		return nil, 0, fmt.Errorf("blocks.json path is empty")
 	}
 
 	data, err := os.ReadFile(blocksJSONPath)
 	if err != nil {
-		return nil, nil, fmt.Errorf("read blocks.json: %w", err)
+		return nil, 0, fmt.Errorf("read blocks.json: %w", err)
 	}
 
 	var blocks []blockDefinition
 	if err := json.Unmarshal(data, &blocks); err != nil {
-		return nil, nil, fmt.Errorf("parse blocks.json: %w", err)
+		return nil, 0, fmt.Errorf("parse blocks.json: %w", err)
 	}
 	if len(blocks) == 0 {
-		return nil, nil, fmt.Errorf("blocks.json has no block definitions")
+		return nil, 0, fmt.Errorf("blocks.json has no block definitions")
 	}
 
 	maxStateID := int32(-1)
 	for _, block := range blocks {
 		if block.MinStateID < 0 || block.MaxStateID < block.MinStateID {
-			return nil, nil, fmt.Errorf(
+			return nil, 0, fmt.Errorf(
 				"invalid state id range in blocks.json: min=%d max=%d",
 				block.MinStateID,
 				block.MaxStateID,
@@ -126,7 +186,10 @@ func LoadStateMetadataFromBlocksJSON(blocksJSONPath string) ([]bool, []string, e
 			maxStateID = block.MaxStateID
 		}
 	}
+	return blocks, maxStateID, nil
+}
 
+func stateMetadata(blocks []blockDefinition, maxStateID int32) ([]bool, []string) {
 	solidByStateID := make([]bool, int(maxStateID)+1)
 	blockNameByStateID := make([]string, int(maxStateID)+1)
 	for _, block := range blocks {
@@ -142,22 +205,27 @@ func LoadStateMetadataFromBlocksJSON(blocksJSONPath string) ([]bool, []string, e
 			}
 		}
 	}
-	return solidByStateID, blockNameByStateID, nil
+	return solidByStateID, blockNameByStateID
 }
 
 func (bs *BlockStore) StoreChunk(chunkX, chunkZ int32, sections []ChunkSection) error {
 	return bs.StoreChunkWithBlockEntities(chunkX, chunkZ, sections, nil)
 }
 
+// StoreChunkWithBlockEntities stores a chunk with one section per section of
+// the store's height, from the lowest up.
 func (bs *BlockStore) StoreChunkWithBlockEntities(chunkX, chunkZ int32, sections []ChunkSection, blockEntities []BlockEntity) error {
-	if len(sections) != ChunkSectionCount {
-		return fmt.Errorf("invalid section count: got %d, want %d", len(sections), ChunkSectionCount)
+	_, sectionCount := bs.Height()
+	if len(sections) != sectionCount {
+		return fmt.Errorf("invalid section count: got %d, want %d", len(sections), sectionCount)
 	}
 
 	chunk := &Chunk{
-		Sections:      make([]ChunkSection, ChunkSectionCount),
+		Sections:      make([]ChunkSection, sectionCount),
 		BlockEntities: make(map[BlockPos]BlockEntity),
 		BlockActions:  make(map[BlockPos]BlockActionRecord),
+		SkyLight:      make([][]byte, sectionCount+2),
+		BlockLight:    make([][]byte, sectionCount+2),
 	}
 
 	for i := range sections {
@@ -170,9 +238,18 @@ func (bs *BlockStore) StoreChunkWithBlockEntities(chunkX, chunkZ int32, sections
 			)
 		}
 
+		if n := len(sections[i].Biomes); n != 0 && n != BiomesPerSection {
+			return fmt.Errorf("invalid section %d biome count: got %d, want %d", i, n, BiomesPerSection)
+		}
+
 		copied := make([]int32, BlocksPerSection)
 		copy(copied, sections[i].BlockStates)
-		chunk.Sections[i] = ChunkSection{BlockStates: copied}
+		var biomes []int32
+		if len(sections[i].Biomes) != 0 {
+			biomes = make([]int32, BiomesPerSection)
+			copy(biomes, sections[i].Biomes)
+		}
+		chunk.Sections[i] = ChunkSection{BlockStates: copied, Biomes: biomes}
 	}
 
 	for _, blockEntity := range blockEntities {
@@ -194,6 +271,10 @@ func (bs *BlockStore) StoreChunkWithBlockEntities(chunkX, chunkZ int32, sections
 
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
+	// A concurrent SetHeight would leave the chunk misaligned.
+	if _, current := bs.heightLocked(); current != sectionCount {
+		return fmt.Errorf("invalid section count: got %d, want %d", sectionCount, current)
+	}
 	if bs.chunks == nil {
 		bs.chunks = make(map[ChunkPos]*Chunk)
 	}
@@ -201,28 +282,72 @@ func (bs *BlockStore) StoreChunkWithBlockEntities(chunkX, chunkZ int32, sections
 	return nil
 }
 
-func (bs *BlockStore) UnloadChunk(chunkX, chunkZ int32) {
+// SetHeight sizes the store for a dimension whose blocks span minY up to
+// minY+height-1, as its dimension_type says. Loaded chunks no longer line up
+// with a new height, so they are archived and dropped when it changes.
+func (bs *BlockStore) SetHeight(minY, height int) error {
+	if height <= 0 || minY%ChunkSectionHeight != 0 || height%ChunkSectionHeight != 0 {
+		return fmt.Errorf("invalid dimension height: min_y %d, height %d", minY, height)
+	}
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
-	delete(bs.chunks, ChunkPos{X: chunkX, Z: chunkZ})
+	if currentMinY, currentCount := bs.heightLocked(); currentMinY == minY && currentCount == height/ChunkSectionHeight {
+		return nil
+	}
+	bs.archiveLocked(time.Now())
+	bs.chunks = make(map[ChunkPos]*Chunk)
+	bs.minY, bs.sectionCount = minY, height/ChunkSectionHeight
+	return nil
 }
 
-func (bs *BlockStore) SetBlockState(x, y, z int, stateID int32) bool {
-	if y < ChunkMinY || y > ChunkMaxY {
-		return false
+// Height returns the lowest y of the store and how many sections each chunk
+// has.
+func (bs *BlockStore) Height() (minY, sectionCount int) {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+	return bs.heightLocked()
+}
+
+func (bs *BlockStore) heightLocked() (minY, sectionCount int) {
+	if bs.sectionCount == 0 {
+		return ChunkMinY, ChunkSectionCount
+	}
+	return bs.minY, bs.sectionCount
+}
+
+// blockIndexLocked locates a position within its chunk's sections. ok is
+// false outside the store's height.
+func (bs *BlockStore) blockIndexLocked(x, y, z int) (sectionIndex, blockIndex int, ok bool) {
+	minY, sectionCount := bs.heightLocked()
+	if y < minY || y >= minY+sectionCount*ChunkSectionHeight {
+		return 0, 0, false
+	}
+	localY := (y - minY) % ChunkSectionHeight
+	return (y - minY) / ChunkSectionHeight, localY*16*16 + floorMod16(z)*16 + floorMod16(x), true
+}
+
+func (bs *BlockStore) UnloadChunk(chunkX, chunkZ int32) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	pos := ChunkPos{X: chunkX, Z: chunkZ}
+	if chunk, ok := bs.chunks[pos]; ok && bs.chunkCache != nil {
+		minY, _ := bs.heightLocked()
+		bs.chunkCache.Put(chunkX, chunkZ, minY, chunk.Sections, time.Now())
 	}
+	delete(bs.chunks, pos)
+}
 
+func (bs *BlockStore) SetBlockState(x, y, z int, stateID int32) bool {
 	chunkX := floorDiv16(x)
 	chunkZ := floorDiv16(z)
-	localX := floorMod16(x)
-	localZ := floorMod16(z)
-	sectionIndex := (y - ChunkMinY) / ChunkSectionHeight
-	localY := (y - ChunkMinY) % ChunkSectionHeight
-	blockIndex := localY*16*16 + localZ*16 + localX
 
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
 
+	sectionIndex, blockIndex, ok := bs.blockIndexLocked(x, y, z)
+	if !ok {
+		return false
+	}
 	chunk, ok := bs.chunks[ChunkPos{X: int32(chunkX), Z: int32(chunkZ)}]
 	if !ok {
 		return false
@@ -341,26 +466,21 @@ func (bs *BlockStore) LoadedChunkCount() int {
 func (bs *BlockStore) Clear() {
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
+	bs.archiveLocked(time.Now())
 	bs.chunks = make(map[ChunkPos]*Chunk)
 }
 
 func (bs *BlockStore) GetBlockState(x, y, z int) (int32, bool) {
-	if y < ChunkMinY || y > ChunkMaxY {
-		return 0, false
-	}
-
 	chunkX := floorDiv16(x)
 	chunkZ := floorDiv16(z)
-	localX := floorMod16(x)
-	localZ := floorMod16(z)
-
-	sectionIndex := (y - ChunkMinY) / ChunkSectionHeight
-	localY := (y - ChunkMinY) % ChunkSectionHeight
-	blockIndex := localY*16*16 + localZ*16 + localX
 
 	bs.mu.RLock()
 	defer bs.mu.RUnlock()
 
+	sectionIndex, blockIndex, ok := bs.blockIndexLocked(x, y, z)
+	if !ok {
+		return 0, false
+	}
 	chunk, ok := bs.chunks[ChunkPos{X: int32(chunkX), Z: int32(chunkZ)}]
 	if !ok {
 		return 0, false
@@ -378,10 +498,11 @@ func (bs *BlockStore) GetBlockState(x, y, z int) (int32, bool) {
 
 func (bs *BlockStore) IsSolid(x, y, z int) bool {
 	stateID, ok := bs.GetBlockState(x, y, z)
-	if !ok || stateID < 0 {
-		return false
-	}
-	if int(stateID) >= len(bs.solidByStateID) {
+	return ok && bs.stateIsSolid(stateID)
+}
+
+func (bs *BlockStore) stateIsSolid(stateID int32) bool {
+	if stateID < 0 || int(stateID) >= len(bs.solidByStateID) {
 		return false
 	}
 	return bs.solidByStateID[stateID]
@@ -405,7 +526,51 @@ func (bs *BlockStore) GetBlockNameByStateID(stateID int32) (string, bool) {
 	return name, true
 }
 
+// DescribeBlockState decodes a state ID into its block and properties.
+func (bs *BlockStore) DescribeBlockState(stateID int32) (BlockState, bool) {
+	return bs.states.State(stateID)
+}
+
+// LookupBlockState finds the state ID for a block and properties, filling
+// unspecified properties from the block's default state.
+func (bs *BlockStore) LookupBlockState(block string, properties map[string]string) (int32, bool) {
+	return bs.states.StateID(block, properties)
+}
+
+// CollisionBoxes returns the block-local collision boxes at a position.
+// Unloaded positions have none.
+func (bs *BlockStore) CollisionBoxes(x, y, z int) []CollisionBox {
+	stateID, ok := bs.GetBlockState(x, y, z)
+	if !ok {
+		return nil
+	}
+	return bs.stateCollisionBoxes(stateID)
+}
+
+func (bs *BlockStore) stateCollisionBoxes(stateID int32) []CollisionBox {
+	if bs.shapes != nil {
+		return bs.shapes.Shape(stateID)
+	}
+	if bs.stateIsSolid(stateID) {
+		return fullBlockShape
+	}
+	return nil
+}
+
+var fullBlockShape = []CollisionBox{{MaxX: 1, MaxY: 1, MaxZ: 1}}
+
+// BlockStates returns the registry behind DescribeBlockState and
+// LookupBlockState. It is nil for stores built without blocks.json.
+func (bs *BlockStore) BlockStates() *BlockStateRegistry {
+	return bs.states
+}
+
+// defaultBlocksJSONPath prefers the active game data version and falls back
+// to the bundled 1.21.11 directory.
 func defaultBlocksJSONPath() string {
+	if d := activeGameData.Load(); d != nil {
+		return d.BlocksJSONPath()
+	}
 	candidates := []string{
 		filepath.Join("1.21.11", "blocks.json"),
 	}
@@ -431,6 +596,11 @@ func firstExistingPath(candidates []string) string {
 	return filepath.Clean(candidates[0])
 }
 
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
 func floorDiv16(v int) int {
 	q := v / 16
 	if v < 0 && v%16 != 0 {
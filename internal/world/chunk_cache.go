@@ -0,0 +1,509 @@
+package world
+
+import (
+	"bufio"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	chunkCacheMagic   = "LOCUSCC2"
+	chunkCacheFileExt = ".chunks"
+	// chunkCacheMagicV1 files hold overworld-height chunks without their
+	// min_y and section count.
+	chunkCacheMagicV1 = "LOCUSCC1"
+	// MaxCachedChunks bounds one dimension's cache; the chunks seen longest
+	// ago are dropped first.
+	MaxCachedChunks = 8192
+)
+
+// ChunkCache keeps the last-seen block states of chunks the server has
+// unloaded, one region file per dimension under a directory. Sections are
+// kept palette-compressed in memory and on disk. State IDs are only
+// meaningful for one protocol version, so use one directory per version.
+//
+// A nil *ChunkCache caches nothing.
+type ChunkCache struct {
+	// saveMu orders region file writes; it is taken before mu.
+	saveMu    sync.Mutex
+	mu        sync.RWMutex
+	dir       string
+	dimension string
+	chunks    map[ChunkPos]*cachedChunk
+	dirty     bool
+}
+
+type cachedChunk struct {
+	seenAt   time.Time
+	minY     int
+	sections []packedSection
+}
+
+// packedSection stores BlocksPerSection palette indices of bits each, packed
+// into words without spanning word boundaries. A single-state section has
+// bits == 0 and no data.
+type packedSection struct {
+	bits    uint8
+	palette []int32
+	data    []uint64
+}
+
+// OpenChunkCache creates dir if needed. No dimension is selected until
+// SetDimension is called.
+func OpenChunkCache(dir string) (*ChunkCache, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("chunk cache directory is empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create chunk cache directory: %w", err)
+	}
+	return &ChunkCache{dir: dir, chunks: make(map[ChunkPos]*cachedChunk)}, nil
+}
+
+// Dimension returns the dimension whose chunks are cached.
+func (c *ChunkCache) Dimension() string {
+	if c == nil {
+		return ""
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.dimension
+}
+
+// SetDimension saves the current dimension's chunks and loads those of name.
+// The new dimension is selected even when its file cannot be read, starting
+// empty, so the error only reports lost history.
+func (c *ChunkCache) SetDimension(name string) error {
+	if c == nil {
+		return nil
+	}
+	c.saveMu.Lock()
+	defer c.saveMu.Unlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if name == c.dimension {
+		return nil
+	}
+	saveErr := c.saveLocked()
+	c.dimension = name
+	c.chunks = make(map[ChunkPos]*cachedChunk)
+	c.dirty = false
+	if name == "" {
+		return saveErr
+	}
+	chunks, err := readChunkCacheFile(c.pathLocked())
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Join(saveErr, fmt.Errorf("load chunk cache for %s: %w", name, err))
+	}
+	if chunks != nil {
+		c.chunks = chunks
+	}
+	return saveErr
+}
+
+// Put records the block states of a chunk whose lowest section starts at
+// minY as seen at seenAt. It does nothing before a dimension is selected or
+// when the sections are malformed.
+func (c *ChunkCache) Put(chunkX, chunkZ int32, minY int, sections []ChunkSection, seenAt time.Time) {
+	if c == nil || len(sections) == 0 || len(sections) > math.MaxUint16 {
+		return
+	}
+	chunk := &cachedChunk{seenAt: seenAt, minY: minY, sections: make([]packedSection, len(sections))}
+	for i := range sections {
+		if len(sections[i].BlockStates) != BlocksPerSection {
+			return
+		}
+		chunk.sections[i] = packSection(sections[i].BlockStates)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.dimension == "" {
+		return
+	}
+	pos := ChunkPos{X: chunkX, Z: chunkZ}
+	if _, ok := c.chunks[pos]; !ok && len(c.chunks) >= MaxCachedChunks {
+		c.evictOldestLocked()
+	}
+	c.chunks[pos] = chunk
+	c.dirty = true
+}
+
+func (c *ChunkCache) evictOldestLocked() {
+	var oldest ChunkPos
+	var oldestAt time.Time
+	first := true
+	for pos, chunk := range c.chunks {
+		if first || chunk.seenAt.Before(oldestAt) {
+			oldest, oldestAt, first = pos, chunk.seenAt, false
+		}
+	}
+	delete(c.chunks, oldest)
+}
+
+// BlockState returns the cached state at a position and when its chunk was
+// last seen.
+func (c *ChunkCache) BlockState(x, y, z int) (int32, time.Time, bool) {
+	if c == nil {
+		return 0, time.Time{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	chunk, ok := c.chunks[ChunkPos{X: int32(floorDiv16(x)), Z: int32(floorDiv16(z))}]
+	if !ok || y < chunk.minY || y >= chunk.minY+len(chunk.sections)*ChunkSectionHeight {
+		return 0, time.Time{}, false
+	}
+	localY := (y - chunk.minY) % ChunkSectionHeight
+	index := localY*16*16 + floorMod16(z)*16 + floorMod16(x)
+	return chunk.sections[(y-chunk.minY)/ChunkSectionHeight].get(index), chunk.seenAt, true
+}
+
+// SeenAt returns when a cached chunk was last seen.
+func (c *ChunkCache) SeenAt(chunkX, chunkZ int32) (time.Time, bool) {
+	if c == nil {
+		return time.Time{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	chunk, ok := c.chunks[ChunkPos{X: chunkX, Z: chunkZ}]
+	if !ok {
+		return time.Time{}, false
+	}
+	return chunk.seenAt, true
+}
+
+// Len returns the number of cached chunks in the current dimension.
+func (c *ChunkCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.chunks)
+}
+
+// Save writes the current dimension's region file if anything changed. The
+// file is written from a copy, so Put and lookups are not held up by disk
+// I/O.
+func (c *ChunkCache) Save() error {
+	if c == nil {
+		return nil
+	}
+	c.saveMu.Lock()
+	defer c.saveMu.Unlock()
+	c.mu.Lock()
+	if !c.dirty || c.dimension == "" {
+		c.mu.Unlock()
+		return nil
+	}
+	dimension, path := c.dimension, c.pathLocked()
+	chunks := maps.Clone(c.chunks)
+	c.dirty = false
+	c.mu.Unlock()
+
+	if err := writeChunkCacheFile(path, chunks); err != nil {
+		c.mu.Lock()
+		if c.dimension == dimension {
+			c.dirty = true
+		}
+		c.mu.Unlock()
+		return fmt.Errorf("save chunk cache for %s: %w", dimension, err)
+	}
+	return nil
+}
+
+func (c *ChunkCache) saveLocked() error {
+	if !c.dirty || c.dimension == "" {
+		return nil
+	}
+	if err := writeChunkCacheFile(c.pathLocked(), c.chunks); err != nil {
+		return fmt.Errorf("save chunk cache for %s: %w", c.dimension, err)
+	}
+	c.dirty = false
+	return nil
+}
+
+func (c *ChunkCache) pathLocked() string {
+	name := strings.NewReplacer(":", "_", "/", "_", "\\", "_").Replace(trimMinecraftNamespace(c.dimension))
+	return filepath.Join(c.dir, name+chunkCacheFileExt)
+}
+
+func packSection(states []int32) packedSection {
+	indexOf := make(map[int32]int)
+	var palette []int32
+	for _, state := range states {
+		if _, ok := indexOf[state]; !ok {
+			indexOf[state] = len(palette)
+			palette = append(palette, state)
+		}
+	}
+	section := packedSection{bits: uint8(bits.Len(uint(len(palette) - 1))), palette: palette}
+	if section.bits == 0 {
+		return section
+	}
+	perWord := 64 / int(section.bits)
+	section.data = make([]uint64, (BlocksPerSection+perWord-1)/perWord)
+	for i, state := range states {
+		shift := uint(i%perWord) * uint(section.bits)
+		section.data[i/perWord] |= uint64(indexOf[state]) << shift
+	}
+	return section
+}
+
+func (s packedSection) get(index int) int32 {
+	if s.bits == 0 {
+		return s.palette[0]
+	}
+	perWord := 64 / int(s.bits)
+	shift := uint(index%perWord) * uint(s.bits)
+	paletteIndex := int((s.data[index/perWord] >> shift) & (1<<s.bits - 1))
+	if paletteIndex >= len(s.palette) {
+		return 0
+	}
+	return s.palette[paletteIndex]
+}
+
+// Region files are zlib-compressed: the magic, a chunk count, then per chunk
+// its position, last-seen Unix milliseconds, min_y, section count and that
+// many sections of bits, palette and packed words. All integers are
+// big-endian. Version 1 files have no min_y or section count and always
+// hold ChunkSectionCount sections from ChunkMinY.
+func writeChunkCacheFile(path string, chunks map[ChunkPos]*cachedChunk) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	zw := zlib.NewWriter(tmp)
+	w := bufio.NewWriter(zw)
+	write := func(v any) {
+		if err == nil {
+			err = binary.Write(w, binary.BigEndian, v)
+		}
+	}
+	_, err = w.WriteString(chunkCacheMagic)
+	write(uint32(len(chunks)))
+	for pos, chunk := range chunks {
+		write(pos.X)
+		write(pos.Z)
+		write(chunk.seenAt.UnixMilli())
+		write(int32(chunk.minY))
+		write(uint16(len(chunk.sections)))
+		for _, section := range chunk.sections {
+			write(section.bits)
+			write(uint16(len(section.palette)))
+			write(section.palette)
+			write(section.data)
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = zw.Close()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func readChunkCacheFile(path string) (map[ChunkPos]*cachedChunk, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	zr, err := zlib.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	r := bufio.NewReader(zr)
+
+	magic := make([]byte, len(chunkCacheMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return nil, err
+	}
+	version1 := string(magic) == chunkCacheMagicV1
+	if !version1 && string(magic) != chunkCacheMagic {
+		return nil, fmt.Errorf("not a chunk cache file")
+	}
+	read := func(v any) {
+		if err == nil {
+			err = binary.Read(r, binary.BigEndian, v)
+		}
+	}
+	var count uint32
+	read(&count)
+	if err != nil {
+		return nil, err
+	}
+	chunks := make(map[ChunkPos]*cachedChunk, min(count, MaxCachedChunks))
+	for range count {
+		var pos ChunkPos
+		var seenAt int64
+		read(&pos.X)
+		read(&pos.Z)
+		read(&seenAt)
+		minY, sectionCount := int32(ChunkMinY), uint16(ChunkSectionCount)
+		if !version1 {
+			read(&minY)
+			read(&sectionCount)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if sectionCount == 0 {
+			return nil, fmt.Errorf("chunk (%d,%d): no sections", pos.X, pos.Z)
+		}
+		chunk := &cachedChunk{seenAt: time.UnixMilli(seenAt), minY: int(minY), sections: make([]packedSection, sectionCount)}
+		for i := range chunk.sections {
+			section := &chunk.sections[i]
+			var paletteLen uint16
+			read(&section.bits)
+			read(&paletteLen)
+			if err != nil {
+				return nil, err
+			}
+			if paletteLen == 0 || section.bits > 32 || int(section.bits) != bits.Len(uint(paletteLen-1)) {
+				return nil, fmt.Errorf("chunk (%d,%d) section %d: invalid palette", pos.X, pos.Z, i)
+			}
+			section.palette = make([]int32, paletteLen)
+			read(section.palette)
+			if section.bits > 0 {
+				perWord := 64 / int(section.bits)
+				section.data = make([]uint64, (BlocksPerSection+perWord-1)/perWord)
+				read(section.data)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		chunks[pos] = chunk
+	}
+	return chunks, nil
+}
+
+// SetChunkCache makes the store archive chunks into c when they are unloaded
+// or cleared. Pass nil to stop archiving.
+func (bs *BlockStore) SetChunkCache(c *ChunkCache) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	bs.chunkCache = c
+}
+
+// SetChunkCacheDimension switches the chunk cache to a dimension. Call it
+// after Clear when the dimension changes, so the old chunks are archived
+// under the old dimension.
+func (bs *BlockStore) SetChunkCacheDimension(name string) error {
+	return bs.cache().SetDimension(name)
+}
+
+// FlushChunkCache archives the loaded chunks and saves the chunk cache. The
+// store is only locked while archiving, not while the file is written.
+func (bs *BlockStore) FlushChunkCache() error {
+	bs.mu.RLock()
+	cache := bs.chunkCache
+	bs.archiveLocked(time.Now())
+	bs.mu.RUnlock()
+	return cache.Save()
+}
+
+// LastKnownBlockState returns the state at a position from a loaded chunk,
+// or else from the chunk cache, together with when it was seen. Loaded
+// chunks are seen now.
+func (bs *BlockStore) LastKnownBlockState(x, y, z int) (int32, time.Time, bool) {
+	if stateID, ok := bs.GetBlockState(x, y, z); ok {
+		return stateID, time.Now(), true
+	}
+	return bs.cache().BlockState(x, y, z)
+}
+
+// KnownBlocks reads the store with unloaded chunks filled in from the chunk
+// cache, so routes can be planned across terrain seen in earlier sessions.
+// Cached blocks may have changed since they were seen.
+type KnownBlocks struct {
+	store *BlockStore
+}
+
+func (bs *BlockStore) KnownBlocks() KnownBlocks {
+	return KnownBlocks{store: bs}
+}
+
+func (k KnownBlocks) GetBlockState(x, y, z int) (int32, bool) {
+	if stateID, ok := k.store.GetBlockState(x, y, z); ok {
+		return stateID, true
+	}
+	stateID, _, ok := k.store.cache().BlockState(x, y, z)
+	return stateID, ok
+}
+
+func (k KnownBlocks) IsSolid(x, y, z int) bool {
+	stateID, ok := k.GetBlockState(x, y, z)
+	return ok && k.store.stateIsSolid(stateID)
+}
+
+func (k KnownBlocks) CollisionBoxes(x, y, z int) []CollisionBox {
+	stateID, ok := k.GetBlockState(x, y, z)
+	if !ok {
+		return nil
+	}
+	return k.store.stateCollisionBoxes(stateID)
+}
+
+func (k KnownBlocks) FluidAt(x, y, z int) Fluid {
+	stateID, ok := k.GetBlockState(x, y, z)
+	if !ok {
+		return Fluid{}
+	}
+	return k.store.stateFluid(stateID)
+}
+
+func (k KnownBlocks) BlockMovement(x, y, z int) BlockMovement {
+	stateID, ok := k.GetBlockState(x, y, z)
+	if !ok {
+		return DefaultBlockMovement
+	}
+	return k.store.stateMovement(stateID)
+}
+
+// ChunkLastSeen reports when a chunk was last seen, loaded or cached.
+func (bs *BlockStore) ChunkLastSeen(chunkX, chunkZ int32) (time.Time, bool) {
+	if bs.IsLoaded(chunkX, chunkZ) {
+		return time.Now(), true
+	}
+	return bs.cache().SeenAt(chunkX, chunkZ)
+}
+
+func (bs *BlockStore) cache() *ChunkCache {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+	return bs.chunkCache
+}
+
+// archiveLocked puts every loaded chunk into the chunk cache. bs.mu must be
+// held.
+func (bs *BlockStore) archiveLocked(seenAt time.Time) {
+	if bs.chunkCache == nil {
+		return
+	}
+	minY, _ := bs.heightLocked()
+	for pos, chunk := range bs.chunks {
+		bs.chunkCache.Put(pos.X, pos.Z, minY, chunk.Sections, seenAt)
+	}
+}
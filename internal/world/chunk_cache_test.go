@@ -0,0 +1,274 @@
+package world
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPackSectionRoundTrip(t *testing.T) {
+	states := make([]int32, BlocksPerSection)
+	for i := range states {
+		states[i] = int32(i % 37 * 100)
+	}
+	section := packSection(states)
+	if section.bits != 6 || len(section.palette) != 37 {
+		t.Fatalf("bits=%d palette=%d, want 6 and 37", section.bits, len(section.palette))
+	}
+	for i, want := range states {
+		if got := section.get(i); got != want {
+			t.Fatalf("get(%d) = %d, want %d", i, got, want)
+		}
+	}
+
+	uniform := packSection(makeFilledSections(9)[0].BlockStates)
+	if uniform.bits != 0 || uniform.data != nil || uniform.get(4095) != 9 {
+		t.Fatalf("uniform section = %+v, want a single-entry palette", uniform)
+	}
+}
+
+func TestChunkCacheKeepsUnloadedChunksAcrossRestarts(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := OpenChunkCache(dir)
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	if err := cache.SetDimension(DimensionOverworld); err != nil {
+		t.Fatalf("SetDimension failed: %v", err)
+	}
+	bs := &BlockStore{chunks: make(map[ChunkPos]*Chunk)}
+	bs.SetChunkCache(cache)
+
+	sections := makeFilledSections(1)
+	sections[8].BlockStates[6*16*16+3*16+2] = 42
+	if err := bs.StoreChunk(-1, 0, sections); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	if _, ok := cache.SeenAt(-1, 0); ok {
+		t.Fatal("loaded chunks should not be cached before they unload")
+	}
+	before := time.Now()
+	bs.UnloadChunk(-1, 0)
+
+	// Global (-14,70,3) is chunk (-1,0), section 8, local (2,6,3).
+	state, seenAt, ok := bs.LastKnownBlockState(-14, 70, 3)
+	if !ok || state != 42 || seenAt.Before(before) {
+		t.Fatalf("LastKnownBlockState = %d, %v, %v; want 42 seen after unload", state, seenAt, ok)
+	}
+	if _, ok := bs.GetBlockState(-14, 70, 3); ok {
+		t.Fatal("GetBlockState should still report the chunk as unloaded")
+	}
+
+	if err := bs.StoreChunk(0, 0, makeFilledSections(2)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	if err := bs.FlushChunkCache(); err != nil {
+		t.Fatalf("FlushChunkCache failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "overworld.chunks")); err != nil {
+		t.Fatalf("region file missing: %v", err)
+	}
+
+	reopened, err := OpenChunkCache(dir)
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	if err := reopened.SetDimension(DimensionOverworld); err != nil {
+		t.Fatalf("SetDimension after restart failed: %v", err)
+	}
+	if reopened.Len() != 2 {
+		t.Fatalf("Len = %d, want 2", reopened.Len())
+	}
+	state, seenAt, ok = reopened.BlockState(-14, 70, 3)
+	if !ok || state != 42 || seenAt.UnixMilli() < before.UnixMilli() {
+		t.Fatalf("BlockState after restart = %d, %v, %v; want 42", state, seenAt, ok)
+	}
+	if state, _, ok := reopened.BlockState(5, -64, 5); !ok || state != 2 {
+		t.Fatalf("flushed loaded chunk = %d, %v; want 2", state, ok)
+	}
+}
+
+func TestKnownBlocksReadsUnloadedChunksFromCache(t *testing.T) {
+	cache, err := OpenChunkCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	if err := cache.SetDimension(DimensionOverworld); err != nil {
+		t.Fatalf("SetDimension failed: %v", err)
+	}
+	bs := &BlockStore{chunks: make(map[ChunkPos]*Chunk), solidByStateID: []bool{false, true}}
+	bs.SetChunkCache(cache)
+	if err := bs.StoreChunk(-1, 0, makeFilledSections(1)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	if err := bs.StoreChunk(0, 0, makeFilledSections(0)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	bs.UnloadChunk(-1, 0)
+
+	known := bs.KnownBlocks()
+	if bs.IsSolid(-5, 0, 5) {
+		t.Fatal("the store itself should not see the unloaded chunk")
+	}
+	if state, ok := known.GetBlockState(-5, 0, 5); !ok || state != 1 {
+		t.Fatalf("KnownBlocks.GetBlockState = %d, %v; want cached 1", state, ok)
+	}
+	if !known.IsSolid(-5, 0, 5) || len(known.CollisionBoxes(-5, 0, 5)) != 1 {
+		t.Fatal("cached stone should be solid with a full collision box")
+	}
+	if known.IsSolid(5, 0, 5) {
+		t.Fatal("loaded air should not be solid")
+	}
+	if _, ok := known.GetBlockState(40, 0, 5); ok {
+		t.Fatal("chunks never seen should stay unknown")
+	}
+}
+
+func TestChunkCacheSeparatesDimensions(t *testing.T) {
+	cache, err := OpenChunkCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	bs := &BlockStore{chunks: make(map[ChunkPos]*Chunk)}
+	bs.SetChunkCache(cache)
+	if err := bs.SetChunkCacheDimension(DimensionOverworld); err != nil {
+		t.Fatalf("SetChunkCacheDimension failed: %v", err)
+	}
+	if err := bs.StoreChunk(0, 0, makeFilledSections(3)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+
+	// A respawn clears the store before switching dimensions.
+	bs.Clear()
+	if err := bs.SetChunkCacheDimension(DimensionNether); err != nil {
+		t.Fatalf("SetChunkCacheDimension failed: %v", err)
+	}
+	if _, _, ok := bs.LastKnownBlockState(0, 0, 0); ok {
+		t.Fatal("overworld chunk should not be known in the nether")
+	}
+
+	if err := bs.SetChunkCacheDimension(DimensionOverworld); err != nil {
+		t.Fatalf("SetChunkCacheDimension failed: %v", err)
+	}
+	if state, _, ok := bs.LastKnownBlockState(0, 0, 0); !ok || state != 3 {
+		t.Fatalf("overworld chunk after returning = %d, %v; want 3", state, ok)
+	}
+}
+
+func TestChunkCacheRejectsCorruptFiles(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "the_end.chunks"), []byte("not zlib"), 0o644); err != nil {
+		t.Fatalf("write corrupt file failed: %v", err)
+	}
+	cache, err := OpenChunkCache(dir)
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	if err := cache.SetDimension(DimensionEnd); err == nil {
+		t.Fatal("SetDimension should report a corrupt region file")
+	}
+	if cache.Dimension() != DimensionEnd || cache.Len() != 0 {
+		t.Fatalf("cache = %q with %d chunks, want an empty end cache", cache.Dimension(), cache.Len())
+	}
+}
+
+func TestNilChunkCacheCachesNothing(t *testing.T) {
+	bs := &BlockStore{chunks: make(map[ChunkPos]*Chunk)}
+	if err := bs.StoreChunk(0, 0, makeFilledSections(1)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	bs.UnloadChunk(0, 0)
+	if _, _, ok := bs.LastKnownBlockState(0, 0, 0); ok {
+		t.Fatal("a store without a chunk cache should forget unloaded chunks")
+	}
+	if err := bs.FlushChunkCache(); err != nil {
+		t.Fatalf("FlushChunkCache without cache failed: %v", err)
+	}
+}
+
+func TestChunkCacheKeepsEachChunksHeight(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := OpenChunkCache(dir)
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	bs := &BlockStore{chunks: make(map[ChunkPos]*Chunk)}
+	bs.SetChunkCache(cache)
+	if err := bs.SetChunkCacheDimension("custom:deep"); err != nil {
+		t.Fatalf("SetChunkCacheDimension failed: %v", err)
+	}
+	if err := bs.SetHeight(-128, 512); err != nil {
+		t.Fatalf("SetHeight failed: %v", err)
+	}
+	sections := make([]ChunkSection, 32)
+	for i := range sections {
+		sections[i] = ChunkSection{BlockStates: make([]int32, BlocksPerSection)}
+	}
+	sections[0].BlockStates[0] = 5
+	if err := bs.StoreChunk(0, 0, sections); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	if state, ok := bs.GetBlockState(0, -128, 0); !ok || state != 5 {
+		t.Fatalf("GetBlockState(0,-128,0) = %d, %v; want 5", state, ok)
+	}
+
+	// Going back to the overworld height archives the deep chunk.
+	if err := bs.SetHeight(ChunkMinY, ChunkSectionCount*ChunkSectionHeight); err != nil {
+		t.Fatalf("SetHeight failed: %v", err)
+	}
+	if bs.IsLoaded(0, 0) {
+		t.Fatal("SetHeight should drop chunks of the old height")
+	}
+	if err := bs.FlushChunkCache(); err != nil {
+		t.Fatalf("FlushChunkCache failed: %v", err)
+	}
+	reopened, err := OpenChunkCache(dir)
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	if err := reopened.SetDimension("custom:deep"); err != nil {
+		t.Fatalf("SetDimension failed: %v", err)
+	}
+	if state, _, ok := reopened.BlockState(0, -128, 0); !ok || state != 5 {
+		t.Fatalf("BlockState(0,-128,0) after restart = %d, %v; want 5", state, ok)
+	}
+	if _, _, ok := reopened.BlockState(0, 384, 0); ok {
+		t.Fatal("y=384 is above the cached chunk")
+	}
+}
+
+func TestChunkCacheReadsVersion1Files(t *testing.T) {
+	dir := t.TempDir()
+	var raw bytes.Buffer
+	zw := zlib.NewWriter(&raw)
+	_, _ = zw.Write([]byte(chunkCacheMagicV1))
+	_ = binary.Write(zw, binary.BigEndian, uint32(1))
+	// Chunk (2,-3), then its last-seen time and 24 single-state sections.
+	for _, v := range []any{int32(2), int32(-3), time.Now().UnixMilli()} {
+		_ = binary.Write(zw, binary.BigEndian, v)
+	}
+	for range ChunkSectionCount {
+		_ = binary.Write(zw, binary.BigEndian, uint8(0))
+		_ = binary.Write(zw, binary.BigEndian, uint16(1))
+		_ = binary.Write(zw, binary.BigEndian, int32(9))
+	}
+	_ = zw.Close()
+	if err := os.WriteFile(filepath.Join(dir, "overworld.chunks"), raw.Bytes(), 0o644); err != nil {
+		t.Fatalf("write version 1 file failed: %v", err)
+	}
+
+	cache, err := OpenChunkCache(dir)
+	if err != nil {
+		t.Fatalf("OpenChunkCache failed: %v", err)
+	}
+	if err := cache.SetDimension(DimensionOverworld); err != nil {
+		t.Fatalf("SetDimension failed: %v", err)
+	}
+	if state, _, ok := cache.BlockState(32, ChunkMinY, -48); !ok || state != 9 {
+		t.Fatalf("BlockState from version 1 file = %d, %v; want 9", state, ok)
+	}
+}
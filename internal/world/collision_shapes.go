@@ -0,0 +1,97 @@
+package world
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// CollisionBox is one box of a block's collision shape in block-local
+// coordinates. Fences and walls reach MaxY 1.5.
+type CollisionBox struct {
+	MinX, MinY, MinZ float64
+	MaxX, MaxY, MaxZ float64
+}
+
+// CollisionShapes maps state IDs to collision boxes, loaded from
+// minecraft-data's blockCollisionShapes.json. States share box slices.
+type CollisionShapes struct {
+	byState [][]CollisionBox
+}
+
+type collisionShapesFile struct {
+	// Blocks maps a block name to one shape ID for all its states, or to one
+	// shape ID per state in state order.
+	Blocks map[string]json.RawMessage `json:"blocks"`
+	Shapes map[string][][6]float64    `json:"shapes"`
+}
+
+// LoadCollisionShapes reads blockCollisionShapes.json, using states to find
+// each block's state range.
+func LoadCollisionShapes(path string, states *BlockStateRegistry) (*CollisionShapes, error) {
+	if states == nil {
+		return nil, fmt.Errorf("collision shapes need a block state registry")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read blockCollisionShapes.json: %w", err)
+	}
+	var file collisionShapesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse blockCollisionShapes.json: %w", err)
+	}
+
+	shapes := make(map[string][]CollisionBox, len(file.Shapes))
+	for id, raw := range file.Shapes {
+		boxes := make([]CollisionBox, len(raw))
+		for i, b := range raw {
+			boxes[i] = CollisionBox{MinX: b[0], MinY: b[1], MinZ: b[2], MaxX: b[3], MaxY: b[4], MaxZ: b[5]}
+		}
+		shapes[id] = boxes
+	}
+
+	c := &CollisionShapes{byState: make([][]CollisionBox, len(states.blockByState))}
+	for name, raw := range file.Blocks {
+		index, ok := states.blockByName[name]
+		if !ok {
+			continue
+		}
+		def := states.blocks[index]
+		count := int(def.maxStateID-def.minStateID) + 1
+
+		var ids []int
+		var single int
+		if err := json.Unmarshal(raw, &single); err == nil {
+			ids = make([]int, count)
+			for i := range ids {
+				ids[i] = single
+			}
+		} else if err := json.Unmarshal(raw, &ids); err != nil {
+			return nil, fmt.Errorf("block %s: invalid shape ids: %w", name, err)
+		}
+		if len(ids) != count {
+			return nil, fmt.Errorf("block %s: %d shape ids for %d states", name, len(ids), count)
+		}
+		for i, id := range ids {
+			boxes, ok := shapes[fmt.Sprint(id)]
+			if !ok {
+				return nil, fmt.Errorf("block %s: unknown shape %d", name, id)
+			}
+			c.byState[int(def.minStateID)+i] = boxes
+		}
+	}
+	return c, nil
+}
+
+// Shape returns the boxes of a state; an empty result means no collision.
+func (c *CollisionShapes) Shape(stateID int32) []CollisionBox {
+	if c == nil || stateID < 0 || int(stateID) >= len(c.byState) {
+		return nil
+	}
+	return c.byState[stateID]
+}
+
+func collisionShapesPath(blocksJSONPath string) string {
+	return filepath.Join(filepath.Dir(blocksJSONPath), "blockCollisionShapes.json")
+}
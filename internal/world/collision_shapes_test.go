@@ -0,0 +1,74 @@
+package world
+
+import "testing"
+
+func TestCollisionShapesFromMinecraftData(t *testing.T) {
+	states := loadTestBlockStates(t)
+	shapes, err := LoadCollisionShapes(collisionShapesPath(defaultBlocksJSONPath()), states)
+	if err != nil {
+		t.Fatalf("LoadCollisionShapes failed: %v", err)
+	}
+
+	stoneID, _ := states.DefaultStateID("stone")
+	stone := shapes.Shape(stoneID)
+	if len(stone) != 1 || stone[0] != (CollisionBox{MaxX: 1, MaxY: 1, MaxZ: 1}) {
+		t.Fatalf("stone shape = %+v, want full cube", stone)
+	}
+	if air := shapes.Shape(0); len(air) != 0 {
+		t.Fatalf("air shape = %+v, want empty", air)
+	}
+
+	slab, ok := states.StateID("oak_slab", map[string]string{"type": "bottom"})
+	if !ok {
+		t.Fatal("oak_slab[type=bottom] not found")
+	}
+	if boxes := shapes.Shape(slab); len(boxes) != 1 || boxes[0].MaxY != 0.5 {
+		t.Fatalf("bottom slab shape = %+v, want half-height box", boxes)
+	}
+	top, _ := states.StateID("oak_slab", map[string]string{"type": "top"})
+	if boxes := shapes.Shape(top); len(boxes) != 1 || boxes[0].MinY != 0.5 || boxes[0].MaxY != 1 {
+		t.Fatalf("top slab shape = %+v, want upper half", boxes)
+	}
+
+	fence, _ := states.StateID("oak_fence", map[string]string{"east": "true"})
+	maxY := 0.0
+	for _, box := range shapes.Shape(fence) {
+		maxY = max(maxY, box.MaxY)
+	}
+	if maxY != 1.5 {
+		t.Fatalf("fence max Y = %v, want 1.5", maxY)
+	}
+	if shapes.Shape(-1) != nil || shapes.Shape(1<<30) != nil {
+		t.Fatal("out-of-range states should have no shape")
+	}
+}
+
+func TestBlockStoreCollisionBoxes(t *testing.T) {
+	store, err := NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	slab, _ := store.LookupBlockState("oak_slab", map[string]string{"type": "bottom"})
+	if err := store.StoreChunk(0, 0, makeFilledSections(0)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	store.SetBlockState(1, 64, 1, slab)
+
+	if boxes := store.CollisionBoxes(1, 64, 1); len(boxes) != 1 || boxes[0].MaxY != 0.5 {
+		t.Fatalf("CollisionBoxes(slab) = %+v", boxes)
+	}
+	if boxes := store.CollisionBoxes(2, 64, 1); len(boxes) != 0 {
+		t.Fatalf("CollisionBoxes(air) = %+v", boxes)
+	}
+	if boxes := store.CollisionBoxes(100, 64, 100); boxes != nil {
+		t.Fatalf("unloaded CollisionBoxes = %+v, want nil", boxes)
+	}
+
+	fallback := &BlockStore{chunks: make(map[ChunkPos]*Chunk), solidByStateID: []bool{false, true}}
+	if err := fallback.StoreChunk(0, 0, makeFilledSections(1)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	if boxes := fallback.CollisionBoxes(0, 0, 0); len(boxes) != 1 || boxes[0].MaxY != 1 {
+		t.Fatalf("fallback CollisionBoxes = %+v, want full cube", boxes)
+	}
+}
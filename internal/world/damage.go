@@ -0,0 +1,110 @@
+package world
+
+import (
+	"fmt"
+	"time"
+)
+
+// DamageSource describes the last hit the bot took. Entity IDs are zero when
+// there is none; vanilla never hands out entity ID 0.
+type DamageSource struct {
+	// Seq increases with every recorded hit so consumers can tell a new hit
+	// from one they have already seen.
+	Seq uint64
+	// Type is the damage_type registry name without the minecraft: prefix,
+	// e.g. "mob_attack" or "arrow".
+	Type string
+	// SourceEntityID is the entity responsible, e.g. the skeleton, and
+	// DirectEntityID the one that hit, e.g. its arrow.
+	SourceEntityID int32
+	DirectEntityID int32
+	// The source position is the explicit position sent with the hit, or
+	// else where the responsible entity was at the time.
+	HasSourcePos bool
+	SourceX      float64
+	SourceY      float64
+	SourceZ      float64
+	// HurtYaw comes from the hurt animation: the direction of the hit in
+	// degrees relative to where the bot faced, 90 being straight ahead.
+	HasHurtYaw bool
+	HurtYaw    float32
+	Time       time.Time
+}
+
+// SetDamageTypes applies the server's damage_type registry keys, in network
+// ID order.
+func (ws *WorldState) SetDamageTypes(names []string) {
+	types := make([]string, len(names))
+	for i, name := range names {
+		types[i] = trimMinecraftNamespace(name)
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.damageTypes = types
+}
+
+// DamageTypeName returns the name of a damage type ID, or "damage_type#ID"
+// before the registry is known.
+func (ws *WorldState) DamageTypeName(id int32) string {
+	ws.mu.RLock()
+	defer ws.mu.RUnlock()
+	return ws.damageTypeNameLocked(id)
+}
+
+func (ws *WorldState) damageTypeNameLocked(id int32) string {
+	if id >= 0 && int(id) < len(ws.damageTypes) {
+		return ws.damageTypes[id]
+	}
+	return fmt.Sprintf("damage_type#%d", id)
+}
+
+// RecordDamage stores a hit on the bot. typeID indexes the damage_type
+// registry; pos is the explicit source position, or nil.
+func (ws *WorldState) RecordDamage(typeID, sourceEntityID, directEntityID int32, pos *[3]float64) DamageSource {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.damageSeq++
+	damage := DamageSource{
+		Seq:            ws.damageSeq,
+		Type:           ws.damageTypeNameLocked(typeID),
+		SourceEntityID: max(sourceEntityID, 0),
+		DirectEntityID: max(directEntityID, 0),
+		Time:           time.Now(),
+	}
+	switch {
+	case pos != nil:
+		damage.HasSourcePos = true
+		damage.SourceX, damage.SourceY, damage.SourceZ = pos[0], pos[1], pos[2]
+	case ws.entities[damage.SourceEntityID] != nil:
+		e := ws.entities[damage.SourceEntityID]
+		damage.HasSourcePos = true
+		damage.SourceX, damage.SourceY, damage.SourceZ = e.X, e.Y, e.Z
+	case ws.entities[damage.DirectEntityID] != nil:
+		e := ws.entities[damage.DirectEntityID]
+		damage.HasSourcePos = true
+		damage.SourceX, damage.SourceY, damage.SourceZ = e.X, e.Y, e.Z
+	}
+	ws.lastDamage = &damage
+	return damage
+}
+
+// RecordHurtDirection adds the hurt animation direction to the last hit.
+// The server sends it right after the damage event.
+func (ws *WorldState) RecordHurtDirection(yaw float32) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if ws.lastDamage == nil {
+		return
+	}
+	ws.lastDamage.HasHurtYaw = true
+	ws.lastDamage.HurtYaw = yaw
+}
+
+// copyLastDamage must be called with ws.mu held.
+func (ws *WorldState) copyLastDamage() *DamageSource {
+	if ws.lastDamage == nil {
+		return nil
+	}
+	damage := *ws.lastDamage
+	return &damage
+}
@@ -0,0 +1,35 @@
+package world
+
+import "testing"
+
+func TestWorldStateRecordsDamageSource(t *testing.T) {
+	ws := &WorldState{}
+	if got := ws.DamageTypeName(3); got != "damage_type#3" {
+		t.Fatalf("DamageTypeName before registry = %q", got)
+	}
+	ws.SetDamageTypes([]string{"minecraft:arrow", "minecraft:explosion", "minecraft:mob_attack"})
+	ws.AddEntity(Entity{EntityID: 40, Type: 1, X: 5, Y: 64, Z: 9})
+
+	first := ws.RecordDamage(2, 40, 40, nil)
+	if first.Type != "mob_attack" || first.SourceEntityID != 40 || !first.HasSourcePos || first.SourceX != 5 || first.SourceZ != 9 {
+		t.Fatalf("RecordDamage from entity = %+v", first)
+	}
+	ws.RecordHurtDirection(270)
+
+	// An arrow from a shooter we cannot see falls back to the arrow.
+	ws.AddEntity(Entity{EntityID: 41, Type: 5, X: 1, Y: 65, Z: 2})
+	arrow := ws.RecordDamage(0, 99, 41, nil)
+	if arrow.Seq <= first.Seq || arrow.SourceEntityID != 99 || arrow.DirectEntityID != 41 || arrow.SourceX != 1 || arrow.HasHurtYaw {
+		t.Fatalf("RecordDamage arrow = %+v", arrow)
+	}
+
+	blast := ws.RecordDamage(1, -1, -1, &[3]float64{10, 20, 30})
+	if blast.SourceEntityID != 0 || blast.DirectEntityID != 0 || blast.SourceY != 20 {
+		t.Fatalf("RecordDamage explicit position = %+v", blast)
+	}
+	ws.RecordHurtDirection(90)
+	last := ws.GetState().LastDamage
+	if last == nil || last.Seq != blast.Seq || !last.HasHurtYaw || last.HurtYaw != 90 {
+		t.Fatalf("LastDamage = %+v", last)
+	}
+}
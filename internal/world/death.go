@@ -0,0 +1,51 @@
+package world
+
+import "time"
+
+// DeathLocation is where the bot died. Message and Time are empty when the
+// location came from the login packet of an earlier session.
+type DeathLocation struct {
+	X         float64
+	Y         float64
+	Z         float64
+	Dimension string
+	Message   string
+	Time      time.Time
+}
+
+// RecordDeath stores the current position and dimension as the last death
+// location and returns it.
+func (ws *WorldState) RecordDeath(message string) DeathLocation {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	death := DeathLocation{
+		X:         ws.position.X,
+		Y:         ws.position.Y,
+		Z:         ws.position.Z,
+		Dimension: ws.dimensionName,
+		Message:   message,
+		Time:      time.Now(),
+	}
+	ws.lastDeath = &death
+	return death
+}
+
+// SetLastDeath restores a death location the server remembers, keeping a
+// newer one recorded in this process.
+func (ws *WorldState) SetLastDeath(death DeathLocation) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if ws.lastDeath != nil && !ws.lastDeath.Time.IsZero() {
+		return
+	}
+	ws.lastDeath = &death
+}
+
+// copyLastDeath must be called with ws.mu held.
+func (ws *WorldState) copyLastDeath() *DeathLocation {
+	if ws.lastDeath == nil {
+		return nil
+	}
+	death := *ws.lastDeath
+	return &death
+}
@@ -0,0 +1,29 @@
+package world
+
+import "testing"
+
+func TestWorldStateRecordsDeath(t *testing.T) {
+	ws := &WorldState{}
+	ws.SetLastDeath(DeathLocation{X: 1, Y: 2, Z: 3, Dimension: "minecraft:the_nether"})
+	if death := ws.GetState().LastDeath; death == nil || death.Dimension != "minecraft:the_nether" {
+		t.Fatalf("last death = %+v, want the login location", death)
+	}
+
+	ws.UpdateDimensionContext("minecraft:overworld", 10)
+	ws.UpdatePosition(Position{X: 10.5, Y: 64, Z: -3.5})
+	death := ws.RecordDeath("death.attack.mob(Bot, entity.minecraft.zombie)")
+	if death.X != 10.5 || death.Y != 64 || death.Z != -3.5 || death.Dimension != "minecraft:overworld" || death.Time.IsZero() {
+		t.Fatalf("RecordDeath = %+v", death)
+	}
+
+	// A stale login location must not replace a death seen this session.
+	ws.SetLastDeath(DeathLocation{X: 1, Y: 2, Z: 3, Dimension: "minecraft:the_nether"})
+	snap := ws.GetState()
+	if snap.LastDeath == nil || *snap.LastDeath != death {
+		t.Fatalf("last death = %+v, want %+v", snap.LastDeath, death)
+	}
+	snap.LastDeath.X = 0
+	if ws.GetState().LastDeath.X != 10.5 {
+		t.Fatal("snapshot shares the death location with the world state")
+	}
+}
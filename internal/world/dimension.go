@@ -11,6 +11,8 @@ type DimensionBounds struct {
 	Height int
 }
 
+// VanillaDimensionBounds returns the built-in height of a vanilla dimension.
+// Registries.DimensionBounds prefers the server's dimension_type registry.
 func VanillaDimensionBounds(name string) (DimensionBounds, bool) {
 	switch name {
 	case DimensionOverworld:
@@ -0,0 +1,138 @@
+package world
+
+import (
+	"strings"
+	"unicode"
+)
+
+// effectNames maps status effect registry IDs to display names.
+// Generated from 1.21.11/effects.json (Protocol 774).
+var effectNames = [...]string{
+	0:  "Speed",
+	1:  "Slowness",
+	2:  "Haste",
+	3:  "Mining Fatigue",
+	4:  "Strength",
+	5:  "Instant Health",
+	6:  "Instant Damage",
+	7:  "Jump Boost",
+	8:  "Nausea",
+	9:  "Regeneration",
+	10: "Resistance",
+	11: "Fire Resistance",
+	12: "Water Breathing",
+	13: "Invisibility",
+	14: "Blindness",
+	15: "Night Vision",
+	16: "Hunger",
+	17: "Weakness",
+	18: "Poison",
+	19: "Wither",
+	20: "Health Boost",
+	21: "Absorption",
+	22: "Saturation",
+	23: "Glowing",
+	24: "Levitation",
+	25: "Luck",
+	26: "Bad Luck",
+	27: "Slow Falling",
+	28: "Conduit Power",
+	29: "Dolphin's Grace",
+	30: "Bad Omen",
+	31: "Hero of the Village",
+	32: "Darkness",
+	33: "Trial Omen",
+	34: "Raid Omen",
+	35: "Wind Charged",
+	36: "Weaving",
+	37: "Oozing",
+	38: "Infested",
+	39: "Breath of the Nautilus",
+}
+
+// effectKeys maps status effect registry IDs to registry names without the
+// minecraft: namespace.
+var effectKeys = [...]string{
+	0:  "speed",
+	1:  "slowness",
+	2:  "haste",
+	3:  "mining_fatigue",
+	4:  "strength",
+	5:  "instant_health",
+	6:  "instant_damage",
+	7:  "jump_boost",
+	8:  "nausea",
+	9:  "regeneration",
+	10: "resistance",
+	11: "fire_resistance",
+	12: "water_breathing",
+	13: "invisibility",
+	14: "blindness",
+	15: "night_vision",
+	16: "hunger",
+	17: "weakness",
+	18: "poison",
+	19: "wither",
+	20: "health_boost",
+	21: "absorption",
+	22: "saturation",
+	23: "glowing",
+	24: "levitation",
+	25: "luck",
+	26: "unluck",
+	27: "slow_falling",
+	28: "conduit_power",
+	29: "dolphins_grace",
+	30: "bad_omen",
+	31: "hero_of_the_village",
+	32: "darkness",
+	33: "trial_omen",
+	34: "raid_omen",
+	35: "wind_charged",
+	36: "weaving",
+	37: "oozing",
+	38: "infested",
+	39: "breath_of_the_nautilus",
+}
+
+// EffectName returns the display name for a status effect registry ID.
+func EffectName(effectID int32) string {
+	if d := activeGameData.Load(); d != nil && d.effectNames != nil {
+		return d.EffectName(effectID)
+	}
+	if effectID < 0 || int(effectID) >= len(effectNames) {
+		return ""
+	}
+	return effectNames[effectID]
+}
+
+// EffectKey returns the registry name, such as "jump_boost", for a status
+// effect registry ID.
+func EffectKey(effectID int32) string {
+	if d := activeGameData.Load(); d != nil && d.effectKeys != nil {
+		return d.EffectKey(effectID)
+	}
+	if effectID < 0 || int(effectID) >= len(effectKeys) {
+		return ""
+	}
+	return effectKeys[effectID]
+}
+
+// effectKeyFromData turns a minecraft-data effect name such as "JumpBoost"
+// into its registry name. Bad Luck is the one effect whose names differ.
+func effectKeyFromData(name string) string {
+	if name == "BadLuck" {
+		return "unluck"
+	}
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
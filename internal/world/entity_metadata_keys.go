@@ -0,0 +1,164 @@
+package world
+
+// entityMetadataKeys lists the metadata field names of each entity type, by
+// metadata index. Generated from minecraft-data entities.json for Minecraft
+// 1.21.11 (Protocol 774).
+var entityMetadataKeys = [...][]string{
+	0:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // acacia_boat
+	1:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // acacia_chest_boat
+	2:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "dancing", "can_duplicate"},                                                                                                                                                                                                                                                    // allay
+	3:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "radius", "waiting", "particle"},                                                                                                                                                                                                                                                                                                                                                                                    // area_effect_cloud
+	4:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "armadillo_state"},                                                                                                                                                                                                                                                     // armadillo
+	5:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "client_flags", "head_pose", "body_pose", "left_arm_pose", "right_arm_pose", "left_leg_pose", "right_leg_pose"},                                                                                                                                                                             // armor_stand
+	6:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "flags", "pierce_level", "in_ground", "effect_color"},                                                                                                                                                                                                                                                                                                                                                               // arrow
+	7:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "variant", "playing_dead", "from_bucket"},                                                                                                                                                                                                                              // axolotl
+	8:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // bamboo_chest_raft
+	9:   {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // bamboo_raft
+	10:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "flags"},                                                                                                                                                                                                                                                                       // bat
+	11:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "anger_end_time"},                                                                                                                                                                                                                                             // bee
+	12:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // birch_boat
+	13:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // birch_chest_boat
+	14:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "flags"},                                                                                                                                                                                                                                                                       // blaze
+	15:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "transformation_interpolation_start_delta_ticks", "transformation_interpolation_duration", "pos_rot_interpolation_duration", "translation", "scale", "left_rotation", "right_rotation", "billboard_render_constraints", "brightness_override", "view_range", "shadow_radius", "shadow_strength", "width", "height", "glow_color_override", "block_state"},                                                           // block_display
+	16:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "sheared"},                                                                                                                                                                                                                                                                     // bogged
+	17:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags"},                                                                                                                                                                                                                                                                                // breeze
+	18:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"},                                                                                                                                                                                                                                                                                                                                                                                                                     // breeze_wind_charge
+	19:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "dash", "last_pose_change_tick"},                                                                                                                                                                                                                              // camel
+	20:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "dash", "last_pose_change_tick"},                                                                                                                                                                                                                              // camel_husk
+	21:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "owneruuid", "variant", "is_lying", "relax_state_one", "collar_color"},                                                                                                                                                                                        // cat
+	22:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "flags"},                                                                                                                                                                                                                                                                       // cave_spider
+	23:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // cherry_boat
+	24:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // cherry_chest_boat
+	25:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "custom_display_block", "display_offset"},                                                                                                                                                                                                                                                                                                                                              // chest_minecart
+	26:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "variant"},                                                                                                                                                                                                                                                             // chicken
+	27:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "from_bucket"},                                                                                                                                                                                                                                                                 // cod
+	28:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "weather_state", "copper_golem_state"},                                                                                                                                                                                                                                         // copper_golem
+	29:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "custom_display_block", "display_offset", "command_name", "last_output"},                                                                                                                                                                                                                                                                                                               // command_block_minecart
+	30:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "variant"},                                                                                                                                                                                                                                                             // cow
+	31:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "can_move", "is_active", "is_tearing_down", "home_pos"},                                                                                                                                                                                                                        // creaking
+	32:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "swell_dir", "is_powered", "is_ignited"},                                                                                                                                                                                                                                       // creeper
+	33:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // dark_oak_boat
+	34:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // dark_oak_chest_boat
+	35:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "got_fish", "moistness_level"},                                                                                                                                                                                                                                         // dolphin
+	36:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "chest"},                                                                                                                                                                                                                                                      // donkey
+	37:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"},                                                                                                                                                                                                                                                                                                                                                                                                                     // dragon_fireball
+	38:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "special_type", "drowned_conversion"},                                                                                                                                                                                                                                  // drowned
+	39:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item_stack"},                                                                                                                                                                                                                                                                                                                                                                                                       // egg
+	40:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "moving", "attack_target"},                                                                                                                                                                                                                                                     // elder_guardian
+	41:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "carry_state", "creepy", "stared_at"},                                                                                                                                                                                                                                          // enderman
+	42:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags"},                                                                                                                                                                                                                                                                                // endermite
+	43:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "phase"},                                                                                                                                                                                                                                                                       // ender_dragon
+	44:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item_stack"},                                                                                                                                                                                                                                                                                                                                                                                                       // ender_pearl
+	45:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "beam_target", "show_bottom"},                                                                                                                                                                                                                                                                                                                                                                                       // end_crystal
+	46:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "is_celebrating", "spell_casting"},                                                                                                                                                                                                                                             // evoker
+	47:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"},                                                                                                                                                                                                                                                                                                                                                                                                                     // evoker_fangs
+	48:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item_stack"},                                                                                                                                                                                                                                                                                                                                                                                                       // experience_bottle
+	49:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "value"},                                                                                                                                                                                                                                                                                                                                                                                                            // experience_orb
+	50:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item_stack"},                                                                                                                                                                                                                                                                                                                                                                                                       // eye_of_ender
+	51:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "start_pos"},                                                                                                                                                                                                                                                                                                                                                                                                        // falling_block
+	52:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item_stack"},                                                                                                                                                                                                                                                                                                                                                                                                       // fireball
+	53:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "fireworks_item", "attached_to_target", "shot_at_angle"},                                                                                                                                                                                                                                                                                                                                                            // firework_rocket
+	54:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "type", "flags", "trusted_0", "trusted_1"},                                                                                                                                                                                                                             // fox
+	55:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "variant", "tongue_target"},                                                                                                                                                                                                                                            // frog
+	56:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "custom_display_block", "display_offset", "fuel"},                                                                                                                                                                                                                                                                                                                                      // furnace_minecart
+	57:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "is_charging"},                                                                                                                                                                                                                                                                 // ghast
+	58:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "is_leash_holder", "stays_still"},                                                                                                                                                                                                                                      // happy_ghast
+	59:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags"},                                                                                                                                                                                                                                                                                // giant
+	60:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "direction", "item", "rotation"},                                                                                                                                                                                                                                                                                                                                                                                    // glow_item_frame
+	61:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "dark_ticks_remaining"},                                                                                                                                                                                                                                                // glow_squid
+	62:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "is_screaming_goat", "has_left_horn", "has_right_horn"},                                                                                                                                                                                                                // goat
+	63:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "moving", "attack_target"},                                                                                                                                                                                                                                                     // guardian
+	64:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "immune_to_zombification"},                                                                                                                                                                                                                                             // hoglin
+	65:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "custom_display_block", "display_offset"},                                                                                                                                                                                                                                                                                                                                              // hopper_minecart
+	66:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "type_variant"},                                                                                                                                                                                                                                               // horse
+	67:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "special_type", "drowned_conversion"},                                                                                                                                                                                                                                  // husk
+	68:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "is_celebrating", "spell_casting"},                                                                                                                                                                                                                                             // illusioner
+	69:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "width", "height", "response"},                                                                                                                                                                                                                                                                                                                                                                                      // interaction
+	70:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "flags"},                                                                                                                                                                                                                                                                       // iron_golem
+	71:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item"},                                                                                                                                                                                                                                                                                                                                                                                                             // item
+	72:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "transformation_interpolation_start_delta_ticks", "transformation_interpolation_duration", "pos_rot_interpolation_duration", "translation", "scale", "left_rotation", "right_rotation", "billboard_render_constraints", "brightness_override", "view_range", "shadow_radius", "shadow_strength", "width", "height", "glow_color_override", "item_stack", "item_display"},                                            // item_display
+	73:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "direction", "item", "rotation"},                                                                                                                                                                                                                                                                                                                                                                                    // item_frame
+	74:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // jungle_boat
+	75:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // jungle_chest_boat
+	76:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"},                                                                                                                                                                                                                                                                                                                                                                                                                     // leash_knot
+	77:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"},                                                                                                                                                                                                                                                                                                                                                                                                                     // lightning_bolt
+	78:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "chest", "strength", "variant"},                                                                                                                                                                                                                               // llama
+	79:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"},                                                                                                                                                                                                                                                                                                                                                                                                                     // llama_spit
+	80:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "size"},                                                                                                                                                                                                                                                                        // magma_cube
+	81:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // mangrove_boat
+	82:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // mangrove_chest_boat
+	83:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "player_main_hand", "player_mode_customisation", "profile", "immovable", "description"},                                                                                                                                                                                                     // mannequin
+	84:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"},                                                                                                                                                                                                                                                                                                                                                                                                                     // marker
+	85:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "custom_display_block", "display_offset"},                                                                                                                                                                                                                                                                                                                                              // minecart
+	86:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "type"},                                                                                                                                                                                                                                                                // mooshroom
+	87:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "chest"},                                                                                                                                                                                                                                                      // mule
+	88:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "owneruuid", "dash"},                                                                                                                                                                                                                                          // nautilus
+	89:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // oak_boat
+	90:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // oak_chest_boat
+	91:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "trusting"},                                                                                                                                                                                                                                                            // ocelot
+	92:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item"},                                                                                                                                                                                                                                                                                                                                                                                                             // ominous_item_spawner
+	93:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "direction", "painting_variant"},                                                                                                                                                                                                                                                                                                                                                                                    // painting
+	94:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // pale_oak_boat
+	95:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // pale_oak_chest_boat
+	96:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "unhappy_counter", "sneeze_counter", "eat_counter", "main_gene", "hidden_gene", "flags"},                                                                                                                                                                               // panda
+	97:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags"},                                                                                                                                                                                                                                                                                // parched
+	98:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "owneruuid", "variant"},                                                                                                                                                                                                                                       // parrot
+	99:  {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "size"},                                                                                                                                                                                                                                                                        // phantom
+	100: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "boost_time", "variant"},                                                                                                                                                                                                                                               // pig
+	101: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "immune_to_zombification", "baby", "is_charging_crossbow", "is_dancing"},                                                                                                                                                                                                       // piglin
+	102: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "immune_to_zombification"},                                                                                                                                                                                                                                                     // piglin_brute
+	103: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "is_celebrating", "is_charging_crossbow"},                                                                                                                                                                                                                                      // pillager
+	104: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "standing"},                                                                                                                                                                                                                                                            // polar_bear
+	105: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item_stack"},                                                                                                                                                                                                                                                                                                                                                                                                       // splash_potion
+	106: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item_stack"},                                                                                                                                                                                                                                                                                                                                                                                                       // lingering_potion
+	107: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "from_bucket", "puff_state"},                                                                                                                                                                                                                                                   // pufferfish
+	108: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "type"},                                                                                                                                                                                                                                                                // rabbit
+	109: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "is_celebrating"},                                                                                                                                                                                                                                                              // ravager
+	110: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "from_bucket", "type"},                                                                                                                                                                                                                                                         // salmon
+	111: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "wool"},                                                                                                                                                                                                                                                                // sheep
+	112: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "attach_face", "peek", "color"},                                                                                                                                                                                                                                                // shulker
+	113: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"},                                                                                                                                                                                                                                                                                                                                                                                                                     // shulker_bullet
+	114: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags"},                                                                                                                                                                                                                                                                                // silverfish
+	115: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "stray_conversion"},                                                                                                                                                                                                                                                            // skeleton
+	116: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags"},                                                                                                                                                                                                                                                               // skeleton_horse
+	117: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "size"},                                                                                                                                                                                                                                                                        // slime
+	118: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item_stack"},                                                                                                                                                                                                                                                                                                                                                                                                       // small_fireball
+	119: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "state", "drop_seed_at_tick"},                                                                                                                                                                                                                                          // sniffer
+	120: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "item_stack"},                                                                                                                                                                                                                                                                                                                                                                                                       // snowball
+	121: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "pumpkin"},                                                                                                                                                                                                                                                                     // snow_golem
+	122: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "custom_display_block", "display_offset"},                                                                                                                                                                                                                                                                                                                                              // spawner_minecart
+	123: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "flags", "pierce_level", "in_ground"},                                                                                                                                                                                                                                                                                                                                                                               // spectral_arrow
+	124: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "flags"},                                                                                                                                                                                                                                                                       // spider
+	125: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // spruce_boat
+	126: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "paddle_left", "paddle_right", "bubble_time"},                                                                                                                                                                                                                                                                                                                                          // spruce_chest_boat
+	127: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby"},                                                                                                                                                                                                                                                                        // squid
+	128: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags"},                                                                                                                                                                                                                                                                                // stray
+	129: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "boost_time", "suffocating"},                                                                                                                                                                                                                                           // strider
+	130: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "from_bucket"},                                                                                                                                                                                                                                                                 // tadpole
+	131: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "transformation_interpolation_start_delta_ticks", "transformation_interpolation_duration", "pos_rot_interpolation_duration", "translation", "scale", "left_rotation", "right_rotation", "billboard_render_constraints", "brightness_override", "view_range", "shadow_radius", "shadow_strength", "width", "height", "glow_color_override", "text", "line_width", "background_color", "text_opacity", "style_flags"}, // text_display
+	132: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "fuse", "block_state"},                                                                                                                                                                                                                                                                                                                                                                                              // tnt
+	133: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hurt", "hurtdir", "damage", "custom_display_block", "display_offset"},                                                                                                                                                                                                                                                                                                                                              // tnt_minecart
+	134: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "chest", "strength", "variant"},                                                                                                                                                                                                                               // trader_llama
+	135: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "flags", "pierce_level", "in_ground", "loyalty", "foil"},                                                                                                                                                                                                                                                                                                                                                            // trident
+	136: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "from_bucket", "type_variant"},                                                                                                                                                                                                                                                 // tropical_fish
+	137: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "has_egg", "laying_egg"},                                                                                                                                                                                                                                               // turtle
+	138: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "flags"},                                                                                                                                                                                                                                                                       // vex
+	139: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "unhappy_counter", "villager_data"},                                                                                                                                                                                                                                    // villager
+	140: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "is_celebrating"},                                                                                                                                                                                                                                                              // vindicator
+	141: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "unhappy_counter"},                                                                                                                                                                                                                                                     // wandering_trader
+	142: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "client_anger_level"},                                                                                                                                                                                                                                                          // warden
+	143: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"},                                                                                                                                                                                                                                                                                                                                                                                                                     // wind_charge
+	144: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "is_celebrating", "using_item"},                                                                                                                                                                                                                                                // witch
+	145: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "target_a", "target_b", "target_c", "inv"},                                                                                                                                                                                                                                     // wither
+	146: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags"},                                                                                                                                                                                                                                                                                // wither_skeleton
+	147: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "dangerous"},                                                                                                                                                                                                                                                                                                                                                                                                        // wither_skull
+	148: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "owneruuid", "interested", "collar_color", "anger_end_time", "variant", "sound_variant"},                                                                                                                                                                      // wolf
+	149: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby"},                                                                                                                                                                                                                                                                        // zoglin
+	150: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "special_type", "drowned_conversion"},                                                                                                                                                                                                                                  // zombie
+	151: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags"},                                                                                                                                                                                                                                                               // zombie_horse
+	152: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "flags", "owneruuid", "dash", "variant"},                                                                                                                                                                                                                               // zombie_nautilus
+	153: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "special_type", "drowned_conversion", "converting", "villager_data"},                                                                                                                                                                                                   // zombie_villager
+	154: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "mob_flags", "baby", "special_type", "drowned_conversion"},                                                                                                                                                                                                                                  // zombified_piglin
+	155: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos", "player_main_hand", "player_mode_customisation", "player_absorption", "score", "shoulder_parrot_left", "shoulder_parrot_right"},                                                                                                                                                             // player
+	156: {"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen", "hooked_entity", "biting"},                                                                                                                                                                                                                                                                                                                                                                                          // fishing_bobber
+}
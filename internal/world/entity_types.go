@@ -164,8 +164,33 @@ var entityTypeNames = [...]string{
 
 // EntityTypeName returns the display name for a given entity type ID.
 func EntityTypeName(typeID int32) string {
+	if d := activeGameData.Load(); d != nil {
+		return d.EntityTypeName(typeID)
+	}
 	if typeID >= 0 && int(typeID) < len(entityTypeNames) {
 		return entityTypeNames[typeID]
 	}
 	return ""
 }
+
+// EntityMetadataIndex returns the metadata index of a named field for an
+// entity type. Fields such as "baby" sit at different indexes depending on
+// the entity's class hierarchy.
+func EntityMetadataIndex(typeID int32, key string) (uint8, bool) {
+	if d := activeGameData.Load(); d != nil {
+		return d.EntityMetadataIndex(typeID, key)
+	}
+	if typeID < 0 || int(typeID) >= len(entityMetadataKeys) {
+		return 0, false
+	}
+	return metadataKeyIndex(entityMetadataKeys[typeID], key)
+}
+
+func metadataKeyIndex(keys []string, key string) (uint8, bool) {
+	for i, k := range keys {
+		if k == key {
+			return uint8(i), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,157 @@
+package world
+
+import (
+	"math"
+	"time"
+)
+
+const ticksPerDay = 24000
+
+// Day phases by time of day in ticks: dusk starts at sunset, night when
+// monsters may spawn in the open and dawn shortly before sunrise.
+const (
+	DayPhaseDay   = "day"
+	DayPhaseDusk  = "dusk"
+	DayPhaseNight = "night"
+	DayPhaseDawn  = "dawn"
+)
+
+var moonPhases = [8]string{
+	"full_moon", "waning_gibbous", "last_quarter", "waning_crescent",
+	"new_moon", "waxing_crescent", "first_quarter", "waxing_gibbous",
+}
+
+// TimeOfDay is the time within the current day, 0 being sunrise.
+func (t GameTime) TimeOfDay() int64 {
+	return floorModInt64(t.WorldTime, ticksPerDay)
+}
+
+func (t GameTime) DayPhase() string {
+	switch tod := t.TimeOfDay(); {
+	case tod < 12000:
+		return DayPhaseDay
+	case tod < 13000:
+		return DayPhaseDusk
+	case tod < 23000:
+		return DayPhaseNight
+	default:
+		return DayPhaseDawn
+	}
+}
+
+// MoonPhase follows the vanilla eight-day cycle starting at full moon.
+func (t GameTime) MoonPhase() string {
+	day := t.WorldTime / ticksPerDay
+	if t.WorldTime < 0 && t.WorldTime%ticksPerDay != 0 {
+		day--
+	}
+	return moonPhases[floorModInt64(day, int64(len(moonPhases)))]
+}
+
+func floorModInt64(v, m int64) int64 {
+	return ((v % m) + m) % m
+}
+
+// Weather levels go from 0 to 1 and fade over a few seconds when the weather
+// changes. Raining is the flag from the begin and end raining game events.
+type Weather struct {
+	Raining      bool
+	RainLevel    float32
+	ThunderLevel float32
+}
+
+// Name is "thunder", "rain" or "clear", using the client's thresholds.
+func (w Weather) Name() string {
+	switch {
+	case w.ThunderLevel > 0.9:
+		return "thunder"
+	case w.RainLevel > 0.2:
+		return "rain"
+	default:
+		return "clear"
+	}
+}
+
+// WorldBorder is the square border of the current dimension. Diameter is
+// the side length now and TargetDiameter the one a resize ends at; both are
+// equal for a border that is not moving.
+type WorldBorder struct {
+	Known          bool
+	CenterX        float64
+	CenterZ        float64
+	Diameter       float64
+	TargetDiameter float64
+}
+
+// Distance returns how far x, z is inside the border, negative outside. It
+// uses the target diameter of a shrinking border, which is the safe side.
+func (b WorldBorder) Distance(x, z float64) float64 {
+	half := math.Min(b.Diameter, b.TargetDiameter) / 2
+	return half - math.Max(math.Abs(x-b.CenterX), math.Abs(z-b.CenterZ))
+}
+
+func (ws *WorldState) SetRaining(raining bool) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.weather.Raining = raining
+}
+
+func (ws *WorldState) SetRainLevel(level float32) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.weather.RainLevel = level
+}
+
+func (ws *WorldState) SetThunderLevel(level float32) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.weather.ThunderLevel = level
+}
+
+// UpdateWorldBorder replaces the border. A border whose diameters differ
+// reaches TargetDiameter after durationTicks.
+func (ws *WorldState) UpdateWorldBorder(border WorldBorder, durationTicks int32) {
+	border.Known = true
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.border = border
+	ws.startBorderResizeLocked(durationTicks)
+}
+
+func (ws *WorldState) UpdateWorldBorderCenter(x, z float64) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.border.CenterX, ws.border.CenterZ = x, z
+}
+
+// UpdateWorldBorderSize starts a resize over durationTicks; pass the same
+// diameter twice to resize at once.
+func (ws *WorldState) UpdateWorldBorderSize(diameter, target float64, durationTicks int32) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.border.Known = true
+	ws.border.Diameter, ws.border.TargetDiameter = diameter, target
+	ws.startBorderResizeLocked(durationTicks)
+}
+
+func (ws *WorldState) startBorderResizeLocked(durationTicks int32) {
+	ws.borderResizeStart = time.Now()
+	ws.borderResizeDuration = time.Duration(max(durationTicks, 0)) * 50 * time.Millisecond
+}
+
+// currentBorderLocked moves the diameter along the running resize, settling
+// on the target once the resize is over.
+func (ws *WorldState) currentBorderLocked(now time.Time) WorldBorder {
+	border := ws.border
+	if border.Diameter == border.TargetDiameter {
+		return border
+	}
+	elapsed := now.Sub(ws.borderResizeStart)
+	if elapsed >= ws.borderResizeDuration {
+		border.Diameter = border.TargetDiameter
+		return border
+	}
+	progress := float64(elapsed) / float64(ws.borderResizeDuration)
+	border.Diameter += (border.TargetDiameter - border.Diameter) * progress
+	return border
+}
@@ -0,0 +1,89 @@
+package world
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGameTimeDayAndMoonPhase(t *testing.T) {
+	tests := []struct {
+		worldTime int64
+		phase     string
+		moon      string
+	}{
+		{0, DayPhaseDay, "full_moon"},
+		{11999, DayPhaseDay, "full_moon"},
+		{12500, DayPhaseDusk, "full_moon"},
+		{18000, DayPhaseNight, "full_moon"},
+		{23500, DayPhaseDawn, "full_moon"},
+		{24000 + 6000, DayPhaseDay, "waning_gibbous"},
+		{4*24000 + 14000, DayPhaseNight, "new_moon"},
+		{8*24000 + 1000, DayPhaseDay, "full_moon"},
+		{-1000, DayPhaseDawn, "waxing_gibbous"},
+	}
+	for _, tt := range tests {
+		gt := GameTime{WorldTime: tt.worldTime}
+		if got := gt.DayPhase(); got != tt.phase {
+			t.Errorf("DayPhase(%d) = %q, want %q", tt.worldTime, got, tt.phase)
+		}
+		if got := gt.MoonPhase(); got != tt.moon {
+			t.Errorf("MoonPhase(%d) = %q, want %q", tt.worldTime, got, tt.moon)
+		}
+	}
+}
+
+func TestWeatherName(t *testing.T) {
+	tests := []struct {
+		weather Weather
+		want    string
+	}{
+		{Weather{}, "clear"},
+		{Weather{Raining: true, RainLevel: 0.1}, "clear"},
+		{Weather{Raining: true, RainLevel: 1}, "rain"},
+		{Weather{Raining: true, RainLevel: 1, ThunderLevel: 1}, "thunder"},
+	}
+	for _, tt := range tests {
+		if got := tt.weather.Name(); got != tt.want {
+			t.Errorf("%+v.Name() = %q, want %q", tt.weather, got, tt.want)
+		}
+	}
+}
+
+func TestWorldBorderDistance(t *testing.T) {
+	ws := &WorldState{}
+	ws.UpdateWorldBorder(WorldBorder{CenterX: 0, CenterZ: 0, Diameter: 200, TargetDiameter: 200}, 0)
+	ws.UpdateWorldBorderCenter(100, 0)
+	ws.UpdateWorldBorderSize(200, 100, 600)
+
+	border := ws.GetState().WorldBorder
+	if !border.Known || border.CenterX != 100 {
+		t.Fatalf("border = %+v", border)
+	}
+	// Shrinking to 100 leaves 50 blocks each side of x=100.
+	if d := border.Distance(130, 10); d != 20 {
+		t.Fatalf("Distance inside = %v, want 20", d)
+	}
+	if d := border.Distance(160, 0); d != -10 {
+		t.Fatalf("Distance outside = %v, want -10", d)
+	}
+}
+
+func TestWorldBorderResizeSettlesOnTarget(t *testing.T) {
+	ws := &WorldState{}
+	ws.UpdateWorldBorderSize(400, 200, 200)
+
+	// Halfway through the ten second resize.
+	ws.borderResizeStart = time.Now().Add(-5 * time.Second)
+	if border := ws.GetState().WorldBorder; border.Diameter > 301 || border.Diameter < 299 || border.TargetDiameter != 200 {
+		t.Fatalf("border halfway = %+v, want diameter 300", border)
+	}
+
+	ws.borderResizeStart = time.Now().Add(-11 * time.Second)
+	border := ws.GetState().WorldBorder
+	if border.Diameter != 200 || border.TargetDiameter != 200 {
+		t.Fatalf("border after resize = %+v, want 200", border)
+	}
+	if d := border.Distance(90, 0); d != 10 {
+		t.Fatalf("Distance after resize = %v, want 10", d)
+	}
+}
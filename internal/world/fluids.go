@@ -0,0 +1,106 @@
+package world
+
+import "strconv"
+
+type FluidKind uint8
+
+const (
+	FluidNone FluidKind = iota
+	FluidWater
+	FluidLava
+)
+
+func (k FluidKind) String() string {
+	switch k {
+	case FluidWater:
+		return "water"
+	case FluidLava:
+		return "lava"
+	}
+	return "none"
+}
+
+// Fluid is the fluid occupying a block. Level follows the block's "level"
+// property: 0 is a source, 1-7 flow away from it, 8 and up are falling.
+// Waterlogged blocks and underwater plants are water sources.
+type Fluid struct {
+	Kind  FluidKind
+	Level int
+}
+
+// Amount is the fluid's fill in eighths of a block, as vanilla computes
+// heights from it: sources and falling fluid are full.
+func (f Fluid) Amount() int {
+	if f.Kind == FluidNone {
+		return 0
+	}
+	if f.Level == 0 || f.Level >= 8 {
+		return 8
+	}
+	return 8 - f.Level
+}
+
+func (f Fluid) Falling() bool {
+	return f.Kind != FluidNone && f.Level >= 8
+}
+
+// alwaysWaterlogged blocks are water sources without a waterlogged property.
+var alwaysWaterlogged = map[string]bool{
+	"bubble_column": true,
+	"kelp":          true,
+	"kelp_plant":    true,
+	"seagrass":      true,
+	"tall_seagrass": true,
+}
+
+// fluidTable precomputes the fluid of every state so lookups in the physics
+// tick do not decode properties.
+func fluidTable(r *BlockStateRegistry) []Fluid {
+	if r == nil {
+		return nil
+	}
+	fluids := make([]Fluid, len(r.blockByState))
+	for _, def := range r.blocks {
+		waterlogged := false
+		for _, p := range def.properties {
+			if p.Name == "waterlogged" {
+				waterlogged = true
+			}
+		}
+		for id := def.minStateID; id <= def.maxStateID; id++ {
+			switch {
+			case def.name == "water" || def.name == "lava":
+				state, _ := r.State(id)
+				level, _ := strconv.Atoi(state.Property("level"))
+				kind := FluidWater
+				if def.name == "lava" {
+					kind = FluidLava
+				}
+				fluids[id] = Fluid{Kind: kind, Level: level}
+			case alwaysWaterlogged[def.name]:
+				fluids[id] = Fluid{Kind: FluidWater}
+			case waterlogged:
+				if state, _ := r.State(id); state.Property("waterlogged") == "true" {
+					fluids[id] = Fluid{Kind: FluidWater}
+				}
+			}
+		}
+	}
+	return fluids
+}
+
+// FluidAt returns the fluid in a block; unloaded blocks hold none.
+func (bs *BlockStore) FluidAt(x, y, z int) Fluid {
+	stateID, ok := bs.GetBlockState(x, y, z)
+	if !ok {
+		return Fluid{}
+	}
+	return bs.stateFluid(stateID)
+}
+
+func (bs *BlockStore) stateFluid(stateID int32) Fluid {
+	if stateID < 0 || int(stateID) >= len(bs.fluids) {
+		return Fluid{}
+	}
+	return bs.fluids[stateID]
+}
@@ -0,0 +1,55 @@
+package world
+
+import "testing"
+
+func TestBlockStoreFluidAt(t *testing.T) {
+	store, err := NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	if err := store.StoreChunk(0, 0, makeFilledSections(0)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	set := func(x int, block string, props map[string]string) {
+		t.Helper()
+		id, ok := store.LookupBlockState(block, props)
+		if !ok {
+			t.Fatalf("LookupBlockState(%s, %v) failed", block, props)
+		}
+		store.SetBlockState(x, 64, 0, id)
+	}
+	set(0, "water", map[string]string{"level": "0"})
+	set(1, "water", map[string]string{"level": "3"})
+	set(2, "water", map[string]string{"level": "9"})
+	set(3, "lava", map[string]string{"level": "0"})
+	set(4, "oak_stairs", map[string]string{"waterlogged": "true"})
+	set(5, "oak_stairs", map[string]string{"waterlogged": "false"})
+	set(6, "seagrass", nil)
+	set(7, "stone", nil)
+
+	tests := []struct {
+		x       int
+		want    Fluid
+		amount  int
+		falling bool
+	}{
+		{0, Fluid{Kind: FluidWater}, 8, false},
+		{1, Fluid{Kind: FluidWater, Level: 3}, 5, false},
+		{2, Fluid{Kind: FluidWater, Level: 9}, 8, true},
+		{3, Fluid{Kind: FluidLava}, 8, false},
+		{4, Fluid{Kind: FluidWater}, 8, false},
+		{5, Fluid{}, 0, false},
+		{6, Fluid{Kind: FluidWater}, 8, false},
+		{7, Fluid{}, 0, false},
+	}
+	for _, tt := range tests {
+		got := store.FluidAt(tt.x, 64, 0)
+		if got != tt.want || got.Amount() != tt.amount || got.Falling() != tt.falling {
+			t.Fatalf("FluidAt(%d) = %+v (amount %d, falling %v), want %+v (amount %d, falling %v)",
+				tt.x, got, got.Amount(), got.Falling(), tt.want, tt.amount, tt.falling)
+		}
+	}
+	if got := store.FluidAt(100, 64, 100); got.Kind != FluidNone {
+		t.Fatalf("unloaded FluidAt = %+v, want none", got)
+	}
+}
@@ -0,0 +1,184 @@
+package world
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+)
+
+// GameData holds the registry tables of one Minecraft version, loaded from a
+// minecraft-data version directory. The compiled tables in item_names.go and
+// entity_types.go describe 1.21.11 and are used when no GameData is active.
+type GameData struct {
+	Dir             string
+	itemNames       []string
+	entityTypeNames []string
+	entityMetadata  [][]string
+	// effectNames and effectKeys are nil when the version directory has no
+	// effects.json, and soundNames when it has no sounds.json.
+	effectNames []string
+	effectKeys  []string
+	soundNames  []string
+}
+
+type registryEntry struct {
+	ID           int32    `json:"id"`
+	Name         string   `json:"name"`
+	DisplayName  string   `json:"displayName"`
+	MetadataKeys []string `json:"metadataKeys"`
+}
+
+// LoadGameData reads items.json and entities.json from dir. blocks.json is
+// read later by NewBlockStore, since each bot keeps its own store.
+func LoadGameData(dir string) (*GameData, error) {
+	items, _, err := loadRegistryNames(filepath.Join(dir, "items.json"))
+	if err != nil {
+		return nil, err
+	}
+	entities, metadata, err := loadRegistryNames(filepath.Join(dir, "entities.json"))
+	if err != nil {
+		return nil, err
+	}
+	data := &GameData{Dir: dir, itemNames: items, entityTypeNames: entities, entityMetadata: metadata}
+	effectsPath := filepath.Join(dir, "effects.json")
+	if _, err := os.Stat(effectsPath); err == nil {
+		if data.effectNames, _, err = loadRegistryNames(effectsPath); err != nil {
+			return nil, err
+		}
+		if data.effectKeys, err = loadEffectKeys(effectsPath); err != nil {
+			return nil, err
+		}
+	}
+	soundsPath := filepath.Join(dir, "sounds.json")
+	if _, err := os.Stat(soundsPath); err == nil {
+		if data.soundNames, _, err = loadRegistryNames(soundsPath); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// loadRegistryNames returns the display names of a registry file, indexed by
+// ID, along with each entry's metadataKeys (only present in entities.json).
+func loadRegistryNames(path string) ([]string, [][]string, error) {
+	entries, maxID, err := readRegistryEntries(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make([]string, maxID+1)
+	metadataKeys := make([][]string, maxID+1)
+	for _, e := range entries {
+		name := e.DisplayName
+		if name == "" {
+			name = e.Name
+		}
+		names[e.ID] = name
+		metadataKeys[e.ID] = e.MetadataKeys
+	}
+	return names, metadataKeys, nil
+}
+
+// loadEffectKeys returns the registry names of effects.json, indexed by ID.
+func loadEffectKeys(path string) ([]string, error) {
+	entries, maxID, err := readRegistryEntries(path)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, maxID+1)
+	for _, e := range entries {
+		keys[e.ID] = effectKeyFromData(e.Name)
+	}
+	return keys, nil
+}
+
+func readRegistryEntries(path string) ([]registryEntry, int32, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("read %s: %w", filepath.Base(path), err)
+	}
+	var entries []registryEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, 0, fmt.Errorf("parse %s: %w", filepath.Base(path), err)
+	}
+	maxID := int32(-1)
+	for _, e := range entries {
+		if e.ID < 0 {
+			return nil, 0, fmt.Errorf("%s: negative id %d", filepath.Base(path), e.ID)
+		}
+		maxID = max(maxID, e.ID)
+	}
+	return entries, maxID, nil
+}
+
+// ItemName returns the display name for an item registry ID in this version.
+func (d *GameData) ItemName(itemID int32) string {
+	if itemID < 0 || int(itemID) >= len(d.itemNames) {
+		return ""
+	}
+	return d.itemNames[itemID]
+}
+
+// EntityTypeName returns the display name for an entity type ID in this version.
+func (d *GameData) EntityTypeName(typeID int32) string {
+	if typeID < 0 || int(typeID) >= len(d.entityTypeNames) {
+		return ""
+	}
+	return d.entityTypeNames[typeID]
+}
+
+// EffectName returns the display name for a status effect ID in this version.
+func (d *GameData) EffectName(effectID int32) string {
+	if effectID < 0 || int(effectID) >= len(d.effectNames) {
+		return ""
+	}
+	return d.effectNames[effectID]
+}
+
+// EffectKey returns the registry name for a status effect ID in this version.
+func (d *GameData) EffectKey(effectID int32) string {
+	if effectID < 0 || int(effectID) >= len(d.effectKeys) {
+		return ""
+	}
+	return d.effectKeys[effectID]
+}
+
+// SoundName returns the name of a sound event ID in this version.
+func (d *GameData) SoundName(soundID int32) string {
+	if soundID < 0 || int(soundID) >= len(d.soundNames) {
+		return ""
+	}
+	return d.soundNames[soundID]
+}
+
+// EntityMetadataIndex returns the metadata index of a named field, such as
+// "baby" or "health", for an entity type in this version.
+func (d *GameData) EntityMetadataIndex(typeID int32, key string) (uint8, bool) {
+	if typeID < 0 || int(typeID) >= len(d.entityMetadata) {
+		return 0, false
+	}
+	return metadataKeyIndex(d.entityMetadata[typeID], key)
+}
+
+func (d *GameData) BlocksJSONPath() string {
+	return filepath.Join(d.Dir, "blocks.json")
+}
+
+// NewBlockStore creates an empty store using this version's block states.
+func (d *GameData) NewBlockStore() (*BlockStore, error) {
+	return NewBlockStoreFromBlocksJSON(d.BlocksJSONPath())
+}
+
+var activeGameData atomic.Pointer[GameData]
+
+// UseGameData makes ItemName and EntityTypeName resolve through d. Passing nil
+// restores the compiled 1.21.11 tables.
+func UseGameData(d *GameData) {
+	activeGameData.Store(d)
+}
+
+// ActiveGameData returns the GameData set by UseGameData, or nil.
+func ActiveGameData() *GameData {
+	return activeGameData.Load()
+}
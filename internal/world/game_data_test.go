@@ -0,0 +1,110 @@
+package world
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeGameDataDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"items.json":    `[{"id":0,"name":"air","displayName":"Air"},{"id":2,"name":"future_item","displayName":"Future Item"}]`,
+		"entities.json": `[{"id":0,"name":"allay","displayName":"Allay"},{"id":1,"name":"future_mob","metadataKeys":["shared_flags","baby"]}]`,
+		"blocks.json":   `[{"minStateId":0,"maxStateId":0,"boundingBox":"empty","displayName":"Air"},{"minStateId":1,"maxStateId":1,"boundingBox":"block","displayName":"Future Block"}]`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s failed: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func TestLoadGameData(t *testing.T) {
+	data, err := LoadGameData(writeGameDataDir(t))
+	if err != nil {
+		t.Fatalf("LoadGameData failed: %v", err)
+	}
+	if got := data.ItemName(2); got != "Future Item" {
+		t.Fatalf("ItemName(2) = %q, want Future Item", got)
+	}
+	if got := data.ItemName(1); got != "" {
+		t.Fatalf("ItemName(1) = %q, want empty for a gap", got)
+	}
+	if got := data.EntityTypeName(1); got != "future_mob" {
+		t.Fatalf("EntityTypeName(1) = %q, want name fallback future_mob", got)
+	}
+
+	store, err := data.NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	if name, ok := store.GetBlockNameByStateID(1); !ok || name != "Future Block" {
+		t.Fatalf("GetBlockNameByStateID(1) = %q, %v, want Future Block", name, ok)
+	}
+}
+
+func TestUseGameDataOverridesCompiledTables(t *testing.T) {
+	data, err := LoadGameData(writeGameDataDir(t))
+	if err != nil {
+		t.Fatalf("LoadGameData failed: %v", err)
+	}
+	UseGameData(data)
+	t.Cleanup(func() { UseGameData(nil) })
+
+	if got := ItemName(2); got != "Future Item" {
+		t.Fatalf("ItemName(2) = %q, want Future Item", got)
+	}
+	if got := EntityTypeName(0); got != "Allay" {
+		t.Fatalf("EntityTypeName(0) = %q, want Allay", got)
+	}
+	if index, ok := EntityMetadataIndex(1, "baby"); !ok || index != 1 {
+		t.Fatalf("EntityMetadataIndex(1, baby) = %d, %v, want 1", index, ok)
+	}
+	// This version has no sounds.json, so 1.21.11's sound IDs must not leak in.
+	if got := SoundName(451); got != "" {
+		t.Fatalf("SoundName(451) = %q, want empty without the version's sounds.json", got)
+	}
+	store, err := NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	if name, ok := store.GetBlockNameByStateID(1); !ok || name != "Future Block" {
+		t.Fatalf("NewBlockStore state 1 = %q, %v, want the active version's Future Block", name, ok)
+	}
+
+	UseGameData(nil)
+	if got := ItemName(1031); got != "Egg" {
+		t.Fatalf("ItemName(1031) = %q after reset, want Egg", got)
+	}
+	// Zombies keep "baby" at 16, piglins at 17.
+	if index, ok := EntityMetadataIndex(150, "baby"); !ok || index != 16 {
+		t.Fatalf("EntityMetadataIndex(zombie, baby) = %d, %v, want 16", index, ok)
+	}
+	if _, ok := EntityMetadataIndex(32, "baby"); ok {
+		t.Fatal("creepers have no baby flag")
+	}
+}
+
+func TestLoadGameDataMissingFile(t *testing.T) {
+	if _, err := LoadGameData(t.TempDir()); err == nil {
+		t.Fatal("LoadGameData should fail without items.json")
+	}
+}
+
+func TestEffectKeysMatchBundledGameData(t *testing.T) {
+	data, err := LoadGameData(filepath.Join("..", "..", "1.21.11"))
+	if err != nil {
+		t.Fatalf("LoadGameData failed: %v", err)
+	}
+	for id, want := range effectKeys {
+		if got := data.EffectKey(int32(id)); got != want {
+			t.Fatalf("EffectKey(%d) = %q, want %q", id, got, want)
+		}
+	}
+	if got := EffectKey(7); got != "jump_boost" {
+		t.Fatalf("EffectKey(7) = %q, want jump_boost", got)
+	}
+}
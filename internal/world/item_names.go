@@ -1512,6 +1512,9 @@ var itemNames = [1505]string{
 
 // ItemName returns the display name for the given item registry ID.
 func ItemName(itemID int32) string {
+	if d := activeGameData.Load(); d != nil {
+		return d.ItemName(itemID)
+	}
 	if itemID < 0 || int(itemID) >= len(itemNames) {
 		return ""
 	}
@@ -0,0 +1,130 @@
+package world
+
+const (
+	// LightSectionCount covers the overworld plus one section below and
+	// above it, as the light packets do. Other heights have their section
+	// count plus two.
+	LightSectionCount = ChunkSectionCount + 2
+	LightSectionBytes = 2048
+	MaxLightLevel     = 15
+)
+
+type LightLevel struct {
+	Sky   uint8
+	Block uint8
+}
+
+// Max is the brighter of sky and block light, ignoring time of day.
+func (l LightLevel) Max() uint8 {
+	return max(l.Sky, l.Block)
+}
+
+// LightSection is one section's light from a chunk or light update packet.
+// Index 0 is the section below the world. Nil Data means all dark.
+type LightSection struct {
+	Index int
+	Data  []byte
+}
+
+// LightUpdate replaces the listed sections of a chunk; other sections keep
+// their light.
+type LightUpdate struct {
+	Sky   []LightSection
+	Block []LightSection
+}
+
+// UpdateLight applies a light update to a loaded chunk. Sections outside
+// the world's light range or with malformed data are skipped. It returns
+// false when the chunk is not loaded.
+func (bs *BlockStore) UpdateLight(chunkX, chunkZ int32, update LightUpdate) bool {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	chunk, ok := bs.chunks[ChunkPos{X: chunkX, Z: chunkZ}]
+	if !ok {
+		return false
+	}
+	if chunk.SkyLight == nil {
+		chunk.SkyLight = make([][]byte, len(chunk.Sections)+2)
+	}
+	if chunk.BlockLight == nil {
+		chunk.BlockLight = make([][]byte, len(chunk.Sections)+2)
+	}
+	applyLight(chunk.SkyLight, update.Sky)
+	applyLight(chunk.BlockLight, update.Block)
+	return true
+}
+
+func applyLight(dst [][]byte, sections []LightSection) {
+	for _, section := range sections {
+		if section.Index < 0 || section.Index >= len(dst) {
+			continue
+		}
+		if section.Data != nil && len(section.Data) != LightSectionBytes {
+			continue
+		}
+		if section.Data == nil {
+			dst[section.Index] = []byte{}
+			continue
+		}
+		dst[section.Index] = append([]byte(nil), section.Data...)
+	}
+}
+
+// GetLight returns the sky and block light at a position. Sky sections the
+// server never sent count as open sky above the highest known one and dark
+// below it; unknown block light is dark. ok is false for unloaded chunks.
+func (bs *BlockStore) GetLight(x, y, z int) (LightLevel, bool) {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+	minY, sectionCount := bs.heightLocked()
+	index := floorDiv16(y-minY) + 1
+	localY := floorMod16(y - minY)
+	nibble := localY*16*16 + floorMod16(z)*16 + floorMod16(x)
+
+	chunk, ok := bs.chunks[ChunkPos{X: int32(floorDiv16(x)), Z: int32(floorDiv16(z))}]
+	if !ok {
+		return LightLevel{}, false
+	}
+	if index >= sectionCount+2 {
+		return LightLevel{Sky: MaxLightLevel}, true
+	}
+	if index < 0 {
+		return LightLevel{}, true
+	}
+	return LightLevel{
+		Sky:   skyLightAt(chunk.SkyLight, index, nibble),
+		Block: lightAt(chunk.BlockLight, index, nibble),
+	}, true
+}
+
+func lightAt(sections [][]byte, index, nibble int) uint8 {
+	if index >= len(sections) || len(sections[index]) == 0 {
+		return 0
+	}
+	b := sections[index][nibble>>1]
+	if nibble&1 == 0 {
+		return b & 0x0f
+	}
+	return b >> 4
+}
+
+func skyLightAt(sections [][]byte, index, nibble int) uint8 {
+	if index < len(sections) && sections[index] != nil {
+		return lightAt(sections, index, nibble)
+	}
+	known := false
+	for i := index + 1; i < len(sections); i++ {
+		if sections[i] != nil {
+			known = true
+			break
+		}
+	}
+	hasAny := known
+	for i := 0; i < index && !hasAny && i < len(sections); i++ {
+		hasAny = sections[i] != nil
+	}
+	if hasAny && !known {
+		return MaxLightLevel
+	}
+	return 0
+}
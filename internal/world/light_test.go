@@ -0,0 +1,65 @@
+package world
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBlockStoreLight(t *testing.T) {
+	bs := &BlockStore{chunks: make(map[ChunkPos]*Chunk), solidByStateID: []bool{false, true}}
+	if err := bs.StoreChunk(0, 0, makeFilledSections(0)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+
+	if _, ok := bs.GetLight(100, 64, 100); ok {
+		t.Fatal("unloaded chunk should report no light")
+	}
+	if got, _ := bs.GetLight(1, 64, 1); got != (LightLevel{}) {
+		t.Fatalf("light before any update = %+v, want dark", got)
+	}
+
+	// Section index for y=64 is (64+64)/16+1 = 9. Set block light 12 at
+	// local (1, 0, 2) and leave the rest of the section at 3.
+	block := bytes.Repeat([]byte{0x33}, LightSectionBytes)
+	nibble := 0*256 + 2*16 + 1
+	block[nibble>>1] = block[nibble>>1]&0x0f | 12<<4
+	sky := bytes.Repeat([]byte{0xff}, LightSectionBytes)
+	ok := bs.UpdateLight(0, 0, LightUpdate{
+		Sky:   []LightSection{{Index: 9, Data: sky}, {Index: 5}},
+		Block: []LightSection{{Index: 9, Data: block}},
+	})
+	if !ok {
+		t.Fatal("UpdateLight on a loaded chunk should succeed")
+	}
+
+	if got, _ := bs.GetLight(1, 64, 2); got != (LightLevel{Sky: 15, Block: 12}) {
+		t.Fatalf("GetLight(1,64,2) = %+v, want sky 15 block 12", got)
+	}
+	if got, _ := bs.GetLight(0, 64, 2); got != (LightLevel{Sky: 15, Block: 3}) {
+		t.Fatalf("GetLight(0,64,2) = %+v, want sky 15 block 3", got)
+	}
+	if got, _ := bs.GetLight(0, 200, 0); got.Sky != 15 {
+		t.Fatalf("sky above the highest known section = %d, want open sky", got.Sky)
+	}
+	if got, _ := bs.GetLight(0, 0, 0); got.Sky != 0 {
+		t.Fatalf("empty sky section (index 5) = %d, want dark", got.Sky)
+	}
+	if got, _ := bs.GetLight(0, -20, 0); got.Sky != 0 {
+		t.Fatalf("unknown sky below known sections = %d, want dark", got.Sky)
+	}
+
+	if bs.UpdateLight(5, 5, LightUpdate{Block: []LightSection{{Index: 9, Data: block}}}) {
+		t.Fatal("UpdateLight on an unloaded chunk should fail")
+	}
+	bs.UpdateLight(0, 0, LightUpdate{Block: []LightSection{{Index: 9}, {Index: 99}, {Index: 3, Data: []byte{1}}}})
+	if got, _ := bs.GetLight(1, 64, 2); got.Block != 0 {
+		t.Fatalf("block light after clearing = %d, want 0", got.Block)
+	}
+
+	if err := bs.StoreChunk(0, 0, makeFilledSections(0)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	if got, _ := bs.GetLight(1, 64, 2); got != (LightLevel{}) {
+		t.Fatalf("reloaded chunk light = %+v, want reset", got)
+	}
+}
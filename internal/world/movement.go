@@ -0,0 +1,79 @@
+package world
+
+// BlockMovement is how a block changes movement on or inside it. Values are
+// vanilla's per-block friction, speed and jump factors; minecraft-data does
+// not carry them.
+type BlockMovement struct {
+	// Friction is the block's slipperiness: 0.6 for most blocks, 0.98 for ice.
+	Friction      float64
+	SpeedFactor   float64
+	JumpFactor    float64
+	Climbable     bool
+	SneakDescends bool
+	Bouncy        bool
+	// Stuck scales movement inside cobwebs and similar blocks; zero when the
+	// block does not trap.
+	Stuck [3]float64
+}
+
+var DefaultBlockMovement = BlockMovement{Friction: 0.6, SpeedFactor: 1, JumpFactor: 1}
+
+var blockMovementOverrides = map[string]func(*BlockMovement){
+	"ice":                  func(m *BlockMovement) { m.Friction = 0.98 },
+	"packed_ice":           func(m *BlockMovement) { m.Friction = 0.98 },
+	"frosted_ice":          func(m *BlockMovement) { m.Friction = 0.98 },
+	"blue_ice":             func(m *BlockMovement) { m.Friction = 0.989 },
+	"slime_block":          func(m *BlockMovement) { m.Friction = 0.8; m.Bouncy = true },
+	"soul_sand":            func(m *BlockMovement) { m.SpeedFactor = 0.4 },
+	"honey_block":          func(m *BlockMovement) { m.SpeedFactor = 0.4; m.JumpFactor = 0.5 },
+	"ladder":               climbable,
+	"vine":                 climbable,
+	"cave_vines":           climbable,
+	"weeping_vines":        climbable,
+	"twisting_vines":       climbable,
+	"cave_vines_plant":     climbable,
+	"weeping_vines_plant":  climbable,
+	"twisting_vines_plant": climbable,
+	"scaffolding":          func(m *BlockMovement) { m.Climbable = true; m.SneakDescends = true },
+	"cobweb":               func(m *BlockMovement) { m.Stuck = [3]float64{0.25, 0.05, 0.25} },
+	"sweet_berry_bush":     func(m *BlockMovement) { m.Stuck = [3]float64{0.8, 0.75, 0.8} },
+	"powder_snow":          func(m *BlockMovement) { m.Stuck = [3]float64{0.9, 1.5, 0.9} },
+}
+
+func climbable(m *BlockMovement) { m.Climbable = true }
+
+// movementTable holds one entry per block in registry order.
+func movementTable(r *BlockStateRegistry) []BlockMovement {
+	if r == nil {
+		return nil
+	}
+	table := make([]BlockMovement, len(r.blocks))
+	for i, def := range r.blocks {
+		table[i] = DefaultBlockMovement
+		if override, ok := blockMovementOverrides[def.name]; ok {
+			override(&table[i])
+		}
+	}
+	return table
+}
+
+// BlockMovement returns the movement modifiers of the block at a position.
+// Unloaded blocks and stores without block data use DefaultBlockMovement.
+func (bs *BlockStore) BlockMovement(x, y, z int) BlockMovement {
+	stateID, ok := bs.GetBlockState(x, y, z)
+	if !ok {
+		return DefaultBlockMovement
+	}
+	return bs.stateMovement(stateID)
+}
+
+func (bs *BlockStore) stateMovement(stateID int32) BlockMovement {
+	if bs.states == nil || stateID < 0 || int(stateID) >= len(bs.states.blockByState) {
+		return DefaultBlockMovement
+	}
+	index := bs.states.blockByState[stateID]
+	if index < 0 || int(index) >= len(bs.movement) {
+		return DefaultBlockMovement
+	}
+	return bs.movement[index]
+}
@@ -0,0 +1,58 @@
+package world
+
+import "testing"
+
+func TestBlockStoreBlockMovement(t *testing.T) {
+	store, err := NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	if err := store.StoreChunk(0, 0, makeFilledSections(0)); err != nil {
+		t.Fatalf("StoreChunk failed: %v", err)
+	}
+	blocks := []string{"stone", "blue_ice", "soul_sand", "honey_block", "ladder", "scaffolding", "cobweb", "slime_block"}
+	for x, block := range blocks {
+		id, ok := store.LookupBlockState(block, nil)
+		if !ok {
+			t.Fatalf("LookupBlockState(%s) failed", block)
+		}
+		store.SetBlockState(x, 64, 0, id)
+	}
+
+	if got := store.BlockMovement(0, 64, 0); got != DefaultBlockMovement {
+		t.Fatalf("stone movement = %+v, want default", got)
+	}
+	if got := store.BlockMovement(1, 64, 0); got.Friction != 0.989 {
+		t.Fatalf("blue ice friction = %v", got.Friction)
+	}
+	if got := store.BlockMovement(2, 64, 0); got.SpeedFactor != 0.4 || got.JumpFactor != 1 {
+		t.Fatalf("soul sand movement = %+v", got)
+	}
+	if got := store.BlockMovement(3, 64, 0); got.SpeedFactor != 0.4 || got.JumpFactor != 0.5 {
+		t.Fatalf("honey movement = %+v", got)
+	}
+	if got := store.BlockMovement(4, 64, 0); !got.Climbable || got.SneakDescends {
+		t.Fatalf("ladder movement = %+v", got)
+	}
+	if got := store.BlockMovement(5, 64, 0); !got.Climbable || !got.SneakDescends {
+		t.Fatalf("scaffolding movement = %+v", got)
+	}
+	if got := store.BlockMovement(6, 64, 0); got.Stuck != [3]float64{0.25, 0.05, 0.25} {
+		t.Fatalf("cobweb movement = %+v", got)
+	}
+	if got := store.BlockMovement(7, 64, 0); !got.Bouncy || got.Friction != 0.8 {
+		t.Fatalf("slime movement = %+v", got)
+	}
+	if got := store.BlockMovement(100, 64, 100); got != DefaultBlockMovement {
+		t.Fatalf("unloaded movement = %+v, want default", got)
+	}
+}
+
+func TestBlockMovementOverridesNameRealBlocks(t *testing.T) {
+	states := loadTestBlockStates(t)
+	for name := range blockMovementOverrides {
+		if _, ok := states.DefaultStateID(name); !ok {
+			t.Errorf("movement override for unknown block %q", name)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package world
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+type Experience struct {
+	Level int32
+	// Progress is how far the bar is towards the next level, 0 to 1.
+	Progress float32
+	Total    int32
+}
+
+type Abilities struct {
+	Invulnerable bool
+	Flying       bool
+	AllowFlying  bool
+	CreativeMode bool
+	FlySpeed     float32
+	WalkSpeed    float32
+}
+
+// ActiveEffect is a status effect on the bot. Name is the display name and
+// Key the registry name. Expires is zero for infinite effects.
+type ActiveEffect struct {
+	ID        int32
+	Name      string
+	Key       string
+	Amplifier int32
+	Expires   time.Time
+}
+
+// Level is the effect level as shown in game, amplifier 0 being level 1.
+func (e ActiveEffect) Level() int32 {
+	return e.Amplifier + 1
+}
+
+// Remaining returns the time left; ok is false for infinite effects.
+func (e ActiveEffect) Remaining(now time.Time) (time.Duration, bool) {
+	if e.Expires.IsZero() {
+		return 0, false
+	}
+	return max(e.Expires.Sub(now), 0), true
+}
+
+// EffectLevel returns the level of an active effect by registry name, such
+// as "jump_boost", or 0.
+func (s Snapshot) EffectLevel(key string) int32 {
+	key = strings.TrimPrefix(key, "minecraft:")
+	for _, e := range s.Effects {
+		if e.Key == key {
+			return e.Level()
+		}
+	}
+	return 0
+}
+
+func (ws *WorldState) UpdateGameMode(gameMode string) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.gameMode = gameMode
+}
+
+func (ws *WorldState) UpdateExperience(exp Experience) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.experience = exp
+}
+
+func (ws *WorldState) UpdateAbilities(abilities Abilities) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.abilities = abilities
+}
+
+// AddEffect adds or replaces an effect. durationTicks is -1 for infinite
+// effects.
+func (ws *WorldState) AddEffect(effectID, amplifier, durationTicks int32) {
+	effect := ActiveEffect{ID: effectID, Name: EffectName(effectID), Key: EffectKey(effectID), Amplifier: amplifier}
+	if durationTicks >= 0 {
+		effect.Expires = time.Now().Add(time.Duration(durationTicks) * 50 * time.Millisecond)
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if ws.effects == nil {
+		ws.effects = make(map[int32]ActiveEffect)
+	}
+	ws.effects[effectID] = effect
+}
+
+func (ws *WorldState) RemoveEffect(effectID int32) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	delete(ws.effects, effectID)
+}
+
+func (ws *WorldState) ClearEffects() {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.effects = nil
+}
+
+// sortedEffects must be called with ws.mu held.
+func (ws *WorldState) sortedEffects() []ActiveEffect {
+	if len(ws.effects) == 0 {
+		return nil
+	}
+	effects := make([]ActiveEffect, 0, len(ws.effects))
+	for _, e := range ws.effects {
+		effects = append(effects, e)
+	}
+	sort.Slice(effects, func(i, j int) bool { return effects[i].ID < effects[j].ID })
+	return effects
+}
@@ -0,0 +1,41 @@
+package world
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorldStateTracksPlayerStatus(t *testing.T) {
+	ws := &WorldState{}
+	ws.UpdateGameMode("creative")
+	ws.UpdateExperience(Experience{Level: 5, Progress: 0.25, Total: 80})
+	ws.UpdateAbilities(Abilities{Flying: true, AllowFlying: true, FlySpeed: 0.05})
+	ws.AddEffect(7, 1, 600) // Jump Boost II, 30s
+	ws.AddEffect(0, 0, -1)  // Speed I, infinite
+
+	snap := ws.GetState()
+	if snap.GameMode != "creative" || snap.Experience.Level != 5 || !snap.Abilities.Flying {
+		t.Fatalf("status not tracked: mode=%q exp=%+v abilities=%+v", snap.GameMode, snap.Experience, snap.Abilities)
+	}
+	if len(snap.Effects) != 2 || snap.Effects[0].Name != "Speed" || snap.Effects[1].Name != "Jump Boost" {
+		t.Fatalf("effects = %+v, want Speed then Jump Boost", snap.Effects)
+	}
+	if snap.EffectLevel("jump_boost") != 2 || snap.EffectLevel("minecraft:speed") != 1 || snap.EffectLevel("slowness") != 0 {
+		t.Fatalf("EffectLevel jump=%d speed=%d slowness=%d, want 2, 1 and 0", snap.EffectLevel("jump_boost"), snap.EffectLevel("minecraft:speed"), snap.EffectLevel("slowness"))
+	}
+	if left, ok := snap.Effects[1].Remaining(time.Now()); !ok || left <= 29*time.Second || left > 30*time.Second {
+		t.Fatalf("jump boost remaining = %v, %v, want about 30s", left, ok)
+	}
+	if _, ok := snap.Effects[0].Remaining(time.Now()); ok {
+		t.Fatal("infinite effect should report no remaining time")
+	}
+
+	ws.RemoveEffect(0)
+	if effects := ws.GetState().Effects; len(effects) != 1 || effects[0].ID != 7 {
+		t.Fatalf("effects after remove = %+v", effects)
+	}
+	ws.ClearEffects()
+	if effects := ws.GetState().Effects; len(effects) != 0 {
+		t.Fatalf("effects after clear = %+v", effects)
+	}
+}
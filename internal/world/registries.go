@@ -0,0 +1,179 @@
+package world
+
+import (
+	"slices"
+	"sync"
+)
+
+// Registry names used with Registries, without the minecraft: prefix.
+const (
+	RegistryBlock         = "block"
+	RegistryItem          = "item"
+	RegistryEntityType    = "entity_type"
+	RegistryDimensionType = "dimension_type"
+	RegistryChatType      = "chat_type"
+)
+
+// Registries holds the registries and tags the server synchronizes during
+// the configuration phase. Registry and tag names may be given with or
+// without the minecraft: prefix.
+type Registries struct {
+	mu   sync.RWMutex
+	keys map[string][]string
+	// dimensionTypes is keyed by dimension_type entry name.
+	dimensionTypes map[string]DimensionBounds
+	// tags maps registry to tag to the sorted network IDs in the tag.
+	tags map[string]map[string][]int32
+}
+
+func NewRegistries() *Registries {
+	return &Registries{
+		keys:           make(map[string][]string),
+		dimensionTypes: make(map[string]DimensionBounds),
+		tags:           make(map[string]map[string][]int32),
+	}
+}
+
+// SetRegistry stores the entry keys of a registry in network ID order.
+func (r *Registries) SetRegistry(registry string, keys []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys[trimMinecraftNamespace(registry)] = append([]string(nil), keys...)
+}
+
+// Keys returns the entry keys of a registry in network ID order, or nil if
+// the server has not sent it.
+func (r *Registries) Keys(registry string) []string {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]string(nil), r.keys[trimMinecraftNamespace(registry)]...)
+}
+
+// EntryName returns the key of the registry entry with a network ID.
+func (r *Registries) EntryName(registry string, id int32) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	keys := r.keys[trimMinecraftNamespace(registry)]
+	if id < 0 || int(id) >= len(keys) {
+		return "", false
+	}
+	return keys[id], true
+}
+
+// SetDimensionType records the height of a dimension_type entry.
+func (r *Registries) SetDimensionType(name string, bounds DimensionBounds) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dimensionTypes[trimMinecraftNamespace(name)] = bounds
+}
+
+// DimensionBounds returns the height of the dimension type with network ID
+// typeID. Without a synchronized dimension_type entry it falls back to the
+// vanilla bounds of the dimension called dimension.
+func (r *Registries) DimensionBounds(typeID int32, dimension string) (DimensionBounds, bool) {
+	if r != nil {
+		r.mu.RLock()
+		keys := r.keys[RegistryDimensionType]
+		var bounds DimensionBounds
+		ok := false
+		if typeID >= 0 && int(typeID) < len(keys) {
+			bounds, ok = r.dimensionTypes[trimMinecraftNamespace(keys[typeID])]
+		}
+		r.mu.RUnlock()
+		if ok {
+			return bounds, true
+		}
+	}
+	return VanillaDimensionBounds(dimension)
+}
+
+// SetTags replaces the tags of one registry. Other registries keep theirs.
+func (r *Registries) SetTags(registry string, tags map[string][]int32) {
+	sorted := make(map[string][]int32, len(tags))
+	for name, ids := range tags {
+		ids = slices.Clone(ids)
+		slices.Sort(ids)
+		sorted[trimMinecraftNamespace(name)] = slices.Compact(ids)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tags[trimMinecraftNamespace(registry)] = sorted
+}
+
+// Tag returns the network IDs in a tag, such as the block IDs of
+// "mineable/pickaxe".
+func (r *Registries) Tag(registry, tag string) ([]int32, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids, ok := r.tags[trimMinecraftNamespace(registry)][trimMinecraftNamespace(tag)]
+	return slices.Clone(ids), ok
+}
+
+// HasTag reports whether the registry entry with a network ID is in a tag.
+func (r *Registries) HasTag(registry, tag string, id int32) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, found := slices.BinarySearch(r.tags[trimMinecraftNamespace(registry)][trimMinecraftNamespace(tag)], id)
+	return found
+}
+
+// TagsOf returns the sorted names of the tags a registry entry is in.
+func (r *Registries) TagsOf(registry string, id int32) []string {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var names []string
+	for name, ids := range r.tags[trimMinecraftNamespace(registry)] {
+		if _, found := slices.BinarySearch(ids, id); found {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// SetRegistries makes block tag lookups use r.
+func (bs *BlockStore) SetRegistries(r *Registries) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	bs.registries = r
+}
+
+// BlockHasTag reports whether the block of a state is in a block tag, such
+// as "logs" or "mineable/pickaxe". It is false until the server sends tags.
+func (bs *BlockStore) BlockHasTag(stateID int32, tag string) bool {
+	blockID, ok := bs.states.BlockID(stateID)
+	if !ok {
+		return false
+	}
+	bs.mu.RLock()
+	registries := bs.registries
+	bs.mu.RUnlock()
+	return registries.HasTag(RegistryBlock, tag, blockID)
+}
+
+// BlockTags returns the sorted block tags of a state's block.
+func (bs *BlockStore) BlockTags(stateID int32) []string {
+	blockID, ok := bs.states.BlockID(stateID)
+	if !ok {
+		return nil
+	}
+	bs.mu.RLock()
+	registries := bs.registries
+	bs.mu.RUnlock()
+	return registries.TagsOf(RegistryBlock, blockID)
+}
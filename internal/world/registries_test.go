@@ -0,0 +1,78 @@
+package world
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegistriesDimensionBounds(t *testing.T) {
+	r := NewRegistries()
+	r.SetRegistry("minecraft:dimension_type", []string{"minecraft:overworld", "custom:deep"})
+	r.SetDimensionType("custom:deep", DimensionBounds{MinY: -128, Height: 512})
+
+	if got, ok := r.DimensionBounds(1, "custom:mines"); !ok || got != (DimensionBounds{MinY: -128, Height: 512}) {
+		t.Fatalf("DimensionBounds(custom type) = %+v, %v", got, ok)
+	}
+	// The overworld entry came without data, so the vanilla bounds apply.
+	if got, ok := r.DimensionBounds(0, DimensionOverworld); !ok || got != (DimensionBounds{MinY: -64, Height: 384}) {
+		t.Fatalf("DimensionBounds(overworld) = %+v, %v", got, ok)
+	}
+	if _, ok := r.DimensionBounds(5, "custom:mines"); ok {
+		t.Fatal("unknown type of a custom dimension should have no bounds")
+	}
+	var nilRegistries *Registries
+	if _, ok := nilRegistries.DimensionBounds(0, DimensionNether); !ok {
+		t.Fatal("nil registries should fall back to vanilla bounds")
+	}
+
+	if name, ok := r.EntryName("dimension_type", 1); !ok || name != "custom:deep" {
+		t.Fatalf("EntryName = %q, %v", name, ok)
+	}
+}
+
+func TestRegistriesTags(t *testing.T) {
+	r := NewRegistries()
+	r.SetTags("minecraft:block", map[string][]int32{
+		"minecraft:logs":             {49, 47, 49},
+		"minecraft:mineable/axe":     {49},
+		"minecraft:mineable/pickaxe": {1},
+	})
+	r.SetTags("minecraft:item", map[string][]int32{"minecraft:logs": {130}})
+
+	if ids, ok := r.Tag("block", "logs"); !ok || !reflect.DeepEqual(ids, []int32{47, 49}) {
+		t.Fatalf("Tag(logs) = %v, %v, want sorted unique IDs", ids, ok)
+	}
+	if !r.HasTag("minecraft:block", "mineable/pickaxe", 1) || r.HasTag("block", "mineable/pickaxe", 49) {
+		t.Fatal("HasTag(mineable/pickaxe) mismatch")
+	}
+	if got := r.TagsOf("block", 49); !reflect.DeepEqual(got, []string{"logs", "mineable/axe"}) {
+		t.Fatalf("TagsOf(49) = %v", got)
+	}
+
+	// A later update replaces only the registries it names.
+	r.SetTags("block", map[string][]int32{"logs": {50}})
+	if r.HasTag("block", "logs", 49) || !r.HasTag("block", "logs", 50) || !r.HasTag("item", "logs", 130) {
+		t.Fatal("SetTags should replace one registry's tags")
+	}
+}
+
+func TestBlockStoreBlockTags(t *testing.T) {
+	store, err := NewBlockStore()
+	if err != nil {
+		t.Fatalf("NewBlockStore failed: %v", err)
+	}
+	const oakLogState = 137
+	if store.BlockHasTag(oakLogState, "logs") {
+		t.Fatal("BlockHasTag should be false before tags arrive")
+	}
+	r := NewRegistries()
+	r.SetTags(RegistryBlock, map[string][]int32{"logs": {49}, "mineable/axe": {49}, "mineable/pickaxe": {1}})
+	store.SetRegistries(r)
+
+	if !store.BlockHasTag(oakLogState, "minecraft:logs") || store.BlockHasTag(oakLogState, "mineable/pickaxe") {
+		t.Fatal("oak_log tags mismatch")
+	}
+	if got := store.BlockTags(1); !reflect.DeepEqual(got, []string{"mineable/pickaxe"}) {
+		t.Fatalf("BlockTags(stone) = %v", got)
+	}
+}
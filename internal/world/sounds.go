@@ -0,0 +1,147 @@
+package world
+
+import (
+	"math"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// SoundMemoryTTL is how long a heard sound is remembered.
+	SoundMemoryTTL = 30 * time.Second
+	maxSounds      = 64
+)
+
+// Sound is a sound the bot heard recently. Repeats of the same sound in the
+// same category from the same block merge into one entry.
+type Sound struct {
+	// Seq increases with every sound heard, repeats included, so consumers
+	// can tell new sounds from ones they have already seen.
+	Seq uint64
+	// Name is the sound event without the minecraft: prefix, such as
+	// "entity.creeper.primed".
+	Name string
+	// Category is the sound source: "hostile", "block", "weather" and so on.
+	Category string
+	X        float64
+	Y        float64
+	Z        float64
+	// EntityID is the entity the sound follows, zero for sounds played at a
+	// fixed position.
+	EntityID   int32
+	Volume     float32
+	Count      int
+	FirstHeard time.Time
+	LastHeard  time.Time
+}
+
+type soundKey struct {
+	pos      BlockPos
+	category string
+	name     string
+}
+
+func (s Sound) key() soundKey {
+	return soundKey{
+		pos:      BlockPos{X: int(math.Floor(s.X)), Y: int(math.Floor(s.Y)), Z: int(math.Floor(s.Z))},
+		category: s.Category,
+		name:     s.Name,
+	}
+}
+
+// SoundName returns the name of a sound event ID as sent in the sound
+// packets, using the one-based IDs of sounds.json. Without active GameData
+// the bundled 1.21.11 sounds.json is loaded on first use; a version without
+// sounds.json names no sounds rather than borrowing 1.21.11's IDs.
+func SoundName(soundID int32) string {
+	if d := activeGameData.Load(); d != nil {
+		return d.SoundName(soundID)
+	}
+	names := defaultSoundNames()
+	if soundID < 0 || int(soundID) >= len(names) {
+		return ""
+	}
+	return names[soundID]
+}
+
+var defaultSoundNames = sync.OnceValue(func() []string {
+	names, _, err := loadRegistryNames(filepath.Join(filepath.Dir(defaultBlocksJSONPath()), "sounds.json"))
+	if err != nil {
+		return nil
+	}
+	return names
+})
+
+// RecordSound remembers a sound heard at a position.
+func (ws *WorldState) RecordSound(name, category string, x, y, z float64, volume float32) Sound {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return ws.recordSoundLocked(Sound{Name: trimMinecraftNamespace(name), Category: category, X: x, Y: y, Z: z, Volume: volume})
+}
+
+// RecordEntitySound remembers a sound that follows an entity, heard where the
+// entity is now. ok is false when the entity is not tracked.
+func (ws *WorldState) RecordEntitySound(name, category string, entityID int32, volume float32) (Sound, bool) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	e := ws.entities[entityID]
+	if e == nil {
+		return Sound{}, false
+	}
+	return ws.recordSoundLocked(Sound{
+		Name:     trimMinecraftNamespace(name),
+		Category: category,
+		X:        e.X,
+		Y:        e.Y,
+		Z:        e.Z,
+		EntityID: entityID,
+		Volume:   volume,
+	}), true
+}
+
+func (ws *WorldState) recordSoundLocked(sound Sound) Sound {
+	now := time.Now()
+	ws.sounds = slices.DeleteFunc(ws.sounds, func(s Sound) bool {
+		return now.Sub(s.LastHeard) > SoundMemoryTTL
+	})
+	ws.soundSeq++
+	sound.Seq = ws.soundSeq
+	sound.Count = 1
+	sound.FirstHeard, sound.LastHeard = now, now
+	key := sound.key()
+	if i := slices.IndexFunc(ws.sounds, func(s Sound) bool { return s.key() == key }); i >= 0 {
+		sound.Count += ws.sounds[i].Count
+		sound.FirstHeard = ws.sounds[i].FirstHeard
+		ws.sounds = slices.Delete(ws.sounds, i, i+1)
+	}
+	ws.sounds = append(ws.sounds, sound)
+	if len(ws.sounds) > maxSounds {
+		ws.sounds = slices.Delete(ws.sounds, 0, len(ws.sounds)-maxSounds)
+	}
+	return sound
+}
+
+// ClearSounds forgets all sounds, for example after changing dimension.
+func (ws *WorldState) ClearSounds() {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.sounds = nil
+}
+
+// recentSoundsLocked copies the sounds heard within SoundMemoryTTL, oldest
+// first.
+func (ws *WorldState) recentSoundsLocked() []Sound {
+	if len(ws.sounds) == 0 {
+		return nil
+	}
+	now := time.Now()
+	sounds := make([]Sound, 0, len(ws.sounds))
+	for _, s := range ws.sounds {
+		if now.Sub(s.LastHeard) <= SoundMemoryTTL {
+			sounds = append(sounds, s)
+		}
+	}
+	return sounds
+}
@@ -0,0 +1,58 @@
+package world
+
+import "testing"
+
+func TestSoundName(t *testing.T) {
+	if got := SoundName(451); got != "entity.creeper.primed" {
+		t.Fatalf("SoundName(451) = %q, want entity.creeper.primed", got)
+	}
+	if got := SoundName(0); got != "" {
+		t.Fatalf("SoundName(0) = %q, want empty for the inline marker", got)
+	}
+}
+
+func TestRecordSoundMergesRepeats(t *testing.T) {
+	ws := &WorldState{}
+	first := ws.RecordSound("minecraft:entity.zombie.ambient", "hostile", 10.2, 64, -3.5, 1)
+	ws.RecordSound("block.wooden_door.open", "block", 2, 64, 2, 1)
+	repeat := ws.RecordSound("entity.zombie.ambient", "hostile", 10.8, 64.5, -3.1, 1)
+
+	if repeat.Count != 2 || repeat.Seq <= first.Seq || !repeat.FirstHeard.Equal(first.FirstHeard) {
+		t.Fatalf("repeat = %+v, want count 2 merged with %+v", repeat, first)
+	}
+	sounds := ws.GetState().Sounds
+	if len(sounds) != 2 {
+		t.Fatalf("len(Sounds) = %d, want 2", len(sounds))
+	}
+	if sounds[0].Name != "block.wooden_door.open" || sounds[1].Name != "entity.zombie.ambient" {
+		t.Fatalf("Sounds = %+v, want door then the repeated zombie", sounds)
+	}
+
+	ws.ClearSounds()
+	if sounds := ws.GetState().Sounds; len(sounds) != 0 {
+		t.Fatalf("Sounds after ClearSounds = %+v", sounds)
+	}
+}
+
+func TestRecordEntitySound(t *testing.T) {
+	ws := &WorldState{}
+	if _, ok := ws.RecordEntitySound("entity.creeper.primed", "hostile", 7, 1); ok {
+		t.Fatal("RecordEntitySound should fail for an unknown entity")
+	}
+	ws.AddEntity(Entity{EntityID: 7, Type: 32, X: 3, Y: 70, Z: -4})
+	sound, ok := ws.RecordEntitySound("entity.creeper.primed", "hostile", 7, 1)
+	if !ok || sound.EntityID != 7 || sound.X != 3 || sound.Y != 70 || sound.Z != -4 {
+		t.Fatalf("RecordEntitySound = %+v, %v, want the creeper's position", sound, ok)
+	}
+}
+
+func TestRecordSoundKeepsNewest(t *testing.T) {
+	ws := &WorldState{}
+	for i := 0; i < maxSounds+5; i++ {
+		ws.RecordSound("block.stone.step", "block", float64(i), 64, 0, 1)
+	}
+	sounds := ws.GetState().Sounds
+	if len(sounds) != maxSounds || sounds[0].X != 5 {
+		t.Fatalf("kept %d sounds starting at x=%v, want %d starting at 5", len(sounds), sounds[0].X, maxSounds)
+	}
+}
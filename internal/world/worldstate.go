@@ -5,6 +5,7 @@ import (
 	"math"
 	"strings"
 	"sync"
+	"time"
 )
 
 type WorldState struct {
@@ -19,7 +20,23 @@ type WorldState struct {
 	playerList       []Player
 	entities         map[int32]*Entity
 	pendingItemNames map[int32]string
-	mu               sync.RWMutex
+	gameMode         string
+	experience       Experience
+	abilities        Abilities
+	effects          map[int32]ActiveEffect
+	lastDeath        *DeathLocation
+	damageTypes      []string
+	lastDamage       *DamageSource
+	damageSeq        uint64
+	weather          Weather
+	border           WorldBorder
+	// borderResizeStart and borderResizeDuration time the resize towards
+	// border.TargetDiameter.
+	borderResizeStart    time.Time
+	borderResizeDuration time.Duration
+	sounds               []Sound
+	soundSeq             uint64
+	mu                   sync.RWMutex
 }
 
 type Entity struct {
@@ -30,6 +47,55 @@ type Entity struct {
 	Y        float64
 	Z        float64
 	ItemName string
+
+	CustomName string
+	// Health and MaxHealth are zero until the server sends them; only living
+	// entities have either.
+	Health    float32
+	MaxHealth float32
+	Pose      string
+	OnFire    bool
+	Baby      bool
+	MainHand  string
+	OffHand   string
+	// Armor holds the item names worn in the feet, legs, chest and head
+	// slots, in EquipmentSlot order starting at EquipmentFeet.
+	Armor [4]string
+	// Velocity in blocks per tick.
+	VelX    float64
+	VelY    float64
+	VelZ    float64
+	HeadYaw float32
+}
+
+// EquipmentSlot numbers follow the Set Equipment packet.
+type EquipmentSlot int8
+
+const (
+	EquipmentMainHand EquipmentSlot = iota
+	EquipmentOffHand
+	EquipmentFeet
+	EquipmentLegs
+	EquipmentChest
+	EquipmentHead
+)
+
+// Shared entity flags, metadata index 0.
+const (
+	EntityFlagOnFire    byte = 0x01
+	EntityFlagCrouching byte = 0x02
+	EntityFlagInvisible byte = 0x20
+	EntityFlagGlowing   byte = 0x40
+)
+
+// EntityMetadata is the part of an entity's metadata that the world tracks.
+// Nil fields were not in the update and keep their previous value.
+type EntityMetadata struct {
+	Flags      *byte
+	CustomName *string
+	Pose       *string
+	Health     *float32
+	Baby       *bool
 }
 
 type Snapshot struct {
@@ -43,6 +109,19 @@ type Snapshot struct {
 	ViewCenterChunkZ   int32
 	PlayerList         []Player
 	Entities           []Entity
+	GameMode           string
+	Experience         Experience
+	Abilities          Abilities
+	// Effects are the bot's active status effects, ordered by ID.
+	Effects []ActiveEffect
+	// LastDeath is where the bot last died, or nil if it is not known.
+	LastDeath *DeathLocation
+	// LastDamage is the last hit the bot took, or nil.
+	LastDamage  *DamageSource
+	Weather     Weather
+	WorldBorder WorldBorder
+	// Sounds are the sounds heard within SoundMemoryTTL, oldest first.
+	Sounds []Sound
 }
 
 func (s Snapshot) String() string {
@@ -100,8 +179,16 @@ func (ws *WorldState) GetState() Snapshot {
 	ws.mu.RLock()
 	defer ws.mu.RUnlock()
 	entities := make([]Entity, 0, len(ws.entities))
+	entityByUUID := make(map[string]int32, len(ws.entities))
 	for _, e := range ws.entities {
 		entities = append(entities, *e)
+		if e.UUID != "" {
+			entityByUUID[e.UUID] = e.EntityID
+		}
+	}
+	players := append([]Player(nil), ws.playerList...)
+	for i := range players {
+		players[i].EntityID = entityByUUID[players[i].UUID]
 	}
 	return Snapshot{
 		Position:           ws.position,
@@ -112,8 +199,17 @@ func (ws *WorldState) GetState() Snapshot {
 		SimulationDistance: ws.simulationDist,
 		ViewCenterChunkX:   ws.viewCenterChunkX,
 		ViewCenterChunkZ:   ws.viewCenterChunkZ,
-		PlayerList:         append([]Player(nil), ws.playerList...),
+		PlayerList:         players,
 		Entities:           entities,
+		GameMode:           ws.gameMode,
+		Experience:         ws.experience,
+		Abilities:          ws.abilities,
+		Effects:            ws.sortedEffects(),
+		LastDeath:          ws.copyLastDeath(),
+		LastDamage:         ws.copyLastDamage(),
+		Weather:            ws.weather,
+		WorldBorder:        ws.currentBorderLocked(time.Now()),
+		Sounds:             ws.recentSoundsLocked(),
 	}
 }
 
@@ -163,8 +259,46 @@ func (ws *WorldState) UpdateViewCenter(chunkX, chunkZ int32) {
 }
 
 type Player struct {
-	Name string
-	UUID string
+	Name        string
+	UUID        string
+	GameMode    string
+	Listed      bool
+	Latency     int32
+	DisplayName string
+	// EntityID is the player's entity while it is in view, 0 otherwise.
+	// Only snapshots fill it in.
+	EntityID int32
+}
+
+// PlayerUpdate is one player entry of a Player Info Update. Nil fields were
+// not part of the update.
+type PlayerUpdate struct {
+	UUID        string
+	Name        *string
+	GameMode    *string
+	Listed      *bool
+	Latency     *int32
+	DisplayName *string
+}
+
+var gameModeNames = [...]string{"survival", "creative", "adventure", "spectator"}
+
+// GameModeName returns the name of a protocol game mode ID.
+func GameModeName(id int32) string {
+	if id < 0 || int(id) >= len(gameModeNames) {
+		return fmt.Sprintf("unknown(%d)", id)
+	}
+	return gameModeNames[id]
+}
+
+// PlayerByName finds a listed player by name, ignoring case.
+func (s Snapshot) PlayerByName(name string) (Player, bool) {
+	for _, p := range s.PlayerList {
+		if strings.EqualFold(p.Name, name) {
+			return p, true
+		}
+	}
+	return Player{}, false
 }
 
 func (ws *WorldState) AddPlayer(players []Player) {
@@ -190,6 +324,58 @@ func (ws *WorldState) AddPlayer(players []Player) {
 	}
 }
 
+// UpdatePlayers applies Player Info Update entries. An entry with a Name adds
+// the player; other entries only touch players already in the list.
+func (ws *WorldState) UpdatePlayers(updates []PlayerUpdate) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	for _, u := range updates {
+		idx := -1
+		for i, player := range ws.playerList {
+			if player.UUID == u.UUID {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			if u.Name == nil {
+				continue
+			}
+			ws.playerList = append(ws.playerList, Player{UUID: u.UUID})
+			idx = len(ws.playerList) - 1
+		}
+		p := &ws.playerList[idx]
+		if u.Name != nil {
+			p.Name = *u.Name
+		}
+		if u.GameMode != nil {
+			p.GameMode = *u.GameMode
+		}
+		if u.Listed != nil {
+			p.Listed = *u.Listed
+		}
+		if u.Latency != nil {
+			p.Latency = *u.Latency
+		}
+		if u.DisplayName != nil {
+			p.DisplayName = *u.DisplayName
+		}
+	}
+}
+
+// PlayerEntityID returns the entity ID of the player with uuid while its
+// entity is in view.
+func (ws *WorldState) PlayerEntityID(uuid string) (int32, bool) {
+	ws.mu.RLock()
+	defer ws.mu.RUnlock()
+	for _, e := range ws.entities {
+		if e.UUID == uuid {
+			return e.EntityID, true
+		}
+	}
+	return 0, false
+}
+
 func (ws *WorldState) RemovePlayer(uuid string) {
 	ws.mu.Lock()
 	defer ws.mu.Unlock()
@@ -278,3 +464,82 @@ func (ws *WorldState) UpdateEntityItemName(entityID int32, itemName string) {
 	}
 	ws.pendingItemNames[entityID] = itemName
 }
+
+// EntityType returns the type of a tracked entity.
+func (ws *WorldState) EntityType(entityID int32) (int32, bool) {
+	ws.mu.RLock()
+	defer ws.mu.RUnlock()
+	if e, ok := ws.entities[entityID]; ok {
+		return e.Type, true
+	}
+	return 0, false
+}
+
+func (ws *WorldState) UpdateEntityMetadata(entityID int32, m EntityMetadata) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	e, ok := ws.entities[entityID]
+	if !ok {
+		return
+	}
+	if m.Flags != nil {
+		e.OnFire = *m.Flags&EntityFlagOnFire != 0
+	}
+	if m.CustomName != nil {
+		e.CustomName = *m.CustomName
+	}
+	if m.Pose != nil {
+		e.Pose = *m.Pose
+	}
+	if m.Health != nil {
+		e.Health = *m.Health
+	}
+	if m.Baby != nil {
+		e.Baby = *m.Baby
+	}
+}
+
+// UpdateEntityEquipment sets the item in one equipment slot; an empty name
+// clears it. Body and saddle slots are ignored.
+func (ws *WorldState) UpdateEntityEquipment(entityID int32, slot EquipmentSlot, itemName string) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	e, ok := ws.entities[entityID]
+	if !ok {
+		return
+	}
+	switch {
+	case slot == EquipmentMainHand:
+		e.MainHand = itemName
+	case slot == EquipmentOffHand:
+		e.OffHand = itemName
+	case slot >= EquipmentFeet && slot <= EquipmentHead:
+		e.Armor[slot-EquipmentFeet] = itemName
+	}
+}
+
+func (ws *WorldState) UpdateEntityVelocity(entityID int32, vx, vy, vz float64) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if e, ok := ws.entities[entityID]; ok {
+		e.VelX = vx
+		e.VelY = vy
+		e.VelZ = vz
+	}
+}
+
+func (ws *WorldState) UpdateEntityHeadYaw(entityID int32, headYaw float32) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if e, ok := ws.entities[entityID]; ok {
+		e.HeadYaw = headYaw
+	}
+}
+
+func (ws *WorldState) UpdateEntityMaxHealth(entityID int32, maxHealth float32) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if e, ok := ws.entities[entityID]; ok {
+		e.MaxHealth = maxHealth
+	}
+}
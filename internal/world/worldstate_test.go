@@ -118,3 +118,74 @@ func TestClearEntitiesAlsoClearsPendingMetadata(t *testing.T) {
 		t.Fatalf("snapshot.Entities[0].ItemName = %q, want empty", snapshot.Entities[0].ItemName)
 	}
 }
+
+func TestUpdateEntityMetadataEquipmentAndVelocity(t *testing.T) {
+	ws := &WorldState{}
+	ws.AddEntity(Entity{EntityID: 7, Type: 150})
+
+	flags := EntityFlagOnFire | EntityFlagCrouching
+	name, pose, health, baby := "Bob", "crouching", float32(12), true
+	ws.UpdateEntityMetadata(7, EntityMetadata{Flags: &flags, CustomName: &name, Pose: &pose, Health: &health, Baby: &baby})
+	// A later partial update keeps the other fields.
+	health = 8
+	ws.UpdateEntityMetadata(7, EntityMetadata{Health: &health})
+	ws.UpdateEntityEquipment(7, EquipmentMainHand, "Iron Sword")
+	ws.UpdateEntityEquipment(7, EquipmentHead, "Iron Helmet")
+	ws.UpdateEntityVelocity(7, 0.1, -0.08, 0)
+	ws.UpdateEntityHeadYaw(7, 90)
+	ws.UpdateEntityMaxHealth(7, 20)
+	ws.UpdateEntityMetadata(8, EntityMetadata{Health: &health})
+
+	snapshot := ws.GetState()
+	if len(snapshot.Entities) != 1 {
+		t.Fatalf("len(snapshot.Entities) = %d, want 1", len(snapshot.Entities))
+	}
+	e := snapshot.Entities[0]
+	if !e.OnFire || !e.Baby || e.CustomName != "Bob" || e.Pose != "crouching" || e.Health != 8 || e.MaxHealth != 20 {
+		t.Fatalf("metadata not applied: %+v", e)
+	}
+	if e.MainHand != "Iron Sword" || e.Armor[EquipmentHead-EquipmentFeet] != "Iron Helmet" {
+		t.Fatalf("equipment not applied: %+v", e)
+	}
+	if e.VelX != 0.1 || e.VelY != -0.08 || e.HeadYaw != 90 {
+		t.Fatalf("motion not applied: %+v", e)
+	}
+	if typeID, ok := ws.EntityType(7); !ok || typeID != 150 {
+		t.Fatalf("EntityType(7) = %d, %v, want 150", typeID, ok)
+	}
+}
+
+func TestUpdatePlayersKeepsFieldsAndLinksEntity(t *testing.T) {
+	ws := &WorldState{}
+	name, mode, listed := "Alex", GameModeName(0), true
+	ws.UpdatePlayers([]PlayerUpdate{{UUID: "u1", Name: &name, GameMode: &mode, Listed: &listed}})
+
+	// Later updates only carry the changed fields; unknown UUIDs are ignored.
+	latency, display := int32(42), "[Admin] Alex"
+	mode = GameModeName(1)
+	ws.UpdatePlayers([]PlayerUpdate{
+		{UUID: "u1", Latency: &latency, DisplayName: &display, GameMode: &mode},
+		{UUID: "u2", Latency: &latency},
+	})
+	ws.AddEntity(Entity{EntityID: 9, UUID: "u1", Type: 155})
+
+	snapshot := ws.GetState()
+	if len(snapshot.PlayerList) != 1 {
+		t.Fatalf("len(snapshot.PlayerList) = %d, want 1", len(snapshot.PlayerList))
+	}
+	want := Player{Name: "Alex", UUID: "u1", GameMode: "creative", Listed: true, Latency: 42, DisplayName: "[Admin] Alex", EntityID: 9}
+	if snapshot.PlayerList[0] != want {
+		t.Fatalf("player = %+v, want %+v", snapshot.PlayerList[0], want)
+	}
+	if p, ok := snapshot.PlayerByName("alex"); !ok || p.EntityID != 9 {
+		t.Fatalf("PlayerByName(alex) = %+v, %v, want entity 9", p, ok)
+	}
+	if id, ok := ws.PlayerEntityID("u1"); !ok || id != 9 {
+		t.Fatalf("PlayerEntityID(u1) = %d, %v, want 9", id, ok)
+	}
+
+	ws.RemoveEntities([]int32{9})
+	if p, _ := ws.GetState().PlayerByName("Alex"); p.EntityID != 0 {
+		t.Fatalf("EntityID = %d after the entity left view, want 0", p.EntityID)
+	}
+}